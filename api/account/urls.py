@@ -3,5 +3,14 @@ from . import views
 
 app_name='account'
 urlpatterns=[
-    path('', views.AccountView.as_view(), name='account')
+    path('', views.AccountView.as_view(), name='account'),
+    path('devices/', views.DevicesView.as_view(), name='devices'),
+    path('preferences/', views.UiPreferencesView.as_view(), name='ui_preferences'),
+    path('notifications/', views.NotificationPreferencesView.as_view(), name='notification_preferences'),
+    path('devices/<int:id>/', views.DeviceView.as_view(), name='device'),
+    path('dns-credentials/', views.DnsCredentialsView.as_view(), name='dns_credentials'),
+    path('dns-credentials/<int:id>/', views.DnsCredentialView.as_view(), name='dns_credential'),
+    path('channels/', views.NotificationChannelsView.as_view(), name='notification_channels'),
+    path('channels/<int:id>/', views.NotificationChannelView.as_view(), name='notification_channel'),
+    path('actions/', views.ActionsView.as_view(), name='actions')
 ]
\ No newline at end of file
@@ -1,6 +1,26 @@
 from rest_framework.views import APIView
 from rest_framework.response import Response
 from rest_framework import status
+from datetime import datetime
+from core.models import (
+    NotificationPreference, DnsCredential, DNS_PROVIDER_CHOICES, UI_THEME_CHOICES, UI_DENSITY_CHOICES,
+    UI_LANDING_PAGE_CHOICES, NotificationChannel, NOTIFICATION_CHANNEL_CHOICES
+)
+from core.utils.notifications import EVENTS, get_preferences, is_public_url, post_in_background
+from core.utils import actions
+from api.websites.services.dns_providers import PROVIDER_KEYS, PROVIDERS
+
+
+# The UI preferences and the values each one accepts
+UI_PREFERENCES = {
+    'theme': ('ui_theme', UI_THEME_CHOICES),
+    'table_density': ('ui_density', UI_DENSITY_CHOICES),
+    'landing_page': ('ui_landing_page', UI_LANDING_PAGE_CHOICES),
+}
+
+
+def ui_preferences(user) -> dict:
+    return {key: getattr(user, field) for key, (field, choices) in UI_PREFERENCES.items()}
 
 
 class AccountView(APIView):
@@ -13,7 +33,329 @@ class AccountView(APIView):
         user = request.user
         result = {
             'username': user.username,
-            'is_root': user.is_superuser
+            'is_root': user.is_superuser,
+            'preferences': ui_preferences(user)
         }
         response = Response(result, status=status.HTTP_200_OK)
-        return response
\ No newline at end of file
+        return response
+
+
+class DevicesView(APIView):
+    """Devices View
+    
+    Lists the devices the user has signed in from. The device of the current session is marked.
+    """
+    http_method_names = ['get']
+    
+    def get(self, request, *args, **kw):
+        current = request.session.get('device_id')
+        devices = request.user.devices.filter(revoked=False).order_by('-last_seen')
+        return Response({
+            'devices': [{
+                'id': device.id,
+                'label': device.label,
+                'user_agent': device.user_agent,
+                'ip_addr': device.ip_addr,
+                'last_seen': device.last_seen,
+                'created': device.created,
+                'current': device.id == current
+            } for device in devices]
+        })
+
+
+class DeviceView(APIView):
+    """Label or revoke a device. Sessions from a revoked device are ended."""
+    http_method_names = ['post', 'delete']
+    
+    def get_device(self, request, device_id):
+        return request.user.devices.filter(id=device_id, revoked=False).first()
+    
+    def post(self, request, *args, **kwargs):
+        device = self.get_device(request, kwargs.get('id'))
+        if not device:
+            return Response({
+                'message': 'Target device was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        label = request.POST.get('label', '').strip()
+        if len(label) > 50:
+            return Response({
+                'errors': {'label': ['The label cannot be longer than 50 characters.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        device.label = label or None
+        device.save()
+        return Response({
+            'message': 'The device has been updated.'
+        })
+    
+    def delete(self, request, *args, **kwargs):
+        device = self.get_device(request, kwargs.get('id'))
+        if not device:
+            return Response({
+                'message': 'Target device was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        device.revoked = True
+        device.save()
+        return Response({
+            'message': 'The device has been revoked.'
+        })
+
+
+class NotificationPreferencesView(APIView):
+    """Notification Preferences View
+    
+    Returns or updates the channels the user wants to be notified through per event, and the quiet hours
+    during which no notification emails are sent.
+    """
+    http_method_names = ['get', 'post']
+    
+    def preferences(self, user):
+        return {
+            'events': EVENTS,
+            'preferences': get_preferences(user),
+            'quiet_hours_start': user.quiet_hours_start.strftime('%H:%M') if user.quiet_hours_start else None,
+            'quiet_hours_end': user.quiet_hours_end.strftime('%H:%M') if user.quiet_hours_end else None
+        }
+    
+    def get(self, request, *args, **kw):
+        return Response(self.preferences(request.user))
+    
+    def post(self, request, *args, **kw):
+        user = request.user
+        preferences = request.data.get('preferences') or {}
+        errors = {}
+        if not isinstance(preferences, dict) or any(event not in EVENTS for event in preferences):
+            errors['preferences'] = ['Preferences should be keyed by a valid event.']
+        
+        quiet_hours = {}
+        for key in ['quiet_hours_start', 'quiet_hours_end']:
+            value = request.data.get(key)
+            try:
+                quiet_hours[key] = datetime.strptime(value, '%H:%M').time() if value else None
+            except (TypeError, ValueError):
+                errors[key] = ['The time should be in HH:MM format.']
+        
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        for event, channels in preferences.items():
+            channels = channels if isinstance(channels, dict) else {}
+            NotificationPreference.objects.update_or_create(user=user, event=event, defaults={
+                'panel': bool(channels.get('panel')),
+                'email': bool(channels.get('email'))
+            })
+        
+        user.quiet_hours_start = quiet_hours.get('quiet_hours_start')
+        user.quiet_hours_end = quiet_hours.get('quiet_hours_end')
+        user.save()
+        return Response(self.preferences(user))
+
+
+class UiPreferencesView(APIView):
+    """UI Preferences View
+    
+    Returns or updates the UI preferences of the user, like the theme, so they follow the user across
+    browsers. Only the posted preferences are updated.
+    """
+    http_method_names = ['get', 'post']
+    
+    def get(self, request, *args, **kw):
+        return Response({
+            'preferences': ui_preferences(request.user),
+            'choices': {key: dict(choices) for key, (field, choices) in UI_PREFERENCES.items()}
+        })
+    
+    def post(self, request, *args, **kw):
+        user = request.user
+        errors = {}
+        for key, (field, choices) in UI_PREFERENCES.items():
+            value = request.data.get(key)
+            if value is None:
+                continue
+            if value not in dict(choices):
+                errors[key] = [f'{key} should be one of {", ".join(dict(choices))}.']
+            else:
+                setattr(user, field, value)
+        
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        user.save()
+        return Response({'preferences': ui_preferences(user)})
+
+
+class DnsCredentialsView(APIView):
+    """DNS Credentials View
+    
+    Lists or saves the DNS provider API credentials of the user, used to get the wildcard SSL certificates
+    through DNS challenges. The credentials are verified with the provider before saving and they are
+    never returned back.
+    """
+    http_method_names = ['get', 'post']
+    
+    def get(self, request, *args, **kw):
+        return Response({
+            'providers': [{'name': name, 'label': label, 'keys': PROVIDER_KEYS.get(name)} for name, label in DNS_PROVIDER_CHOICES],
+            'credentials': [{
+                'id': c.id,
+                'provider': c.provider,
+                'label': c.label,
+                'websites': list(c.websites.values_list('label', flat=True)),
+                'created': c.created
+            } for c in request.user.dns_credentials.order_by('-created')]
+        })
+    
+    def post(self, request, *args, **kw):
+        provider = request.data.get('provider')
+        label = (request.data.get('label') or '').strip()
+        errors = {}
+        if provider not in PROVIDERS:
+            errors['provider'] = ['Select a valid DNS provider.']
+        if not label:
+            errors['label'] = ['A label is required.']
+        
+        credentials = {key: (request.data.get(key) or '').strip() for key in PROVIDER_KEYS.get(provider, [])}
+        for key, value in credentials.items():
+            if not value:
+                errors[key] = ['This field is required.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        try:
+            zones = PROVIDERS.get(provider)(credentials).zones()
+        except Exception as e:
+            return Response({
+                'errors': {'provider': [f'The credentials cannot be verified: {e}']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        credential = DnsCredential(user=request.user, provider=provider, label=label)
+        credential.set_credentials(credentials)
+        credential.save()
+        return Response({
+            'message': 'The DNS credentials have been saved.',
+            'id': credential.id,
+            'zones': zones
+        })
+
+
+class DnsCredentialView(APIView):
+    """DNS Credential View
+    
+    Deletes a DNS credential of the user. The websites using it go back to the HTTP challenge.
+    """
+    http_method_names = ['delete']
+    
+    def delete(self, request, *args, **kw):
+        credential = request.user.dns_credentials.filter(id=kw.get('id')).first()
+        if not credential:
+            return Response({
+                'message': 'The DNS credential was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        credential.delete()
+        return Response({'message': 'The DNS credential has been deleted.'})
+
+
+class NotificationChannelsView(APIView):
+    """Notification Channels View
+    
+    Lists or adds the webhook, Slack and Discord channels of the user. The notifications of the selected
+    events, or of all events if none are selected, are posted to the channel URL.
+    """
+    http_method_names = ['get', 'post']
+    
+    def get(self, request, *args, **kw):
+        return Response({
+            'kinds': [{'name': name, 'label': label} for name, label in NOTIFICATION_CHANNEL_CHOICES],
+            'events': [{'name': name, 'label': label} for name, label in EVENTS.items()],
+            'channels': [{
+                'id': c.id,
+                'kind': c.kind,
+                'url': c.url,
+                'events': c.events.split(',') if c.events else [],
+                'created': c.created
+            } for c in request.user.notification_channels.order_by('-created')]
+        })
+    
+    def post(self, request, *args, **kw):
+        kind = request.data.get('kind')
+        url = (request.data.get('url') or '').strip()
+        events = request.data.get('events') or []
+        if isinstance(events, str):
+            events = [e.strip() for e in events.split(',') if e.strip()]
+        
+        errors = {}
+        if kind not in dict(NOTIFICATION_CHANNEL_CHOICES):
+            errors['kind'] = ['Select a valid channel type.']
+        if len(url) > 500 or not is_public_url(url):
+            errors['url'] = ['A valid HTTPS URL of a public host is required.']
+        invalid = [e for e in events if e not in EVENTS]
+        if invalid:
+            errors['events'] = [f'Unknown events: {", ".join(invalid)}.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        channel = NotificationChannel.objects.create(user=request.user, kind=kind, url=url, events=','.join(events) or None)
+        return Response({
+            'message': 'The notification channel has been added.',
+            'id': channel.id
+        })
+
+
+class NotificationChannelView(APIView):
+    """Notification Channel View
+    
+    Sends a test notification to a channel of the user, or deletes the channel.
+    """
+    http_method_names = ['post', 'delete']
+    
+    def get_channel(self, request, id):
+        return request.user.notification_channels.filter(id=id).first()
+    
+    def post(self, request, *args, **kw):
+        channel = self.get_channel(request, kw.get('id'))
+        if not channel:
+            return Response({
+                'message': 'The notification channel was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        # The outcome isn't reported back, it would tell what answers at the URL
+        post_in_background(channel, 'Test notification', details='The notification channel is working.')
+        return Response({'message': 'The test notification has been sent, it should show up in the channel shortly.'})
+    
+    def delete(self, request, *args, **kw):
+        channel = self.get_channel(request, kw.get('id'))
+        if not channel:
+            return Response({
+                'message': 'The notification channel was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        channel.delete()
+        return Response({'message': 'The notification channel has been deleted.'})
+
+
+class ActionsView(APIView):
+    """Actions View
+
+    Returns the catalog of the API actions the signed in user can run, with their methods, paths and params,
+    for the command palette of the UI and for scripts that discover what the panel can do. The q param
+    searches the names and the descriptions, the changes param limits the catalog to the actions that
+    change something or to the read only ones.
+    """
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kw):
+        results = actions.available_actions(request.user)
+        changes = request.GET.get('changes')
+        if changes is not None:
+            changes = changes in ['1', 'true']
+            results = [action for action in results if action.get('changes') == changes]
+        q = request.GET.get('q', '').strip().lower()
+        if q:
+            results = [
+                action for action in results
+                if q in action.get('name').lower() or q in (action.get('description') or '').lower()
+            ]
+        return Response({'count': len(results), 'results': results}, status=status.HTTP_200_OK)
@@ -0,0 +1,110 @@
+import json
+from io import BytesIO
+from urllib.parse import urlencode, urlsplit
+from django.conf import settings
+from django.http import HttpRequest, QueryDict
+from django.urls import resolve, Resolver404
+from rest_framework.views import APIView
+from rest_framework.response import Response
+from rest_framework import status
+from core.utils import audit
+
+
+BATCH_METHODS = ['GET', 'POST', 'PUT', 'PATCH', 'DELETE']
+
+
+class BatchView(APIView):
+    """Batch View
+
+    Runs several API requests in one round trip, i.e. to load the system stats, the services and the
+    firewall status together, or to apply a bulk operation to many websites. The requests run in order as
+    the signed in user and each response is returned with its status code, a failing request doesn't stop
+    the others unless stop_on_error is set. The requests that change something are audited one by one,
+    tagged with the request ID of the batch. The streaming endpoints, i.e. the downloads, cannot be batched.
+
+    The body is JSON: {"requests": [{"method": "GET", "path": "/api/stats/"}], "stop_on_error": false}
+    """
+    http_method_names = ['post']
+
+    def subrequest(self, request, method: str, path: str, data: dict) -> HttpRequest:
+        """Builds the request of a batch item, sharing the session of the batch request."""
+        outer = request._request
+        url = urlsplit(path)
+        body = urlencode(data, doseq=True).encode() if method != 'GET' else b''
+
+        inner = HttpRequest()
+        inner.method = method
+        inner.path = url.path
+        inner.path_info = url.path
+        if settings.FORCE_SCRIPT_NAME and url.path.startswith(settings.FORCE_SCRIPT_NAME):
+            inner.path_info = url.path[len(settings.FORCE_SCRIPT_NAME):]
+        inner.META = {k: v for k, v in outer.META.items() if not k.startswith(('CONTENT_', 'wsgi.'))}
+        inner.META.update({
+            'REQUEST_METHOD': method,
+            'PATH_INFO': inner.path_info,
+            'QUERY_STRING': url.query,
+            'CONTENT_TYPE': 'application/x-www-form-urlencoded',
+            'CONTENT_LENGTH': str(len(body))
+        })
+        inner.GET = QueryDict(url.query)
+        inner.COOKIES = outer.COOKIES
+        inner.session = outer.session
+        inner.user = request.user
+        inner._stream = BytesIO(body)
+        inner._read_started = False
+        # The batch request itself passed the CSRF check
+        inner._dont_enforce_csrf_checks = True
+        return inner
+
+    def post(self, request, *args, **kw):
+        try:
+            payload = json.loads(request.body or b'{}')
+            items = payload.get('requests')
+            if not isinstance(items, list) or not items:
+                raise ValueError
+        except (ValueError, AttributeError):
+            return Response({
+                'errors': {'requests': ['Provide the list of requests to run.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        if len(items) > settings.FASTCP_BATCH_MAX_REQUESTS:
+            return Response({
+                'errors': {'requests': [f'A batch can run up to {settings.FASTCP_BATCH_MAX_REQUESTS} requests.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        batch_path = request._request.path_info
+        results = []
+        for item in items:
+            item = item if isinstance(item, dict) else {}
+            method = str(item.get('method', 'GET')).upper()
+            path = str(item.get('path', ''))
+            data = item.get('data') if isinstance(item.get('data'), dict) else {}
+
+            inner = self.subrequest(request, method, path, data)
+            if method not in BATCH_METHODS or not inner.path_info.startswith('/api/') or inner.path_info == batch_path:
+                results.append({'path': path, 'status': 400, 'body': {'message': 'Only the API can be requested in a batch.'}})
+            else:
+                try:
+                    match = resolve(inner.path_info)
+                    response = match.func(inner, *match.args, **match.kwargs)
+                    if hasattr(response, 'render'):
+                        response.render()
+                    if method != 'GET':
+                        audit.record_request(inner, response, match)
+                    if response.streaming:
+                        # Downloads, exports and log streams have no content to return, the file or the
+                        # process behind them is released
+                        response.close()
+                        results.append({'path': path, 'status': 400, 'body': {'message': 'Streaming endpoints cannot be batched.'}})
+                    else:
+                        try:
+                            body = json.loads(response.content) if response.content else None
+                        except ValueError:
+                            body = response.content.decode(errors='replace')
+                        results.append({'path': path, 'status': response.status_code, 'body': body})
+                except Resolver404:
+                    results.append({'path': path, 'status': 404, 'body': {'message': f'{path} was not found.'}})
+
+            if payload.get('stop_on_error') and results[-1].get('status') >= 400:
+                break
+        return Response({'responses': results})
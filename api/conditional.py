@@ -0,0 +1,34 @@
+import json, hashlib
+from django.core.serializers.json import DjangoJSONEncoder
+from django.utils.cache import get_conditional_response
+
+
+def etag_for(*parts) -> str:
+    """Returns a strong ETag of JSON serializable parts, i.e. the rows a response is built from."""
+    digest = hashlib.sha1(json.dumps(parts, cls=DjangoJSONEncoder, sort_keys=True).encode()).hexdigest()
+    return f'"{digest}"'
+
+
+def not_modified(request: object, etag: str):
+    """Not modified.
+
+    Returns a 304 response if the client has the version with this ETag already, so the frequently
+    polled endpoints can check the ETag of the rows they read before building the response. The ETag
+    should be computed from cheaper queries than the response needs. Only If-None-Match is answered, no
+    Last-Modified is sent, as the rows have no modification time and the jobs change within a second.
+
+    Args:
+        request (object): The request.
+        etag (str): The ETag of the current version.
+
+    Returns:
+        object: The 304 response, None if the response has to be built.
+    """
+    return get_conditional_response(request, etag=etag)
+
+
+def with_etag(response: object, etag: str) -> object:
+    response['ETag'] = etag
+    # The responses differ per user, so shared caches must not store them
+    response['Cache-Control'] = 'private, no-cache'
+    return response
@@ -1,17 +1,19 @@
 from core.models import Database, User
 from rest_framework import serializers
 from core.signals import create_db
+from django.conf import settings
 
 
 # Disallowed names
 DISALLOWED_NAMES = ['fastcp', 'root', 'mysql', 'test',
-                    'information_schema', 'performance_schema', 'sys', 'ubuntu', 'admin']
+                    'information_schema', 'performance_schema', 'sys', 'ubuntu', 'admin',
+                    'postgres', 'template0', 'template1', 'public', 'pg_monitor']
 
 
 class DatabaseSerializer(serializers.ModelSerializer):
     class Meta:
         model = Database
-        fields = ['id', 'name', 'username', 'created']
+        fields = ['id', 'name', 'username', 'engine', 'created']
         read_only_fields = ['id', 'created']
 
     def validate_name(self, value):
@@ -28,6 +30,12 @@ class DatabaseSerializer(serializers.ModelSerializer):
                 f'{value} is not allowed to be used as a username.')
         return value
 
+    def validate_engine(self, value):
+        """The engine of an existing database cannot be changed."""
+        if self.instance and self.instance.engine != value:
+            raise serializers.ValidationError('The engine of a database cannot be changed.')
+        return value
+
     def create(self, validated_data):
         request = self.context['request']
         user = request.user
@@ -55,6 +63,9 @@ class DatabaseSerializer(serializers.ModelSerializer):
             raise serializers.ValidationError(
                 {'name': [f'The allowed quota limit of {limit_str} has reached.']})
 
+        if validated_data.get('engine') == 'postgresql' and 'postgresql' not in settings.FASTCP_DATABASE_ENGINES:
+            raise serializers.ValidationError({'engine': ['PostgreSQL databases are not enabled on this server.']})
+
         validated_data['user'] = ssh_user
         database = Database.objects.create(**validated_data)
         create_db.send(sender=database, password=request.POST.get('password'))
@@ -1,7 +1,50 @@
+import os, tempfile, secrets
+from contextlib import contextmanager
 import MySQLdb as mdb
 from django.conf import settings
 
 
+def defaults_file(user: str = None, password: str = None) -> str:
+    """Writes the MySQL credentials of FastCP, or the given ones, to a temp file for the MySQL CLI tools, so
+    the password doesn't show in the process list. The caller removes the file."""
+    fd, path = tempfile.mkstemp(prefix='fastcp-mysql-')
+    with os.fdopen(fd, 'w') as f:
+        f.write(f'[client]\nuser={user or settings.FASTCP_SQL_USER}\npassword={password or settings.FASTCP_SQL_PASSWORD}\n')
+    return path
+
+
+@contextmanager
+def scoped_login(dbname: str, owner: str):
+    """Scoped login.
+
+    Lets FastCP log in to a database for a while with a temporary local account that has privileges on
+    that database alone, so the statements cannot touch anything else. The account of the database user
+    is left alone, so the apps using it keep their access. The views, triggers, routines and events the
+    temporary account defines are handed to the database user before the account is dropped. If the
+    account is left behind, nobody can log in with it as its password is not stored.
+
+    Args:
+        dbname (str): The database name.
+        owner (str): The MySQL username of the database.
+
+    Yields:
+        tuple: The temporary username and password.
+    """
+    service = FastcpSqlService()
+    username = f'fastcp_tmp_{secrets.token_hex(6)}'
+    password = secrets.token_urlsafe(24)
+    try:
+        service._execute_sql(f"CREATE USER '{username}'@'localhost' IDENTIFIED BY '{password}'")
+        service._execute_sql(f"GRANT ALL PRIVILEGES ON `{dbname}`.* TO '{username}'@'localhost'")
+        yield username, password
+    finally:
+        try:
+            service.reassign_definer(dbname, f'{username}@localhost', owner)
+        finally:
+            service._execute_sql(f"DROP USER IF EXISTS '{username}'@'localhost'")
+            service.con.close()
+
+
 class FastcpSqlService(object):
     """FastCP sql service.
 
@@ -81,6 +124,62 @@ class FastcpSqlService(object):
         res_2 = self._execute_sql(f"ALTER USER '{username}'@'localhost' IDENTIFIED BY '{password}'")
         return all([res_1, res_2])
 
+    def show_create_user(self, username: str) -> str:
+        """Returns the statement creating the local account of a user, with the hash of its password."""
+        cur = self.con.cursor()
+        try:
+            try:
+                cur.execute('SET SESSION print_identified_with_as_hex = ON')
+            except mdb.Error:
+                # MariaDB and older MySQL versions print the hashes as text already
+                pass
+            cur.execute(f"SHOW CREATE USER '{username}'@'localhost'")
+            return cur.fetchone()[0]
+        finally:
+            cur.close()
+
+    def reassign_definer(self, dbname: str, definer: str, owner: str) -> None:
+        """Hands the stored objects of a database defined by an account to the user of the database, so they
+        keep working once the account is dropped. Views are altered, and the other objects are created again
+        from their own statements as they cannot be altered.
+
+        Args:
+            dbname (str): The database name.
+            definer (str): The account defining the objects, as user@host.
+            owner (str): The MySQL username of the database.
+        """
+        old_user, old_host = definer.split('@')
+        old, new = f'`{old_user}`@`{old_host}`', f'`{owner}`@`localhost`'
+        cur = self.con.cursor()
+        try:
+            cur.execute(f'USE `{dbname}`')
+            cur.execute(
+                'SELECT TABLE_NAME, VIEW_DEFINITION, SECURITY_TYPE, CHECK_OPTION FROM information_schema.VIEWS '
+                'WHERE TABLE_SCHEMA = %s AND DEFINER = %s', (dbname, definer))
+            for name, body, security, check in cur.fetchall():
+                check = f' WITH {check} CHECK OPTION' if check != 'NONE' else ''
+                cur.execute(f'ALTER DEFINER = {new} SQL SECURITY {security} VIEW `{name}` AS {body}{check}')
+
+            objects = []
+            cur.execute('SELECT TRIGGER_NAME FROM information_schema.TRIGGERS WHERE TRIGGER_SCHEMA = %s AND DEFINER = %s',
+                        (dbname, definer))
+            objects += [('TRIGGER', name, 2) for (name,) in cur.fetchall()]
+            cur.execute('SELECT ROUTINE_TYPE, ROUTINE_NAME FROM information_schema.ROUTINES '
+                        'WHERE ROUTINE_SCHEMA = %s AND DEFINER = %s', (dbname, definer))
+            objects += [(kind, name, 2) for kind, name in cur.fetchall()]
+            cur.execute('SELECT EVENT_NAME FROM information_schema.EVENTS WHERE EVENT_SCHEMA = %s AND DEFINER = %s',
+                        (dbname, definer))
+            objects += [('EVENT', name, 3) for (name,) in cur.fetchall()]
+            for kind, name, column in objects:
+                cur.execute(f'SHOW CREATE {kind} `{name}`')
+                row = cur.fetchone()
+                statement = row[column]
+                cur.execute('SET SESSION sql_mode = %s', (row[1],))
+                cur.execute(f'DROP {kind} `{name}`')
+                cur.execute(statement.replace(f'DEFINER={old}', f'DEFINER={new}', 1))
+        finally:
+            cur.close()
+
     def drop_db(self, dbname: str) -> bool:
         """Drops the database"""
         return self._execute_sql(f"DROP DATABASE {dbname}")
@@ -91,3 +190,15 @@ class FastcpSqlService(object):
         res_1 = self._execute_sql(f"DROP USER '{user}'@'localhost'")
         res_2 = self._execute_sql(f"DROP USER '{user}'@'%'")
         return all([res_1, res_2])
+
+    def global_status(self) -> dict:
+        """Returns the global status counters and the max connections of the server."""
+        cur = self.con.cursor()
+        try:
+            cur.execute('SHOW GLOBAL STATUS')
+            values = {name: value for name, value in cur.fetchall()}
+            cur.execute("SHOW VARIABLES LIKE 'max_connections'")
+            values.update({name: value for name, value in cur.fetchall()})
+            return values
+        finally:
+            cur.close()
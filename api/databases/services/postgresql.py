@@ -0,0 +1,132 @@
+import os, secrets
+from contextlib import contextmanager
+from subprocess import run, PIPE, DEVNULL, STDOUT
+
+
+PSQL_PATH = '/usr/bin/psql'
+
+
+def ensure_server() -> bool:
+    """Installs and starts the PostgreSQL server if it's missing. Returns True if the server is available."""
+    if not os.path.exists(PSQL_PATH):
+        env = dict(os.environ, DEBIAN_FRONTEND='noninteractive')
+        res = run(['/usr/bin/apt-get', 'install', '-y', 'postgresql'], stdout=DEVNULL, stderr=STDOUT, env=env, timeout=900)
+        if res.returncode != 0:
+            return False
+    return run(['/usr/bin/systemctl', 'enable', '--now', 'postgresql'], stdout=DEVNULL, stderr=DEVNULL).returncode == 0
+
+
+def _ident(name: str) -> str:
+    """Quotes an identifier, the names are slugs but may contain hyphens."""
+    return '"' + name.replace('"', '""') + '"'
+
+
+def _literal(value: str) -> str:
+    return "'" + value.replace("'", "''") + "'"
+
+
+@contextmanager
+def scoped_login(dbname: str, owner: str):
+    """Scoped login.
+
+    Lets FastCP log in to a database for a while with a temporary role, a member of the role owning the
+    database that switches to it on login, so the statements have the privileges of the owner alone and
+    the objects they create belong to it. The owner's own password is left alone, so the apps using it
+    keep their access. If the role is left behind, nobody can log in with it as its password is not stored.
+
+    Args:
+        dbname (str): The database name.
+        owner (str): The role owning the database.
+
+    Yields:
+        tuple: The temporary role name and password.
+    """
+    service = PostgresSqlService()
+    username = f'fastcp_tmp_{secrets.token_hex(6)}'
+    password = secrets.token_urlsafe(24)
+    try:
+        service._execute_sql(f'CREATE ROLE {_ident(username)} LOGIN PASSWORD {_literal(password)} IN ROLE {_ident(owner)}')
+        service._execute_sql(f'ALTER ROLE {_ident(username)} IN DATABASE {_ident(dbname)} SET role = {_literal(owner)}')
+        yield username, password
+    finally:
+        service._execute_sql(f'SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE usename = {_literal(username)}')
+        # Anything created after a RESET ROLE goes to the owner as well
+        service._execute_sql(
+            f'REASSIGN OWNED BY {_ident(username)} TO {_ident(owner)}; DROP OWNED BY {_ident(username)}', dbname)
+        service.drop_user(username)
+
+
+class PostgresSqlService(object):
+    """PostgreSQL service.
+
+    This class handles the interaction with PostgreSQL databases. The statements are run with psql as the
+    postgres system user, which is the superuser of the local server over peer authentication.
+    """
+
+    def _execute_sql(self, sql: str, dbname: str = 'postgres') -> bool:
+        """Execute SQL.
+
+        Executes an SQL statement.
+
+        Args:
+            sql (str): The SQL statement.
+            dbname (str): The database to connect to.
+
+        Returns:
+            bool: True on success and False otherwise.
+        """
+        res = run(
+            ['/usr/sbin/runuser', '-u', 'postgres', '--', PSQL_PATH, '-v', 'ON_ERROR_STOP=1', '-d', dbname, '-f', '-'],
+            input=sql.encode(), stdout=PIPE, stderr=PIPE, timeout=120
+        )
+        return res.returncode == 0
+
+    def _query(self, sql: str, dbname: str = 'postgres') -> str:
+        """Executes a query and returns its output unaligned, without the headers."""
+        res = run(
+            ['/usr/sbin/runuser', '-u', 'postgres', '--', PSQL_PATH, '-v', 'ON_ERROR_STOP=1', '-At', '-d', dbname, '-f', '-'],
+            input=sql.encode(), stdout=PIPE, stderr=PIPE, timeout=120
+        )
+        return res.stdout.decode().strip()
+
+    def setup_db(self, user: str, password: str, dbname: str) -> bool:
+        """Setup DB.
+
+        Creates a role with the given password and a database owned by the role. Other roles cannot connect
+        to the database.
+
+        Args:
+            user (str): The role name.
+            password (str): The plain text password.
+            dbname (str): The database name.
+
+        Returns:
+            bool: True on success and False otherwise
+        """
+        if not ensure_server():
+            return False
+        res_1 = self._execute_sql(f'CREATE ROLE {_ident(user)} LOGIN PASSWORD {_literal(password)}')
+        res_2 = res_1 and self._execute_sql(f'CREATE DATABASE {_ident(dbname)} OWNER {_ident(user)}')
+        res_3 = res_2 and self._execute_sql(f'REVOKE ALL ON DATABASE {_ident(dbname)} FROM PUBLIC')
+        return all([res_1, res_2, res_3])
+
+    def update_password(self, username: str, password: str) -> bool:
+        """Update a role's password.
+
+        Args:
+            username (str): The role name.
+            password (str): New password for the role.
+
+        Returns:
+            bool: True on success False otherwise.
+        """
+        return self._execute_sql(f'ALTER ROLE {_ident(username)} PASSWORD {_literal(password)}')
+
+    def drop_db(self, dbname: str) -> bool:
+        """Drops the database, the open connections are ended first"""
+        self._execute_sql(f'SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = {_literal(dbname)}')
+        return self._execute_sql(f'DROP DATABASE IF EXISTS {_ident(dbname)}')
+
+    def drop_user(self, user: str) -> bool:
+        """Drops the role"""
+        return self._execute_sql(f'DROP ROLE IF EXISTS {_ident(user)}')
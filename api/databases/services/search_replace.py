@@ -0,0 +1,225 @@
+import re
+import MySQLdb as mdb
+from .mysql import scoped_login
+
+
+# Column types that may hold text. Enum and set columns are left out, as their values are a fixed list
+# and a replaced value would be stored as an empty string.
+TEXT_TYPES = ('char', 'varchar', 'tinytext', 'text', 'mediumtext', 'longtext', 'tinyblob', 'blob',
+              'mediumblob', 'longblob', 'json')
+
+# Values looking like PHP serialized data
+SERIALIZED_RE = re.compile(rb'^(?:[aO]:\d+:|s:\d+:"|[bid]:[^;]*;$|N;$)', re.S)
+
+
+class UnserializeError(Exception):
+    pass
+
+
+def php_unserialize(data: bytes, pos: int = 0) -> tuple:
+    """Parses PHP serialized data into tagged tuples so it can be serialized back exactly.
+
+    Args:
+        data (bytes): The serialized data.
+        pos (int): The position to start parsing at.
+
+    Returns:
+        tuple: The parsed value and the position after it.
+    """
+    try:
+        kind = data[pos:pos + 1]
+        if kind == b'N':
+            return ('N',), pos + 2
+        if kind in (b'b', b'i', b'd'):
+            end = data.index(b';', pos)
+            return (kind.decode(), data[pos + 2:end]), end + 1
+        if kind == b's':
+            colon = data.index(b':', pos + 2)
+            length = int(data[pos + 2:colon])
+            start = colon + 2
+            if data[start + length:start + length + 2] != b'";':
+                raise UnserializeError('String length mismatch.')
+            return ('s', data[start:start + length]), start + length + 2
+        if kind in (b'a', b'O'):
+            class_name = None
+            if kind == b'O':
+                colon = data.index(b':', pos + 2)
+                length = int(data[pos + 2:colon])
+                class_name = data[colon + 2:colon + 2 + length]
+                pos = colon + 2 + length + 1
+            else:
+                pos = pos + 1
+            colon = data.index(b':', pos + 1)
+            count = int(data[pos + 1:colon])
+            pos = colon + 2
+            items = []
+            for i in range(count):
+                key, pos = php_unserialize(data, pos)
+                value, pos = php_unserialize(data, pos)
+                items.append((key, value))
+            if data[pos:pos + 1] != b'}':
+                raise UnserializeError('Unterminated array.')
+            return (kind.decode(), class_name, items), pos + 1
+    except (ValueError, IndexError) as e:
+        raise UnserializeError(str(e))
+    raise UnserializeError(f'Unsupported type {kind!r}.')
+
+
+def php_serialize(value: tuple) -> bytes:
+    """Serializes the tagged tuples returned by php_unserialize back to PHP serialized data."""
+    kind = value[0]
+    if kind == 'N':
+        return b'N;'
+    if kind in ('b', 'i', 'd'):
+        return kind.encode() + b':' + value[1] + b';'
+    if kind == 's':
+        return b's:' + str(len(value[1])).encode() + b':"' + value[1] + b'";'
+    items = b''.join([php_serialize(k) + php_serialize(v) for k, v in value[2]])
+    count = str(len(value[2])).encode()
+    if kind == 'O':
+        class_name = value[1]
+        return b'O:' + str(len(class_name)).encode() + b':"' + class_name + b'":' + count + b':{' + items + b'}'
+    return b'a:' + count + b':{' + items + b'}'
+
+
+def replace_value(data: bytes, search: bytes, replace: bytes) -> bytes:
+    """Replace value.
+
+    Replaces a string in a value. Serialized values are unserialized first, the strings in them are
+    replaced, including the serialized strings nested in them, and the value is serialized back with the
+    correct string lengths. Serialized values that cannot be parsed are returned as is rather than being
+    corrupted.
+
+    Args:
+        data (bytes): The value.
+        search (bytes): The string to search for.
+        replace (bytes): The replacement.
+
+    Returns:
+        bytes: The new value.
+    """
+    if search not in data:
+        return data
+
+    if not SERIALIZED_RE.match(data):
+        return data.replace(search, replace)
+
+    try:
+        value, end = php_unserialize(data)
+        if end != len(data):
+            raise UnserializeError('Trailing data.')
+    except UnserializeError:
+        return data
+
+    def walk(value):
+        if value[0] == 's':
+            return ('s', replace_value(value[1], search, replace))
+        if value[0] in ('a', 'O'):
+            return (value[0], value[1], [(k, walk(v)) for k, v in value[2]])
+        return value
+
+    return php_serialize(walk(value))
+
+
+class SearchReplaceService(object):
+    """Search and replace service.
+    The statements run with a temporary account scoped to the database, while the site keeps its access.
+    This class replaces a string in all text columns of a database, like wp-cli search-replace does.
+    The statements run as the database user, so they are scoped to the database of the user.
+    """
+
+    def __init__(self, database) -> None:
+        """The connection is established when the search and replace runs.
+
+        Args:
+            database (Database): The database object.
+        """
+        self.database = database
+        self.con = None
+
+    def _query(self, sql: str, params: tuple = None) -> list:
+        """Executes an SQL statement and returns the rows."""
+        cur = self.con.cursor()
+        try:
+            cur.execute(sql, params)
+            return cur.fetchall()
+        finally:
+            cur.close()
+
+    def _columns(self, table: str) -> tuple:
+        """Returns the primary key columns and the text columns of a table."""
+        primary, text = [], []
+        for row in self._query(f'SHOW COLUMNS FROM `{table}`'):
+            name, col_type, key = row[0].decode(), row[1].decode().lower(), row[3].decode()
+            if key == 'PRI':
+                primary.append(name)
+            if re.split(r'[(\s]', col_type)[0] in TEXT_TYPES:
+                text.append(name)
+        return primary, text
+
+    def run(self, search: str, replace: str, dry_run: bool = True) -> dict:
+        """Run search and replace.
+
+        Args:
+            search (str): The string to search for.
+            replace (str): The replacement.
+            dry_run (bool): Only count the changes without saving them.
+
+        Returns:
+            dict: The number of changed rows and cells per table and the tables that were skipped.
+        """
+        search_b, replace_b = search.encode(), replace.encode()
+        like = '%' + search.replace('\\', '\\\\').replace('%', '\\%').replace('_', '\\_') + '%'
+        report = {'dry_run': dry_run, 'tables': [], 'skipped': [], 'rows': 0, 'cells': 0}
+
+        with scoped_login(self.database.name, self.database.username) as (username, password):
+            self.con = mdb.connect(
+                host='localhost', user=username, passwd=password, db=self.database.name,
+                charset='utf8mb4', use_unicode=False)
+            try:
+                self._replace(search_b, replace_b, like, dry_run, report)
+            finally:
+                self.con.close()
+        return report
+
+    def _replace(self, search_b: bytes, replace_b: bytes, like: str, dry_run: bool, report: dict) -> None:
+        """Replaces the string in all tables and adds the changes to the report."""
+        for (table,) in self._query('SHOW TABLES'):
+            table = table.decode()
+            primary, text = self._columns(table)
+            if not text:
+                continue
+            if not primary:
+                report['skipped'].append(table)
+                continue
+
+            cols = ', '.join([f'`{c}`' for c in primary + text])
+            where = ' OR '.join([f'`{c}` LIKE %s' for c in text])
+            rows = self._query(f'SELECT {cols} FROM `{table}` WHERE {where}', tuple([like] * len(text)))
+
+            changed_rows = changed_cells = 0
+            for row in rows:
+                keys, values = row[:len(primary)], row[len(primary):]
+                changes = {}
+                for col, value in zip(text, values):
+                    if value is not None:
+                        new_value = replace_value(bytes(value), search_b, replace_b)
+                        if new_value != value:
+                            changes[col] = new_value
+                if not changes:
+                    continue
+
+                changed_rows += 1
+                changed_cells += len(changes)
+                if not dry_run:
+                    sets = ', '.join([f'`{c}` = %s' for c in changes])
+                    pk = ' AND '.join([f'`{c}` = %s' for c in primary])
+                    self._query(f'UPDATE `{table}` SET {sets} WHERE {pk}', tuple(changes.values()) + tuple(keys))
+
+            if changed_rows:
+                report['tables'].append({'table': table, 'rows': changed_rows, 'cells': changed_cells})
+                report['rows'] += changed_rows
+                report['cells'] += changed_cells
+
+        if not dry_run:
+            self.con.commit()
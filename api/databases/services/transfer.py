@@ -0,0 +1,139 @@
+import os, pwd, gzip, zlib, time, stat, logging, tempfile
+from subprocess import Popen, PIPE, DEVNULL
+from django.conf import settings
+from core.models import Database
+from core.utils import jobs
+from core.utils.filesystem import get_user_paths
+from .mysql import defaults_file, scoped_login as mysql_login
+from .postgresql import PSQL_PATH, scoped_login as postgres_login
+
+
+logger = logging.getLogger('fastcp.databases')
+
+
+CHUNK_SIZE = 1024 * 1024
+
+# Seconds between the progress updates of an import
+PROGRESS_SECONDS = 2
+
+
+def export_stream(database: object):
+    """Export stream.
+
+    Dumps a database and yields the dump gzipped in chunks, so databases of any size can be downloaded
+    without being written to the disk or held in memory.
+
+    Args:
+        database (object): Database model object.
+
+    Yields:
+        bytes: The chunks of the gzipped SQL dump.
+    """
+    defaults = None
+    if database.engine == 'postgresql':
+        cmd = ['/usr/sbin/runuser', '-u', 'postgres', '--', '/usr/bin/pg_dump', '--no-owner', '--no-privileges', database.name]
+    else:
+        defaults = defaults_file()
+        cmd = ['/usr/bin/mysqldump', f'--defaults-extra-file={defaults}', '--single-transaction', '--quick', '--routines',
+               '--triggers', database.name]
+
+    proc = Popen(cmd, stdout=PIPE, stderr=DEVNULL)
+    compressor = zlib.compressobj(6, zlib.DEFLATED, 31)
+    try:
+        while True:
+            chunk = proc.stdout.read(CHUNK_SIZE)
+            if not chunk:
+                break
+            data = compressor.compress(chunk)
+            if data:
+                yield data
+        yield compressor.flush()
+    finally:
+        proc.kill()
+        proc.wait()
+        if defaults:
+            os.remove(defaults)
+
+
+def open_dump(path: str, root: str) -> object:
+    """Opens a dump for reading, and checks the file that was opened rather than the path, so a symlink
+    swapped in after the dump was picked cannot point the import at a file outside the given root."""
+    fd = os.open(path, os.O_RDONLY | os.O_NONBLOCK)
+    real = os.readlink(f'/proc/self/fd/{fd}')
+    if not real.startswith(os.path.realpath(root) + '/') or not stat.S_ISREG(os.fstat(fd).st_mode):
+        os.close(fd)
+        raise RuntimeError('The dump is not a file in the allowed directory.')
+    return os.fdopen(fd, 'rb')
+
+
+def import_database(job: object, params: dict) -> dict:
+    """Import database.
+
+    Imports a .sql or .sql.gz dump into an existing database as a background job. The progress is the
+    share of the file read so far. The client runs as the owner of the database and logs in with a
+    temporary account scoped to the database, so the dump can do nothing the database user could not do
+    and the imported objects belong to the database user.
+
+    Args:
+        job (object): The Job model object.
+        params (dict): The ID of the database, the path of the dump and whether to delete it afterwards.
+
+    Returns:
+        dict: The size of the imported file.
+    """
+    database = Database.objects.get(id=params.get('database_id'))
+    path = params.get('path')
+    owner = database.user.username
+    info = pwd.getpwnam(owner)
+    root = settings.FASTCP_DB_IMPORT_DIR if params.get('delete') else get_user_paths(database.user).get('base_path')
+
+    credentials = None
+    try:
+        with open_dump(path, root) as raw:
+            total = os.fstat(raw.fileno()).st_size
+            jobs.report_progress(job, 0, total)
+            login = postgres_login if database.engine == 'postgresql' else mysql_login
+            with login(database.name, database.username) as (username, password), tempfile.TemporaryFile() as errors:
+                env = None
+                if database.engine == 'postgresql':
+                    fd, credentials = tempfile.mkstemp(prefix='fastcp-pgpass-')
+                    with os.fdopen(fd, 'w') as f:
+                        f.write(f'127.0.0.1:*:{database.name}:{username}:{password}\n')
+                    env = dict(os.environ, PGPASSFILE=credentials)
+                    client = [PSQL_PATH, '-v', 'ON_ERROR_STOP=1', '-q', '-h', '127.0.0.1', '-U', username,
+                              '-d', database.name]
+                else:
+                    credentials = defaults_file(username, password)
+                    client = ['/usr/bin/mysql', f'--defaults-extra-file={credentials}', database.name]
+                os.chown(credentials, info.pw_uid, info.pw_gid)
+
+                cmd = ['/usr/sbin/runuser', '-u', owner, '--'] + client
+                proc = Popen(cmd, stdin=PIPE, stdout=DEVNULL, stderr=errors, env=env, cwd='/')
+                dump = gzip.GzipFile(fileobj=raw) if path.endswith('.gz') else raw
+                try:
+                    last_report = time.monotonic()
+                    while True:
+                        chunk = dump.read(CHUNK_SIZE)
+                        if not chunk:
+                            break
+                        proc.stdin.write(chunk)
+                        if time.monotonic() - last_report >= PROGRESS_SECONDS:
+                            jobs.report_progress(job, raw.tell())
+                            last_report = time.monotonic()
+                    proc.stdin.close()
+                except BrokenPipeError:
+                    # The client quit on an error, which is read below
+                    pass
+                if proc.wait() != 0:
+                    errors.seek(0)
+                    logger.warning('Importing into %s failed: %s', database.name,
+                                   errors.read().decode(errors='replace').strip()[-1000:])
+                    raise RuntimeError('The database server rejected the dump, check that it is a valid dump.')
+    finally:
+        if credentials:
+            os.remove(credentials)
+        if params.get('delete'):
+            os.remove(path)
+
+    jobs.report_progress(job, total)
+    return {'database': database.name, 'size': total}
@@ -9,5 +9,8 @@ router.register('', views.DatabaseViewSet)
 app_name='databases'
 urlpatterns=[
     path('<int:id>/reset-password/', views.ResetPasswordView().as_view(), name='reset_sql_password'),
+    path('<int:id>/search-replace/', views.SearchReplaceView().as_view(), name='search_replace'),
+    path('<int:id>/export/', views.ExportDatabaseView().as_view(), name='export'),
+    path('<int:id>/import/', views.ImportDatabaseView().as_view(), name='import'),
     path('', include(router.urls)),
 ]
\ No newline at end of file
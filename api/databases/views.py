@@ -8,6 +8,14 @@ from rest_framework.views import APIView
 from rest_framework.response import Response
 from rest_framework import status
 from core.utils.system import change_db_password
+from .services.search_replace import SearchReplaceService
+from .services import transfer
+from core.utils import jobs
+from core.utils.filesystem import get_user_paths
+from django.conf import settings
+from django.http import StreamingHttpResponse
+from datetime import datetime
+import os, uuid
 
 
 class ResetPasswordView(APIView):
@@ -29,7 +37,7 @@ class ResetPasswordView(APIView):
             }, status=status.HTTP_404_NOT_FOUND)
         
         # Update password
-        password = change_db_password(db_obj.username)
+        password = change_db_password(db_obj.username, db_obj.engine)
         
         if password:
             # Return the new password
@@ -42,6 +50,138 @@ class ResetPasswordView(APIView):
                 'message': 'Password cannot be updated for this user.'
             }, status=status.HTTP_404_NOT_FOUND)
 
+class SearchReplaceView(APIView):
+    """Search and replace a string in a database.
+    
+    Serialized PHP data is handled safely. Changes are only counted unless dry_run is set to false.
+    """
+    http_method_names = ['post']
+    
+    def post(self, request, *args, **kwargs):
+        user = request.user
+        db_id = kwargs.get('id')
+
+        if user.is_superuser:
+            db_obj = Database.objects.filter(pk=db_id).first()
+        else:
+            db_obj = user.databases.filter(pk=db_id).first()
+        
+        if not db_obj:
+            return Response({
+                'message': 'The requested database cannot be found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        if db_obj.engine != 'mysql':
+            return Response({
+                'message': 'Search and replace is only supported for MySQL databases.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        
+        search = request.POST.get('search', '')
+        replace = request.POST.get('replace', '')
+        if not search:
+            return Response({
+                'errors': {'search': ['The string to search for is required.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        dry_run = request.POST.get('dry_run') != 'false'
+        try:
+            report = SearchReplaceService(db_obj).run(search, replace, dry_run=dry_run)
+        except Exception as e:
+            return Response({
+                'message': f'Search and replace failed: {e}'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        
+        return Response(report)
+
+class ExportDatabaseView(APIView):
+    """Download a gzipped SQL dump of a database.
+    
+    The dump is streamed as it's generated, so databases of any size can be exported.
+    """
+    http_method_names = ['get']
+    
+    def get(self, request, *args, **kwargs):
+        user = request.user
+        db_id = kwargs.get('id')
+
+        if user.is_superuser:
+            db_obj = Database.objects.filter(pk=db_id).first()
+        else:
+            db_obj = user.databases.filter(pk=db_id).first()
+        
+        if not db_obj:
+            return Response({
+                'message': 'The requested database cannot be found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        response = StreamingHttpResponse(transfer.export_stream(db_obj), content_type='application/gzip')
+        response['Content-Disposition'] = f'attachment; filename="{db_obj.name}-{datetime.now().strftime("%Y%m%d%H%M%S")}.sql.gz"'
+        response['X-Accel-Buffering'] = 'no'
+        return response
+
+
+class ImportDatabaseView(APIView):
+    """Import a .sql or .sql.gz dump into a database.
+    
+    The dump is either uploaded as file or it's the path of a dump in the home directory of the database
+    owner, i.e. uploaded over SFTP, which suits dumps too large for the browser. The import runs as a
+    background job that reports the progress.
+    """
+    http_method_names = ['post']
+    
+    def post(self, request, *args, **kwargs):
+        user = request.user
+        db_id = kwargs.get('id')
+
+        if user.is_superuser:
+            db_obj = Database.objects.filter(pk=db_id).first()
+        else:
+            db_obj = user.databases.filter(pk=db_id).first()
+        
+        if not db_obj:
+            return Response({
+                'message': 'The requested database cannot be found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        max_size = settings.FASTCP_DB_IMPORT_MAX_MB * 1024 * 1024
+        upload = request.FILES.get('file')
+        if upload:
+            name = os.path.basename(upload.name)
+            size = upload.size
+        else:
+            home = os.path.realpath(get_user_paths(db_obj.user).get('base_path'))
+            path = os.path.realpath(os.path.join(home, request.POST.get('path', '').lstrip('/')))
+            name = os.path.basename(path)
+            if not path.startswith(f'{home}/') or not os.path.isfile(path):
+                return Response({
+                    'errors': {'path': ['Upload a dump or enter the path of a dump in the home directory of the database owner.']}
+                }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+            size = os.path.getsize(path)
+        
+        if not name.endswith('.sql') and not name.endswith('.sql.gz'):
+            return Response({
+                'errors': {'file': ['Only .sql and .sql.gz dumps can be imported.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        if size > max_size:
+            return Response({
+                'errors': {'file': [f'The dump cannot be larger than {settings.FASTCP_DB_IMPORT_MAX_MB} MB.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        if upload:
+            os.makedirs(settings.FASTCP_DB_IMPORT_DIR, mode=0o700, exist_ok=True)
+            path = os.path.join(settings.FASTCP_DB_IMPORT_DIR, f'{uuid.uuid4().hex}-{name}')
+            with open(path, 'wb') as f:
+                for chunk in upload.chunks():
+                    f.write(chunk)
+        
+        params = {'database_id': db_obj.id, 'path': path, 'delete': bool(upload)}
+        job = jobs.start_job(request.user, 'import_database', db_obj.name, params, transfer.import_database)
+        return Response({
+            'message': f'Importing {name} into {db_obj.name}.',
+            'job': jobs.serialize_job(job)
+        })
+
+
 class DatabaseViewSet(viewsets.ModelViewSet):
     """Database View
     
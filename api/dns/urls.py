@@ -0,0 +1,10 @@
+from django.urls import path
+from . import views
+
+app_name='dns'
+urlpatterns=[
+    path('zones/', views.ZonesView.as_view(), name='zones'),
+    path('zones/<int:id>/', views.ZoneView.as_view(), name='zone'),
+    path('zones/<int:id>/records/', views.RecordsView.as_view(), name='records'),
+    path('zones/<int:id>/records/<int:record_id>/', views.RecordView.as_view(), name='record')
+]
@@ -0,0 +1,226 @@
+import json
+from rest_framework.views import APIView
+from rest_framework.response import Response
+from rest_framework import status
+from core.models import Website, DnsZone, DnsCredential
+from core.utils import dnszones
+
+
+def serialize_record(record: object) -> dict:
+    return {
+        'id': record.id,
+        'name': record.name,
+        'fqdn': record.fqdn,
+        'type': record.type,
+        'value': record.value,
+        'ttl': record.ttl
+    }
+
+
+class ZonesView(APIView):
+    """DNS Zones View
+
+    Lists the DNS zones of the user, or creates a zone for a domain of a website. A local zone is served by
+    the BIND of the server, an external zone is pushed to the DNS provider of a saved DNS credential. New
+    zones get the records pointing the domain to the server.
+    """
+    http_method_names = ['get', 'post']
+
+    def get_queryset(self, user):
+        if user.is_superuser:
+            return DnsZone.objects.all()
+        return DnsZone.objects.filter(user=user)
+
+    def get(self, request, *args, **kw):
+        zones = self.get_queryset(request.user).select_related('dns_credential').order_by('domain')
+        return Response([{
+            'id': z.id,
+            'domain': z.domain,
+            'backend': z.backend,
+            'dns_credential': z.dns_credential.label if z.dns_credential else None,
+            'records': z.records.count(),
+            'serial': z.serial,
+            'created': z.created
+        } for z in zones])
+
+    def post(self, request, *args, **kw):
+        user = request.user
+        errors = {}
+        domain = request.POST.get('domain', '').strip().lower()
+        websites = Website.objects.all() if user.is_superuser else Website.objects.filter(user=user)
+        if not websites.filter(domains__domain=domain).exists():
+            errors['domain'] = [f'{domain} is not a domain of your websites.']
+        elif DnsZone.objects.filter(domain=domain).exists():
+            errors['domain'] = [f'A DNS zone already exists for {domain}.']
+
+        backend = request.POST.get('backend', 'local')
+        dns_credential = None
+        if backend == 'external':
+            credentials = DnsCredential.objects.all() if user.is_superuser else DnsCredential.objects.filter(user=user)
+            dns_credential = credentials.filter(id=request.POST.get('dns_credential_id') or 0).first()
+            if not dns_credential:
+                errors['dns_credential_id'] = ['Select the DNS credential of the provider that hosts the zone.']
+        elif backend != 'local':
+            errors['backend'] = ['The backend should be either local or external.']
+
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        zone = DnsZone.objects.create(user=user, domain=domain, backend=backend, dns_credential=dns_credential)
+        if request.POST.get('default_records', '1') not in ['0', 'false']:
+            dnszones.default_records(zone)
+        error = dnszones.sync_zone(zone)
+        if error:
+            zone.delete()
+            return Response({
+                'message': f'The DNS zone cannot be published: {error}'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({
+            'message': f'DNS zone of {domain} has been created.',
+            'id': zone.id
+        })
+
+
+class ZoneView(APIView):
+    """DNS Zone View
+
+    Returns a DNS zone along with its records, or deletes it. Deleting an external zone keeps the records
+    at the provider.
+    """
+    http_method_names = ['get', 'delete']
+
+    def get_zone(self, request, id):
+        """Returns the zone if it belongs to the user, admins can access all."""
+        if request.user.is_superuser:
+            return DnsZone.objects.filter(id=id).first()
+        return DnsZone.objects.filter(id=id, user=request.user).first()
+
+    def not_found(self, id):
+        return Response({
+            'message': f'DNS zone with ID {id} was not found.'
+        }, status=status.HTTP_404_NOT_FOUND)
+
+    def get(self, request, *args, **kw):
+        zone = self.get_zone(request, kw.get('id'))
+        if not zone:
+            return self.not_found(kw.get('id'))
+        return Response({
+            'id': zone.id,
+            'domain': zone.domain,
+            'backend': zone.backend,
+            'serial': zone.serial,
+            'records': [serialize_record(r) for r in zone.records.order_by('name', 'type')]
+        })
+
+    def delete(self, request, *args, **kw):
+        zone = self.get_zone(request, kw.get('id'))
+        if not zone:
+            return self.not_found(kw.get('id'))
+
+        zone.delete()
+        dnszones.delete_zone(zone)
+        return Response({'message': f'DNS zone of {zone} has been deleted.'})
+
+
+class RecordsView(ZoneView):
+    """DNS Records View
+
+    Lists the records of a DNS zone or adds a record. The name is relative to the zone, @ being the domain
+    itself.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kw):
+        zone = self.get_zone(request, kw.get('id'))
+        if not zone:
+            return self.not_found(kw.get('id'))
+        return Response([serialize_record(r) for r in zone.records.order_by('name', 'type')])
+
+    def post(self, request, *args, **kw):
+        zone = self.get_zone(request, kw.get('id'))
+        if not zone:
+            return self.not_found(kw.get('id'))
+
+        name = request.POST.get('name', '@').strip().lower() or '@'
+        rtype = request.POST.get('type', '').strip().upper()
+        value = request.POST.get('value', '').strip()
+        try:
+            ttl = int(request.POST.get('ttl', 3600))
+        except ValueError:
+            ttl = 0
+        errors = dnszones.validate_record(rtype, name, value, ttl)
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        record = zone.records.create(name=name, type=rtype, value=value, ttl=ttl)
+        error = dnszones.sync_zone(zone, name, rtype)
+        if error:
+            record.delete()
+            dnszones.sync_zone(zone, name, rtype)
+            return Response({
+                'message': f'The record cannot be published: {error}'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({
+            'message': f'{record.type} record of {record.fqdn} has been added.',
+            'record': serialize_record(record)
+        })
+
+
+class RecordView(ZoneView):
+    """DNS Record View
+
+    Updates the value or the TTL of a DNS record, or deletes the record.
+    """
+    http_method_names = ['post', 'delete']
+
+    def get_record(self, request, kw):
+        zone = self.get_zone(request, kw.get('id'))
+        if not zone:
+            return None
+        return zone.records.select_related('zone').filter(id=kw.get('record_id')).first()
+
+    def record_not_found(self, kw):
+        return Response({
+            'message': f'DNS record with ID {kw.get("record_id")} was not found.'
+        }, status=status.HTTP_404_NOT_FOUND)
+
+    def post(self, request, *args, **kw):
+        record = self.get_record(request, kw)
+        if not record:
+            return self.record_not_found(kw)
+
+        value = request.POST.get('value', record.value).strip()
+        try:
+            ttl = int(request.POST.get('ttl', record.ttl))
+        except ValueError:
+            ttl = 0
+        errors = dnszones.validate_record(record.type, record.name, value, ttl)
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        previous = json.loads(record.provider_ref) if record.provider_ref else None
+        record.value, record.ttl = value, ttl
+        record.save()
+        error = dnszones.sync_zone(record.zone, record.name, record.type, previous)
+        if error:
+            return Response({
+                'message': f'The record has been saved but cannot be published: {error}'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({
+            'message': f'{record.type} record of {record.fqdn} has been updated.',
+            'record': serialize_record(record)
+        })
+
+    def delete(self, request, *args, **kw):
+        record = self.get_record(request, kw)
+        if not record:
+            return self.record_not_found(kw)
+
+        previous = json.loads(record.provider_ref) if record.provider_ref else None
+        record.delete()
+        error = dnszones.sync_zone(record.zone, record.name, record.type, previous)
+        if error:
+            return Response({
+                'message': f'The record has been deleted but cannot be unpublished: {error}'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({'message': f'{record.type} record of {record.fqdn} has been deleted.'})
@@ -55,6 +55,11 @@ class ReadFileSerializer(ValidPathSerializer):
     path = serializers.CharField()
 
 
+class DownloadFileSerializer(ValidPathSerializer):
+    """Defines fields required to download a file."""
+    path = serializers.CharField()
+
+
 class ExtractArchiveSerializer(ValidPathSerializer):
     """Defines fields required to extract an archive."""
     path = serializers.CharField()
@@ -1,3 +1,4 @@
+import os, pwd, stat
 from core.utils.system import run_cmd
 from core.models import User
 from django.conf import settings
@@ -46,7 +47,26 @@ class BaseService(object):
         owner = self.get_owner_by_path(path)
         return owner and (user.id == owner.id or user.is_superuser)
         
-    def is_allowed(self, path: str, user: object) -> bool:
+    def resolve_path(self, path: str, follow_symlinks: bool = True) -> str:
+        """Resolve path.
+        
+        Resolves the .. segments and the symlinks of a path, so a path cannot escape the user's directory
+        through them. If follow_symlinks is False, the last segment is kept as is so a symlink itself can
+        be deleted, moved or renamed.
+        
+        Args:
+            path (str): Path string.
+            follow_symlinks (bool): Resolve the last segment too.
+        
+        Returns:
+            str: The resolved absolute path.
+        """
+        path = str(path)
+        if follow_symlinks:
+            return os.path.realpath(path)
+        return os.path.join(os.path.realpath(os.path.dirname(path.rstrip('/'))), os.path.basename(path.rstrip('/')))
+    
+    def is_allowed(self, path: str, user: object, follow_symlinks: bool = True) -> bool:
         """Ensure path is allowed.
         
         For security reasons, only certain paths are allowed. This method checks and ensures that the path
@@ -55,15 +75,78 @@ class BaseService(object):
         Args:
             path (str): Path string.
             user (object): Userm odel object.
+            follow_symlinks (bool): Check the target of a symlink rather than the symlink itself.
             
         Returns:
             bool: True if allowed False otherwise.
         """
-        path = str(path)
+        path = self.resolve_path(path, follow_symlinks)
         if self.is_owner(path, user):
-            return path.startswith(settings.FILE_MANAGER_ROOT) and len(path.split('/')) >= 6
+            return path.startswith(settings.FILE_MANAGER_ROOT.rstrip('/') + '/') and len(path.split('/')) >= 6
         return False
+    
+    def as_owner(self, path: str, func, *args) -> bool:
+        """Run as owner.
+        
+        Runs a filesystem operation in a child process that drops to the UID and GID of the owner of the
+        path, so the kernel stops the operation from touching files the owner cannot, even through a
+        symlink swapped in after the path checks.
         
+        Args:
+            path (str): The path the operation runs on, its owner is the user to run as.
+            func (callable): The operation, it must not touch the database.
+            args: The arguments of the operation.
+        
+        Returns:
+            bool: True if the operation succeeded and False otherwise.
+        """
+        owner = self.get_owner_by_path(self.resolve_path(path, follow_symlinks=False))
+        try:
+            entry = pwd.getpwnam(owner.username)
+        except (AttributeError, KeyError):
+            return False
+        
+        pid = os.fork()
+        if pid == 0:
+            code = 1
+            try:
+                os.setgroups([])
+                os.setgid(entry.pw_gid)
+                os.setuid(entry.pw_uid)
+                if func(*args) is not False:
+                    code = 0
+            except BaseException:
+                pass
+            finally:
+                os._exit(code)
+        
+        _, exit_status = os.waitpid(pid, 0)
+        return os.WIFEXITED(exit_status) and os.WEXITSTATUS(exit_status) == 0
+    
+    def open_owned(self, path: str) -> object:
+        """Open owned file.
+        
+        Opens the resolved path for reading without following a symlink swapped in after the path checks,
+        and only if the opened file is a regular file of the owner of the path, so a swapped in directory
+        symlink or a hard link cannot expose a file the owner cannot read.
+        
+        Args:
+            path (str): The path of the file.
+        
+        Returns:
+            object: The file object opened for binary reading on success and None on failure.
+        """
+        owner = self.get_owner_by_path(self.resolve_path(path))
+        try:
+            uid = pwd.getpwnam(owner.username).pw_uid
+            fd = os.open(self.resolve_path(path), os.O_RDONLY | os.O_NOFOLLOW | os.O_NONBLOCK)
+        except (AttributeError, KeyError, OSError):
+            return None
+        info = os.fstat(fd)
+        if not stat.S_ISREG(info.st_mode) or info.st_uid != uid:
+            os.close(fd)
+            return None
+        return os.fdopen(fd, 'rb')
     
     def fix_ownership(self, path: str) -> None:
         """Fix ownership.
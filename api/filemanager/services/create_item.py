@@ -29,15 +29,9 @@ class CreateItemService(BaseService):
         new_path = os.path.join(path, item_name)
         
         if self.is_allowed(new_path, user) and not os.path.exists(new_path):
-            try:
-                if item_type == 'file':
-                    open(new_path, 'a').close()
-                elif item_type == 'directory':
-                    os.makedirs(new_path)
-                
-                self.fix_ownership(new_path)
-                return True
-            except Exception as e:
-                pass
+            if item_type == 'file':
+                return self.as_owner(new_path, lambda: open(new_path, 'x').close())
+            elif item_type == 'directory':
+                return self.as_owner(new_path, os.makedirs, new_path)
     
         return False
\ No newline at end of file
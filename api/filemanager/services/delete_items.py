@@ -29,14 +29,12 @@ class DeleteItemsService(BaseService):
             user = self.request.user
             if len(paths):
                 for path in paths:
-                    if self.is_allowed(path, user):
-                        if os.path.isdir(path):
-                            try:
-                                shutil.rmtree(path)
-                            except:
-                                pass
-                        if os.path.isfile(path):
-                            os.remove(path)
+                    if self.is_allowed(path, user, follow_symlinks=False):
+                        path = self.resolve_path(path, follow_symlinks=False)
+                        if os.path.isdir(path) and not os.path.islink(path):
+                            self.as_owner(path, shutil.rmtree, path, True)
+                        elif os.path.lexists(path):
+                            self.as_owner(path, os.remove, path)
                 
                 return True
         except Exception as e:
@@ -0,0 +1,29 @@
+from .base_service import BaseService
+
+
+class DownloadFileService(BaseService):
+    """Download a file.
+    
+    Opens a file so its content can be streamed to the user.
+    """
+    
+    def __init__(self, request):
+        self.request = request
+    
+    def open_file(self, validated_data: dict) -> object:
+        """Open file.
+        
+        Opens the resolved path without following a symlink swapped in after the path checks, and only if
+        it's a regular file of the owner.
+        
+        Args:
+            validated_data (dict): Validated data from serializer (api.filemanager.serializers.DownloadFileSerializer)
+        
+        Returns:
+            object: The file object opened for binary reading on success and None on failure.
+        """
+        user = self.request.user
+        path = validated_data.get('path')
+        if not path or not self.is_allowed(path, user):
+            return None
+        return self.open_owned(path)
@@ -27,9 +27,8 @@ class ExtractArchiveService(BaseService):
             user = self.request.user
                 
             if self.is_allowed(path, user) and self.is_allowed(root_path, user):
-                cpfs.extract_zip(root_path, archive_path=path)
-                self.fix_ownership(root_path)
-                return True
+                # Extracted as the owner, so the archive cannot write through a symlink out of the user's directory
+                return self.as_owner(root_path, cpfs.extract_zip, root_path, path)
                         
         except Exception as e:
             pass
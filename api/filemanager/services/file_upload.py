@@ -1,5 +1,6 @@
 import os
 from .base_service import BaseService
+from core.utils import malware
 import requests
 
 
@@ -10,6 +11,7 @@ class FileUploadService(BaseService):
     """
     def __init__(self, request):
         self.request = request
+        self.quarantined = None # The uploaded file if it was quarantined as malware
     
     def upload_file(self, validated_data) -> bool:
         """Process upload.
@@ -24,14 +26,16 @@ class FileUploadService(BaseService):
         user = self.request.user
             
         f = validated_data.get('file')
-        dest_path = os.path.join(path, f.name)
+        dest_path = os.path.join(path, os.path.basename(f.name))
         if self.is_allowed(path, user) and not os.path.exists(dest_path):
-            with open(dest_path, 'wb+') as destination:
-                for chunk in f.chunks():
-                    destination.write(chunk)    
-            
-            self.fix_ownership(dest_path)
-            return True
+            def write():
+                # The upload is streamed to the disk chunk by chunk
+                with open(dest_path, 'xb') as destination:
+                    for chunk in f.chunks():
+                        destination.write(chunk)
+            if self.as_owner(path, write):
+                self.quarantined = malware.scan_and_quarantine(dest_path)
+                return True
         return False
 
     
@@ -52,12 +56,15 @@ class FileUploadService(BaseService):
         
         # Check if allowed
         if self.is_allowed(path, user):
-            with requests.get(remote_url, stream=True) as res:
-                # Check status code
-                if res.status_code == 200:
-                    with open(dest_path, 'wb') as f:
+            def fetch():
+                with requests.get(remote_url, stream=True, timeout=60) as res:
+                    # Check status code
+                    if res.status_code != 200:
+                        return False
+                    with open(dest_path, 'xb') as f:
                         for chunk in res.iter_content(chunk_size=(1024*1024)):
                             f.write(chunk)
-                
-                    return True
+            if self.as_owner(path, fetch):
+                self.quarantined = malware.scan_and_quarantine(dest_path)
+                return True
         return False
\ No newline at end of file
@@ -31,9 +31,7 @@ class GenerateArchiveService(BaseService):
             if len(paths) and root_path and self.is_allowed(root_path, user):
                 filename = os.path.basename(paths[0])
                 archive_name = f'{slugify(filename)}.zip'
-                cpfs.create_zip(root_path, archive_name, selected=paths)
-                self.fix_ownership(root_path)
-                return True
+                return self.as_owner(root_path, cpfs.create_zip, root_path, archive_name, paths)
         except Exception as e:
             pass
         
@@ -1,3 +1,4 @@
+from django.conf import settings
 from core.utils import filesystem as cpfs
 import os
 from pathlib import Path
@@ -29,7 +30,9 @@ class ListFileService(BaseService):
         search = validated_data.get('search')
         user = self.request.user
         
-        if path:                
+        # The listed directory may be above the allowed paths, i.e. the user's home, but not out of it
+        resolved = self.resolve_path(path) if path else ''
+        if path and (resolved + '/').startswith(settings.FILE_MANAGER_ROOT.rstrip('/') + '/') and (user.is_superuser or self.is_owner(resolved, user)):
             path = Path(path)
             files = []
             for p in path.iterdir():
@@ -31,20 +31,18 @@ class MoveDataService(BaseService):
             paths = validated_data.get('paths').split(',')
             if len(paths):
                 for p in paths:
-                    try:
-                        if validated_data.get('action') == 'move':
-                            shutil.move(p, dest_root)
-                        else:
-                            if os.path.isdir(p):
-                                dest_root = os.path.join(dest_root, os.path.basename(p))
-                                copy_tree(p, dest_root)
-                            else:
-                                shutil.copy2(p, dest_root)
-                    except Exception as e:
+                    if not self.is_allowed(p, user, follow_symlinks=False):
                         errors = True
-        
-        
-            self.fix_ownership(dest_root) 
+                        continue
+                    if validated_data.get('action') == 'move':
+                        moved = self.as_owner(dest_root, shutil.move, p, dest_root)
+                    elif os.path.isdir(p):
+                        moved = self.as_owner(dest_root, copy_tree, p, os.path.join(dest_root, os.path.basename(p)))
+                    else:
+                        moved = self.as_owner(dest_root, shutil.copy2, p, dest_root)
+                    errors = errors or not moved
+        else:
+            errors = True
                    
         if errors:
             return False
@@ -35,11 +35,12 @@ class ReadFileService(BaseService):
             # files larger than 10MB.
             
             if PATH_INFO.get('size') <= 10000000:
-                try:
-                    with open(path, 'rb') as f:
-                        content = f.read()
-                    content = content.decode('utf-8')
-                except UnicodeDecodeError as e:
-                    pass
+                f = self.open_owned(path)
+                if f:
+                    try:
+                        with f:
+                            content = f.read().decode('utf-8')
+                    except UnicodeDecodeError as e:
+                        pass
                 
         return content
\ No newline at end of file
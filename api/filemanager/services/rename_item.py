@@ -27,11 +27,10 @@ class RenameItemService(BaseService):
             
         old_path = os.path.join(root_path, old_name)
         new_path = os.path.join(root_path, new_name)
-        if all([os.path.exists(old_path), not os.path.exists(new_path), self.is_allowed(new_path, user), self.is_allowed(old_path, user)]):
+        if all([os.path.lexists(old_path), not os.path.lexists(new_path), self.is_allowed(new_path, user), self.is_allowed(old_path, user, follow_symlinks=False)]):
             try:
-                os.rename(old_path, new_path)
-                self.fix_ownership(new_path)
-                return True
+                # Renamed as the owner like the other changes, so a swapped in symlink cannot redirect it
+                return self.as_owner(root_path, os.rename, old_path, new_path)
             except:
                 pass    
         
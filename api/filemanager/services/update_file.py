@@ -25,14 +25,10 @@ class UpdateFileService(BaseService):
         path = validated_data.get('path')
         
         if path and os.path.exists(path) and self.is_allowed(path, user):
-            try: 
-                data = validated_data.get('content')
+            data = validated_data.get('content')
+            def write():
                 with open(path, 'wb') as f:
                     f.write(data.encode())
-                
-                self.fix_ownership(path)
-                return True
-            except UnicodeDecodeError as e:
-                pass
+            return self.as_owner(path, write)
         
         return False
\ No newline at end of file
@@ -1,5 +1,5 @@
+import os
 from core.utils import filesystem as cpfs
-from core.utils.system import run_cmd
 from .base_service import BaseService
 
 
@@ -27,9 +27,8 @@ class UpdatePermissionService(BaseService):
             
         if path and self.is_allowed(path, user):
             try:
-                run_cmd(f'/usr/bin/chmod {permissions} {path}')
-                self.fix_ownership(path)
-                return True
-            except Exception as e:
-                pass
+                mode = int(str(permissions), 8)
+            except ValueError:
+                return False
+            return self.as_owner(path, os.chmod, path, mode)
         return False
\ No newline at end of file
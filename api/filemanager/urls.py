@@ -5,6 +5,7 @@ app_name = 'filemanager'
 urlpatterns = [
     path('files/', views.FileListView.as_view(), name='files'),
     path('file-manipulation/', views.FileObjectView.as_view(), name='file_manipulation'),
+    path('download/', views.DownloadFileView.as_view(), name='download'),
     path('generate-archive/', views.GenerateArchiveView.as_view(), name='generate_archive'),
     path('delete-items/', views.DeleteItemsView.as_view(), name='delete_items'),
     path('extract-archive/', views.ExtractArchiveView().as_view(), name='extract_archive'),
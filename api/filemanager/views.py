@@ -1,3 +1,5 @@
+import os
+from django.http import FileResponse
 from rest_framework.views import APIView
 from rest_framework.response import Response
 from rest_framework import status
@@ -10,6 +12,7 @@ from .services.generate_archive import GenerateArchiveService
 from .services.update_file import UpdateFileService
 from .services.create_item import CreateItemService
 from .services.read_file import ReadFileService
+from .services.download_file import DownloadFileService
 from .services.move_items import MoveDataService
 from .services.file_upload import FileUploadService
 from .services.rename_item import RenameItemService
@@ -30,7 +33,12 @@ class UploadFileView(APIView):
         if not s.is_valid():
             return Response(s.errors, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
         
-        if FileUploadService(request).upload_file(s.validated_data):
+        service = FileUploadService(request)
+        if service.upload_file(s.validated_data):
+            if service.quarantined:
+                return Response({
+                    'error': f'File has been quarantined as it was detected as {service.quarantined.signature}.'
+                }, status=status.HTTP_400_BAD_REQUEST)
             return Response({
                 'message': 'File has been successfully uploaded.'
             })
@@ -52,7 +60,12 @@ class RemoteUpload(APIView):
         if not s.is_valid():
             return Response(s.errors, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
         
-        if FileUploadService(request).remote_upload(s.validated_data):
+        service = FileUploadService(request)
+        if service.remote_upload(s.validated_data):
+            if service.quarantined:
+                return Response({
+                    'error': f'File has been quarantined as it was detected as {service.quarantined.signature}.'
+                }, status=status.HTTP_400_BAD_REQUEST)
             return Response({
                 'message': 'File has been successfully fetched.'
             })
@@ -148,6 +161,27 @@ class FileObjectView(APIView):
             }, status=status.HTTP_400_BAD_REQUEST)
 
 
+class DownloadFileView(APIView):
+    """Download File.
+    
+    This view streams a file to the user in chunks, so large files such as backups and archives can be
+    downloaded without loading them into memory.
+    """
+    http_method_names = ['get']
+    
+    def get(self, request, *args, **kwargs):
+        s = serializers.DownloadFileSerializer(data=request.GET)
+        if not s.is_valid():
+            return Response(s.errors, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        f = DownloadFileService(request).open_file(s.validated_data)
+        if f is None:
+            return Response({
+                'error': 'File cannot be downloaded.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return FileResponse(f, as_attachment=True, filename=os.path.basename(s.validated_data.get('path')))
+
+
 class GenerateArchiveView(APIView):
     """Generate Archive
     
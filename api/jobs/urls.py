@@ -0,0 +1,8 @@
+from django.urls import path
+from . import views
+
+app_name='jobs'
+urlpatterns=[
+    path('', views.JobsView.as_view(), name='jobs'),
+    path('<int:id>/', views.JobView.as_view(), name='job')
+]
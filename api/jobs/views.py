@@ -0,0 +1,45 @@
+from rest_framework.views import APIView
+from rest_framework.response import Response
+from rest_framework import status
+from core.models import Job
+from core.utils.jobs import serialize_job
+from api import conditional
+
+
+class JobsView(APIView):
+    """Jobs View
+
+    Lists the latest background jobs of the user, admins see the jobs of all users. Polling clients get
+    304 responses with If-None-Match while no job changed.
+    """
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kw):
+        jobs = Job.objects.all() if request.user.is_superuser else Job.objects.filter(user=request.user)
+        if request.GET.get('kind'):
+            jobs = jobs.filter(kind=request.GET.get('kind'))
+        jobs = jobs.order_by('-created')[:50]
+        # The queue positions change with the other waiting jobs
+        queued = Job.objects.filter(state='queued').values_list('id', flat=True)
+        etag = conditional.etag_for(list(jobs.values_list('id', 'state', 'progress', 'total', 'finished')), list(queued))
+        return conditional.with_etag(conditional.not_modified(request, etag) or Response([serialize_job(j) for j in jobs]), etag)
+
+
+class JobView(APIView):
+    """Job View
+
+    Returns the progress and the results of a background job, the panel polls it until the job is done.
+    Polling clients get 304 responses with If-None-Match while the job didn't change.
+    """
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kw):
+        jobs = Job.objects.all() if request.user.is_superuser else Job.objects.filter(user=request.user)
+        job = jobs.filter(id=kw.get('id')).first()
+        if not job:
+            return Response({
+                'message': f'Job with ID {kw.get("id")} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        data = serialize_job(job)
+        etag = conditional.etag_for(data)
+        return conditional.with_etag(conditional.not_modified(request, etag) or Response(data), etag)
@@ -0,0 +1,11 @@
+from django.urls import path
+from . import views
+
+app_name='mail'
+urlpatterns=[
+    path('domains/', views.MailDomainsView.as_view(), name='domains'),
+    path('domains/<int:id>/', views.MailDomainView.as_view(), name='domain'),
+    path('domains/<int:id>/dkim/', views.DkimView.as_view(), name='dkim'),
+    path('domains/<int:id>/mailboxes/', views.MailboxesView.as_view(), name='mailboxes'),
+    path('domains/<int:id>/mailboxes/<int:mailbox_id>/', views.MailboxView.as_view(), name='mailbox')
+]
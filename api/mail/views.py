@@ -0,0 +1,246 @@
+import re
+from rest_framework.views import APIView
+from rest_framework.response import Response
+from rest_framework import status
+from core.models import Website, MailDomain
+from core.utils import mail
+from core.utils.system import rand_passwd
+
+
+LOCAL_PART_RE = re.compile(r'^[a-z0-9](?:[a-z0-9._+-]{0,62}[a-z0-9])?$')
+
+
+class MailDomainsView(APIView):
+    """Mail Domains View
+
+    Lists the mail domains of the user, or enables emails for a domain of a website. A DKIM key is generated
+    for the new domain and the DNS records to publish are returned.
+    """
+    http_method_names = ['get', 'post']
+
+    def get_queryset(self, user):
+        if user.is_superuser:
+            return MailDomain.objects.all()
+        return MailDomain.objects.filter(website__user=user)
+
+    def get(self, request, *args, **kw):
+        domains = self.get_queryset(request.user).select_related('website').order_by('domain')
+        return Response([{
+            'id': d.id,
+            'domain': d.domain,
+            'website': d.website.label,
+            'mailboxes': d.mailboxes.count(),
+            'dns_records': mail.dns_records(d),
+            'created': d.created
+        } for d in domains])
+
+    def post(self, request, *args, **kw):
+        user = request.user
+        domain = request.POST.get('domain', '').strip().lower()
+        websites = Website.objects.all() if user.is_superuser else Website.objects.filter(user=user)
+        website = websites.filter(domains__domain=domain).first()
+        if not website:
+            return Response({
+                'errors': {'domain': [f'{domain} is not a domain of your websites.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        if MailDomain.objects.filter(domain=domain).exists():
+            return Response({
+                'errors': {'domain': [f'Emails are already enabled for {domain}.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        mail_domain = MailDomain.objects.create(website=website, domain=domain)
+        mail.generate_dkim(mail_domain)
+        mail.sync_mail_config()
+        return Response({
+            'message': f'Emails have been enabled for {domain}. Publish the DNS records to start receiving emails.',
+            'id': mail_domain.id,
+            'dns_records': mail.dns_records(mail_domain)
+        })
+
+
+class MailDomainView(APIView):
+    """Mail Domain View
+
+    Returns the DNS records of a mail domain, or disables emails for it. Disabling a domain deletes its
+    mailboxes along with the emails.
+    """
+    http_method_names = ['get', 'delete']
+
+    def get_mail_domain(self, request, id):
+        """Returns the mail domain if it belongs to the user, admins can access all."""
+        if request.user.is_superuser:
+            return MailDomain.objects.filter(id=id).first()
+        return MailDomain.objects.filter(id=id, website__user=request.user).first()
+
+    def not_found(self, id):
+        return Response({
+            'message': f'Mail domain with ID {id} was not found.'
+        }, status=status.HTTP_404_NOT_FOUND)
+
+    def get(self, request, *args, **kw):
+        mail_domain = self.get_mail_domain(request, kw.get('id'))
+        if not mail_domain:
+            return self.not_found(kw.get('id'))
+        return Response({
+            'id': mail_domain.id,
+            'domain': mail_domain.domain,
+            'dns_records': mail.dns_records(mail_domain)
+        })
+
+    def delete(self, request, *args, **kw):
+        mail_domain = self.get_mail_domain(request, kw.get('id'))
+        if not mail_domain:
+            return self.not_found(kw.get('id'))
+
+        mail_domain.delete()
+        mail.sync_mail_config()
+        mail.delete_domain_data(mail_domain)
+        return Response({'message': f'Emails have been disabled for {mail_domain}.'})
+
+
+class DkimView(MailDomainView):
+    """DKIM View
+
+    Rotates the DKIM key of a mail domain. The new DNS record has to be published, the emails sent until
+    then fail the DKIM checks.
+    """
+    http_method_names = ['post']
+
+    def post(self, request, *args, **kw):
+        mail_domain = self.get_mail_domain(request, kw.get('id'))
+        if not mail_domain:
+            return self.not_found(kw.get('id'))
+
+        if not mail.generate_dkim(mail_domain):
+            return Response({
+                'message': 'The DKIM key cannot be generated.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        mail.sync_mail_config()
+        return Response({
+            'message': 'A new DKIM key has been generated.',
+            'dns_records': mail.dns_records(mail_domain)
+        })
+
+
+class MailboxesView(MailDomainView):
+    """Mailboxes View
+
+    Lists the mailboxes of a mail domain along with their disk usage, or creates a mailbox. A password is
+    generated if none is provided and it's only returned once.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kw):
+        mail_domain = self.get_mail_domain(request, kw.get('id'))
+        if not mail_domain:
+            return self.not_found(kw.get('id'))
+
+        return Response([{
+            'id': m.id,
+            'address': m.address,
+            'quota_mb': m.quota_mb,
+            'usage': mail.mailbox_usage(m),
+            'created': m.created
+        } for m in mail_domain.mailboxes.order_by('local_part')])
+
+    def post(self, request, *args, **kw):
+        mail_domain = self.get_mail_domain(request, kw.get('id'))
+        if not mail_domain:
+            return self.not_found(kw.get('id'))
+
+        errors = {}
+        local_part = request.POST.get('local_part', '').strip().lower()
+        if not LOCAL_PART_RE.match(local_part) or '..' in local_part:
+            errors['local_part'] = ['Enter a valid mailbox name, i.e. info.']
+        elif mail_domain.mailboxes.filter(local_part=local_part).exists():
+            errors['local_part'] = [f'{local_part}@{mail_domain} already exists.']
+
+        password = request.POST.get('password') or rand_passwd()
+        if len(password) < 8:
+            errors['password'] = ['The password should be at least 8 characters long.']
+
+        try:
+            quota_mb = int(request.POST.get('quota_mb', 1024))
+            if quota_mb < 0:
+                raise ValueError
+        except ValueError:
+            errors['quota_mb'] = ['The quota should be a number of MBs, 0 for unlimited.']
+
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        password_hash = mail.hash_password(password)
+        if not password_hash:
+            return Response({
+                'message': 'The password cannot be hashed, is Dovecot installed?'
+            }, status=status.HTTP_400_BAD_REQUEST)
+
+        mailbox = mail_domain.mailboxes.create(local_part=local_part, password_hash=password_hash, quota_mb=quota_mb)
+        mail.sync_mail_config()
+        return Response({
+            'message': f'{mailbox} has been created.',
+            'id': mailbox.id,
+            'address': mailbox.address,
+            'password': password
+        })
+
+
+class MailboxView(MailDomainView):
+    """Mailbox View
+
+    Updates the quota or resets the password of a mailbox, or deletes the mailbox along with its emails.
+    Posting reset_password generates a new password, which is only returned once.
+    """
+    http_method_names = ['post', 'delete']
+
+    def get_mailbox(self, request, kw):
+        mail_domain = self.get_mail_domain(request, kw.get('id'))
+        if not mail_domain:
+            return None
+        return mail_domain.mailboxes.filter(id=kw.get('mailbox_id')).first()
+
+    def post(self, request, *args, **kw):
+        mailbox = self.get_mailbox(request, kw)
+        if not mailbox:
+            return Response({
+                'message': f'Mailbox with ID {kw.get("mailbox_id")} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        result = {'message': f'{mailbox} has been updated.'}
+        if request.POST.get('quota_mb') is not None:
+            try:
+                quota_mb = int(request.POST.get('quota_mb'))
+                if quota_mb < 0:
+                    raise ValueError
+            except ValueError:
+                return Response({
+                    'errors': {'quota_mb': ['The quota should be a number of MBs, 0 for unlimited.']}
+                }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+            mailbox.quota_mb = quota_mb
+
+        if request.POST.get('reset_password'):
+            password = rand_passwd()
+            password_hash = mail.hash_password(password)
+            if not password_hash:
+                return Response({
+                    'message': 'The password cannot be hashed, is Dovecot installed?'
+                }, status=status.HTTP_400_BAD_REQUEST)
+            mailbox.password_hash = password_hash
+            result['password'] = password
+
+        mailbox.save()
+        mail.sync_mail_config()
+        return Response(result)
+
+    def delete(self, request, *args, **kw):
+        mailbox = self.get_mailbox(request, kw)
+        if not mailbox:
+            return Response({
+                'message': f'Mailbox with ID {kw.get("mailbox_id")} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        mailbox.delete()
+        mail.sync_mail_config()
+        mail.delete_mailbox_data(mailbox)
+        return Response({'message': f'{mailbox} has been deleted.'})
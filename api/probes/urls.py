@@ -0,0 +1,10 @@
+from django.urls import path
+from . import views
+
+app_name='probes'
+urlpatterns=[
+    path('', views.ProbesView.as_view(), name='probes'),
+    path('<int:id>/', views.DeleteProbeView.as_view(), name='delete_probe'),
+    path('targets/', views.ProbeTargetsView.as_view(), name='targets'),
+    path('results/', views.ProbeResultsView.as_view(), name='results')
+]
@@ -0,0 +1,107 @@
+from rest_framework.views import APIView
+from rest_framework.response import Response
+from rest_framework import permissions
+from rest_framework import status
+from django.utils.text import slugify
+from core.models import Probe
+from core.utils import monitoring
+
+
+class ProbesView(APIView):
+    """Probes View
+    
+    Lists the external probes or registers a new one. The token of a probe is only shown once, when the
+    probe is registered. Only admins are allowed to manage probes.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        probes = Probe.objects.order_by('name').values('id', 'name', 'last_seen', 'created')
+        return Response({'probes': list(probes)})
+    
+    def post(self, request, *args, **kw):
+        name = slugify(request.POST.get('name', ''))
+        if not name or name == 'local':
+            return Response({
+                'errors': {'name': ['A valid probe name is required.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        if Probe.objects.filter(name=name).exists():
+            return Response({
+                'errors': {'name': [f'A probe named {name} already exists.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        probe, token = monitoring.create_probe(name)
+        return Response({
+            'message': 'The probe has been registered. Save the token now, it will not be shown again.',
+            'id': probe.id,
+            'name': probe.name,
+            'token': token
+        })
+
+
+class DeleteProbeView(APIView):
+    """Deletes an external probe, its results are kept."""
+    http_method_names = ['delete']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def delete(self, request, *args, **kwargs):
+        probe = Probe.objects.filter(id=kwargs.get('id')).first()
+        if not probe:
+            return Response({
+                'message': 'Target probe was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        probe.delete()
+        return Response({
+            'message': 'The probe has been deleted.'
+        })
+
+
+class ProbeTargetsView(APIView):
+    """Probe Targets View
+    
+    Probes authenticate with the token they were registered with instead of a session. This view
+    returns the URLs a probe should check.
+    """
+    http_method_names = ['get']
+    authentication_classes = []
+    permission_classes = [permissions.AllowAny]
+    
+    def get_probe(self, request):
+        """Returns the probe the bearer token in the request belongs to."""
+        scheme, _, token = request.META.get('HTTP_AUTHORIZATION', '').partition(' ')
+        if scheme != 'Bearer' or not token:
+            return None
+        return Probe.objects.filter(token_hash=monitoring.hash_token(token)).first()
+    
+    def get(self, request, *args, **kw):
+        if not self.get_probe(request):
+            return Response({
+                'message': 'Invalid probe token.'
+            }, status=status.HTTP_401_UNAUTHORIZED)
+        return Response({'targets': monitoring.probe_targets()})
+
+
+class ProbeResultsView(ProbeTargetsView):
+    """Receives the check results from a probe."""
+    http_method_names = ['post']
+    
+    def post(self, request, *args, **kw):
+        probe = self.get_probe(request)
+        if not probe:
+            return Response({
+                'message': 'Invalid probe token.'
+            }, status=status.HTTP_401_UNAUTHORIZED)
+        
+        results = request.data.get('results')
+        errors = monitoring.validate_probe_results(results)
+        if errors:
+            return Response({
+                'errors': {'results': errors}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        return Response({
+            'recorded': monitoring.record_probe_results(probe, results)
+        })
@@ -0,0 +1,12 @@
+from rest_framework.renderers import JSONRenderer
+
+
+class EventStreamRenderer(JSONRenderer):
+    """Event stream renderer.
+    
+    Lets the views that stream server-sent events accept the text/event-stream requests of EventSource.
+    The events are streamed by the views themselves, the errors returned before streaming are rendered
+    as JSON.
+    """
+    media_type = 'text/event-stream'
+    format = 'event-stream'
@@ -0,0 +1,21 @@
+from rest_framework.views import APIView
+from rest_framework.response import Response
+from rest_framework import status
+from core.utils.search import search
+
+
+class SearchView(APIView):
+    """Search View
+
+    Searches everything the user can access, like domains, usernames, database names, cron commands and
+    notes, and returns typed results with links to them.
+    """
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kw):
+        query = request.GET.get('q', '').strip()
+        if len(query) < 2:
+            return Response({
+                'errors': {'q': ['Enter at least 2 characters to search for.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        return Response({'query': query, 'results': search(request.user, query)})
@@ -4,5 +4,7 @@ from . import views
 app_name='stats'
 urlpatterns=[
     path('common/', views.StatsView.as_view(), name='stats'),
-    path('hardware/', views.HardwareinfoView.as_view(), name='hardwareinfo')
+    path('hardware/', views.HardwareinfoView.as_view(), name='hardwareinfo'),
+    path('disk/', views.DiskView.as_view(), name='disk'),
+    path('disk/expand/', views.ExpandDiskView.as_view(), name='expand_disk')
 ]
\ No newline at end of file
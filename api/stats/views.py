@@ -2,21 +2,26 @@ from rest_framework.views import APIView
 from rest_framework.response import Response
 from rest_framework import permissions
 from core.utils.generics import system_stats, hardware_info
+from core.utils.disk import disk_status, expand_root_disk
 from rest_framework import status
+from api import conditional
+import os
 
 
 class StatsView(APIView):
     """Stats View
     
     Returns data for the dashboard widgets. It returns general stats like number of websites, databases,
-    storage & RAM stats etc.
+    storage & RAM stats etc. Polling clients get 304 responses with If-None-Match while the stats are the
+    same.
     """
     http_method_names = ['get']
     permission_classes = [permissions.IsAdminUser]
     def get(self, request, *args, **kw):
         result = system_stats()
-        response = Response(result, status=status.HTTP_200_OK)
-        return response
+        etag = conditional.etag_for(result)
+        response = conditional.not_modified(request, etag) or Response(result, status=status.HTTP_200_OK)
+        return conditional.with_etag(response, etag)
 
 class HardwareinfoView(APIView):
     """Hardware Info View
@@ -28,4 +33,47 @@ class HardwareinfoView(APIView):
     def get(self, request, *args, **kw):
         result = hardware_info()
         response = Response(result, status=status.HTTP_200_OK)
-        return response
\ No newline at end of file
+        return response
+
+class DiskView(APIView):
+    """Disk View
+    
+    Returns the root filesystem usage and either the disk can be expanded to use the space added by the
+    cloud provider or not.
+    """
+    http_method_names = ['get']
+    permission_classes = [permissions.IsAdminUser]
+    def get(self, request, *args, **kw):
+        return Response(disk_status(), status=status.HTTP_200_OK)
+
+class ExpandDiskView(APIView):
+    """Expand Disk View
+    
+    Grows the root partition and the filesystem to fill the disk after the volume has been resized by the
+    cloud provider.
+    """
+    http_method_names = ['post']
+    permission_classes = [permissions.IsAdminUser]
+    def post(self, request, *args, **kw):
+        if not disk_status().get('expandable'):
+            return Response({
+                'message': 'There is no unallocated space to expand the disk into.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        
+        try:
+            expanded = expand_root_disk()
+        except FileNotFoundError as e:
+            command = os.path.basename(e.filename or 'growpart')
+            # growpart comes with cloud-guest-utils, which minimal images leave out
+            hint = ' Install cloud-guest-utils and try again.' if command == 'growpart' else ''
+            return Response({
+                'message': f'The disk cannot be expanded because {command} is not installed.{hint}'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        if expanded:
+            return Response({
+                'message': 'The disk has been expanded successfully.',
+                'disk': disk_status()
+            })
+        return Response({
+            'message': 'The disk cannot be expanded. Please expand it manually.'
+        }, status=status.HTTP_400_BAD_REQUEST)
@@ -0,0 +1,32 @@
+from django.urls import path
+from . import views
+
+app_name='system'
+urlpatterns=[
+    path('tuning/', views.TuningView.as_view(), name='tuning'),
+    path('reboot/', views.RebootView.as_view(), name='reboot'),
+    path('hostname/', views.HostnameView.as_view(), name='hostname'),
+    path('default-page/', views.DefaultPageView.as_view(), name='default_page'),
+    path('discover/', views.DiscoverView.as_view(), name='discover'),
+    path('discover/import/', views.ImportVhostView.as_view(), name='import_vhost'),
+    path('discover/take-over/', views.TakeOverPortsView.as_view(), name='take_over_ports'),
+    path('operations/', views.OperationsView.as_view(), name='operations'),
+    path('operations/<int:id>/retry/', views.RetryOperationView.as_view(), name='retry_operation'),
+    path('logs/', views.ServiceLogsView.as_view(), name='service_logs'),
+    path('logs/<str:unit>/', views.ServiceLogsView.as_view(), name='service_log'),
+    path('backup/', views.ServerBackupView.as_view(), name='server_backup'),
+    path('backup/<int:id>/download/', views.DownloadServerBackupView.as_view(), name='download_server_backup'),
+    path('credentials-key/', views.CredentialsKeyView.as_view(), name='credentials_key'),
+    path('intrusion-protection/', views.IntrusionProtectionView.as_view(), name='intrusion_protection'),
+    path('intrusion-protection/unban/', views.UnbanView.as_view(), name='unban'),
+    path('log-rotation/', views.LogRotationView.as_view(), name='log_rotation'),
+    path('audit-log/', views.AuditLogView.as_view(), name='audit_log'),
+    path('migrations/', views.MigrationsView.as_view(), name='migrations'),
+    path('retention/', views.RetentionView.as_view(), name='retention'),
+    path('metrics/', views.MetricsView.as_view(), name='metrics'),
+    path('onboarding/', views.OnboardingView.as_view(), name='onboarding'),
+    path('telemetry/', views.TelemetryView.as_view(), name='telemetry'),
+    path('templates/', views.SystemTemplatesView.as_view(), name='templates'),
+    path('templates/preview/<path:name>', views.SystemTemplatePreviewView.as_view(), name='template_preview'),
+    path('templates/<path:name>', views.SystemTemplateView.as_view(), name='template')
+]
@@ -0,0 +1,710 @@
+from rest_framework.views import APIView
+from rest_framework.response import Response
+from rest_framework import permissions
+from rest_framework import status
+from rest_framework.settings import api_settings
+from api.renderers import EventStreamRenderer
+from core.utils import tuning, reboot, hostname, templates, discovery, journal, servicelogs, telemetry, serverbackup, jobs, exports, retention, metrics, onboarding, logstream, logfiles, audit, fail2ban, schema, vault
+from core.models import User, Operation, Job, PHP_CHOICES
+import os
+from django.conf import settings
+from django.http import StreamingHttpResponse, HttpResponse
+from django.core.serializers.json import DjangoJSONEncoder
+import validators, json
+
+
+class TuningView(APIView):
+    """Tuning View
+    
+    Lists the tuning profiles along with the current values of swap and kernel tunables, and applies or
+    reverts a profile. Only admins are allowed to tune the server.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response(tuning.tuning_status(), status=status.HTTP_200_OK)
+    
+    def post(self, request, *args, **kw):
+        if request.POST.get('action') == 'revert':
+            if tuning.revert_profile():
+                return Response({
+                    'message': 'The tuning profile has been reverted.',
+                    'tuning': tuning.tuning_status()
+                })
+            return Response({
+                'message': 'There is no applied tuning profile to revert.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        
+        profile = request.POST.get('profile')
+        if profile not in tuning.PROFILES:
+            return Response({
+                'errors': {'profile': [f'{profile} is not a valid tuning profile.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        if tuning.apply_profile(profile):
+            return Response({
+                'message': 'The tuning profile has been applied.',
+                'tuning': tuning.tuning_status()
+            })
+        return Response({
+            'message': 'The tuning profile has been applied partially. Please check the system logs.'
+        }, status=status.HTTP_400_BAD_REQUEST)
+
+
+class RebootView(APIView):
+    """Reboot View
+    
+    Returns either a reboot is required and the report of the last supervised reboot, and schedules a
+    supervised reboot. After the reboot, FastCP verifies that all services and websites came back healthy.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response(reboot.reboot_status(), status=status.HTTP_200_OK)
+    
+    def post(self, request, *args, **kw):
+        if reboot.reboot_status().get('pending'):
+            return Response({
+                'message': 'A reboot has already been scheduled.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        
+        # The services and websites are checked before the reboot is scheduled, which takes a while
+        job = jobs.start_job(request.user, 'supervised_reboot', 'server', {}, reboot.reboot_job)
+        return Response({
+            'message': 'The server will reboot in a minute once the services and websites have been checked. They will be verified again once it is back.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class HostnameView(APIView):
+    """Hostname View
+    
+    Checks the hostname, FQDN and reverse DNS configuration of the server and warns about mismatches that
+    break outbound mail or Let's Encrypt. A new FQDN can be posted to fix the hostname.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response(hostname.check_hostname(), status=status.HTTP_200_OK)
+    
+    def post(self, request, *args, **kw):
+        fqdn = request.POST.get('fqdn', '').strip().lower()
+        if not validators.domain(fqdn):
+            return Response({
+                'errors': {'fqdn': [f'{fqdn} is not a valid fully qualified domain name.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        if hostname.set_hostname(fqdn):
+            return Response({
+                'message': 'The hostname has been updated.',
+                'hostname': hostname.check_hostname()
+            })
+        return Response({
+            'message': 'The hostname cannot be updated.'
+        }, status=status.HTTP_400_BAD_REQUEST)
+
+
+class SystemTemplatesView(APIView):
+    """System Templates View
+    
+    Lists the templates FastCP generates the vhosts, pools and other files from, along with either the
+    admins have overridden them.
+    """
+    http_method_names = ['get']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response(templates.list_templates())
+
+
+class SystemTemplateView(APIView):
+    """System Template View
+    
+    Returns, overrides or resets a system template. Overrides are saved in FASTCP_TEMPLATES_DIR and they
+    are used for the files generated afterwards.
+    """
+    http_method_names = ['get', 'post', 'delete']
+    permission_classes = [permissions.IsAdminUser]
+    template_name = None
+    
+    def get_template_name(self):
+        """Returns the template name, None if it's not a system template."""
+        name = self.template_name or self.kwargs.get('name')
+        if name in templates.SYSTEM_TEMPLATES:
+            return name
+        return None
+    
+    def not_found(self):
+        return Response({
+            'message': f'Template {self.kwargs.get("name")} was not found.'
+        }, status=status.HTTP_404_NOT_FOUND)
+    
+    def get(self, request, *args, **kw):
+        name = self.get_template_name()
+        if not name:
+            return self.not_found()
+        return Response(templates.template_source(name))
+    
+    def post(self, request, *args, **kw):
+        name = self.get_template_name()
+        if not name:
+            return self.not_found()
+        
+        source = request.POST.get('template', '')
+        if not source.strip():
+            return Response({
+                'errors': {'template': ['The template cannot be empty.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        error = templates.save_override(name, source)
+        if error:
+            return Response({
+                'errors': {'template': [error]}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        return Response({
+            'message': 'The template has been updated, the files generated from now on will use it.',
+            **templates.template_source(name)
+        })
+    
+    def delete(self, request, *args, **kw):
+        name = self.get_template_name()
+        if not name:
+            return self.not_found()
+        templates.delete_override(name)
+        return Response({
+            'message': 'The template has been reset.',
+            **templates.template_source(name)
+        })
+
+
+class SystemTemplatePreviewView(SystemTemplateView):
+    """System Template Preview View
+    
+    Renders a template with sample values. If the template source is posted, it's rendered in place of the
+    current template so an override can be previewed before saving it.
+    """
+    http_method_names = ['post']
+    
+    def post(self, request, *args, **kw):
+        name = self.get_template_name()
+        if not name:
+            return self.not_found()
+        return Response(templates.render_preview(name, request.POST.get('template')))
+
+
+class DefaultPageView(SystemTemplateView):
+    """Default Page View
+    
+    Returns, customizes or resets the template of the page new websites are created with. The template
+    can use the label, username, php, web_root, server_ip and site_name variables.
+    """
+    template_name = 'system/default-index.txt'
+
+
+class DiscoverView(APIView):
+    """Discover View
+    
+    Lists the websites served by the NGINX, Apache and Caddy vhosts that FastCP didn't create, so they can
+    be adopted, along with the other web servers holding the HTTP and HTTPS ports.
+    """
+    http_method_names = ['get']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response({
+            'vhosts': discovery.discover_vhosts(),
+            'conflicts': discovery.port_conflicts()
+        })
+
+
+class ImportVhostView(APIView):
+    """Import Vhost View
+    
+    Creates a FastCP website from a discovered vhost, copying its files and disabling the original vhost.
+    """
+    http_method_names = ['post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def post(self, request, *args, **kw):
+        errors = {}
+        label = request.POST.get('label', '').strip()
+        if not label:
+            errors['label'] = ['A label is required.']
+        
+        user = User.objects.filter(username=request.POST.get('ssh_user'), is_superuser=False).first()
+        if not user:
+            errors['ssh_user'] = ['An SSH user should be selected as the owner of this website.']
+        
+        php = request.POST.get('php')
+        if php not in [v for v, _ in PHP_CHOICES]:
+            errors['php'] = [f'PHP {php} is not installed.']
+        
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        try:
+            website = discovery.import_vhost(
+                request.POST.get('vhost'), user, label, php,
+                copy_files=request.POST.get('copy_files') != 'false',
+                disable_original=request.POST.get('disable_original') != 'false'
+            )
+        except ValueError as e:
+            return Response({'errors': {'vhost': [str(e)]}}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        except OSError as e:
+            return Response({
+                'message': f'The website has been created but its files cannot be copied: {e}'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        
+        return Response({
+            'message': f'{website} has been imported.',
+            'id': website.id
+        })
+
+
+class TakeOverPortsView(APIView):
+    """Take Over Ports View
+    
+    Stops the web server that holds the HTTP and HTTPS ports so NGINX can serve the FastCP websites.
+    """
+    http_method_names = ['post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def post(self, request, *args, **kw):
+        service = request.POST.get('service')
+        if discovery.take_over_ports(service):
+            return Response({
+                'message': f'{service} no longer listens on the HTTP ports.',
+                'conflicts': discovery.port_conflicts()
+            })
+        return Response({
+            'message': f'The HTTP ports cannot be taken over from {service}.'
+        }, status=status.HTTP_400_BAD_REQUEST)
+
+
+class OperationsView(APIView):
+    """Operations View
+    
+    Lists the journaled multi-step operations, latest first. The operations left half applied by a crash
+    are resumed in the background and show up as recovered, the failed ones can be retried.
+    """
+    http_method_names = ['get']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        operations = Operation.objects.order_by('-started')
+        state = request.GET.get('state')
+        if state:
+            operations = operations.filter(state=state)
+        return Response([{
+            'id': o.id,
+            'kind': o.kind,
+            'target': o.target,
+            'state': o.state,
+            'steps': journal.operation_steps(o),
+            'error': o.error,
+            'started': o.started,
+            'finished': o.finished
+        } for o in operations[:100]])
+
+
+class RetryOperationView(APIView):
+    """Retry Operation View
+    
+    Resumes a failed operation from the step that failed.
+    """
+    http_method_names = ['post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def post(self, request, *args, **kw):
+        operation = Operation.objects.filter(id=kw.get('id'), state='failed').first()
+        if not operation:
+            return Response({
+                'message': f'Failed operation with ID {kw.get("id")} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        if not journal.retry_operation(operation):
+            return Response({
+                'message': f'The operation failed again: {operation.error}'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({'message': f'{operation} has been completed.'})
+
+
+class ServiceLogsView(APIView):
+    """Service Logs View
+    
+    Lists the services whose logs can be read, or returns the recent journal entries of a service. With
+    follow, the new entries are streamed as JSON lines until FASTCP_LOG_FOLLOW_SECONDS pass, and clients
+    continue from the cursor of the last entry. EventSource clients get server-sent events instead, with
+    the cursors as the event IDs so reconnects continue through the Last-Event-ID header.
+    """
+    http_method_names = ['get']
+    permission_classes = [permissions.IsAdminUser]
+    renderer_classes = api_settings.DEFAULT_RENDERER_CLASSES + [EventStreamRenderer]
+    
+    def get(self, request, *args, **kw):
+        unit = kw.get('unit')
+        if not unit:
+            return Response({'units': servicelogs.log_units(), 'priorities': servicelogs.PRIORITIES})
+        
+        errors = {}
+        if unit not in servicelogs.log_units():
+            errors['unit'] = [f'Logs of {unit} are not available.']
+        priority = request.GET.get('priority') or None
+        if priority and priority not in servicelogs.PRIORITIES:
+            errors['priority'] = [f'Priority should be one of {", ".join(servicelogs.PRIORITIES)}.']
+        try:
+            lines = int(request.GET.get('lines', 100))
+            if lines < 1 or lines > 1000:
+                raise ValueError
+        except ValueError:
+            errors['lines'] = ['Lines should be a number from 1 to 1000.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        cursor = request.GET.get('cursor') or request.META.get('HTTP_LAST_EVENT_ID') or None
+        if request.accepted_renderer.format == 'event-stream':
+            entries = servicelogs.follow_entries(unit, priority, cursor)
+            response = StreamingHttpResponse((logstream.sse_event(e, 'entry', e.get('cursor')) for e in entries), content_type='text/event-stream')
+            response['Cache-Control'] = 'no-cache'
+            response['X-Accel-Buffering'] = 'no'
+            return response
+        if request.GET.get('follow') in ['1', 'true']:
+            entries = servicelogs.follow_entries(unit, priority, cursor)
+            response = StreamingHttpResponse((f'{json.dumps(e)}\n' for e in entries), content_type='application/x-ndjson')
+            response['X-Accel-Buffering'] = 'no'
+            return response
+        return Response({'unit': unit, 'entries': servicelogs.journal_entries(unit, lines, priority, cursor)})
+
+
+class TelemetryView(APIView):
+    """Telemetry View
+    
+    Shows whether the anonymous usage stats and crash reports are sent, where to, and the exact data the
+    next upload sends.
+    """
+    http_method_names = ['get']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response({
+            'enabled': settings.FASTCP_TELEMETRY,
+            'url': settings.FASTCP_TELEMETRY_URL,
+            'payload': telemetry.payload()
+        })
+
+
+class MigrationsView(APIView):
+    """Migrations View
+
+    Shows the applied and the pending database migrations of each app and the database backups taken
+    before the migrations. Pending migrations mean the panel was updated without running migrate.
+    """
+    http_method_names = ['get']
+    permission_classes = [permissions.IsAdminUser]
+
+    def get(self, request, *args, **kw):
+        return Response(schema.migration_status())
+
+
+class ServerBackupView(APIView):
+    """Server Backup View
+    
+    Lists the paths a server backup includes, or takes a server backup of the panel state and the system
+    config as a background job. The archive is restored with the disaster-restore command.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        backups = Job.objects.filter(kind='server_backup').order_by('-created')[:20]
+        return Response({
+            'paths': serverbackup.backup_paths(),
+            'backups': [jobs.serialize_job(j) for j in backups]
+        })
+    
+    def post(self, request, *args, **kw):
+        job = jobs.start_job(request.user, 'server_backup', 'server', {}, serverbackup.server_backup)
+        return Response({
+            'message': 'Backing up the server.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class CredentialsKeyView(APIView):
+    """Credentials Key View
+    
+    Lists the past rotations of the key encrypting the stored credentials, or rotates it as a background
+    job that re-encrypts the stored credentials with the new key.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        rotations = Job.objects.filter(kind='rotate_credentials_key').order_by('-created')[:20]
+        return Response({
+            'rotations': [jobs.serialize_job(j) for j in rotations]
+        })
+    
+    def post(self, request, *args, **kw):
+        job = jobs.start_job(request.user, 'rotate_credentials_key', 'server', {}, vault.rotate_job)
+        return Response({
+            'message': 'Rotating the credentials key.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class DownloadServerBackupView(APIView):
+    """Download Server Backup View
+    
+    Downloads the archive of a finished server backup. Range requests are supported, so interrupted
+    downloads can be resumed.
+    """
+    http_method_names = ['get']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        job = Job.objects.filter(id=kw.get('id'), kind='server_backup', state='done').first()
+        if not job or not os.path.exists(exports.artifact_path(job)):
+            return Response({
+                'message': f'Finished server backup with ID {kw.get("id")} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        return exports.ranged_file_response(request, exports.artifact_path(job), os.path.basename(exports.artifact_path(job)))
+
+
+class AuditLogView(APIView):
+    """Audit Log View
+
+    Lists the audit entries of the API calls that changed something and of the background jobs they
+    started, newest first. The entries can be filtered by user, source, action, result, request_id, since
+    and until, and the filtered entries are exported as a file with export=csv or export=json.
+    """
+    http_method_names = ['get']
+    permission_classes = [permissions.IsAdminUser]
+    PER_PAGE = 100
+
+    def get(self, request, *args, **kw):
+        entries = audit.filter_entries(request.GET)
+        export = request.GET.get('export')
+        if export == 'csv':
+            response = StreamingHttpResponse(audit.csv_rows(entries), content_type='text/csv')
+            response['Content-Disposition'] = 'attachment; filename="fastcp-audit.csv"'
+            return response
+        if export == 'json':
+            data = [audit.serialize_entry(e) for e in entries.iterator()]
+            response = HttpResponse(json.dumps(data, cls=DjangoJSONEncoder), content_type='application/json')
+            response['Content-Disposition'] = 'attachment; filename="fastcp-audit.json"'
+            return response
+        if export:
+            return Response({
+                'errors': {'export': ['Export should be either csv or json.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        try:
+            page = int(request.GET.get('page', 1))
+            if page < 1:
+                raise ValueError
+        except ValueError:
+            return Response({
+                'errors': {'page': ['Page should be a positive number.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        start = (page - 1) * self.PER_PAGE
+        # One more entry than the page tells if there is a next page
+        results = list(entries[start:start + self.PER_PAGE + 1])
+        return Response({
+            'links': {
+                'next': page + 1 if len(results) > self.PER_PAGE else None,
+                'previous': page - 1 if page > 1 else None
+            },
+            'results': [audit.serialize_entry(e) for e in results[:self.PER_PAGE]]
+        })
+
+class RetentionView(APIView):
+    """Retention View
+    
+    Shows the retention period, the number of rows and the number of expired rows of the tables that grow
+    with time, or purges the expired rows right away instead of waiting for the daily purge.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response(retention.retention_status())
+    
+    def post(self, request, *args, **kw):
+        return Response({
+            'message': 'The expired data has been purged.',
+            'deleted': retention.purge_expired()
+        })
+
+
+class MetricsView(APIView):
+    """Metrics View
+    
+    Shows the configured metrics exporters along with the samples the next push sends, or pushes the
+    metrics right away to verify the exporters.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response({
+            'exporters': metrics.exporters(),
+            'samples': [{'name': f'{metrics.PREFIX}_{name}', 'labels': labels, 'value': value} for name, labels, value in metrics.collect()]
+        })
+    
+    def post(self, request, *args, **kw):
+        if not metrics.exporters():
+            return Response({
+                'message': 'No metrics exporter is configured.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        
+        errors = metrics.export_metrics()
+        failed = [name for name, error in errors.items() if error]
+        if failed:
+            return Response({
+                'message': f'The metrics cannot be pushed to {", ".join(failed)}.',
+                'errors': errors
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({'message': 'The metrics have been pushed.'})
+
+
+class OnboardingView(APIView):
+    """Onboarding View
+    
+    Returns the checklist of the recommended steps after installing FastCP, which reflects the server
+    state. Posting a step with skipped marks it as done by hand, i.e. if backups are taken by another tool.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response(onboarding.checklist(request.user))
+    
+    def post(self, request, *args, **kw):
+        step = request.POST.get('step')
+        if step not in [name for name, title, description in onboarding.STEPS]:
+            return Response({
+                'errors': {'step': [f'{step} is not a valid onboarding step.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        onboarding.skip_step(step, request.POST.get('skipped') in ['1', 'true'])
+        return Response({
+            'message': 'The onboarding checklist has been updated.',
+            'checklist': onboarding.checklist(request.user)
+        })
+
+
+class IntrusionProtectionView(APIView):
+    """Intrusion Protection View
+    
+    Shows the fail2ban jails of SSH, the panel and WordPress sign ins along with the IPs they banned, or
+    updates the policy and rewrites the jails. fail2ban is installed in the background if it's missing.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response(fail2ban.status())
+    
+    def post(self, request, *args, **kw):
+        errors = {}
+        jails = request.POST.getlist('jails')
+        invalid = [jail for jail in jails if jail not in fail2ban.JAILS]
+        if invalid:
+            errors['jails'] = [f'Jails should be any of {", ".join(fail2ban.JAILS)}.']
+        ignore_ips = list(filter(None, [ip.strip() for ip in request.POST.getlist('ignore_ips')]))
+        if any(not fail2ban.valid_ip(ip, network=True) for ip in ignore_ips):
+            errors['ignore_ips'] = ['The ignored IPs should be IP addresses or networks, i.e. 203.0.113.0/24.']
+        new_policy = {'jails': jails, 'ignore_ips': ignore_ips}
+        for field, minimum, maximum in [('maxretry', 1, 100), ('wp_maxretry', 1, 1000), ('findtime', 60, 86400), ('bantime', 60, 31536000)]:
+            try:
+                new_policy[field] = int(request.POST.get(field))
+                if new_policy[field] < minimum or new_policy[field] > maximum:
+                    raise ValueError
+            except (TypeError, ValueError):
+                errors[field] = [f'Should be a number from {minimum} to {maximum}.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        if not fail2ban.is_installed():
+            job = jobs.start_job(request.user, 'install_fail2ban', 'server', {'policy': new_policy}, fail2ban.install_job)
+            return Response({
+                'message': 'Installing fail2ban.',
+                'job': jobs.serialize_job(job)
+            })
+        if fail2ban.write_config(new_policy):
+            return Response({
+                'message': 'The intrusion protection policy has been updated.',
+                **fail2ban.status()
+            })
+        return Response({
+            'message': 'The policy has been saved but fail2ban rejected the config. Please check the templates.'
+        }, status=status.HTTP_400_BAD_REQUEST)
+
+
+class UnbanView(APIView):
+    """Unban View
+    
+    Lifts the ban of an IP in a fail2ban jail.
+    """
+    http_method_names = ['post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def post(self, request, *args, **kw):
+        errors = {}
+        jail = request.POST.get('jail')
+        ip = (request.POST.get('ip') or '').strip()
+        if jail not in fail2ban.JAILS:
+            errors['jail'] = [f'Jail should be one of {", ".join(fail2ban.JAILS)}.']
+        if not fail2ban.valid_ip(ip):
+            errors['ip'] = ['Provide a valid IP address.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        if not fail2ban.unban(jail, ip):
+            return Response({
+                'message': f'{ip} cannot be unbanned, it may not be banned in {jail}.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({'message': f'{ip} has been unbanned.'})
+
+
+class LogRotationView(APIView):
+    """Log Rotation View
+    
+    Shows the rotation policy of the website and FastCP logs, or updates it and rewrites the logrotate
+    config. Logs are rotated by age and also once they grow over the size limit.
+    """
+    http_method_names = ['get', 'post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def get(self, request, *args, **kw):
+        return Response({'policy': logfiles.rotation_policy(), 'frequencies': logfiles.FREQUENCIES})
+    
+    def post(self, request, *args, **kw):
+        errors = {}
+        policy = {'compress': request.POST.get('compress') in ['1', 'true']}
+        frequency = request.POST.get('frequency')
+        if frequency not in logfiles.FREQUENCIES:
+            errors['frequency'] = [f'Frequency should be one of {", ".join(logfiles.FREQUENCIES)}.']
+        policy['frequency'] = frequency
+        for field, maximum in [('rotate', 365), ('max_size_mb', 10240)]:
+            try:
+                policy[field] = int(request.POST.get(field))
+                if policy[field] < 1 or policy[field] > maximum:
+                    raise ValueError
+            except (TypeError, ValueError):
+                errors[field] = [f'Should be a number from 1 to {maximum}.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        if logfiles.write_logrotate(policy):
+            return Response({
+                'message': 'The log rotation policy has been updated.',
+                'policy': logfiles.rotation_policy()
+            })
+        return Response({
+            'message': 'The policy has been saved but logrotate rejected the config. Please check the template.'
+        }, status=status.HTTP_400_BAD_REQUEST)
@@ -8,5 +8,12 @@ urlpatterns=[
     path('databases/', include('api.databases.urls', namespace='databases')),
     path('account/', include('api.account.urls', namespace='account')),
     path('stats/', include('api.stats.urls', namespace='stats')),
-    path('file-manager/', include('api.filemanager.urls', namespace='filemanager'))
+    path('file-manager/', include('api.filemanager.urls', namespace='filemanager')),
+    path('system/', include('api.system.urls', namespace='system')),
+    path('probes/', include('api.probes.urls', namespace='probes')),
+    path('mail/', include('api.mail.urls', namespace='mail')),
+    path('dns/', include('api.dns.urls', namespace='dns')),
+    path('batch/', include('api.batch.urls', namespace='batch')),
+    path('jobs/', include('api.jobs.urls', namespace='jobs')),
+    path('search/', include('api.search.urls', namespace='search'))
 ]
\ No newline at end of file
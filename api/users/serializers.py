@@ -1,6 +1,8 @@
 from rest_framework import serializers
 from core.models import User
 from core.signals import create_user
+from core.utils.system import mount_user_tmp
+from core.utils.tags import clean_tags
 
 
 # Disallow some system usernames
@@ -13,7 +15,7 @@ class UserSearilizer(serializers.ModelSerializer):
     """
     class Meta:
         model = User
-        fields = ['id', 'username', 'date_joined', 'total_dbs', 'uid', 'is_active', 'total_sites', 'max_storage', 'storage_used', 'max_dbs', 'max_sites']
+        fields = ['id', 'username', 'date_joined', 'total_dbs', 'uid', 'is_active', 'total_sites', 'max_storage', 'storage_used', 'max_dbs', 'max_sites', 'tmp_size', 'tags', 'notes']
         read_only_fields = ['id', 'date_joined', 'total_dbs', 'uid', 'storage_used', 'total_sites']
     
     
@@ -23,6 +25,26 @@ class UserSearilizer(serializers.ModelSerializer):
             raise serializers.ValidationError('The provided username is not allowed.')
         return value
     
+    def validate_tags(self, value):
+        try:
+            return clean_tags(value)
+        except ValueError as e:
+            raise serializers.ValidationError(str(e))
+    
+    def validate_tmp_size(self, value):
+        """Ensure that tmp size is not negative."""
+        if value < 0:
+            raise serializers.ValidationError('The tmp size cannot be negative.')
+        return value
+    
+    def update(self, instance, validated_data):
+        """Update user and remount the temp dir if its size has changed."""
+        old_tmp_size = instance.tmp_size
+        user = super().update(instance, validated_data)
+        if user.tmp_size != old_tmp_size:
+            mount_user_tmp(user)
+        return user
+    
     def create(self, validated_data):
         """Create user"""
         request = self.context['request']
@@ -9,5 +9,12 @@ router.register('', views.UsersViewSet)
 app_name='sshusers'
 urlpatterns=[
     path('<int:id>/reset-password/', views.ResetPasswordView().as_view(), name='reset_password'),
+    path('<int:id>/fix-permissions/', views.FixPermissionsView().as_view(), name='fix_permissions'),
+    path('<int:id>/oom-events/', views.OomEventsView().as_view(), name='oom_events'),
+    path('<int:id>/usage/', views.UsageView().as_view(), name='usage'),
+    path('<int:id>/tasks/', views.TasksView().as_view(), name='tasks'),
+    path('<int:id>/tasks/<int:task_id>/', views.TaskView().as_view(), name='task'),
+    path('<int:id>/logs/', views.LogsView().as_view(), name='logs'),
+    path('<int:id>/logs/<str:name>/', views.LogsView().as_view(), name='log'),
     path('', include(router.urls)),
 ]
\ No newline at end of file
@@ -1,11 +1,16 @@
+from datetime import timedelta
+from django.conf import settings
+from django.utils import timezone
 from rest_framework.views import APIView
 from rest_framework import viewsets
 from rest_framework import status
 from rest_framework import permissions
-from core.models import User
+from core.models import User, ScheduledTask, TASK_SCHEDULE_CHOICES
 from rest_framework.response import Response
 from . import serializers
 from core.utils.system import change_password
+from core.utils import jobs, ownership, oom, tags, usage, logfiles, logstream, timers
+from django.db.models import Q
 
 
 class ResetPasswordView(APIView):
@@ -35,6 +40,69 @@ class ResetPasswordView(APIView):
         })
         
 
+class FixPermissionsView(APIView):
+    """Fix permissions.
+    
+    Gives the files of all websites of a user back to the user, in a background job. With dry_run, the
+    paths that would be changed are only reported. Only admins can fix the permissions of all websites.
+    """
+    http_method_names = ['post']
+    permission_classes = [permissions.IsAdminUser]
+    
+    def post(self, request, *args, **kwargs):
+        user = User.objects.filter(pk=kwargs.get('id'), is_superuser=False).first()
+        if not user:
+            return Response({
+                'message': 'The requested user account cannot be found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        dry_run = request.POST.get('dry_run') in ['1', 'true']
+        website_ids = list(user.websites.values_list('id', flat=True))
+        job = jobs.start_job(request.user, 'fix_ownership', user.username, {'website_ids': website_ids, 'dry_run': dry_run}, ownership.fix_ownership_job)
+        return Response({
+            'message': 'Checking the permissions.' if dry_run else 'Fixing the permissions.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class OomEventsView(APIView):
+    """OOM events.
+
+    Lists the processes of a user killed by the OOM killer in the past days, so the 502 errors they cause
+    can be told apart from application errors. Users can only list their own events.
+    """
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kwargs):
+        user = request.user
+        user_id = kwargs.get('id')
+
+        if user.is_superuser and user_id != user.id:
+            user = User.objects.filter(pk=user_id).first()
+
+        if not user:
+            return Response({
+                'message': 'The requested user account cannot be found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        try:
+            days = min(max(int(request.GET.get('days', 7)), 1), settings.FASTCP_OOM_RETENTION_DAYS)
+        except ValueError:
+            days = 7
+        events = user.oom_events.filter(occurred__gte=timezone.now() - timedelta(days=days)).order_by('-occurred')
+        return Response({
+            'user': user.username,
+            'kills_24h': oom.recent_oom_kills(user),
+            'events': [{
+                'pid': e.pid,
+                'process': e.process,
+                'memory_limit': e.cgroup,
+                'rss_kb': e.rss_kb,
+                'occurred': e.occurred
+            } for e in events[:500]]
+        })
+
+
 class UsersViewSet(viewsets.ModelViewSet):
     """User View
     
@@ -54,7 +122,241 @@ class UsersViewSet(viewsets.ModelViewSet):
 
         search_q = self.request.GET.get('q')
         if search_q:
-            queryset = queryset.filter(username__icontains=search_q)
+            queryset = queryset.filter(Q(username__icontains=search_q) | tags.search_q(search_q))
+
+        tag = self.request.GET.get('tag')
+        if tag:
+            queryset = tags.filter_tagged(queryset, tag)
              
         return queryset
-        
\ No newline at end of file
+
+
+class UsageView(APIView):
+    """Usage.
+
+    Returns the CPU, memory, disk and traffic history of a user over the last hour, 24 hours or 30 days,
+    for the usage graphs. Users can only read their own usage.
+    """
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kwargs):
+        user = request.user
+        user_id = kwargs.get('id')
+
+        if user.is_superuser and user_id != user.id:
+            user = User.objects.filter(pk=user_id).first()
+
+        if not user:
+            return Response({
+                'message': 'The requested user account cannot be found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        range_name = request.GET.get('range', '24h')
+        if range_name not in usage.RANGES:
+            return Response({
+                'errors': {'range': [f'Select one of {", ".join(usage.RANGES)}.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        return Response({
+            'user': user.username,
+            'range': range_name,
+            'points': usage.usage_series(user, range_name=range_name)
+        })
+
+
+class LogsView(APIView):
+    """Logs.
+
+    Lists the log files of a user, i.e. the error and access logs of their websites along with the rotated
+    ones, or reads a page of a log file, newest lines first. The lines can be searched and filtered by
+    level. Users can only read their own logs.
+    """
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kwargs):
+        user = request.user
+        user_id = kwargs.get('id')
+
+        if user.is_superuser and user_id != user.id:
+            user = User.objects.filter(pk=user_id).first()
+
+        if not user:
+            return Response({
+                'message': 'The requested user account cannot be found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        name = kwargs.get('name')
+        if not name:
+            return Response({'user': user.username, 'logs': logfiles.list_logs(user)})
+
+        errors = {}
+        level = request.GET.get('level') or None
+        if level and level not in logstream.LEVELS:
+            errors['level'] = [f'Level should be one of {", ".join(logstream.LEVELS)}.']
+        try:
+            page = int(request.GET.get('page', 1))
+            if page < 1:
+                raise ValueError
+        except ValueError:
+            errors['page'] = ['Page should be a positive number.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        result = logfiles.read_log(user, name, page, search=request.GET.get('search') or None, level=level)
+        if result is None:
+            return Response({
+                'message': f'The log {name} cannot be read.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        return Response(result)
+
+
+def task_errors(data: dict, task: object = None) -> dict:
+    """Validates the fields of a scheduled task and returns the errors."""
+    errors = {}
+    name = data.get('name', task.name if task else '').strip()
+    if not timers.NAME_RE.match(name):
+        errors['name'] = ['The name can have up to 50 letters, numbers, spaces, dots, dashes and underscores.']
+    command = data.get('command', task.command if task else '').strip()
+    if not timers.valid_command(command):
+        errors['command'] = ['The command should be a single line without control characters.']
+    schedule_type = data.get('schedule_type', task.schedule_type if task else 'calendar')
+    schedule = data.get('schedule', task.schedule if task else '').strip()
+    if schedule_type not in [choice for choice, label in TASK_SCHEDULE_CHOICES]:
+        errors['schedule_type'] = [f'Schedule type should be one of {", ".join(c for c, l in TASK_SCHEDULE_CHOICES)}.']
+    elif schedule_type == 'calendar' and not timers.valid_calendar(schedule):
+        errors['schedule'] = [f'{schedule} is not a valid systemd calendar expression, i.e. *:0/15 or Mon..Fri 09:00.']
+    elif schedule_type != 'calendar':
+        minimum = settings.FASTCP_TASK_MIN_INTERVAL if schedule_type == 'interval' else 0
+        if not schedule.isdigit() or int(schedule) < minimum:
+            errors['schedule'] = [f'The schedule should be a number of seconds, at least {minimum}.']
+    return errors
+
+
+def serialize_task(task: object) -> dict:
+    return {
+        'id': task.id,
+        'name': task.name,
+        'command': task.command,
+        'schedule_type': task.schedule_type,
+        'schedule': task.schedule,
+        'enabled': task.enabled,
+        'created': task.created,
+        'status': timers.task_status(task)
+    }
+
+
+class TasksView(APIView):
+    """Scheduled tasks.
+
+    Lists the scheduled tasks of a user or adds one. The tasks run by systemd timers rather than crontab,
+    inside the systemd slice of the user, and can run on a calendar, every number of seconds or once
+    after boot. Users can only manage their own tasks.
+    """
+    http_method_names = ['get', 'post']
+
+    def get_user(self, request, user_id):
+        user = request.user
+        if user.is_superuser and user_id != user.id:
+            user = User.objects.filter(pk=user_id, is_superuser=False).first()
+        return user
+
+    def get(self, request, *args, **kwargs):
+        user = self.get_user(request, kwargs.get('id'))
+        if not user:
+            return Response({
+                'message': 'The requested user account cannot be found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        return Response([serialize_task(t) for t in user.scheduled_tasks.order_by('name')])
+
+    def post(self, request, *args, **kwargs):
+        user = self.get_user(request, kwargs.get('id'))
+        if not user or user.is_superuser:
+            return Response({
+                'message': 'The requested user account cannot be found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if user.scheduled_tasks.count() >= settings.FASTCP_MAX_USER_TASKS:
+            return Response({
+                'message': f'A user can have up to {settings.FASTCP_MAX_USER_TASKS} scheduled tasks.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+
+        errors = task_errors(request.POST)
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        task = ScheduledTask.objects.create(
+            user=user, name=request.POST.get('name').strip(), command=request.POST.get('command').strip(),
+            schedule_type=request.POST.get('schedule_type', 'calendar'), schedule=request.POST.get('schedule').strip()
+        )
+        if not timers.write_units(task):
+            return Response({
+                'message': 'The task has been saved but its timer cannot be started. Please check the system logs.',
+                'task': serialize_task(task)
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({
+            'message': 'The task has been scheduled.',
+            'task': serialize_task(task)
+        })
+
+
+class TaskView(TasksView):
+    """Scheduled task.
+
+    Returns a scheduled task along with the output of its recent runs, updates it, runs it right away with
+    action=run, or deletes it.
+    """
+    http_method_names = ['get', 'post', 'delete']
+
+    def get_task(self, request, kwargs):
+        user = self.get_user(request, kwargs.get('id'))
+        if not user:
+            return None
+        return user.scheduled_tasks.filter(id=kwargs.get('task_id')).first()
+
+    def not_found(self, kwargs):
+        return Response({
+            'message': f'Scheduled task with ID {kwargs.get("task_id")} was not found.'
+        }, status=status.HTTP_404_NOT_FOUND)
+
+    def get(self, request, *args, **kwargs):
+        task = self.get_task(request, kwargs)
+        if not task:
+            return self.not_found(kwargs)
+        return Response({**serialize_task(task), 'output': timers.task_output(task)})
+
+    def post(self, request, *args, **kwargs):
+        task = self.get_task(request, kwargs)
+        if not task:
+            return self.not_found(kwargs)
+
+        if request.POST.get('action') == 'run':
+            if timers.run_now(task):
+                return Response({'message': 'The task has been started.'})
+            return Response({
+                'message': 'The task cannot be started.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+
+        errors = task_errors(request.POST, task)
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        for field in ['name', 'command', 'schedule_type', 'schedule']:
+            if field in request.POST:
+                setattr(task, field, request.POST.get(field).strip())
+        if 'enabled' in request.POST:
+            task.enabled = request.POST.get('enabled') in ['1', 'true']
+        task.save()
+        if not timers.write_units(task):
+            return Response({
+                'message': 'The task has been saved but its timer cannot be updated. Please check the system logs.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({
+            'message': 'The task has been updated.',
+            'task': serialize_task(task)
+        })
+
+    def delete(self, request, *args, **kwargs):
+        task = self.get_task(request, kwargs)
+        if not task:
+            return self.not_found(kwargs)
+        task.delete()
+        return Response({'message': 'The task has been deleted.'})
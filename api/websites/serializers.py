@@ -1,9 +1,10 @@
 from rest_framework import serializers
-from core.models import Website, Domain, Database
-import validators
+from core.models import Website, Domain, Database, SiteCheck
+import validators, grp
 from core import signals
 from core.models import User
 from core.utils import system
+from core.utils.tags import clean_tags
 from django.db.models import Q
 
 
@@ -11,6 +12,84 @@ class ChangePhpVersionSerializer(serializers.ModelSerializer):
     class Meta:
         model = Website
         fields = ['php']
+
+
+class CanonicalHostSerializer(serializers.ModelSerializer):
+    class Meta:
+        model = Website
+        fields = ['force_https', 'canonical_host']
+
+
+class BackendSerializer(serializers.ModelSerializer):
+    class Meta:
+        model = Website
+        fields = ['backend']
+
+    def validate(self, data):
+        """Ensure that the website has an app to proxy to before it's switched to the proxy backend."""
+        if data.get('backend') == 'proxy' and not self.instance.proxy_upstream:
+            raise serializers.ValidationError({'backend': 'Set the upstream of the app before switching to the proxy backend.'})
+        return data
+
+
+class AclProfileSerializer(serializers.ModelSerializer):
+    class Meta:
+        model = Website
+        fields = ['acl_profile', 'acl_group']
+    
+    def validate(self, data):
+        """Ensure that the shared-group profile has an existing system group."""
+        if data.get('acl_profile') == 'shared-group':
+            group = data.get('acl_group')
+            try:
+                grp.getgrnam(group or '')
+            except KeyError:
+                raise serializers.ValidationError({'acl_group': f'Group {group} does not exist on the server.'})
+        else:
+            data['acl_group'] = None
+        return data
+
+
+class SiteCheckSerializer(serializers.ModelSerializer):
+    class Meta:
+        model = SiteCheck
+        fields = ['id', 'path', 'max_ms', 'created']
+        read_only_fields = ['id', 'created']
+    
+    def validate_path(self, value):
+        """Ensure that the path is a URL path of the website."""
+        if not value.startswith('/') or any(c.isspace() for c in value):
+            raise serializers.ValidationError('The path should start with a / and it cannot contain spaces.')
+        return value
+    
+    def validate_max_ms(self, value):
+        """Ensure that the threshold is sane."""
+        if value < 100:
+            raise serializers.ValidationError('The threshold cannot be less than 100ms.')
+        return value
+
+
+class MirrorSerializer(serializers.ModelSerializer):
+    class Meta:
+        model = Website
+        fields = ['mirror_to', 'mirror_percent']
+    
+    def validate_mirror_percent(self, value):
+        """Ensure that the percentage is sane."""
+        if value < 0 or value > 100:
+            raise serializers.ValidationError('Mirrored traffic should be between 0 and 100 percent.')
+        return value
+    
+    def validate_mirror_to(self, value):
+        """Only mirror to another website of the same SSH user."""
+        if value:
+            if value.id == self.instance.id:
+                raise serializers.ValidationError('A website cannot mirror traffic to itself.')
+            if value.user_id != self.instance.user_id:
+                raise serializers.ValidationError('Traffic can only be mirrored to a website of the same SSH user.')
+            if not value.domains.exists():
+                raise serializers.ValidationError('The target website does not have any domains.')
+        return value
   
 class DomainSerializer(serializers.ModelSerializer):
     class Meta:
@@ -31,10 +110,23 @@ class WebsiteSerializer(serializers.ModelSerializer):
     domains = DomainSerializer(many=True, required=False)
     class Meta:
         model = Website
-        fields = ['id', 'label', 'user', 'metadata', 'domains', 'has_ssl', 'php']
-        read_only_fields = ['id', 'has_ssl', 'root_path', 'domains', 'metadata', 'domains', 'user']
+        fields = ['id', 'label', 'user', 'metadata', 'domains', 'has_ssl', 'php', 'force_https', 'canonical_host', 'dev_extension', 'dev_mode_until', 'backend', 'dns_credential', 'tags', 'notes']
+        read_only_fields = ['id', 'has_ssl', 'root_path', 'domains', 'metadata', 'domains', 'user', 'force_https', 'canonical_host', 'dev_extension', 'dev_mode_until', 'backend', 'dns_credential']
         
+    def get_fields(self):
+        """The notes are for the admins only, the owners can neither see nor change them."""
+        fields = super().get_fields()
+        request = self.context.get('request')
+        if not request or not request.user.is_superuser:
+            fields.pop('notes', None)
+        return fields
         
+    def validate_tags(self, value):
+        try:
+            return clean_tags(value)
+        except ValueError as e:
+            raise serializers.ValidationError(str(e))
+
     def validate_domains(self, value):
         # Validate domains
         domains = list(filter(None, [domain.strip() for domain in value.strip().split(',')]))
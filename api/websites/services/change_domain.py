@@ -0,0 +1,91 @@
+import os, re
+from core.models import Database, Domain
+from core import signals
+from core.utils.filesystem import get_website_paths
+from api.databases.services.search_replace import SearchReplaceService
+from api.websites.services.ssl import FastcpSsl
+
+
+WP_DB_NAME_RE = re.compile(r"define\(\s*['\"]DB_NAME['\"]\s*,\s*['\"]([^'\"]+)['\"]\s*\)")
+
+
+def wp_database(website: object) -> object:
+    """Returns the database a WordPress website uses as per its wp-config.php, None if unknown."""
+    wp_config = os.path.join(get_website_paths(website).get('web_root'), 'wp-config.php')
+    if not os.path.exists(wp_config):
+        return None
+    with open(wp_config) as f:
+        match = WP_DB_NAME_RE.search(f.read())
+    if not match:
+        return None
+    return Database.objects.filter(user=website.user, name=match.group(1)).first()
+
+
+def update_wp_config(website: object, old_domain: str, new_domain: str) -> bool:
+    """Updates the URLs in wp-config.php, i.e. WP_HOME and WP_SITEURL. Returns True if the file changed."""
+    wp_config = os.path.join(get_website_paths(website).get('web_root'), 'wp-config.php')
+    if not os.path.exists(wp_config):
+        return False
+    with open(wp_config) as f:
+        content = f.read()
+    new_content = content.replace(f'//{old_domain}', f'//{new_domain}')
+    if new_content == content:
+        return False
+    with open(wp_config, 'w') as f:
+        f.write(new_content)
+    return True
+
+
+def change_domain(website: object, domain: object, new_domain: str, database: object = None, redirect_old: bool = True) -> dict:
+    """Change domain.
+
+    Renames a domain of a website. The database URLs and wp-config.php are updated, the vhosts are
+    regenerated, an SSL certificate is requested for the new domain and the old domain is kept as a
+    redirect to the new one if requested. The vhost and pool names are derived from the website slug, so
+    they don't change with the domain.
+
+    Args:
+        website (object): Website model object.
+        domain (object): The Domain model object to rename.
+        new_domain (str): The new domain.
+        database (object): The database to update the URLs in, detected from wp-config.php for WordPress.
+        redirect_old (bool): Keep the old domain and redirect it to the new one.
+
+    Returns:
+        dict: The outcome of each step.
+    """
+    old_domain = domain.domain
+    report = {'old_domain': old_domain, 'new_domain': new_domain}
+
+    domain.domain = new_domain
+    domain.ssl = False
+    domain.ssl_error = None
+    domain.ssl_retries = 0
+    domain.save()
+
+    # Old domains pointing to this domain point to the new one now
+    Domain.objects.filter(redirect_to=old_domain).update(redirect_to=new_domain)
+    if redirect_old:
+        Domain.objects.create(website=website, domain=old_domain, ssl=website.has_ssl, redirect_to=new_domain)
+    report['redirect'] = redirect_old
+
+    if not database and website.is_wp:
+        database = wp_database(website)
+    if database:
+        try:
+            report['database'] = SearchReplaceService(database).run(f'//{old_domain}', f'//{new_domain}', dry_run=False)
+        except Exception as e:
+            report['database'] = {'error': str(e)}
+    report['wp_config'] = update_wp_config(website, old_domain, new_domain)
+
+    signals.domains_updated.send(sender=website)
+
+    try:
+        report['ssl'] = FastcpSsl().get_ssl(website)
+    except Exception:
+        report['ssl'] = False
+    if report['ssl']:
+        website.has_ssl = True
+        website.save()
+        signals.domains_updated.send(sender=website, only_nginx=True)
+    return report
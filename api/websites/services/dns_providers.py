@@ -0,0 +1,220 @@
+import hashlib, hmac, json
+from datetime import datetime
+from xml.etree import ElementTree
+from xml.sax.saxutils import escape
+import requests
+
+
+# The keys each provider needs in its credentials
+PROVIDER_KEYS = {
+    'cloudflare': ['api_token'],
+    'route53': ['access_key_id', 'secret_access_key'],
+    'digitalocean': ['api_token'],
+}
+
+
+class DnsProviderError(Exception):
+    pass
+
+
+class DnsProvider(object):
+    """DNS provider.
+
+    The base of the DNS providers that create and remove the TXT records of the ACME DNS-01 challenges.
+
+    Attributes:
+        credentials (dict): The provider specific API credentials.
+    """
+    timeout = 30
+
+    def __init__(self, credentials: dict) -> None:
+        self.credentials = credentials
+
+    def zones(self) -> list:
+        """Returns the zone names of the account."""
+        raise NotImplementedError
+
+    def find_zone(self, name: str) -> str:
+        """Returns the longest zone of the account the record name belongs to."""
+        matches = [zone for zone in self.zones() if name == zone or name.endswith(f'.{zone}')]
+        if not matches:
+            raise DnsProviderError(f'No DNS zone of the account holds {name}.')
+        return max(matches, key=len)
+
+    def create_record(self, name: str, rtype: str, values: list, ttl: int = 120) -> object:
+        """Creates the records of a name and type, and returns the reference to delete them with."""
+        raise NotImplementedError
+
+    def delete_record(self, name: str, rtype: str, values: list, ref: object) -> None:
+        """Deletes the records created with create_record."""
+        raise NotImplementedError
+
+    def create_txt(self, name: str, values: list) -> object:
+        """Creates the TXT records of a name and returns the reference to delete them with."""
+        return self.create_record(name, 'TXT', values)
+
+    def delete_txt(self, name: str, values: list, ref: object) -> None:
+        """Deletes the TXT records created with create_txt."""
+        self.delete_record(name, 'TXT', values, ref)
+
+
+def split_priority(rtype: str, value: str) -> tuple:
+    """Splits the priority off an MX or SRV value, the providers with JSON APIs take it separately."""
+    if rtype in ['MX', 'SRV'] and ' ' in value.strip():
+        priority, rest = value.strip().split(' ', 1)
+        if priority.isdigit():
+            return int(priority), rest
+    return None, value
+
+
+class CloudflareProvider(DnsProvider):
+    api_url = 'https://api.cloudflare.com/client/v4'
+
+    def _request(self, method: str, path: str, **kwargs) -> dict:
+        headers = {'Authorization': f'Bearer {self.credentials.get("api_token")}'}
+        res = requests.request(method, f'{self.api_url}{path}', headers=headers, timeout=self.timeout, **kwargs)
+        data = res.json()
+        if not data.get('success'):
+            errors = ', '.join(e.get('message', '') for e in data.get('errors', []))
+            raise DnsProviderError(f'Cloudflare: {errors or res.status_code}')
+        return data
+
+    def zones(self) -> list:
+        return [zone.get('name') for zone in self._request('GET', '/zones', params={'per_page': 50}).get('result')]
+
+    def _zone_id(self, name: str) -> str:
+        zone = self.find_zone(name)
+        return self._request('GET', '/zones', params={'name': zone}).get('result')[0].get('id')
+
+    def create_record(self, name: str, rtype: str, values: list, ttl: int = 120) -> object:
+        zone_id = self._zone_id(name)
+        record_ids = []
+        for value in values:
+            priority, content = split_priority(rtype, value)
+            data = {'type': rtype, 'name': name, 'content': content, 'ttl': ttl}
+            if priority is not None:
+                data['priority'] = priority
+            record = self._request('POST', f'/zones/{zone_id}/dns_records', json=data)
+            record_ids.append(record.get('result').get('id'))
+        return (zone_id, record_ids)
+
+    def delete_record(self, name: str, rtype: str, values: list, ref: object) -> None:
+        zone_id, record_ids = ref
+        for record_id in record_ids:
+            self._request('DELETE', f'/zones/{zone_id}/dns_records/{record_id}')
+
+
+class DigitalOceanProvider(DnsProvider):
+    api_url = 'https://api.digitalocean.com/v2'
+
+    def _request(self, method: str, path: str, **kwargs) -> dict:
+        headers = {'Authorization': f'Bearer {self.credentials.get("api_token")}'}
+        res = requests.request(method, f'{self.api_url}{path}', headers=headers, timeout=self.timeout, **kwargs)
+        if res.status_code >= 400:
+            try:
+                message = res.json().get('message')
+            except ValueError:
+                message = res.status_code
+            raise DnsProviderError(f'DigitalOcean: {message}')
+        return res.json() if res.content else {}
+
+    def zones(self) -> list:
+        return [domain.get('name') for domain in self._request('GET', '/domains', params={'per_page': 200}).get('domains')]
+
+    def create_record(self, name: str, rtype: str, values: list, ttl: int = 120) -> object:
+        zone = self.find_zone(name)
+        record_name = name[:-len(zone) - 1] if name != zone else '@'
+        record_ids = []
+        for value in values:
+            priority, data = split_priority(rtype, value)
+            record = self._request('POST', f'/domains/{zone}/records', json={
+                'type': rtype, 'name': record_name, 'data': data, 'ttl': max(ttl, 30), 'priority': priority
+            })
+            record_ids.append(record.get('domain_record').get('id'))
+        return (zone, record_ids)
+
+    def delete_record(self, name: str, rtype: str, values: list, ref: object) -> None:
+        zone, record_ids = ref
+        for record_id in record_ids:
+            self._request('DELETE', f'/domains/{zone}/records/{record_id}')
+
+
+class Route53Provider(DnsProvider):
+    host = 'route53.amazonaws.com'
+    region = 'us-east-1'
+    xmlns = 'https://route53.amazonaws.com/doc/2013-04-01/'
+
+    def _sign(self, key: bytes, msg: str) -> bytes:
+        return hmac.new(key, msg.encode(), hashlib.sha256).digest()
+
+    def _request(self, method: str, path: str, body: str = '') -> ElementTree.Element:
+        """Sends a Signature Version 4 signed request to the Route 53 API."""
+        now = datetime.utcnow()
+        amz_date, date_stamp = now.strftime('%Y%m%dT%H%M%SZ'), now.strftime('%Y%m%d')
+        payload_hash = hashlib.sha256(body.encode()).hexdigest()
+        canonical = f'{method}\n{path}\n\nhost:{self.host}\nx-amz-date:{amz_date}\n\nhost;x-amz-date\n{payload_hash}'
+        scope = f'{date_stamp}/{self.region}/route53/aws4_request'
+        to_sign = f'AWS4-HMAC-SHA256\n{amz_date}\n{scope}\n{hashlib.sha256(canonical.encode()).hexdigest()}'
+
+        key = self._sign(f'AWS4{self.credentials.get("secret_access_key")}'.encode(), date_stamp)
+        for part in [self.region, 'route53', 'aws4_request']:
+            key = self._sign(key, part)
+        signature = hmac.new(key, to_sign.encode(), hashlib.sha256).hexdigest()
+
+        headers = {
+            'x-amz-date': amz_date,
+            'Authorization': f'AWS4-HMAC-SHA256 Credential={self.credentials.get("access_key_id")}/{scope}, '
+                             f'SignedHeaders=host;x-amz-date, Signature={signature}'
+        }
+        if body:
+            headers['Content-Type'] = 'application/xml'
+        res = requests.request(method, f'https://{self.host}{path}', data=body.encode(), headers=headers, timeout=self.timeout)
+        root = ElementTree.fromstring(res.content)
+        if res.status_code >= 400:
+            message = root.find(f'.//{{{self.xmlns}}}Message')
+            raise DnsProviderError(f'Route 53: {message.text if message is not None else res.status_code}')
+        return root
+
+    def _hosted_zones(self) -> dict:
+        root = self._request('GET', '/2013-04-01/hostedzone')
+        return {
+            zone.find(f'{{{self.xmlns}}}Name').text.rstrip('.'): zone.find(f'{{{self.xmlns}}}Id').text.split('/')[-1]
+            for zone in root.iter(f'{{{self.xmlns}}}HostedZone')
+        }
+
+    def zones(self) -> list:
+        return list(self._hosted_zones().keys())
+
+    def _change(self, action: str, name: str, rtype: str, values: list, ttl: int) -> str:
+        zone_id = self._hosted_zones().get(self.find_zone(name))
+        body = (
+            f'<?xml version="1.0" encoding="UTF-8"?><ChangeResourceRecordSetsRequest xmlns="{self.xmlns}"><ChangeBatch>'
+            f'<Changes><Change><Action>{action}</Action><ResourceRecordSet><Name>{name}</Name><Type>{rtype}</Type><TTL>{ttl}</TTL>'
+            f'<ResourceRecords>{"".join(f"<ResourceRecord><Value>{escape(v)}</Value></ResourceRecord>" for v in values)}</ResourceRecords>'
+            f'</ResourceRecordSet></Change></Changes></ChangeBatch></ChangeResourceRecordSetsRequest>'
+        )
+        self._request('POST', f'/2013-04-01/hostedzone/{zone_id}/rrset/', body)
+        return zone_id
+
+    def create_record(self, name: str, rtype: str, values: list, ttl: int = 120) -> object:
+        if rtype == 'TXT':
+            values = [json.dumps(value) for value in values]
+        return (self._change('UPSERT', name, rtype, values, ttl), ttl)
+
+    def delete_record(self, name: str, rtype: str, values: list, ref: object) -> None:
+        if rtype == 'TXT':
+            values = [json.dumps(value) for value in values]
+        # Route 53 only deletes a record set that matches exactly, TTL included
+        self._change('DELETE', name, rtype, values, ref[1])
+
+
+PROVIDERS = {
+    'cloudflare': CloudflareProvider,
+    'route53': Route53Provider,
+    'digitalocean': DigitalOceanProvider,
+}
+
+
+def get_provider(dns_credential: object) -> DnsProvider:
+    """Returns the DNS provider of a saved DNS credential."""
+    return PROVIDERS.get(dns_credential.provider)(dns_credential.get_credentials())
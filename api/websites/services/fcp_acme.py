@@ -139,6 +139,9 @@ class FastcpAcme(object):
                 if chall_type == 'http':
                     if isinstance(i.chall, challenges.HTTP01):
                         chall_list.append(i)
+                elif chall_type == 'dns':
+                    if isinstance(i.chall, challenges.DNS01):
+                        chall_list.append((authz.body.identifier.value, i))
         
         return chall_list
 
@@ -155,10 +158,11 @@ class FastcpAcme(object):
             domains (list): List of domain names to get SSL cert for.
             priv_key (str): Private key as a string. If not provided, it will be generated
                             along the CSR.
-            chall_type (str): Challenge type. It defaults to HTTP challenge.
+            chall_type (str): Challenge type, either http or dns. It defaults to HTTP challenge.
 
         Returns:
-            dict: Containing the challenge HTTP path and the auth token.
+            list: Containing the challenge HTTP path and the auth token, or the TXT record name and
+                  value for the DNS challenge.
         """
         
         # Create a CSR and priv key
@@ -171,11 +175,20 @@ class FastcpAcme(object):
         # Get challenge path & token
         self.challs_list = []
         token_paths = []
+        if chall_type == 'dns':
+            for domain, chall in self._select_chall(self.acme_order, chall_type=chall_type):
+                response, validation = chall.chall.response_and_validation(account_key=self.acc_key)
+                self.challs_list.append((chall, response))
+                token_paths.append({
+                    'name': chall.chall.validation_domain_name(domain),
+                    'value': validation
+                })
+            return token_paths
+
         for chall in self._select_chall(self.acme_order, chall_type=chall_type):
-            self.challs_list.append(chall)
             response, validation = chall.chall.response_and_validation(
                 account_key=self.acc_key)
-            self.response = response
+            self.challs_list.append((chall, response))
 
             # Get challenge path
             challange_path = os.path.join(
@@ -201,8 +214,8 @@ class FastcpAcme(object):
             dict: Dict containing SSL certificates and the private key.
         """
         try:
-            for chall in self.challs_list:
-                self.client.answer_challenge(chall, self.response)
+            for chall, response in self.challs_list:
+                self.client.answer_challenge(chall, response)
             order_result = self.client.poll_and_finalize(self.acme_order)
             return {
                 'full_chain': order_result.fullchain_pem,
@@ -1,6 +1,7 @@
 from .fcp_acme import FastcpAcme
+from .dns_providers import get_provider
 import requests
-import os
+import os, time
 from core.utils.filesystem import get_website_paths
 from core.signals import restart_services
 from core.models import Domain
@@ -68,6 +69,108 @@ class FastcpSsl(object):
         return False
 
     
+    def get_acme(self) -> FastcpAcme:
+        """Returns the ACME client, the account is registered and saved on the first use."""
+        acme = FastcpAcme(staging=settings.LETSENCRYPT_IS_STAGING, acc_key=self.acc_key, regr=self.regr)
+        
+        # Save account key
+        if not self.acc_key:
+            with open(FCP_ACCOUNT_KEY_PATH, 'w') as f:
+                f.write(acme.acc_key.json_dumps())
+        
+        # Save account resource so we will not need to register an account
+        # again and again.
+        if not self.regr:
+            with open(FCP_ACCOUNT_RESOURCE_PATH, 'w') as f:
+                f.write(acme.regr.json_dumps())
+        return acme
+    
+    def save_ssl(self, paths: dict, result: dict, domains: list) -> None:
+        """Writes the obtained certificate and the private key, and marks the domains as secured."""
+        # Write private key
+        with open(paths.get('priv_key_path'), 'wb') as f:
+            try:
+                priv_key = result.get('priv_key').encode()
+            except AttributeError:
+                priv_key = result.get('priv_key')
+            
+            # Write private key
+            f.write(priv_key)
+        
+        # Write cert chain
+        with open(paths.get('cert_chain_path'), 'w') as f:
+            f.write(str(result.get('full_chain')))
+            
+        # Restart NGINX
+        restart_services.send(sender=None, services='nginx')
+        
+        # Update domains
+        for dom in domains:
+            Domain.objects.filter(domain=dom).update(ssl=True)
+    
+    def get_ssl_dns(self, website) -> bool:
+        """Get SSL with DNS challenge.
+        
+        Gets a certificate through DNS-01 challenges, creating the TXT records with the DNS provider
+        credential of the website. Unlike the HTTP challenge, the domains don't need to resolve to this
+        server and the wildcard domains get a certificate for their subdomains as well.
+        
+        Args:
+            website (object): The website model object.
+        
+        Returns:
+            bool: True on success False otherwise.
+        """
+        domains = []
+        for dom in website.domains.filter(redirect_to__isnull=True):
+            domains.append(dom.domain)
+            if dom.wildcard:
+                domains.append(f'*.{dom.domain}')
+        if not domains:
+            return False
+        
+        paths = get_website_paths(website)
+        if not os.path.exists(paths.get('ssl_base')):
+            os.makedirs(paths.get('ssl_base'))
+        
+        priv_key = None
+        if os.path.exists(paths.get('priv_key_path')):
+            with open(paths.get('priv_key_path'), 'rb') as f:
+                priv_key = f.read()
+        
+        # The wildcard and the apex domain share the same record name
+        records = {}
+        created = []
+        targets = website.domains.filter(redirect_to__isnull=True)
+        try:
+            provider = get_provider(website.dns_credential)
+            acme = self.get_acme()
+            for record in acme.request_ssl(domains=domains, priv_key=priv_key, chall_type='dns'):
+                records.setdefault(record.get('name'), []).append(record.get('value'))
+            
+            for name, values in records.items():
+                created.append((name, values, provider.create_txt(name, values)))
+            
+            # Give the DNS provider some time to publish the records
+            time.sleep(settings.FASTCP_DNS_PROPAGATION_SECONDS)
+            
+            result = acme.get_ssl()
+            if not result:
+                targets.update(ssl_error="Let's Encrypt did not issue the certificate after the DNS challenge.")
+                return False
+            self.save_ssl(paths, result, [d for d in domains if not d.startswith('*.')])
+            targets.update(ssl_error=None)
+            return True
+        except Exception as e:
+            targets.update(ssl_error=f'The DNS challenge failed: {e}')
+            return False
+        finally:
+            for name, values, ref in created:
+                try:
+                    provider.delete_txt(name, values, ref)
+                except Exception:
+                    pass
+    
     def get_ssl(self, website) -> bool:
         """Get SSL.
         
@@ -84,6 +187,9 @@ class FastcpSsl(object):
         Returns:
             bool: True on success False otherwise.
         """
+        if website.dns_credential:
+            return self.get_ssl_dns(website)
+        
         token_paths = []
         status = False
         try:
@@ -106,18 +212,7 @@ class FastcpSsl(object):
                 priv_key = None
             
             if len(verified_domains):
-                acme = FastcpAcme(staging=settings.LETSENCRYPT_IS_STAGING, acc_key=self.acc_key, regr=self.regr)
-                
-                # Save account key
-                if not self.acc_key:
-                    with open(FCP_ACCOUNT_KEY_PATH, 'w') as f:
-                        f.write(acme.acc_key.json_dumps())
-                
-                # Save account resource so we will not need to register an account
-                # again and again.
-                if not self.regr:
-                    with open(FCP_ACCOUNT_RESOURCE_PATH, 'w') as f:
-                        f.write(acme.regr.json_dumps())
+                acme = self.get_acme()
                 
                 # Initiate an order
                 results = acme.request_ssl(domains=verified_domains, priv_key=priv_key)
@@ -138,26 +233,7 @@ class FastcpSsl(object):
                 result = acme.get_ssl()
                 
                 if result:
-                    # Write private key
-                    with open(paths.get('priv_key_path'), 'wb') as f:
-                        try:
-                            priv_key = result.get('priv_key').encode()
-                        except AttributeError:
-                            priv_key = result.get('priv_key')
-                        
-                        # Write private key
-                        f.write(priv_key)
-                    
-                    # Write cert chain
-                    with open(paths.get('cert_chain_path'), 'w') as f:
-                        f.write(str(result.get('full_chain')))
-                        
-                    # Restart NGINX
-                    restart_services.send(sender=None, services='nginx')
-                    
-                    # Update domains
-                    for dom in verified_domains:
-                        Domain.objects.filter(domain=dom).update(ssl=True)
+                    self.save_ssl(paths, result, verified_domains)
                             
                 status = True
         except Exception as e:
@@ -0,0 +1,209 @@
+import os, re, tempfile
+from subprocess import run, PIPE
+from django.conf import settings
+from django.db import transaction
+from django.db.models import Q
+from django.utils import timezone
+from core.models import Website, Database, Staging
+from core import signals
+from core.utils import system, jobs, immutable, integrity, warmup
+from core.utils.filesystem import get_website_paths
+from api.databases.services.search_replace import SearchReplaceService
+from api.databases.services.mysql import defaults_file
+from api.websites.services.change_domain import wp_database, update_wp_config
+
+
+WP_DB_DEFINE_RE = r"(define\(\s*['\"]{name}['\"]\s*,\s*['\"])[^'\"]*(['\"]\s*\))"
+
+
+def primary_domain(website: object) -> str:
+    domain = website.domains.filter(redirect_to__isnull=True).order_by('id').first()
+    return domain.domain if domain else None
+
+
+def staging_domain(website: object) -> str:
+    """Returns the domain of the staging copy, a subdomain of the server provided domain if one is set."""
+    if settings.FASTCP_STAGING_DOMAIN:
+        return f'{website.slug}.{settings.FASTCP_STAGING_DOMAIN}'
+    return f'staging-{website.slug}.{primary_domain(website)}'
+
+
+def staging_names(database: object) -> tuple:
+    """Returns the name and the username of the staging copy of a database, MySQL usernames are at most 32
+    characters long."""
+    return f'{database.name[:44]}_stg', f'{database.name[:28]}_stg'
+
+
+def quota_error(website: object, database: object) -> str:
+    """Returns why the owner of a website cannot have a staging copy of it, None if the staging website and
+    database fit in the quotas of the owner and their names are free."""
+    owner = website.user
+    if owner.websites.count() >= owner.max_sites:
+        return f'The staging copy needs a website, and {owner} has reached the quota of {owner.max_sites} websites.'
+    if database:
+        if owner.databases.count() >= owner.max_dbs:
+            return f'The staging copy needs a database, and {owner} has reached the quota of {owner.max_dbs} databases.'
+        name, username = staging_names(database)
+        if Database.objects.filter(Q(name=name) | Q(username=username)).exists():
+            return f'The staging database {name} already exists.'
+    return None
+
+
+def copy_files(website: object, source: str, dest: str, exclude: list = None) -> None:
+    """Copies a web root over another one with rsync as the owner of the website, so the symlinks in them
+    cannot make the copy read or write files the owner has no access to."""
+    cmd = ['/usr/sbin/runuser', '-u', website.user.username, '--', '/usr/bin/rsync', '-a', '--delete']
+    for pattern in exclude or []:
+        cmd += ['--exclude', pattern]
+    result = run(cmd + [f'{source}/', f'{dest}/'], stdout=PIPE, stderr=PIPE, cwd='/')
+    if result.returncode != 0:
+        raise RuntimeError(f'rsync: {result.stderr.decode().strip()}')
+
+
+def copy_database(source: str, dest: str) -> None:
+    """Copy database.
+
+    Copies the tables and the data of a database into another one with mysqldump, replacing the tables
+    that exist in the destination.
+
+    Args:
+        source (str): The name of the database to copy.
+        dest (str): The name of the destination database.
+
+    Raises:
+        RuntimeError: If the copy fails.
+    """
+    defaults = defaults_file()
+    try:
+        with tempfile.TemporaryFile() as dump:
+            result = run(['/usr/bin/mysqldump', f'--defaults-extra-file={defaults}', '--single-transaction', '--routines',
+                          '--add-drop-table', source], stdout=dump, stderr=PIPE)
+            if result.returncode != 0:
+                raise RuntimeError(f'mysqldump: {result.stderr.decode().strip()}')
+            dump.seek(0)
+            result = run(['/usr/bin/mysql', f'--defaults-extra-file={defaults}', dest], stdin=dump, stderr=PIPE)
+            if result.returncode != 0:
+                raise RuntimeError(f'mysql: {result.stderr.decode().strip()}')
+    finally:
+        os.remove(defaults)
+
+
+def point_wp_config(website: object, dbname: str, dbuser: str, dbpassword: str) -> bool:
+    """Points wp-config.php of a website to another database. Returns True if the file changed."""
+    wp_config = os.path.join(get_website_paths(website).get('web_root'), 'wp-config.php')
+    if not os.path.exists(wp_config):
+        return False
+    with open(wp_config) as f:
+        content = f.read()
+    new_content = content
+    for name, value in [('DB_NAME', dbname), ('DB_USER', dbuser), ('DB_PASSWORD', dbpassword)]:
+        new_content = re.sub(WP_DB_DEFINE_RE.format(name=name), lambda m: f'{m.group(1)}{value}{m.group(2)}', new_content)
+    if new_content == content:
+        return False
+    with open(wp_config, 'w') as f:
+        f.write(new_content)
+    return True
+
+
+def create_staging(job: object, params: dict) -> dict:
+    """Create staging.
+
+    Clones a website to a staging website on a staging subdomain. The files are copied, and the database
+    is copied to a new database with the production URLs replaced by the staging ones. wp-config.php of
+    the staging copy is pointed to the new database.
+
+    Args:
+        job (object): The Job model object.
+        params (dict): The ID of the website and optionally the ID of its database.
+
+    Returns:
+        dict: The outcome of each step.
+    """
+    website = Website.objects.get(id=params.get('website_id'))
+    database = Database.objects.filter(id=params.get('database_id')).first() if params.get('database_id') else None
+    if not database and website.is_wp:
+        database = wp_database(website)
+    jobs.report_progress(job, 0, 4 if database else 2)
+
+    error = quota_error(website, database)
+    if error:
+        raise RuntimeError(error)
+    domain = staging_domain(website)
+    with transaction.atomic():
+        staging_website = Website.objects.create(user=website.user, label=f'{website.label[:22]}-staging', php=website.php, is_wp=website.is_wp)
+        staging_website.domains.create(domain=domain)
+        staging = Staging.objects.create(production=website, website=staging_website, database=database)
+    report = {'website_id': staging_website.id, 'domain': domain}
+
+    # Copy the files
+    source_root = get_website_paths(website).get('web_root')
+    web_root = get_website_paths(staging_website).get('web_root')
+    copy_files(website, source_root, web_root)
+    system.fix_ownership(staging_website)
+    jobs.report_progress(job, 1)
+
+    if database:
+        name, username = staging_names(database)
+        password = system.rand_passwd(20)
+        staging_database = Database.objects.create(user=website.user, name=name, username=username)
+        signals.create_db.send(sender=staging_database, password=password)
+        staging.staging_database = staging_database
+        staging.save()
+        jobs.report_progress(job, 2)
+
+        copy_database(database.name, staging_database.name)
+        report['database'] = SearchReplaceService(staging_database).run(f'//{primary_domain(website)}', f'//{domain}', dry_run=False)
+        point_wp_config(staging_website, staging_database.name, staging_database.username, password)
+        jobs.report_progress(job, 3)
+
+    report['wp_config'] = update_wp_config(staging_website, primary_domain(website), domain)
+    signals.domains_updated.send(sender=staging_website)
+    jobs.report_progress(job, job.total)
+    return report
+
+
+def push_staging(job: object, params: dict) -> dict:
+    """Push staging.
+
+    Pushes the files and the database of a staging website back to production. Production is snapshotted
+    first if the storage supports it. wp-config.php of production is kept, and the staging URLs in the
+    database are replaced by the production ones.
+
+    Args:
+        job (object): The Job model object.
+        params (dict): The ID of the staging and what to push.
+
+    Returns:
+        dict: The outcome of each step.
+    """
+    staging = Staging.objects.select_related('production', 'website', 'database', 'staging_database').get(id=params.get('staging_id'))
+    production, website = staging.production, staging.website
+    jobs.report_progress(job, 0, 3)
+
+    report = {'snapshot': system.snapshot_website(production, label=f'before-push-{timezone.now().strftime("%Y%m%d%H%M%S")}')}
+    jobs.report_progress(job, 1)
+
+    if params.get('files', True):
+        source_root = get_website_paths(website).get('web_root')
+        web_root = get_website_paths(production).get('web_root')
+        with immutable.lifted(production):
+            copy_files(production, source_root, web_root, exclude=['wp-config.php'])
+            system.fix_ownership(production)
+        integrity.rebaseline(production)
+        report['files'] = True
+    jobs.report_progress(job, 2)
+
+    if params.get('database', True) and staging.database and staging.staging_database:
+        copy_database(staging.staging_database.name, staging.database.name)
+        report['database'] = SearchReplaceService(staging.database).run(f'//{primary_domain(website)}', f'//{primary_domain(production)}', dry_run=False)
+
+    staging.pushed = timezone.now()
+    staging.save()
+    jobs.report_progress(job, 3)
+    if params.get('warm_cache'):
+        try:
+            report['warm_cache_job'] = warmup.start_warmup(job.user, production, params.get('concurrency', 2), params.get('rate', 5)).id
+        except jobs.QueueFull:
+            report['warm_cache_job'] = None
+            report['warm_cache_error'] = 'Too many cache warming jobs are waiting already, warm the cache once they finish.'
+    return report
@@ -12,7 +12,54 @@ urlpatterns=[
     path('<int:id>/change-php/', views.ChangePHPVersion().as_view(), name='change_php'),
     path('<int:id>/add-domain/', views.DomainAddView().as_view(), name='add_domain'),
     path('<int:id>/delete-domain/<int:dom_id>/', views.DeleteDomainView().as_view(), name='del_domain'),
+    path('<int:id>/change-domain/<int:dom_id>/', views.ChangeDomainView().as_view(), name='change_domain'),
     path('<int:id>/refresh-ssl/', views.RefreshSsl().as_view(), name='refresh_ssl'),
+    path('<int:id>/snapshots/', views.SnapshotsView().as_view(), name='snapshots'),
+    path('<int:id>/snapshots/restore/', views.RestoreSnapshotView().as_view(), name='restore_snapshot'),
+    path('<int:id>/snapshots/files/', views.SnapshotFilesView().as_view(), name='snapshot_files'),
+    path('<int:id>/snapshots/files/download/', views.DownloadSnapshotFileView().as_view(), name='snapshot_file_download'),
+    path('<int:id>/canonical-host/', views.CanonicalHostView().as_view(), name='canonical_host'),
+    path('<int:id>/backend/', views.BackendView().as_view(), name='backend'),
+    path('<int:id>/app/', views.ProxyAppView().as_view(), name='proxy_app'),
+    path('<int:id>/dns-ssl/', views.DnsSslView().as_view(), name='dns_ssl'),
+    path('<int:id>/mirror/', views.MirrorView().as_view(), name='mirror'),
+    path('<int:id>/checks/', views.SiteChecksView().as_view(), name='checks'),
+    path('<int:id>/checks/<int:check_id>/', views.DeleteSiteCheckView().as_view(), name='delete_check'),
+    path('<int:id>/phpinfo/', views.PhpInfoView().as_view(), name='phpinfo'),
+    path('<int:id>/php-extensions/', views.PhpExtensionsView().as_view(), name='php_extensions'),
+    path('<int:id>/php-compat/', views.PhpCompatView().as_view(), name='php_compat'),
+    path('<int:id>/dev-mode/', views.DevModeView().as_view(), name='dev_mode'),
+    path('<int:id>/debug-mode/', views.DebugModeView().as_view(), name='debug_mode'),
+    path('<int:id>/config/', views.WebsiteConfigView().as_view(), name='config'),
+    path('<int:id>/acl-profile/', views.AclProfileView().as_view(), name='acl_profile'),
+    path('<int:id>/fix-permissions/', views.FixPermissionsView().as_view(), name='fix_permissions'),
+    path('<int:id>/watch-ownership/', views.WatchOwnershipView().as_view(), name='watch_ownership'),
+    path('<int:id>/scan-uploads/', views.ScanUploadsView().as_view(), name='scan_uploads'),
+    path('<int:id>/usage/', views.WebsiteUsageView().as_view(), name='usage'),
+    path('<int:id>/traffic/', views.TrafficView().as_view(), name='traffic'),
+    path('<int:id>/logs/stream/', views.LogStreamView().as_view(), name='log_stream'),
+    path('<int:id>/immutable-files/', views.ImmutableFilesView().as_view(), name='immutable_files'),
+    path('<int:id>/integrity/', views.IntegrityView().as_view(), name='integrity'),
+    path('<int:id>/dependencies/', views.DependenciesView().as_view(), name='dependencies'),
+    path('<int:id>/waf/', views.WafView().as_view(), name='waf'),
+    path('<int:id>/waf/blocked/', views.WafBlockedRequestsView().as_view(), name='waf_blocked'),
+    path('<int:id>/access-rules/', views.AccessRulesView().as_view(), name='access_rules'),
+    path('<int:id>/seo/', views.SeoView().as_view(), name='seo'),
+    path('<int:id>/warm-cache/', views.WarmCacheView().as_view(), name='warm_cache'),
+    path('<int:id>/quarantine/', views.QuarantineView().as_view(), name='quarantine'),
+    path('<int:id>/quarantine/<int:file_id>/', views.QuarantinedFileView().as_view(), name='quarantined_file'),
+    path('<int:id>/sftp-accounts/', views.SftpAccountsView().as_view(), name='sftp_accounts'),
+    path('<int:id>/sftp-accounts/<int:account_id>/', views.SftpAccountView().as_view(), name='sftp_account'),
+    path('<int:id>/protected-paths/', views.ProtectedPathsView().as_view(), name='protected_paths'),
+    path('<int:id>/protected-paths/<int:path_id>/', views.ProtectedPathView().as_view(), name='protected_path'),
+    path('<int:id>/snippets/', views.SnippetsView().as_view(), name='snippets'),
+    path('<int:id>/snippets/<int:snippet_id>/', views.SnippetView().as_view(), name='snippet'),
+    path('<int:id>/staging/', views.StagingView().as_view(), name='staging'),
+    path('<int:id>/staging/push/', views.StagingPushView().as_view(), name='staging_push'),
+    path('<int:id>/export/', views.ExportWebsiteView().as_view(), name='export'),
+    path('<int:id>/export/<int:job_id>/download/', views.DownloadExportView().as_view(), name='download_export'),
+    path('restore/plan/', views.RestorePlanView().as_view(), name='restore_plan'),
+    path('restore/execute/', views.RestoreExecuteView().as_view(), name='restore_execute'),
     path('php-versions/', views.PhpVersionsView().as_view(), name='php_versions'),
     path('', include(router.urls))
 ]
\ No newline at end of file
@@ -2,28 +2,44 @@ from rest_framework import viewsets
 from rest_framework.views import APIView
 from rest_framework.response import Response
 from rest_framework import status
-from core.models import Website
+from rest_framework.settings import api_settings
+from core.models import Website, Domain, Database, DnsCredential, SftpAccount, User, Staging, Job, QuarantinedFile, Snippet
 from . import serializers
 from core.permissions import IsAdminOrOwner
+from api.renderers import EventStreamRenderer
+from api import conditional
 from rest_framework import permissions
 from django.db.models import Q
+import validators, secrets, re, pwd, os, mimetypes
 from .services.get_php_versions import PhpVersionListService
 from core import signals
 from api.websites.services.ssl import FastcpSsl
-from core.utils.system import ssl_expiring
+from api.websites.services.change_domain import change_domain, wp_database
+from api.websites.services import staging
+from core.utils.system import ssl_expiring, snapshot_website, restore_website_snapshot, rand_passwd
+from core.utils.filesystem import get_website_paths, get_user_paths
+from core.utils import volumes, vhosts, monitoring, php, devmode, sftp, jobs, ownership, acls, restore, exports, tags, malware, immutable, usage, integrity, traffic, logstream, dependencies, waf, seo, warmup, access, basicauth, snippets, proxyapps, timers
+from django.conf import settings
+from django.http import StreamingHttpResponse
 
 
-class DomainAddView(APIView):
+class WebsiteMixin(object):
+    """Looks up the website of a view, admins get any website and the users only their own ones."""
+
+    def get_website(self, request, website_id):
+        user = request.user
+        if user.is_superuser:
+            return Website.objects.filter(id=website_id).first()
+        return Website.objects.filter(user=user, id=website_id).first()
+
+
+class DomainAddView(WebsiteMixin, APIView):
     """Add a new domain to a website."""
     http_method_names = ['post']
     
     def post(self, request, *args, **kwargs):
-        user = request.user
         website_id = kwargs.get('id')
-        if user.is_superuser:
-            website = Website.objects.filter(id=website_id).first()
-        else:
-            website = Website.objects.filter(user=user, id=website_id).first()
+        website = self.get_website(request, website_id)
         
         if not website:
             return Response({
@@ -50,17 +66,13 @@ class DomainAddView(APIView):
             'message': 'The domain has been deleted successfully.'
         })
 
-class RefreshSsl(APIView):
+class RefreshSsl(WebsiteMixin, APIView):
     """Refreshes the SSL certificates for a website."""
     http_method_names = ['post']
     
     def post(self, request, *args, **kwargs):
-        user = request.user
         website_id = kwargs.get('id')
-        if user.is_superuser:
-            website = Website.objects.filter(id=website_id).first()
-        else:
-            website = Website.objects.filter(user=user, id=website_id).first()
+        website = self.get_website(request, website_id)
         
         if not website:
             return Response({
@@ -89,18 +101,193 @@ class RefreshSsl(APIView):
                 'message': 'SSL certificates have already been installed for this website.'
             })
 
-class DeleteDomainView(APIView):
+class SnapshotsView(WebsiteMixin, APIView):
+    """List or take snapshots of a website's data."""
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        driver = volumes.get_driver()
+        return Response({
+            'driver': driver.name,
+            'supported': driver.supports_snapshots,
+            'snapshots': driver.list_snapshots(get_website_paths(website).get('base_path'))
+        })
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if not volumes.get_driver().supports_snapshots:
+            return Response({
+                'message': 'The storage of this server does not support snapshots.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+
+        label = snapshot_website(website)
+        if not label:
+            return Response({
+                'message': 'Snapshot cannot be taken for this website.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+
+        return Response({
+            'message': 'Snapshot has been taken successfully.',
+            'snapshot': label
+        })
+
+
+class RestoreSnapshotView(WebsiteMixin, APIView):
+    """Roll a website's data back to a snapshot."""
+    http_method_names = ['post']
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if restore_website_snapshot(website, request.POST.get('snapshot')):
+            integrity.rebaseline(website)
+            return Response({
+                'message': 'Website data has been restored from the snapshot.'
+            })
+        return Response({
+            'message': 'The snapshot cannot be restored.'
+        }, status=status.HTTP_400_BAD_REQUEST)
+
+
+class SnapshotFilesView(WebsiteMixin, APIView):
+    """Browse a snapshot of a website and restore single files or folders from it.
+
+    The directories are listed a page at a time. The selected paths are restored in place by a background
+    job, which snapshots the current state first.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        page = request.GET.get('page', '1')
+        listing = restore.browse_snapshot(website, request.GET.get('snapshot', ''), request.GET.get('path', ''), int(page) if page.isdigit() else 1)
+        if listing is None:
+            return Response({
+                'message': 'The directory was not found in the snapshot.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        return Response(listing)
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        label = request.POST.get('snapshot', '')
+        paths = restore.clean_paths(request.POST.getlist('paths'))
+        if not paths or '' in paths or any(not restore.snapshot_file(website, label, p) for p in paths):
+            return Response({
+                'errors': {'paths': ['Select the files or folders of the snapshot to restore.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        params = {'website_ids': [website.id], 'snapshot': label, 'paths': paths, 'alternate': None, 'dry_run': False}
+        job = jobs.start_job(request.user, 'restore', label, params, restore.restore_job)
+        return Response({
+            'message': 'Restoring the files.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class DownloadSnapshotFileView(WebsiteMixin, APIView):
+    """Download a single file, or a folder as a tar.gz, from a snapshot of a website."""
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        path = restore.snapshot_file(website, request.GET.get('snapshot', ''), request.GET.get('path', '')) if website else None
+        if not path or not request.GET.get('path', '').strip('/'):
+            return Response({
+                'message': 'The file was not found in the snapshot.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        name = os.path.basename(path)
+        if os.path.isdir(path):
+            response = StreamingHttpResponse(restore.stream_directory(path), content_type='application/gzip')
+            response['Content-Disposition'] = f'attachment; filename="{name}.tar.gz"'
+            return response
+        content_type = mimetypes.guess_type(name)[0] or 'application/octet-stream'
+        return exports.ranged_file_response(request, path, name, content_type=content_type)
+
+
+class ChangeDomainView(WebsiteMixin, APIView):
+    """Change a domain of a website and update the website to use the new domain."""
+    http_method_names = ['post']
+    
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        domain = website.domains.filter(id=kwargs.get('dom_id'), redirect_to__isnull=True).first()
+        if not domain:
+            return Response({
+                'message': 'Target domain was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        new_domain = request.POST.get('domain', '').strip().lower()
+        if not validators.domain(new_domain):
+            return Response({
+                'errors': {'domain': [f'{new_domain} is not a valid domain.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        if Domain.objects.filter(domain=new_domain).exists():
+            return Response({
+                'errors': {'domain': [f'{new_domain} already exists in the database.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        database = None
+        if request.POST.get('database'):
+            database = Database.objects.filter(user=website.user, id=request.POST.get('database')).first()
+            if not database:
+                return Response({
+                    'errors': {'database': ['The database was not found.']}
+                }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        report = change_domain(website, domain, new_domain, database=database, redirect_old=request.POST.get('redirect_old') != 'false')
+        return Response({
+            'message': f'{report.get("old_domain")} has been changed to {new_domain}.',
+            'report': report
+        })
+
+class DeleteDomainView(WebsiteMixin, APIView):
     """Delete a domain from a website."""
     http_method_names = ['delete']
     
     def delete(self, request, *args, **kwargs):
-        user = request.user
         website_id = kwargs.get('id')
         dom_id = kwargs.get('dom_id')
-        if user.is_superuser:
-            website = Website.objects.filter(id=website_id).first()
-        else:
-            website = Website.objects.filter(user=user, id=website_id).first()
+        website = self.get_website(request, website_id)
         
         if not website:
             return Response({
@@ -134,7 +321,7 @@ class PasswordUpdateView(APIView):
         })
 
 
-class ChangePHPVersion(APIView):
+class ChangePHPVersion(WebsiteMixin, APIView):
     """Change PHP version of the website."""
     # To-do: Update PHP version on system level
     http_method_names = ['post']
@@ -144,12 +331,8 @@ class ChangePHPVersion(APIView):
         if not s.is_valid():
             return Response(s.errors, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
         
-        user = request.user
         website_id = kwargs.get('id')
-        if user.is_superuser:
-            website = Website.objects.filter(id=website_id).first()
-        else:
-            website = Website.objects.filter(user=user, id=website_id).first()
+        website = self.get_website(request, website_id)
         
         if not website:
             return Response({
@@ -159,11 +342,223 @@ class ChangePHPVersion(APIView):
         new_version=s.validated_data.get('php')
         if website.php != new_version:
             # Send a signal so the FPM conf files will be updated promptly.
-            signals.update_php.send(sender=website, new_version=new_version)
+            responses = signals.update_php.send(sender=website, new_version=new_version)
+            if any(response is False for _, response in responses):
+                return Response({
+                    'message': f'The PHP-FPM pool for PHP {new_version} was rejected, the website stays on PHP {website.php}.'
+                }, status=status.HTTP_400_BAD_REQUEST)
         return Response({
             'message': kwargs
         })
 
+class CanonicalHostView(WebsiteMixin, APIView):
+    """Update the HTTPS and www/non-www redirects of the website."""
+    http_method_names = ['post']
+    
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        s = serializers.CanonicalHostSerializer(website, data=request.POST)
+        if not s.is_valid():
+            return Response({
+                'errors': s.errors
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        website = s.save()
+        signals.domains_updated.send(sender=website, only_nginx=True)
+        return Response({
+            'message': 'Redirect settings have been updated.',
+            'force_https': website.force_https,
+            'canonical_host': website.canonical_host,
+            'redirects': website.host_redirects()
+        })
+
+class BackendView(WebsiteMixin, APIView):
+    """Switch the web server backend of the website between NGINX + Apache, NGINX only and the reverse
+    proxy to an app."""
+    http_method_names = ['post']
+    
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        s = serializers.BackendSerializer(website, data=request.POST)
+        if not s.is_valid():
+            return Response({
+                'errors': s.errors
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        website = s.save()
+        signals.domains_updated.send(sender=website)
+        proxyapps.sync_unit(website)
+        return Response({
+            'message': 'The web server backend has been updated.',
+            'backend': website.backend,
+            'effective_backend': website.get_backend()
+        })
+
+class ProxyAppView(WebsiteMixin, APIView):
+    """Set up the app a website proxies to with the proxy backend.
+
+    The upstream is a local port or a unix socket the app listens on. If a command is set, FastCP runs
+    the app as the owner of the website with a systemd service that restarts it when it crashes, with PORT
+    set to the upstream port. Without a command, the app is expected to be run some other way.
+    """
+    http_method_names = ['get', 'post']
+
+    def app(self, website: object) -> dict:
+        return {
+            'backend': website.get_backend(),
+            'upstream': website.proxy_upstream,
+            'ports': proxyapps.port_range(website.user),
+            'command': website.app_command,
+            'service': proxyapps.app_status(website),
+            'output': proxyapps.app_output(website, 50) if proxyapps.app_status(website) else []
+        }
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response(self.app(website))
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if request.POST.get('action') == 'restart':
+            if not proxyapps.app_status(website):
+                return Response({
+                    'message': 'FastCP does not run the app of this website.'
+                }, status=status.HTTP_400_BAD_REQUEST)
+            proxyapps.restart(website)
+            return Response({
+                'message': 'The app has been restarted.',
+                **self.app(website)
+            })
+
+        errors = {}
+        upstream, error = proxyapps.normalize_upstream(website, request.POST.get('upstream', website.proxy_upstream))
+        if error:
+            errors['upstream'] = [error]
+        elif Website.objects.filter(proxy_upstream=upstream).exclude(id=website.id).exists():
+            errors['upstream'] = [f'{upstream} is used by another website.']
+        command = request.POST.get('command', website.app_command or '').strip()
+        if command and not timers.valid_command(command):
+            errors['command'] = ['The command should be a single line without control characters.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        website.proxy_upstream = upstream
+        website.app_command = command or None
+        website.save()
+        if website.get_backend() == 'proxy':
+            signals.domains_updated.send(sender=website, only_nginx=True)
+        proxyapps.sync_unit(website)
+        return Response({
+            'message': 'The app has been updated.' if website.get_backend() == 'proxy' else 'The app has been saved, switch to the proxy backend to serve it.',
+            **self.app(website)
+        })
+
+
+class DnsSslView(WebsiteMixin, APIView):
+    """Get the SSL certificates of the website through DNS challenges, including wildcard certificates.
+    
+    A DNS credential of the website owner, or of the admin, is selected along with the domains that should
+    get wildcard certificates. Posting an empty credential goes back to the HTTP challenge.
+    """
+    http_method_names = ['post']
+    
+    def post(self, request, *args, **kwargs):
+        user = request.user
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        credential = None
+        if request.POST.get('credential'):
+            credential = DnsCredential.objects.filter(id=request.POST.get('credential'), user__in=[website.user, user]).first()
+            if not credential:
+                return Response({
+                    'errors': {'credential': ['The DNS credential was not found.']}
+                }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        wildcards = set(filter(None, [d.strip().lower() for d in request.POST.get('wildcard', '').split(',')]))
+        if wildcards and not credential:
+            return Response({
+                'errors': {'wildcard': ['Wildcard certificates need a DNS credential.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        unknown = wildcards - set(website.domains.values_list('domain', flat=True))
+        if unknown:
+            return Response({
+                'errors': {'wildcard': [f'{", ".join(sorted(unknown))} do not belong to this website.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        website.dns_credential = credential
+        website.save()
+        for domain in website.domains.all():
+            if domain.wildcard != (domain.domain in wildcards):
+                domain.wildcard = domain.domain in wildcards
+                # The certificate should be requested again with the new names
+                domain.ssl = False
+                domain.save()
+        
+        signals.domains_updated.send(sender=website)
+        return Response({
+            'message': 'DNS challenge settings have been updated, the certificates will be requested shortly.',
+            'credential': credential.id if credential else None,
+            'wildcard': sorted(wildcards)
+        })
+
+class MirrorView(WebsiteMixin, APIView):
+    """Mirror a share of the website's traffic to another website, i.e. a staging copy."""
+    http_method_names = ['post']
+    
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        
+        s = serializers.MirrorSerializer(website, data=request.POST)
+        if not s.is_valid():
+            return Response({
+                'errors': s.errors
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        
+        website = s.save()
+        signals.domains_updated.send(sender=website, only_nginx=True)
+        return Response({
+            'message': 'Traffic mirroring settings have been updated.',
+            'mirror': website.mirror_config()
+        })
+
 class PhpVersionsView(APIView):
     """Gets the list of supported PHP versions."""
     http_method_names = ['get']
@@ -194,6 +589,1627 @@ class WebsiteViewSet(viewsets.ModelViewSet):
 
         search_q = self.request.GET.get('q')
         if search_q:
-            queryset = queryset.filter(label__icontains=search_q)
+            queryset = queryset.filter(Q(label__icontains=search_q) | tags.search_q(search_q, notes=user.is_superuser))
+
+        tag = self.request.GET.get('tag')
+        if tag:
+            queryset = tags.filter_tagged(queryset, tag)
              
-        return queryset
\ No newline at end of file
+        return queryset
+
+    def list(self, request, *args, **kwargs):
+        """Polling clients get 304 responses with If-None-Match while none of the listed websites or their
+        domains changed. The ETag is computed from the rows, before any serialization."""
+        queryset = self.filter_queryset(self.get_queryset())
+        etag = conditional.etag_for(
+            request.GET.urlencode(),
+            list(queryset.values_list()),
+            list(Domain.objects.filter(website__in=queryset).order_by('id').values_list())
+        )
+        return conditional.with_etag(conditional.not_modified(request, etag) or super().list(request, *args, **kwargs), etag)
+
+
+class WebsiteConfigView(APIView):
+    """Inspect the generated server config of a website, the health of its upstreams and the drift of its ACLs."""
+    http_method_names = ['get']
+    permission_classes = [permissions.IsAdminUser]
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = Website.objects.filter(id=website_id).first()
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response({
+            'config': vhosts.website_config(website),
+            'upstreams': vhosts.upstream_health(website),
+            'acls': acls.acl_drift(website)
+        })
+
+
+class SiteChecksView(WebsiteMixin, APIView):
+    """List the synthetic checks of a website with their trends, along with the warnings of the last SEO
+    check if it's on, or add a check for a URL."""
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        checks = []
+        for site_check in website.checks.all():
+            # The last result of each source, local and the external probes
+            last_results = []
+            for source in site_check.results.order_by().values_list('source', flat=True).distinct():
+                last = site_check.results.filter(source=source).order_by('-created').first()
+                last_results.append({
+                    'source': last.source,
+                    'status_code': last.status_code,
+                    'ttfb': last.ttfb,
+                    'latency': last.latency,
+                    'error': last.error,
+                    'healthy': last.healthy,
+                    'created': last.created
+                })
+            checks.append({
+                **serializers.SiteCheckSerializer(site_check).data,
+                'last_results': last_results,
+                'trend': monitoring.check_trend(site_check)
+            })
+        return Response({
+            'checks': checks,
+            'seo': seo.last_report(website) if website.seo_checks else None
+        })
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        s = serializers.SiteCheckSerializer(data=request.POST)
+        if not s.is_valid():
+            return Response({
+                'errors': s.errors
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        if website.checks.filter(path=s.validated_data.get('path')).exists():
+            return Response({
+                'errors': {'path': ['This URL is being checked already.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        site_check = s.save(website=website)
+        return Response(serializers.SiteCheckSerializer(site_check).data)
+
+
+class DeleteSiteCheckView(WebsiteMixin, APIView):
+    """Stop checking a URL of a website."""
+    http_method_names = ['delete']
+
+    def delete(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        site_check = website and website.checks.filter(id=kwargs.get('check_id')).first()
+        if not site_check:
+            return Response({
+                'message': 'Target check was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        site_check.delete()
+        return Response({
+            'message': 'The check has been deleted.'
+        })
+
+
+class PhpInfoView(WebsiteMixin, APIView):
+    """Render phpinfo for the exact pool configuration of a website."""
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        output = php.phpinfo(website)
+        if output is None:
+            return Response({
+                'message': f'PHP {website.php} is not installed.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({'php': website.php, 'phpinfo': output})
+
+
+class PhpExtensionsView(WebsiteMixin, APIView):
+    """List, enable or disable the PHP extensions of a website.
+
+    The extensions are loaded in the pool of the website only, so other websites on the same PHP version
+    don't pay for them. The extensions enabled for all pools cannot be disabled per website.
+    """
+    http_method_names = ['get', 'post']
+
+    def extensions_status(self, website):
+        enabled = php.site_extensions(website)
+        extensions = php.available_extensions(website.php)
+        for extension in extensions:
+            extension['enabled'] = extension.get('global') or extension.get('name') in enabled
+        return {'php': website.php, 'extensions': extensions}
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        return Response(self.extensions_status(website))
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        name = request.POST.get('extension', '').strip().lower()
+        enabled = request.POST.get('enabled') in ['1', 'true']
+        extension = next((e for e in php.available_extensions(website.php) if e.get('name') == name), None)
+        if not php.EXTENSION_RE.match(name) or not extension:
+            return Response({
+                'errors': {'extension': [f'{name} is not installed for PHP {website.php}.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        if not extension.get('per_site'):
+            return Response({
+                'errors': {'extension': [f'{name} is enabled for all websites or cannot be loaded per website.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        extensions = [e for e in php.site_extensions(website) if e != name]
+        if enabled:
+            extensions.append(name)
+        if not php.set_site_extensions(website, extensions):
+            return Response({
+                'message': f'The PHP-FPM pool of {website.label} cannot be updated.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response(self.extensions_status(website))
+
+
+class PhpCompatView(WebsiteMixin, APIView):
+    """Scan a website for code that may break with another PHP version before switching to it. The scan
+    takes a while on large websites, so it runs as a job."""
+    http_method_names = ['post']
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        version = request.POST.get('version')
+        if version not in PhpVersionListService().get_php_versions():
+            return Response({
+                'errors': {'version': [f'PHP {version} is not supported.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        job = jobs.start_job(request.user, 'php_compat', website.label, {'website_id': website.id, 'version': version}, php.compat_job)
+        return Response({
+            'message': f'Scanning {website.label} for PHP {version} compatibility.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class DevModeView(WebsiteMixin, APIView):
+    """Get, enable or disable the developer mode of a website.
+
+    Developer mode enables Xdebug or PCOV in the pool of the website only, and it is disabled
+    automatically once the time window ends. It's enabled by a job, as the extension may have to be
+    installed first.
+    """
+    http_method_names = ['get', 'post', 'delete']
+
+    def dev_status(self, website):
+        active = devmode.dev_mode_active(website)
+        return {
+            'active': active,
+            'extension': website.dev_extension if active else None,
+            'until': website.dev_mode_until if active else None,
+            'warning': f'{website.get_dev_extension_display()} is enabled and slows down this website.' if active else None
+        }
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        return Response(self.dev_status(website))
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        extension = request.POST.get('extension', 'xdebug')
+        try:
+            hours = int(request.POST.get('hours', 1))
+        except ValueError:
+            hours = 0
+        errors = {}
+        if extension not in ['xdebug', 'pcov']:
+            errors['extension'] = ['Developer mode supports xdebug and pcov only.']
+        if hours < 1 or hours > settings.FASTCP_DEV_MODE_MAX_HOURS:
+            errors['hours'] = [f'Developer mode can be enabled for 1 to {settings.FASTCP_DEV_MODE_MAX_HOURS} hours.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        params = {'website_id': website.id, 'extension': extension, 'hours': hours}
+        job = jobs.start_job(request.user, 'dev_mode', website.label, params, devmode.enable_job)
+        return Response({
+            'message': f'Enabling {extension} for {website.label}.',
+            'job': jobs.serialize_job(job)
+        })
+
+    def delete(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if not devmode.disable_dev_mode(website):
+            return Response({
+                'message': 'Developer mode cannot be disabled, the PHP-FPM pool was rejected.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response(self.dev_status(website))
+
+
+class DebugModeView(WebsiteMixin, APIView):
+    """Enable or disable the debug error pages of a website for a list of IPs."""
+    http_method_names = ['post']
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        enabled = request.POST.get('enabled') == 'true'
+        ips = list(filter(None, [ip.strip() for ip in request.POST.get('ips', '').split(',')]))
+        if enabled:
+            invalid = [ip for ip in ips if not validators.ipv4(ip) and not validators.ipv6(ip)]
+            if invalid or not ips:
+                return Response({
+                    'errors': {'ips': [f'{", ".join(invalid)} is not a valid IP.' if invalid else 'At least one IP is required.']}
+                }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        website.debug_mode = enabled
+        website.debug_ips = ','.join(ips) if enabled else None
+        website.debug_key = secrets.token_hex(32) if enabled else None
+        website.save()
+        signals.domains_updated.send(sender=website, only_nginx=True)
+        return Response({
+            'message': f'Debug mode has been {"enabled" if enabled else "disabled"}.',
+            'debug_mode': website.debug_mode,
+            'debug_ips': website.debug_allowed_ips()
+        })
+
+
+
+class SftpAccountsView(WebsiteMixin, APIView):
+    """List or create the virtual SFTP accounts of a website.
+
+    The accounts are jailed to the public directory of the website and don't need a system user, so they
+    can be handed out to developers who should only access this website. A password is generated if none
+    is provided and it's only returned once.
+    """
+    http_method_names = ['get', 'post']
+    username_re = re.compile(r'^[a-z][a-z0-9._-]{2,49}$')
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response({
+            'host': settings.SERVER_IP_ADDR,
+            'port': settings.FASTCP_SFTP_PORT,
+            'accounts': [{
+                'id': a.id,
+                'username': a.username,
+                'read_only': a.read_only,
+                'created': a.created
+            } for a in website.sftp_accounts.order_by('username')]
+        })
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        errors = {}
+        username = request.POST.get('username', '').strip().lower()
+        if not self.username_re.match(username):
+            errors['username'] = ['The username should be 3 to 50 lowercase letters, digits, dots, dashes or underscores.']
+        elif SftpAccount.objects.filter(username=username).exists() or User.objects.filter(username=username).exists():
+            errors['username'] = [f'{username} is already taken.']
+        else:
+            try:
+                pwd.getpwnam(username)
+                errors['username'] = [f'{username} is a system user.']
+            except KeyError:
+                pass
+
+        password = request.POST.get('password') or rand_passwd()
+        if len(password) < 8:
+            errors['password'] = ['The password should be at least 8 characters long.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        password_hash = sftp.hash_password(password)
+        if not password_hash:
+            return Response({
+                'message': 'The password cannot be hashed.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+
+        account = website.sftp_accounts.create(
+            username=username,
+            password_hash=password_hash,
+            read_only=request.POST.get('read_only') in ['1', 'true']
+        )
+        sftp.sync_sftp_accounts()
+        return Response({
+            'message': f'SFTP account {account} has been created.',
+            'id': account.id,
+            'username': account.username,
+            'password': password,
+            'port': settings.FASTCP_SFTP_PORT
+        })
+
+
+class SftpAccountView(WebsiteMixin, APIView):
+    """Update or delete a virtual SFTP account of a website.
+
+    Posting reset_password generates a new password, which is only returned once.
+    """
+    http_method_names = ['post', 'delete']
+
+    def get_account(self, request, kwargs):
+        website = self.get_website(request, kwargs.get('id'))
+        if not website:
+            return None
+        return website.sftp_accounts.filter(id=kwargs.get('account_id')).first()
+
+    def post(self, request, *args, **kwargs):
+        account = self.get_account(request, kwargs)
+        if not account:
+            return Response({
+                'message': f'SFTP account with ID {kwargs.get("account_id")} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        result = {'message': f'SFTP account {account} has been updated.'}
+        if request.POST.get('read_only') is not None:
+            account.read_only = request.POST.get('read_only') in ['1', 'true']
+
+        if request.POST.get('reset_password'):
+            password = rand_passwd()
+            password_hash = sftp.hash_password(password)
+            if not password_hash:
+                return Response({
+                    'message': 'The password cannot be hashed.'
+                }, status=status.HTTP_400_BAD_REQUEST)
+            account.password_hash = password_hash
+            result['password'] = password
+
+        account.save()
+        sftp.sync_sftp_accounts()
+        return Response(result)
+
+    def delete(self, request, *args, **kwargs):
+        account = self.get_account(request, kwargs)
+        if not account:
+            return Response({
+                'message': f'SFTP account with ID {kwargs.get("account_id")} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        account.delete()
+        sftp.sync_sftp_accounts()
+        return Response({'message': f'SFTP account {account} has been deleted.'})
+
+
+class ProtectedPathsView(WebsiteMixin, APIView):
+    """List or add the HTTP basic auth credentials of the protected paths of a website.
+
+    The path / protects the whole website and a sub path like /wp-admin protects everything under it. A
+    path accepts any of its credentials. A password is generated if none is provided and it's only
+    returned once.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response({
+            'paths': [{
+                'path': path,
+                'credentials': [{
+                    'id': c.id,
+                    'username': c.username,
+                    'created': c.created
+                } for c in credentials]
+            } for path, credentials in basicauth.protected_paths(website).items()]
+        })
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        errors = {}
+        path = basicauth.normalize_path(request.POST.get('path', '/'))
+        if not path:
+            errors['path'] = ['The path should start with a slash and contain letters, digits, dots, dashes, underscores or slashes.']
+        username = request.POST.get('username', '').strip()
+        if not basicauth.USERNAME_RE.match(username):
+            errors['username'] = ['The username should be up to 50 letters, digits, dots, dashes, underscores or @.']
+        elif path and website.protected_paths.filter(path=path, username=username).exists():
+            errors['username'] = [f'{username} can already access {path}.']
+        password = request.POST.get('password') or rand_passwd()
+        if len(password) < 8:
+            errors['password'] = ['The password should be at least 8 characters long.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        protected = website.protected_paths.create(path=path, username=username, password_hash=basicauth.hash_password(password))
+        basicauth.write_users_files(website)
+        signals.domains_updated.send(sender=website, only_nginx=True)
+        return Response({
+            'message': f'{path} is protected with the credentials of {username}.',
+            'id': protected.id,
+            'path': path,
+            'username': username,
+            'password': password
+        })
+
+
+class ProtectedPathView(WebsiteMixin, APIView):
+    """Update or delete the HTTP basic auth credentials of a protected path of a website.
+
+    Posting a password changes it, and posting reset_password generates a new one, which is only returned
+    once. The path isn't protected anymore once its last credentials are deleted.
+    """
+    http_method_names = ['post', 'delete']
+
+    def get_credentials(self, request, kwargs):
+        website = self.get_website(request, kwargs.get('id'))
+        if not website:
+            return None
+        return website.protected_paths.filter(id=kwargs.get('path_id')).first()
+
+    def post(self, request, *args, **kwargs):
+        protected = self.get_credentials(request, kwargs)
+        if not protected:
+            return Response({
+                'message': f'Protected path credentials with ID {kwargs.get("path_id")} were not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        result = {'message': f'The credentials of {protected.username} for {protected.path} have been updated.'}
+        password = request.POST.get('password')
+        if request.POST.get('reset_password'):
+            password = rand_passwd()
+            result['password'] = password
+        if not password:
+            return Response({
+                'errors': {'password': ['Provide the new password or reset it.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        if len(password) < 8:
+            return Response({
+                'errors': {'password': ['The password should be at least 8 characters long.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        protected.password_hash = basicauth.hash_password(password)
+        protected.save()
+        basicauth.write_users_files(protected.website)
+        return Response(result)
+
+    def delete(self, request, *args, **kwargs):
+        protected = self.get_credentials(request, kwargs)
+        if not protected:
+            return Response({
+                'message': f'Protected path credentials with ID {kwargs.get("path_id")} were not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        website = protected.website
+        protected.delete()
+        # The vhost stops using the users file before it's removed
+        signals.domains_updated.send(sender=website, only_nginx=True)
+        basicauth.write_users_files(website)
+        return Response({'message': f'The credentials of {protected.username} for {protected.path} have been deleted.'})
+
+
+def serialize_snippet(snippet: object) -> dict:
+    return {
+        'id': snippet.id,
+        'name': snippet.name,
+        'content': snippet.content,
+        'enabled': snippet.enabled,
+        'created': snippet.created,
+        'updated': snippet.updated
+    }
+
+
+class SnippetsView(WebsiteMixin, APIView):
+    """List or add the custom NGINX directives of a website.
+
+    The snippets are included in all server blocks of the website, i.e. for headers, redirects and
+    reverse proxy locations. A snippet is tested with nginx -t before NGINX is reloaded and it's only
+    saved if NGINX accepts it. The directives that reach the files of the server are left to the admins.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response({'snippets': [serialize_snippet(s) for s in website.snippets.order_by('name')]})
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        errors = {}
+        name = request.POST.get('name', '').strip().lower()
+        if not snippets.NAME_RE.match(name):
+            errors['name'] = ['The name should be up to 50 lowercase letters, digits or dashes.']
+        elif website.snippets.filter(name=name).exists():
+            errors['name'] = [f'{name} is already taken.']
+        content = request.POST.get('content', '')
+        content_error = snippets.validate_content(content, admin=request.user.is_superuser)
+        if content_error:
+            errors['content'] = [content_error]
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        snippet = Snippet(website=website, name=name, content=content)
+        nginx_errors = snippets.apply_snippet(snippet, content, admin=request.user.is_superuser)
+        if nginx_errors:
+            return Response({'errors': {'content': [nginx_errors]}}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        snippet.save()
+        return Response({
+            'message': f'Snippet {snippet} has been added.',
+            **serialize_snippet(snippet)
+        })
+
+
+class SnippetView(WebsiteMixin, APIView):
+    """Update, disable or delete a custom NGINX snippet of a website.
+
+    The changed directives are tested with nginx -t like the new ones, and the previous ones are kept if
+    NGINX rejects them. A disabled snippet is kept but not included.
+    """
+    http_method_names = ['post', 'delete']
+
+    def get_snippet(self, request, kwargs):
+        website = self.get_website(request, kwargs.get('id'))
+        if not website:
+            return None
+        return website.snippets.filter(id=kwargs.get('snippet_id')).first()
+
+    def post(self, request, *args, **kwargs):
+        snippet = self.get_snippet(request, kwargs)
+        if not snippet:
+            return Response({
+                'message': f'Snippet with ID {kwargs.get("snippet_id")} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        content = request.POST.get('content', snippet.content)
+        enabled = request.POST.get('enabled') in ['1', 'true'] if request.POST.get('enabled') is not None else snippet.enabled
+        if content != snippet.content:
+            content_error = snippets.validate_content(content, admin=request.user.is_superuser)
+            if content_error:
+                return Response({'errors': {'content': [content_error]}}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        nginx_errors = snippets.apply_snippet(snippet, content if enabled else None, admin=request.user.is_superuser)
+        if nginx_errors:
+            return Response({'errors': {'content': [nginx_errors]}}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        snippet.content = content
+        snippet.enabled = enabled
+        snippet.save()
+        return Response({
+            'message': f'Snippet {snippet} has been updated.',
+            **serialize_snippet(snippet)
+        })
+
+    def delete(self, request, *args, **kwargs):
+        snippet = self.get_snippet(request, kwargs)
+        if not snippet:
+            return Response({
+                'message': f'Snippet with ID {kwargs.get("snippet_id")} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        nginx_errors = snippets.apply_snippet(snippet, None, admin=request.user.is_superuser)
+        if nginx_errors:
+            return Response({
+                'message': f'Snippet {snippet} cannot be removed, NGINX rejects the config without it.',
+                'errors': nginx_errors
+            }, status=status.HTTP_400_BAD_REQUEST)
+        snippet.delete()
+        return Response({'message': f'Snippet {snippet} has been deleted.'})
+
+
+class FixPermissionsView(WebsiteMixin, APIView):
+    """Give the files of a website back to its owner.
+
+    Runs as a background job that reports the progress and the changed paths. With dry_run, the paths
+    that would be changed are only reported.
+    """
+    http_method_names = ['post']
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        dry_run = request.POST.get('dry_run') in ['1', 'true']
+        job = jobs.start_job(request.user, 'fix_ownership', website.label, {'website_ids': [website.id], 'dry_run': dry_run}, ownership.fix_ownership_job)
+        return Response({
+            'message': 'Checking the permissions.' if dry_run else 'Fixing the permissions.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class AclProfileView(WebsiteMixin, APIView):
+    """Get or change the ACL profile of a website.
+
+    The strict profile keeps the files owner only, shared-group gives a group of collaborators write
+    access and www-data lets the web server user read the files for integrations that need it.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        return Response(acls.acl_drift(website))
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        s = serializers.AclProfileSerializer(website, data=request.POST)
+        if not s.is_valid():
+            return Response({
+                'errors': s.errors
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        website = s.save()
+        if not acls.apply_profile(website):
+            return Response({
+                'message': 'The ACL profile has been saved but it cannot be applied.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({
+            'message': f'{website.get_acl_profile_display()} profile has been applied.',
+            **acls.acl_drift(website)
+        })
+
+
+class WatchOwnershipView(WebsiteMixin, APIView):
+    """Enable or disable the ownership watching of a website.
+
+    The watch-ownership command gives the files created in the public directory by other users, i.e. by
+    root cron jobs, back to the website owner within seconds.
+    """
+    http_method_names = ['post']
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        website.watch_ownership = request.POST.get('enabled') in ['1', 'true']
+        website.save()
+        return Response({
+            'message': f'Ownership watching has been {"enabled" if website.watch_ownership else "disabled"}.',
+            'watch_ownership': website.watch_ownership
+        })
+
+
+class ScanUploadsView(WebsiteMixin, APIView):
+    """Enable or disable the upload scanning of a website.
+
+    The PHP files dropped in the uploads directories, through the file manager or by anything the
+    scan-uploads command sees, are scanned with ClamAV and quarantined if they look malicious.
+    """
+    http_method_names = ['post']
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        website.scan_uploads = request.POST.get('enabled') in ['1', 'true']
+        website.save()
+        return Response({
+            'message': f'Upload scanning has been {"enabled" if website.scan_uploads else "disabled"}.',
+            'scan_uploads': website.scan_uploads
+        })
+
+
+class WebsiteUsageView(WebsiteMixin, APIView):
+    """Return the CPU, memory, disk and traffic history of a website for the usage graphs.
+
+    The CPU and memory are the ones of the PHP-FPM pool of the website and the traffic is read from its
+    NGINX access log.
+    """
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        range_name = request.GET.get('range', '24h')
+        if range_name not in usage.RANGES:
+            return Response({
+                'errors': {'range': [f'Select one of {", ".join(usage.RANGES)}.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+        return Response({
+            'website': website.label,
+            'range': range_name,
+            'points': usage.usage_series(website.user, website=website, range_name=range_name)
+        })
+
+
+class LogStreamView(WebsiteMixin, APIView):
+    """Stream the error or the access log of a website as server-sent events.
+
+    The lines are sent as they are written, filtered by level, for up to FASTCP_LOG_FOLLOW_SECONDS. The ID
+    of each event is the offset after the line, so EventSource reconnects continue where they stopped
+    through the Last-Event-ID header. An offset can be passed to start from as well.
+    """
+    http_method_names = ['get']
+    renderer_classes = api_settings.DEFAULT_RENDERER_CLASSES + [EventStreamRenderer]
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        errors = {}
+        kind = request.GET.get('log', 'error')
+        logs = logstream.site_logs(website)
+        if kind not in logs:
+            errors['log'] = [f'Select one of {", ".join(logs)}.']
+        level = request.GET.get('level') or None
+        if level and level not in logstream.LEVELS:
+            errors['level'] = [f'Level should be one of {", ".join(logstream.LEVELS)}.']
+        offset = request.META.get('HTTP_LAST_EVENT_ID') or request.GET.get('offset')
+        if offset is not None and not str(offset).isdigit():
+            errors['offset'] = ['Offset should be a number of bytes.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        allowed_dir = get_user_paths(website.user).get('logs_path')
+        lines = logstream.follow_log(logs.get(kind), allowed_dir, kind, level, int(offset) if offset is not None else None)
+        events = (logstream.sse_event(l, 'line', l.get('offset') if l else None) for l in lines)
+        response = StreamingHttpResponse(events, content_type='text/event-stream')
+        response['Cache-Control'] = 'no-cache'
+        response['X-Accel-Buffering'] = 'no'
+        return response
+
+
+class TrafficView(WebsiteMixin, APIView):
+    """Return the monthly traffic of a website, or set its monthly traffic quota.
+
+    A website that uses up its quota serves the quota exceeded page until the next month or until the
+    quota is raised. Only admins can set the quotas.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response({
+            'quota_gb': website.traffic_quota_gb,
+            'quota_exceeded': website.quota_exceeded,
+            'current_month_bytes': traffic.month_usage(website),
+            'months': [{
+                'month': m.month,
+                'bytes_sent': m.bytes_sent,
+                'requests': m.requests
+            } for m in website.traffic_months.order_by('-month')[:12]]
+        })
+
+    def post(self, request, *args, **kwargs):
+        if not request.user.is_superuser:
+            return Response({
+                'message': 'Only admins can set the traffic quotas.'
+            }, status=status.HTTP_403_FORBIDDEN)
+
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        quota = request.POST.get('quota_gb', '')
+        if not quota.isdigit():
+            return Response({
+                'errors': {'quota_gb': ['The quota should be a number of GB, 0 for unlimited.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        website.traffic_quota_gb = int(quota)
+        website.save()
+        traffic.enforce_quotas()
+        website.refresh_from_db()
+        return Response({
+            'message': 'The traffic quota has been updated.',
+            'quota_gb': website.traffic_quota_gb,
+            'quota_exceeded': website.quota_exceeded
+        })
+
+
+class ImmutableFilesView(WebsiteMixin, APIView):
+    """Lock critical files of a website, like wp-config.php, with the immutable attribute.
+
+    Not even the website owner can change or delete an immutable file, which stops most tampering by a
+    compromised plugin. The locked files are unlocked during restores and staging pushes only.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response({'files': immutable.file_states(website)})
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        files = [immutable.clean_file(website, f) for f in request.POST.getlist('files')]
+        if None in files:
+            return Response({
+                'errors': {'files': ['The files should be relative to the public directory of the website.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        previous = immutable.locked_files(website)
+        website.immutable_files = ','.join(sorted(set(files))) or None
+        # apply only unlocks the suggested files, the other files dropped from the list are unlocked here
+        for name in previous:
+            if name not in files:
+                immutable.set_immutable(immutable.file_path(website, name), False)
+        failed = immutable.apply(website)
+        website.save()
+        return Response({
+            'message': 'The locked files have been updated.' if not failed else f'These files cannot be locked: {", ".join(failed)}.',
+            'files': immutable.file_states(website)
+        }, status=status.HTTP_200_OK if not failed else status.HTTP_400_BAD_REQUEST)
+
+
+class IntegrityView(WebsiteMixin, APIView):
+    """Monitor the integrity of the PHP files of a website.
+
+    A baseline of the hashes of the PHP files is taken when the monitoring is enabled and the files are
+    compared with it periodically. The changes made by restores and staging pushes update the baseline,
+    other changes are reported until they are accepted with a new baseline.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response({
+            'monitor_integrity': website.monitor_integrity,
+            'report': integrity.last_report(website) if website.monitor_integrity else None
+        })
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        action = request.POST.get('action')
+        if action == 'accept':
+            if not website.monitor_integrity:
+                return Response({
+                    'message': 'The integrity monitoring of this website is disabled.'
+                }, status=status.HTTP_400_BAD_REQUEST)
+            integrity.save_baseline(website)
+            return Response({'message': 'The current files have been accepted as the new baseline.'})
+        if action == 'check':
+            if not website.monitor_integrity:
+                return Response({
+                    'message': 'The integrity monitoring of this website is disabled.'
+                }, status=status.HTTP_400_BAD_REQUEST)
+            return Response({'report': integrity.compare(website)})
+
+        website.monitor_integrity = request.POST.get('enabled') in ['1', 'true']
+        website.save()
+        if website.monitor_integrity:
+            integrity.save_baseline(website)
+        else:
+            integrity.delete_baseline(website)
+        return Response({
+            'message': f'Integrity monitoring has been {"enabled" if website.monitor_integrity else "disabled"}.',
+            'monitor_integrity': website.monitor_integrity
+        })
+
+
+class DependenciesView(WebsiteMixin, APIView):
+    """Audit the Composer and NPM dependencies of a website.
+
+    The lockfiles of the website are audited against the advisory databases daily, as the website owner.
+    The last report lists the vulnerable packages of each lockfile with their severity, and a new audit
+    runs in the background on request.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response({'report': dependencies.last_report(website)})
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if not dependencies.find_lockfiles(website):
+            return Response({
+                'message': 'This website has no composer.lock or package-lock.json to audit.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+
+        job = jobs.start_job(request.user, 'audit_dependencies', website.label, {'website_ids': [website.id]}, dependencies.audit_job)
+        return Response({
+            'message': 'Auditing the dependencies.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class WafView(WebsiteMixin, APIView):
+    """Filter the requests of a website with ModSecurity and the OWASP Core Rule Set.
+
+    The WAF blocks the attacks, or only logs them in the detection mode, which helps to find the false
+    positives before blocking. Higher paranoia levels catch more attacks with more false positives, and
+    the rules causing false positives can be disabled by ID. Admins install ModSecurity with the install
+    action if it's missing.
+    """
+    http_method_names = ['get', 'post']
+
+    def waf_status(self, website: object) -> dict:
+        return {
+            'available': waf.is_available(),
+            'crs_version': waf.crs_version(),
+            'crs_rule_files': waf.crs_rule_files(),
+            'enabled': website.waf_enabled,
+            'mode': website.waf_mode,
+            'paranoia': website.waf_paranoia,
+            'disabled_rules': waf.disabled_rules(website)
+        }
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response(self.waf_status(website))
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if request.POST.get('action') == 'install':
+            if not request.user.is_superuser:
+                return Response({
+                    'message': 'Only admins can install ModSecurity.'
+                }, status=status.HTTP_403_FORBIDDEN)
+            job = jobs.start_job(request.user, 'install_waf', 'server', {}, waf.install_job)
+            return Response({
+                'message': 'Installing ModSecurity and the OWASP Core Rule Set.',
+                'job': jobs.serialize_job(job)
+            })
+
+        errors = {}
+        enabled = request.POST.get('enabled') in ['1', 'true'] if request.POST.get('enabled') is not None else website.waf_enabled
+        if enabled and not waf.is_available():
+            errors['enabled'] = ['ModSecurity is not installed on this server. Please ask an admin to install it.']
+        mode = request.POST.get('mode', website.waf_mode)
+        if mode not in ['block', 'detect']:
+            errors['mode'] = ['Mode should be either block or detect.']
+        try:
+            paranoia = int(request.POST.get('paranoia', website.waf_paranoia))
+            if paranoia not in waf.PARANOIA_LEVELS:
+                raise ValueError
+        except (TypeError, ValueError):
+            errors['paranoia'] = ['Paranoia level should be a number from 1 to 4.']
+        rules = [r.strip() for r in request.POST.get('disabled_rules', website.waf_disabled_rules or '').split(',') if r.strip()]
+        if any(not waf.RULE_ID_RE.match(r) for r in rules):
+            errors['disabled_rules'] = ['The disabled rules should be comma separated CRS rule IDs, i.e. 942100.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        website.waf_enabled = enabled
+        website.waf_mode = mode
+        website.waf_paranoia = paranoia
+        website.waf_disabled_rules = ','.join(dict.fromkeys(rules)) or None
+        website.save()
+        waf.write_rules(website)
+        signals.domains_updated.send(sender=website, only_nginx=True)
+        return Response({
+            'message': f'The WAF has been {"enabled" if website.waf_enabled else "disabled"}.',
+            **self.waf_status(website)
+        })
+
+
+class AccessRulesView(WebsiteMixin, APIView):
+    """Allow or deny the IPs and the countries of a website.
+
+    The IPs and the CIDR networks are comma or newline separated. If IPs are allowed, only they are
+    served, and the most specific network decides, so a denied IP within an allowed network is refused.
+    The countries are blocked with the MaxMind GeoIP2 database, admins install the NGINX module with
+    the install action if it's missing.
+    """
+    http_method_names = ['get', 'post']
+
+    def access_status(self, website: object) -> dict:
+        return {
+            'geoip_available': access.is_geoip_available(),
+            **access.access_rules(website)
+        }
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response(self.access_status(website))
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if request.POST.get('action') == 'install':
+            if not request.user.is_superuser:
+                return Response({
+                    'message': 'Only admins can install the GeoIP2 module.'
+                }, status=status.HTTP_403_FORBIDDEN)
+            job = jobs.start_job(request.user, 'install_geoip', 'server', {}, access.install_job)
+            return Response({
+                'message': 'Installing the GeoIP2 module of NGINX.',
+                'job': jobs.serialize_job(job)
+            })
+
+        errors = {}
+        allowed, invalid = access.normalize_ips(request.POST.get('allowed_ips', website.allowed_ips))
+        if invalid:
+            errors['allowed_ips'] = [f'{", ".join(invalid)} should be IPs or networks in CIDR notation.']
+        denied, invalid = access.normalize_ips(request.POST.get('denied_ips', website.denied_ips))
+        if invalid:
+            errors['denied_ips'] = [f'{", ".join(invalid)} should be IPs or networks in CIDR notation.']
+        elif set(allowed) & set(denied):
+            errors['denied_ips'] = [f'{", ".join(sorted(set(allowed) & set(denied)))} cannot be both allowed and denied.']
+        countries, invalid = access.normalize_countries(request.POST.get('blocked_countries', website.blocked_countries))
+        if invalid:
+            errors['blocked_countries'] = [f'{", ".join(invalid)} should be two letter ISO country codes, i.e. US.']
+        elif countries and not access.write_geoip_conf():
+            errors['blocked_countries'] = ['The GeoIP2 module of NGINX or the MaxMind country database is not installed. Please ask an admin to install it.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        website.allowed_ips = ','.join(allowed) or None
+        website.denied_ips = ','.join(denied) or None
+        website.blocked_countries = ','.join(countries) or None
+        website.save()
+        signals.domains_updated.send(sender=website, only_nginx=True)
+        return Response({
+            'message': 'The access rules have been updated.',
+            **self.access_status(website)
+        })
+
+
+class WafBlockedRequestsView(WebsiteMixin, APIView):
+    """List the latest requests of a website that matched the WAF rules, with the rules they matched."""
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        try:
+            limit = min(max(int(request.GET.get('limit', 100)), 1), 1000)
+        except ValueError:
+            limit = 100
+        return Response({
+            'mode': website.waf_mode,
+            'results': waf.blocked_requests(website, limit)
+        })
+
+
+class SeoView(WebsiteMixin, APIView):
+    """Check the SEO basics of a website daily.
+
+    The checks catch the common mistakes that hide a website from the search engines, like a noindex left
+    from development, a robots.txt that disallows everything, a missing or broken sitemap and a website
+    that isn't redirected to HTTPS. Posting the check action runs the checks right away.
+    """
+    http_method_names = ['get', 'post']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response({
+            'seo_checks': website.seo_checks,
+            'report': seo.last_report(website) if website.seo_checks else None
+        })
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if request.POST.get('action') == 'check':
+            if not website.seo_checks:
+                return Response({
+                    'message': 'The SEO checks of this website are off.'
+                }, status=status.HTTP_400_BAD_REQUEST)
+            return Response({'report': seo.check_website(website)})
+
+        website.seo_checks = request.POST.get('enabled') in ['1', 'true']
+        website.save()
+        if website.seo_checks:
+            report = seo.check_website(website)
+        else:
+            report = None
+            seo.delete_report(website)
+        return Response({
+            'message': f'The SEO checks have been {"enabled" if website.seo_checks else "disabled"}.',
+            'seo_checks': website.seo_checks,
+            'report': report
+        })
+
+
+def warmup_options(data: object) -> tuple:
+    """Returns the concurrency and the rate of a cache warmup along with the errors, if any."""
+    errors, options = {}, []
+    for field, default, maximum in [('concurrency', 2, 10), ('rate', 5, 50)]:
+        try:
+            value = int(data.get(field, default))
+            if value < 1 or value > maximum:
+                raise ValueError
+            options.append(value)
+        except (TypeError, ValueError):
+            errors[field] = [f'Should be a number from 1 to {maximum}.']
+    return options, errors
+
+
+class WarmCacheView(WebsiteMixin, APIView):
+    """Warm the caches of a website.
+
+    Requests the pages in the sitemap of the website as a background job, so the page caches are filled
+    before the visitors hit them. The concurrency is the requests that run at once and the rate is the
+    most requests per second.
+    """
+    http_method_names = ['post']
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        options, errors = warmup_options(request.POST)
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        job = warmup.start_warmup(request.user, website, *options)
+        return Response({
+            'message': 'Warming the caches.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class QuarantineView(WebsiteMixin, APIView):
+    """List the quarantined uploads of a website."""
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        return Response({
+            'scan_uploads': website.scan_uploads,
+            'files': [{
+                'id': f.id,
+                'path': f.path,
+                'signature': f.signature,
+                'size': f.size,
+                'restored': f.restored,
+                'created': f.created
+            } for f in website.quarantined_files.order_by('-created')]
+        })
+
+
+class QuarantinedFileView(WebsiteMixin, APIView):
+    """Restore a quarantined upload that was a false positive, or delete it for good."""
+    http_method_names = ['post', 'delete']
+
+    def get_item(self, request, kwargs):
+        website = self.get_website(request, kwargs.get('id'))
+        if not website:
+            return None
+        return QuarantinedFile.objects.filter(website=website, id=kwargs.get('file_id')).first()
+
+    def post(self, request, *args, **kwargs):
+        item = self.get_item(request, kwargs)
+        if not item:
+            return Response({
+                'message': 'The quarantined file was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if not malware.restore_quarantined(item):
+            return Response({
+                'message': 'The file cannot be restored, it was already restored or a file exists in its place.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+        return Response({'message': 'The file has been restored and will not be quarantined again.'})
+
+    def delete(self, request, *args, **kwargs):
+        item = self.get_item(request, kwargs)
+        if not item:
+            return Response({
+                'message': 'The quarantined file was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        malware.delete_quarantined(item)
+        return Response({'message': 'The quarantined file has been deleted.'})
+
+
+class StagingView(WebsiteMixin, APIView):
+    """Clone a website to staging.
+
+    The files and the database of the website are copied to a staging website on a staging subdomain,
+    as a background job. Deleting the staging removes the staging website and its database.
+    """
+    http_method_names = ['get', 'post', 'delete']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        staging_obj = Staging.objects.filter(production=website).select_related('website', 'staging_database').first()
+        if not staging_obj:
+            return Response({'staging': None})
+        return Response({
+            'staging': {
+                'id': staging_obj.id,
+                'website_id': staging_obj.website.id,
+                'domain': staging.primary_domain(staging_obj.website),
+                'database': staging_obj.staging_database.name if staging_obj.staging_database else None,
+                'pushed': staging_obj.pushed,
+                'created': staging_obj.created
+            }
+        })
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if Staging.objects.filter(Q(production=website) | Q(website=website)).exists():
+            return Response({
+                'message': 'This website already has a staging copy or is a staging copy itself.'
+            }, status=status.HTTP_400_BAD_REQUEST)
+
+        errors = {}
+        domain = staging.staging_domain(website)
+        if not validators.domain(domain) or Domain.objects.filter(domain=domain).exists():
+            errors['domain'] = [f'The staging domain {domain} is not valid or is already taken.']
+
+        database_id = request.POST.get('database_id')
+        database = Database.objects.filter(id=database_id, user=website.user, engine='mysql').first() if database_id else None
+        if database_id and not database:
+            errors['database_id'] = [f'Database with ID {database_id} is not a MySQL database of the website owner.']
+
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        quota_error = staging.quota_error(website, database or (wp_database(website) if website.is_wp else None))
+        if quota_error:
+            return Response({
+                'message': quota_error
+            }, status=status.HTTP_400_BAD_REQUEST)
+
+        job = jobs.start_job(request.user, 'create_staging', website.label, {'website_id': website.id, 'database_id': database_id}, staging.create_staging)
+        return Response({
+            'message': f'Cloning the website to {domain}.',
+            'job': jobs.serialize_job(job)
+        })
+
+    def delete(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        staging_obj = Staging.objects.filter(production=website).select_related('website', 'staging_database').first() if website else None
+        if not staging_obj:
+            return Response({
+                'message': f'Target website with ID {website_id} has no staging copy.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        if staging_obj.staging_database:
+            staging_obj.staging_database.delete()
+        staging_obj.website.delete()
+        return Response({'message': 'The staging copy has been deleted.'})
+
+
+class StagingPushView(WebsiteMixin, APIView):
+    """Push staging to production.
+
+    Copies the files and the database of the staging copy back to the production website as a background
+    job. Production is snapshotted first and its wp-config.php is kept. With warm_cache, the caches of
+    production are warmed from its sitemap once the push is done.
+    """
+    http_method_names = ['post']
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        staging_obj = Staging.objects.filter(production=website).first() if website else None
+        if not staging_obj:
+            return Response({
+                'message': f'Target website with ID {website_id} has no staging copy.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        params = {
+            'staging_id': staging_obj.id,
+            'files': request.POST.get('files', 'true') in ['1', 'true'],
+            'database': request.POST.get('database', 'true') in ['1', 'true'],
+            'warm_cache': request.POST.get('warm_cache') in ['1', 'true']
+        }
+        if params.get('warm_cache'):
+            options, errors = warmup_options(request.POST)
+            if errors:
+                return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+            params['concurrency'], params['rate'] = options
+        if not params.get('files') and not params.get('database'):
+            return Response({
+                'errors': {'files': ['Push the files, the database or both.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        job = jobs.start_job(request.user, 'push_staging', website.label, params, staging.push_staging)
+        return Response({
+            'message': 'Pushing the staging copy to production.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class RestorePlanView(APIView):
+    """Plan a selective restore from a snapshot.
+
+    Lists the files a restore of the selected websites, or of some paths of them, would overwrite, create
+    and delete, without changing anything. Databases are not part of the website snapshots.
+    """
+    http_method_names = ['post']
+    dry_run = True
+
+    def post(self, request, *args, **kwargs):
+        user = request.user
+        websites = Website.objects.all() if user.is_superuser else Website.objects.filter(user=user)
+        website_ids = [i for i in request.POST.getlist('website_ids') if i.isdigit()]
+        websites = websites.filter(id__in=website_ids)
+        label = request.POST.get('snapshot', '')
+        paths = restore.clean_paths(request.POST.getlist('paths'))
+        alternate = request.POST.get('alternate', '').strip().strip('/') or None
+
+        errors = {}
+        if not websites.exists() or websites.count() != len(set(website_ids)):
+            errors['website_ids'] = ['Select the websites to restore.']
+        if paths is None:
+            errors['paths'] = ['The paths should be relative to the website directory.']
+        if alternate and restore.clean_paths([alternate]) in [None, ['']]:
+            errors['alternate'] = ['The alternate directory should be relative to the website directory.']
+        if not volumes.get_driver().supports_snapshots:
+            errors['snapshot'] = ['The storage of this server does not support snapshots.']
+        else:
+            base_paths = [get_website_paths(w).get('base_path') for w in websites]
+            if not label or any(label not in volumes.get_driver().list_snapshots(p) for p in base_paths):
+                errors['snapshot'] = [f'Snapshot {label} was not found for all selected websites.']
+        if errors:
+            return Response({'errors': errors}, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        params = {
+            'website_ids': [w.id for w in websites],
+            'snapshot': label,
+            'paths': paths,
+            'alternate': restore.clean_paths([alternate])[0] if alternate else None,
+            'dry_run': self.dry_run
+        }
+        job = jobs.start_job(request.user, 'restore', label, params, restore.restore_job)
+        return Response({
+            'message': 'Planning the restore.' if self.dry_run else 'Restoring the files.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class RestoreExecuteView(RestorePlanView):
+    """Execute a selective restore from a snapshot.
+
+    Restores the selected websites, or some paths of them, in place or into an alternate directory inside
+    each website. In place restores take a snapshot of the current state first.
+    """
+    dry_run = False
+
+
+class ExportWebsiteView(WebsiteMixin, APIView):
+    """Export the files of a website, or of one of its snapshots, to a tar.gz.
+
+    The archive is created by a background job that reports the progress, and it is downloaded from the
+    export download endpoint once the job is done.
+    """
+    http_method_names = ['post']
+
+    def post(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        if not website:
+            return Response({
+                'message': f'Target website with ID {website_id} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+
+        label = request.POST.get('snapshot') or None
+        if label and label not in volumes.get_driver().list_snapshots(get_website_paths(website).get('base_path')):
+            return Response({
+                'errors': {'snapshot': [f'Snapshot {label} was not found.']}
+            }, status=status.HTTP_422_UNPROCESSABLE_ENTITY)
+
+        job = jobs.start_job(request.user, 'export_website', website.slug, {'website_id': website.id, 'snapshot': label}, exports.export_website)
+        return Response({
+            'message': f'Exporting {website.label}.',
+            'job': jobs.serialize_job(job)
+        })
+
+
+class DownloadExportView(WebsiteMixin, APIView):
+    """Download the archive of a finished website export.
+
+    Range requests are supported, so interrupted downloads can be resumed.
+    """
+    http_method_names = ['get']
+
+    def get(self, request, *args, **kwargs):
+        website_id = kwargs.get('id')
+        website = self.get_website(request, website_id)
+        job = Job.objects.filter(id=kwargs.get('job_id'), kind='export_website', target=website.slug, state='done').first() if website else None
+        if not job or not os.path.exists(exports.artifact_path(job)):
+            return Response({
+                'message': f'Finished export with ID {kwargs.get("job_id")} was not found.'
+            }, status=status.HTTP_404_NOT_FOUND)
+        return exports.ranged_file_response(request, exports.artifact_path(job), os.path.basename(exports.artifact_path(job)))
+
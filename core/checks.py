@@ -0,0 +1,170 @@
+import os, re, glob, ipaddress
+from difflib import get_close_matches
+from urllib.parse import urlparse
+from django.conf import settings
+from django.core.checks import Error, Warning, register
+
+
+CSP_MODES = ['report-only', 'enforce', 'off']
+
+# Settings that should be positive numbers and the ones that are percentages
+POSITIVE_SETTINGS = [
+    'FASTCP_COMPAT_MAX_FILES', 'FASTCP_DEV_MODE_MAX_HOURS', 'FASTCP_DEBUG_LOG_LINES', 'FASTCP_CHECK_RETENTION_DAYS',
+    'FASTCP_CHECK_TIMEOUT', 'FASTCP_CHECK_WORKERS',
+    'FASTCP_ROLLBACK_CHECKS', 'FASTCP_WATCHDOG_CPU_RUNS', 'FASTCP_WATCHDOG_MAX_CONNECTIONS',
+    'FASTCP_WATCHDOG_MAX_PROCESSES', 'FASTCP_SESSION_IDLE_MINS', 'FASTCP_SESSION_MAX_HOURS',
+    'FASTCP_SESSION_REMEMBER_DAYS', 'FASTCP_BATCH_MAX_REQUESTS', 'FASTCP_SFTP_PORT',
+    'FASTCP_OWNERSHIP_WORKERS', 'FASTCP_OOM_ALERT_KILLS', 'FASTCP_OOM_RETENTION_DAYS',
+    'FASTCP_LOG_FOLLOW_SECONDS', 'FASTCP_TELEMETRY_MAX_CRASHES',
+    'FASTCP_DB_IMPORT_MAX_MB', 'FASTCP_EXPORT_RETENTION_HOURS',
+    'FASTCP_JOB_RETENTION_DAYS', 'FASTCP_OPERATION_RETENTION_DAYS', 'FASTCP_NOTIFICATION_RETENTION_DAYS',
+    'FASTCP_SYSLOG_PORT', 'FASTCP_USAGE_RAW_RETENTION_DAYS', 'FASTCP_USAGE_RETENTION_DAYS',
+    'FASTCP_JOB_CONCURRENCY', 'FASTCP_JOB_QUEUE_LIMIT', 'FASTCP_MAX_USER_TASKS', 'FASTCP_TASK_MIN_INTERVAL',
+    'FASTCP_WARMUP_MAX_URLS',
+    'FASTCP_DB_BACKUPS_KEEP', 'FASTCP_APP_PORTS_START', 'FASTCP_APP_PORTS_PER_USER',
+]
+PERCENT_SETTINGS = ['FASTCP_DISK_ALERT_PERCENT', 'FASTCP_WATCHDOG_CPU_PERCENT']
+
+# Environment variables that are read under a different setting name or by the Gunicorn config
+ENV_ALIASES = ['FASTCP_APP_SECRET', 'FASTCP_BIND', 'FASTCP_UNIX_SOCKET_GROUP', 'FASTCP_WORKERS']
+
+
+# The NGINX configs the panel server block may be in
+PANEL_VHOST_GLOBS = ['sites-enabled/*', 'conf.d/*.conf', 'vhosts.d/*.conf']
+LISTEN_SSL_RE = re.compile(r'^\s*listen\s+[^;]*\bssl\b[^;]*;', re.M)
+HTTP2_ON_RE = re.compile(r'^\s*http2\s+on\s*;', re.M)
+
+
+def valid_port(port) -> bool:
+    """Returns True if the value is a valid TCP port."""
+    return isinstance(port, int) and 0 < port < 65536
+
+
+@register()
+def fastcp_config_check(app_configs, **kwargs):
+    """FastCP config check.
+
+    Validates the FastCP settings that come from the environment, so a typo or an invalid value is reported
+    with the variable to fix when the panel starts instead of failing later at runtime.
+    """
+    errors = []
+    for name, message in getattr(settings, 'FASTCP_CONFIG_ERRORS', []):
+        errors.append(Error(f'{name}: {message}', hint=f'Set {name} to a number.', id='fastcp.E001'))
+
+    # Unknown variables are mostly typos that silently leave the defaults in effect
+    known = [name for name in dir(settings) if name.startswith('FASTCP_')] + ENV_ALIASES
+    for name in sorted(os.environ):
+        if name.startswith('FASTCP_') and name not in known:
+            matches = get_close_matches(name, known, n=1)
+            errors.append(Warning(
+                f'{name}: unknown setting, it has no effect.',
+                hint=f'Did you mean {matches[0]}?' if matches else 'Remove it from the environment.',
+                id='fastcp.W001'
+            ))
+
+    secret = settings.SECRET_KEY or ''
+    if not settings.DEBUG and (secret.startswith('django-insecure') or len(secret) < 50 or len(set(secret)) < 5):
+        errors.append(Error(
+            'FASTCP_APP_SECRET: the secret key is missing or weak, sessions and tokens can be forged.',
+            hint='Run manage.py rotate-secret or set FASTCP_APP_SECRET to a random string of at least 50 characters.',
+            id='fastcp.E002'
+        ))
+
+    if not valid_port(settings.EMAIL_PORT):
+        errors.append(Error(f'EMAIL_PORT: {settings.EMAIL_PORT} is not a valid port.', hint='Use a port between 1 and 65535.', id='fastcp.E003'))
+
+    upstream = urlparse(settings.FASTCP_PANEL_UPSTREAM)
+    try:
+        upstream_port = upstream.port
+    except ValueError:
+        upstream_port = 0
+    if upstream.scheme not in ['http', 'https'] or not upstream.hostname or (upstream_port is not None and not valid_port(upstream_port)):
+        errors.append(Error(
+            f'FASTCP_PANEL_UPSTREAM: {settings.FASTCP_PANEL_UPSTREAM} is not a valid URL.',
+            hint='Use the URL the panel listens on, i.e. http://127.0.0.1:8000.',
+            id='fastcp.E003'
+        ))
+
+    for ip in settings.FASTCP_TRUSTED_PROXIES:
+        try:
+            ipaddress.ip_address(ip)
+        except ValueError:
+            errors.append(Error(f'FASTCP_TRUSTED_PROXIES: {ip} is not an IP address.', hint='List the IPs of the reverse proxies separated by commas.', id='fastcp.E003'))
+
+    if settings.FASTCP_CREDENTIALS_WEBHOOK and urlparse(settings.FASTCP_CREDENTIALS_WEBHOOK).scheme != 'https':
+        errors.append(Error(
+            'FASTCP_CREDENTIALS_WEBHOOK: the webhook should use HTTPS, the credentials are not posted otherwise.',
+            hint='Use an https:// URL.',
+            id='fastcp.E003'
+        ))
+
+    if settings.FASTCP_CSP_MODE not in CSP_MODES:
+        errors.append(Error(f'FASTCP_CSP_MODE: {settings.FASTCP_CSP_MODE} is not a valid mode.', hint=f'Use one of {", ".join(CSP_MODES)}.', id='fastcp.E004'))
+
+    from core.models import BACKEND_CHOICES
+    # The proxy backend needs an app per website, so it cannot be the server default
+    backends = [name for name, label in BACKEND_CHOICES if name != 'proxy']
+    if settings.FASTCP_WEBSERVER_BACKEND not in backends:
+        errors.append(Error(f'FASTCP_WEBSERVER_BACKEND: {settings.FASTCP_WEBSERVER_BACKEND} is not a valid backend.', hint=f'Use one of {", ".join(backends)}.', id='fastcp.E004'))
+
+    from core.utils.volumes import DRIVERS
+    drivers = ['auto'] + list(DRIVERS)
+    if settings.FASTCP_STORAGE_DRIVER not in drivers:
+        errors.append(Error(f'FASTCP_STORAGE_DRIVER: {settings.FASTCP_STORAGE_DRIVER} is not a valid driver.', hint=f'Use one of {", ".join(drivers)}.', id='fastcp.E004'))
+    elif settings.FASTCP_STORAGE_DRIVER == 'zfs' and not settings.FASTCP_ZFS_DATASET:
+        errors.append(Error('FASTCP_ZFS_DATASET: the ZFS storage driver needs a dataset.', hint='Set FASTCP_ZFS_DATASET, i.e. tank/fastcp.', id='fastcp.E004'))
+
+    for name in POSITIVE_SETTINGS:
+        if getattr(settings, name) <= 0:
+            errors.append(Error(f'{name}: {getattr(settings, name)} should be greater than 0.', id='fastcp.E005'))
+    for name in PERCENT_SETTINGS:
+        if not 0 < getattr(settings, name) <= 100:
+            errors.append(Error(f'{name}: {getattr(settings, name)} should be a percentage between 0 and 100.', id='fastcp.E005'))
+
+    if settings.FASTCP_UI_DIR and not os.path.isdir(settings.FASTCP_UI_DIR):
+        errors.append(Warning(
+            f'FASTCP_UI_DIR: {settings.FASTCP_UI_DIR} is not a directory, the bundled UI files are served.',
+            hint='Point FASTCP_UI_DIR to the directory of the white-label UI files or unset it.',
+            id='fastcp.W004'
+        ))
+
+    if settings.FASTCP_SESSION_IDLE_MINS > settings.FASTCP_SESSION_MAX_HOURS * 60:
+        errors.append(Warning(
+            'FASTCP_SESSION_IDLE_MINS: the idle timeout is longer than the session lifetime, it has no effect.',
+            hint='Lower FASTCP_SESSION_IDLE_MINS or raise FASTCP_SESSION_MAX_HOURS.',
+            id='fastcp.W002'
+        ))
+    return errors
+
+
+def panel_listens_without_http2() -> list:
+    """Returns the NGINX configs that serve the panel certificate on a listen directive without HTTP/2."""
+    configs = []
+    for pattern in PANEL_VHOST_GLOBS:
+        for path in glob.glob(os.path.join(settings.NGINX_BASE_DIR, pattern)):
+            try:
+                with open(path) as f:
+                    content = f.read()
+            except OSError:
+                continue
+            # NGINX 1.25 turns HTTP/2 on with its own directive instead of the listen parameter
+            if settings.FASTCP_PANEL_CERT_PATH not in content or HTTP2_ON_RE.search(content):
+                continue
+            if any('http2' not in listen for listen in LISTEN_SSL_RE.findall(content)):
+                configs.append(path)
+    return configs
+
+
+@register()
+def fastcp_panel_server_check(app_configs, **kwargs):
+    """FastCP panel server check.
+
+    Warns if NGINX serves the panel over TLS without HTTP/2. The panel makes many small API requests at
+    once, which HTTP/1.1 queues over a handful of connections. HTTP/2 works the same with the self-signed
+    and the Let's Encrypt certificates.
+    """
+    return [Warning(
+        f'{path}: the panel is served without HTTP/2.',
+        hint='Add http2 to the ssl listen directives of the panel server block, i.e. listen 8899 ssl http2;, and reload NGINX.',
+        id='fastcp.W003'
+    ) for path in panel_listens_without_http2()]
@@ -18,5 +18,6 @@ def general_settings(request):
         'FASTCP_SITE_URL': settings.FASTCP_SITE_URL,
         'FASTCP_FM_ROOT': settings.FILE_MANAGER_ROOT,
         'FASTCP_VERSION': settings.FASTCP_VERSION,
+        'FASTCP_BASE_PATH': settings.FASTCP_BASE_PATH,
         'PMA_URL': f'https://{settings.SERVER_IP_ADDR}/phpmyadmin'
     }
\ No newline at end of file
@@ -1,5 +1,11 @@
 from django_cron import CronJobBase, Schedule
 from django.core.management import call_command
+from django.conf import settings
+from datetime import timedelta
+import psutil
+from core.utils.notifications import notify_admins
+from core.utils.reboot import start_verification
+from core.utils import jobs, watchdog, monitoring, devmode, journal, oom, telemetry, exports, retention, metrics, logforward, usage, integrity, traffic, logfiles, dependencies, seo
 
 
 class ProcessSsls(CronJobBase):
@@ -21,4 +27,262 @@ class ProcessSsls(CronJobBase):
         In this method, we write the CRON job task or the logic.
         """
         call_command('activate-ssl')
-    
\ No newline at end of file
+
+
+class MonitorDisk(CronJobBase):
+    """Monitor disk.
+    
+    This CRON class alerts the admins when the root filesystem is about to fill, so they can clean up or
+    expand the disk before the websites and databases start failing.
+    """
+    schedule = Schedule(run_every_mins=30)
+    code = 'fastcp.monitor_disk'
+    
+    def do(self):
+        usage = psutil.disk_usage('/')
+        if usage.percent >= settings.FASTCP_DISK_ALERT_PERCENT:
+            notify_admins(
+                f'Disk usage is at {usage.percent}%',
+                details='The root filesystem is about to fill. Free up some space or expand the disk from the hardware info page.',
+                once_every=timedelta(hours=24),
+                event='disk'
+            )
+
+
+class VerifyReboot(CronJobBase):
+    """Verify reboot.
+    
+    After a supervised reboot, this CRON class verifies that all services and websites that were healthy
+    before the reboot came back and notifies the admins about the ones that didn't.
+    """
+    schedule = Schedule(run_every_mins=5)
+    code = 'fastcp.verify_reboot'
+    
+    def do(self):
+        # The checks of all websites take a while, the job runs them in the background
+        try:
+            start_verification()
+        except jobs.QueueFull:
+            pass
+
+
+class ProcessWatchdog(CronJobBase):
+    """Process watchdog.
+    
+    This CRON class looks for abusive processes of hosted users, like cryptominers and forkbombs, kills them
+    if auto-kill is enabled and notifies the admins with the evidence.
+    """
+    schedule = Schedule(run_every_mins=5)
+    code = 'fastcp.process_watchdog'
+    
+    def do(self):
+        for proc in watchdog.scan_processes():
+            if proc.get('pid') is None:
+                killed = settings.FASTCP_WATCHDOG_KILL and watchdog.kill_user_processes(proc.get('user'))
+            else:
+                killed = settings.FASTCP_WATCHDOG_KILL and watchdog.kill_process(proc.get('pid'))
+            action = 'killed' if killed else 'flagged'
+            details = [
+                f'User: {proc.get("user")}',
+                f'PID: {proc.get("pid")}',
+                f'Executable: {proc.get("exe")}',
+                f'Command: {proc.get("cmdline")}',
+                f'CPU: {proc.get("cpu_percent")}%',
+                f'Outbound connections: {proc.get("connections")}',
+                *proc.get('reasons')
+            ]
+            notify_admins(f'Suspicious process {proc.get("name")} of {proc.get("user")} {action}', details='\n'.join(details), event='watchdog')
+
+
+class SyntheticChecks(CronJobBase):
+    """Synthetic checks.
+    
+    This CRON class requests the homepage and the user defined URLs of each website, records the response
+    times and alerts the owners of slow or failing websites.
+    """
+    schedule = Schedule(run_every_mins=5)
+    code = 'fastcp.synthetic_checks'
+    
+    def do(self):
+        monitoring.run_checks()
+        monitoring.purge_results()
+
+
+class ExpireDevMode(CronJobBase):
+    """Expire developer mode.
+    
+    This CRON class turns off the developer mode of the websites once their time window ends, so Xdebug
+    doesn't keep slowing down the websites after the debugging session.
+    """
+    schedule = Schedule(run_every_mins=5)
+    code = 'fastcp.expire_dev_mode'
+    
+    def do(self):
+        devmode.expire_dev_modes()
+
+
+class RecoverOperations(CronJobBase):
+    """Recover operations.
+    
+    This CRON class resumes the journaled operations, like website and user deletions, that were left half
+    applied because the panel died, and notifies the admins about them.
+    """
+    schedule = Schedule(run_every_mins=5)
+    code = 'fastcp.recover_operations'
+    
+    def do(self):
+        for operation in journal.recover_operations():
+            if operation.state == 'recovered':
+                notify_admins(f'Interrupted operation {operation} was recovered', event='operations')
+            else:
+                notify_admins(f'Interrupted operation {operation} cannot be recovered', details=operation.error, event='operations')
+
+
+class RecordOomKills(CronJobBase):
+    """Record OOM kills.
+    
+    This CRON class records the processes of users killed by the OOM killer, which otherwise look like
+    random 502 errors, and lets the users know when their plan is undersized.
+    """
+    schedule = Schedule(run_every_mins=5)
+    code = 'fastcp.record_oom_kills'
+    
+    def do(self):
+        oom.scan_oom_kills()
+        oom.notify_undersized()
+        oom.purge_oom_events()
+
+
+class UploadTelemetry(CronJobBase):
+    """Upload telemetry.
+    
+    This CRON class sends the anonymous usage stats and the crash reports to the maintainers once a day,
+    only if the admin opted in.
+    """
+    schedule = Schedule(run_every_mins=1440)
+    code = 'fastcp.upload_telemetry'
+    
+    def do(self):
+        telemetry.upload()
+
+
+class PurgeExports(CronJobBase):
+    """Purge exports.
+    
+    This CRON class deletes the website exports that have been kept for download longer than the retention
+    period, so they don't fill the disk.
+    """
+    schedule = Schedule(run_every_mins=60)
+    code = 'fastcp.purge_exports'
+    
+    def do(self):
+        exports.purge_exports()
+
+
+class PurgeExpiredData(CronJobBase):
+    """Purge expired data.
+    
+    This CRON class deletes the finished jobs, completed operations, notifications, check results and
+    other rows older than their retention period, so the panel database stays small on long-lived servers.
+    """
+    schedule = Schedule(run_every_mins=1440)
+    code = 'fastcp.purge_expired_data'
+    
+    def do(self):
+        retention.purge_expired()
+
+
+class ExportMetrics(CronJobBase):
+    """Export metrics.
+    
+    This CRON class pushes the per-user and per-website metrics to InfluxDB or a Prometheus remote-write
+    endpoint every minute, only if an exporter is configured.
+    """
+    schedule = Schedule(run_every_mins=1)
+    code = 'fastcp.export_metrics'
+    
+    def do(self):
+        metrics.export_metrics()
+
+
+class SyncLogForwarding(CronJobBase):
+    """Sync log forwarding.
+    
+    This CRON class keeps the rsyslog config that forwards the access logs of the websites in line with
+    the remote syslog settings, so turning the forwarding on or off only takes a settings change. It also
+    writes the logrotate config of the logs again, as it has a block per user.
+    """
+    schedule = Schedule(run_every_mins=60)
+    code = 'fastcp.sync_log_forwarding'
+    
+    def do(self):
+        logforward.sync_site_forwarding()
+        logfiles.write_logrotate()
+
+
+class RecordUsage(CronJobBase):
+    """Record usage.
+    
+    This CRON class samples the CPU, memory and traffic of each user and website every 5 minutes for the
+    usage graphs, and enforces the monthly traffic quotas of the websites.
+    """
+    schedule = Schedule(run_every_mins=5)
+    code = 'fastcp.record_usage'
+    
+    def do(self):
+        usage.record_usage()
+        traffic.enforce_quotas()
+
+
+class RollupUsage(CronJobBase):
+    """Rollup usage.
+    
+    This CRON class measures the disk usage of the users and websites and rolls the 5 minute usage
+    samples of the past hours up into hourly ones, which the 30 days graphs read.
+    """
+    schedule = Schedule(run_every_mins=60)
+    code = 'fastcp.rollup_usage'
+    
+    def do(self):
+        usage.measure_disk()
+        usage.rollup_usage()
+
+
+class CheckIntegrity(CronJobBase):
+    """Check integrity.
+    
+    This CRON class compares the PHP files of the websites that monitor their integrity with their
+    baselines every hour, and alerts about the files added, modified or removed outside of the managed
+    changes, an early sign of a compromised website.
+    """
+    schedule = Schedule(run_every_mins=60)
+    code = 'fastcp.check_integrity'
+    
+    def do(self):
+        integrity.check_websites()
+
+
+class AuditDependencies(CronJobBase):
+    """Audit dependencies.
+    
+    This CRON class audits the Composer and NPM lockfiles of the websites once a day and alerts about
+    the dependencies with critical or high severity vulnerabilities. The audits run as the website owners.
+    """
+    schedule = Schedule(run_every_mins=1440)
+    code = 'fastcp.audit_dependencies'
+    
+    def do(self):
+        dependencies.audit_websites()
+
+
+class CheckSeo(CronJobBase):
+    """Check SEO.
+    
+    This CRON class checks the sitemap, robots.txt, noindex tags and HTTPS redirect of the websites with
+    the SEO checks on once a day. The warnings are shown with the website health.
+    """
+    schedule = Schedule(run_every_mins=1440)
+    code = 'fastcp.check_seo'
+    
+    def do(self):
+        seo.check_websites()
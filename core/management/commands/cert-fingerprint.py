@@ -0,0 +1,46 @@
+import ssl
+from django.conf import settings
+from django.core.management.base import BaseCommand
+from cryptography import x509
+from cryptography.hazmat.backends import default_backend
+from cryptography.hazmat.primitives import hashes
+
+
+def fingerprint(pem_data: str, algorithm: object) -> str:
+    """Returns the fingerprint of a PEM certificate as colon separated hex."""
+    cert = x509.load_pem_x509_certificate(pem_data.encode(), default_backend())
+    return cert.fingerprint(algorithm).hex(':').upper()
+
+
+class Command(BaseCommand):
+    help = 'Show the fingerprint of the panel SSL certificate so it can be verified on first login.'
+
+    def add_arguments(self, parser):
+        parser.add_argument('--cert', default=settings.FASTCP_PANEL_CERT_PATH, help='Path of the panel certificate.')
+        parser.add_argument('--host', help='Compare with the certificate presented at host:port, i.e. 127.0.0.1:8899.')
+
+    def handle(self, *args, **options):
+        try:
+            with open(options.get('cert')) as f:
+                pem_data = f.read()
+        except OSError as e:
+            self.stdout.write(self.style.ERROR(f'Cannot read the certificate: {e}'))
+            return
+
+        sha256 = fingerprint(pem_data, hashes.SHA256())
+        self.stdout.write(f'SHA-256: {sha256}')
+        self.stdout.write(f'SHA-1:   {fingerprint(pem_data, hashes.SHA1())}')
+
+        host = options.get('host')
+        if host:
+            hostname, _, port = host.partition(':')
+            try:
+                presented = ssl.get_server_certificate((hostname, int(port or 443)))
+            except (OSError, ValueError) as e:
+                self.stdout.write(self.style.ERROR(f'Cannot fetch the certificate from {host}: {e}'))
+                return
+
+            if fingerprint(presented, hashes.SHA256()) == sha256:
+                self.stdout.write(self.style.SUCCESS(f'{host} presents this certificate.'))
+            else:
+                self.stdout.write(self.style.ERROR(f'{host} presents a different certificate!'))
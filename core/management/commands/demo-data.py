@@ -0,0 +1,127 @@
+import json, random
+from datetime import timedelta
+from django.conf import settings
+from django.core.management.base import BaseCommand, CommandError
+from django.utils import timezone
+from core.models import User, Website, Domain, Database, Job, PHP_CHOICES
+
+
+# The SSH users along with their websites and databases
+DEMO_USERS = {
+    'alice': [('acme-shop', 'acme-shop.test', True), ('acme-blog', 'blog.acme-shop.test', True)],
+    'bob': [('bobs-portfolio', 'bobs-portfolio.test', False)],
+    'carol': [('carol-agency', 'carol-agency.test', True), ('client-one', 'client-one.test', False)],
+}
+
+# The words and TLDs the fake users, websites and databases of the load test data are named with
+WORDS = ['acme', 'alpha', 'blue', 'cloud', 'delta', 'echo', 'forest', 'green', 'harbor', 'iron', 'jade', 'kite',
+         'lunar', 'maple', 'nova', 'orbit', 'pixel', 'quartz', 'river', 'solar', 'tiger', 'urban', 'violet', 'wave']
+TLDS = ['test', 'example', 'localhost']
+
+
+class Command(BaseCommand):
+    help = 'Fill the panel database with demo users, websites, domains and databases for the demo mode. With --users, it also adds that many fake users with their websites, domains, databases and backup and export job records to load test the API and the UI. Nothing is created on the server, so this only runs in debug or demo mode unless forced.'
+
+    def add_arguments(self, parser):
+        parser.add_argument('--admin', default='admin', help='Username of the demo admin.')
+        parser.add_argument('--users', type=int, default=0, help='Number of fake SSH users to add for load testing.')
+        parser.add_argument('--sites', type=int, default=3, help='Number of websites per fake user.')
+        parser.add_argument('--databases', type=int, default=2, help='Number of databases per fake user.')
+        parser.add_argument('--jobs', type=int, default=5, help='Number of backup and export records per fake user.')
+        parser.add_argument('--force', action='store_true', help='Fill a panel that is neither in debug nor in demo mode.')
+
+    def name(self, taken: set, separator: str = '-') -> str:
+        """Returns a random name that isn't taken yet and marks it as taken."""
+        while True:
+            name = separator.join(random.sample(WORDS, 2)) + f'{separator}{random.randint(1, 9999)}'
+            if name not in taken:
+                taken.add(name)
+                return name
+
+    def demo_users(self, php: str) -> int:
+        """Creates the fixed demo users and websites, returns the number of created websites."""
+        created = 0
+        for username, sites in DEMO_USERS.items():
+            user, _ = User.objects.get_or_create(username=username, defaults={'is_active': True, 'max_storage': 10 * 1024 ** 3})
+            for label, domain, is_wp in sites:
+                if Website.objects.filter(label=label).exists():
+                    continue
+                # Created in bulk so the signals that set the website up on the server are not sent
+                Website.objects.bulk_create([Website(user=user, label=label, slug=label, php=php, is_wp=is_wp)])
+                website = Website.objects.get(label=label)
+                Domain.objects.bulk_create([Domain(website=website, domain=domain), Domain(website=website, domain=f'www.{domain}')])
+                if is_wp:
+                    Database.objects.bulk_create([Database(user=user, name=f'{username}_{label}'.replace('-', '_'), username=label)])
+                created += 1
+        return created
+
+    def fake_users(self, options: dict) -> str:
+        """Creates the fake users of the load test data, returns what was created."""
+        php_versions = [version for version, label in PHP_CHOICES] or ['8.1']
+        labels = set(Website.objects.values_list('label', flat=True))
+        usernames = set(User.objects.values_list('username', flat=True))
+        db_names = set(Database.objects.values_list('name', flat=True))
+        now = timezone.now()
+
+        # Created in bulk so the signals that set the users, websites and databases up on the server are not sent
+        users = User.objects.bulk_create([
+            User(username=self.name(usernames, '_'), is_active=True, max_sites=max(10, options.get('sites')),
+                 max_dbs=max(10, options.get('databases')), max_storage=10 * 1024 ** 3, storage_used=random.randint(0, 5 * 1024 ** 3))
+            for i in range(options.get('users'))
+        ])
+        users = list(User.objects.filter(username__in=[u.username for u in users]))
+
+        websites = []
+        for user in users:
+            for i in range(options.get('sites')):
+                label = self.name(labels)
+                websites.append(Website(user=user, label=label, slug=label, php=random.choice(php_versions), is_wp=random.random() < 0.5))
+        Website.objects.bulk_create(websites)
+        websites = list(Website.objects.filter(label__in=[w.label for w in websites]))
+
+        domains = []
+        for website in websites:
+            domain = f'{website.label}.{random.choice(TLDS)}'
+            domains += [Domain(website=website, domain=domain, ssl=random.random() < 0.7), Domain(website=website, domain=f'www.{domain}')]
+        Domain.objects.bulk_create(domains)
+
+        databases = []
+        for user in users:
+            for i in range(options.get('databases')):
+                name = self.name(db_names, '_')
+                databases.append(Database(user=user, name=name, username=name, engine=random.choice(['mysql', 'mysql', 'postgresql'])))
+        Database.objects.bulk_create(databases)
+
+        jobs = []
+        for user in users:
+            user_sites = [w for w in websites if w.user_id == user.id]
+            for i in range(options.get('jobs')):
+                kind = random.choice(['export_website', 'server_backup']) if user_sites else 'server_backup'
+                website = random.choice(user_sites) if kind == 'export_website' else None
+                state = random.choice(['done', 'done', 'done', 'failed'])
+                created = now - timedelta(hours=random.randint(1, 24 * 30))
+                jobs.append(Job(
+                    user=user, kind=kind, target=website.label if website else 'server', state=state, progress=100, total=100,
+                    results=json.dumps({'size': random.randint(10, 2000) * 1024 ** 2}) if state == 'done' else None,
+                    error='The archive cannot be written.' if state == 'failed' else None, finished=created + timedelta(minutes=random.randint(1, 30))
+                ))
+        Job.objects.bulk_create(jobs)
+        # The created dates are set on insert, so they are spread over the last month afterwards
+        for job in Job.objects.filter(user__in=users):
+            Job.objects.filter(id=job.id).update(created=job.finished - timedelta(minutes=random.randint(1, 30)))
+
+        return f'{len(users)} users, {len(websites)} websites, {len(domains)} domains, {len(databases)} databases and {len(jobs)} jobs'
+
+    def handle(self, *args, **options):
+        if not (settings.DEBUG or settings.FASTCP_DEMO_MODE or options.get('force')):
+            raise CommandError('The demo users and websites do not exist on the server. Use --force to fill this panel anyway.')
+
+        admin, _ = User.objects.get_or_create(
+            username=options.get('admin'), defaults={'is_staff': True, 'is_superuser': True, 'is_active': True}
+        )
+        php = PHP_CHOICES[-1][0] if PHP_CHOICES else '8.1'
+        created = self.demo_users(php)
+        self.stdout.write(self.style.SUCCESS(f'Created {created} demo websites. Sign in as {admin.username} with the demo password.'))
+
+        if options.get('users') > 0:
+            self.stdout.write(self.style.SUCCESS(f'Created {self.fake_users(options)} for load testing.'))
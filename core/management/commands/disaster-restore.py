@@ -0,0 +1,69 @@
+import os, json, shutil, tarfile, tempfile
+from subprocess import run
+from django.conf import settings
+from django.core.management.base import BaseCommand, CommandError
+from core.utils.serverbackup import safe_members, restore_paths
+
+
+class Command(BaseCommand):
+    help = 'Rebuild the panel state and the system config of a server from a server backup. Stop FastCP before restoring.'
+    requires_system_checks = []
+
+    # Services reloaded once the config is in place
+    services = ['nginx', 'apache2', 'named', 'postfix', 'dovecot', 'opendkim', 'proftpd']
+
+    def add_arguments(self, parser):
+        parser.add_argument('archive', help='The server backup archive.')
+        parser.add_argument('--dry-run', action='store_true', help='Only list what would be restored.')
+
+    def handle(self, *args, **options):
+        if not os.path.isfile(options.get('archive')):
+            raise CommandError(f'{options.get("archive")} does not exist.')
+
+        with tarfile.open(options.get('archive'), 'r:gz') as tar:
+            try:
+                members, links = safe_members(tar)
+            except ValueError as e:
+                raise CommandError(str(e))
+            manifest = json.load(tar.extractfile('manifest.json'))
+            self.stdout.write(f'Backup of {manifest.get("hostname")} taken with FastCP {manifest.get("version")}.')
+            paths = restore_paths(manifest)
+            for path in manifest.get('paths') or []:
+                if path not in paths:
+                    self.stdout.write(self.style.WARNING(f'{path} is not a path FastCP backs up, skipping it.'))
+
+            db_path = str(settings.DATABASES.get('default').get('NAME'))
+            self.stdout.write(f'Panel database -> {db_path}')
+            for path in paths:
+                self.stdout.write(f'{path} -> {path}')
+            if options.get('dry_run'):
+                return
+
+            with tempfile.TemporaryDirectory() as tmp_dir:
+                tar.extractall(tmp_dir, members=members)
+                shutil.copy2(os.path.join(tmp_dir, 'panel', 'db.sqlite3'), db_path)
+                files_dir = os.path.join(tmp_dir, 'files')
+                for path in paths:
+                    source = os.path.join(files_dir, path.lstrip('/'))
+                    if os.path.isdir(source):
+                        shutil.copytree(source, path, symlinks=True, dirs_exist_ok=True)
+                    elif os.path.lexists(source):
+                        os.makedirs(os.path.dirname(path), exist_ok=True)
+                        shutil.copy2(source, path, follow_symlinks=False)
+
+                # The links pointing out of their directory were not extracted, they are created in place
+                for member in links:
+                    dest = '/' + os.path.relpath(os.path.normpath(member.name), 'files')
+                    if not any(dest.startswith(path + '/') for path in paths):
+                        continue
+                    os.makedirs(os.path.dirname(dest), exist_ok=True)
+                    if os.path.lexists(dest) and not os.path.isdir(dest):
+                        os.remove(dest)
+                    if not os.path.lexists(dest):
+                        os.symlink(member.linkname, dest)
+
+        run(['/usr/bin/systemctl', 'daemon-reload'])
+        php_services = [f'php{v}-fpm' for v in os.listdir(settings.PHP_INSTALL_PATH)] if os.path.isdir(settings.PHP_INSTALL_PATH) else []
+        for service in self.services + php_services:
+            run(['/usr/bin/systemctl', 'try-restart', service])
+        self.stdout.write(self.style.SUCCESS('The server has been restored. Restore the website data and the databases, then start FastCP.'))
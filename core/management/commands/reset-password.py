@@ -0,0 +1,57 @@
+import os, sys, getpass
+from django.core.management.base import BaseCommand, CommandError
+from core.models import User
+from core.utils import credentials
+from core.utils.system import rand_passwd, set_password
+
+
+class Command(BaseCommand):
+    help = 'Set a new password for a panel user, i.e. to recover the admin access. Only root can run this. The password is generated unless it is asked for or read from the standard input, and the generated one is delivered to the credentials email or webhook, or else saved to the root only credentials file.'
+
+    def add_arguments(self, parser):
+        parser.add_argument('username', help='The username of the panel user.')
+        parser.add_argument('--password-stdin', action='store_true', help='Read the new password from the standard input.')
+        parser.add_argument('--prompt', action='store_true', help='Ask for the new password twice without echoing it.')
+        parser.add_argument('--email', help='Email the generated password to this address.')
+        parser.add_argument('--webhook', help='Post the generated password to this HTTPS webhook URL.')
+        parser.add_argument('--first-run', action='store_true', help='Only set the password if the user never signed in, for the installer.')
+        parser.add_argument('--show', action='store_true', help='Print the generated password instead of delivering it.')
+
+    def handle(self, *args, **options):
+        if os.geteuid() != 0:
+            raise CommandError('Only root can reset the passwords.')
+
+        user = User.objects.filter(username=options.get('username')).first()
+        if not user:
+            raise CommandError(f'User {options.get("username")} does not exist.')
+        if options.get('first_run') and user.last_login:
+            raise CommandError(f'{user} has signed in already, the password is only set on the first run.')
+
+        chosen = options.get('password_stdin') or options.get('prompt')
+        if options.get('prompt'):
+            password = getpass.getpass('New password: ')
+            if password != getpass.getpass('Repeat the new password: '):
+                raise CommandError('The passwords do not match.')
+        elif options.get('password_stdin'):
+            password = sys.stdin.readline().rstrip('\n')
+        else:
+            password = rand_passwd()
+        if chosen and len(password) < 8:
+            raise CommandError('The password should be at least 8 characters long.')
+
+        if not set_password(user.username, password):
+            raise CommandError(f'The password of {user} cannot be set.')
+        self.stdout.write(self.style.SUCCESS(f'The password of {user} has been set.'))
+        if chosen:
+            return
+        if options.get('show'):
+            self.stdout.write(f'Password: {password}')
+            return
+
+        result = credentials.deliver(user.username, password, email=options.get('email'), webhook=options.get('webhook'))
+        for channel in ['email', 'webhook']:
+            if channel in result:
+                style = self.style.SUCCESS if result.get(channel) else self.style.ERROR
+                self.stdout.write(style(f'The {channel} {"delivered" if result.get(channel) else "failed to deliver"} the password.'))
+        if result.get('file'):
+            self.stdout.write(self.style.WARNING(f'The password has been saved to {result.get("file")}, delete it once it is stored safely.'))
@@ -0,0 +1,39 @@
+import os, secrets
+from subprocess import check_call, CalledProcessError, DEVNULL
+from django.conf import settings
+from django.core.management.base import BaseCommand, CommandError
+from django.contrib.sessions.models import Session
+from core.utils.health import PANEL_SERVICE
+
+
+class Command(BaseCommand):
+    help = 'Rotate the secret key the sessions and the signed tokens of the panel are signed with. Only root can run this. The new secret is saved to the root only FASTCP_SECRET_FILE, which overrides FASTCP_APP_SECRET, and everyone has to sign in again.'
+
+    def add_arguments(self, parser):
+        parser.add_argument('--restart', action='store_true', help=f'Restart the {PANEL_SERVICE} service so the new secret takes effect.')
+
+    def handle(self, *args, **options):
+        if os.geteuid() != 0:
+            raise CommandError('Only root can rotate the secret key.')
+
+        path = settings.FASTCP_SECRET_FILE
+        os.makedirs(os.path.dirname(path), mode=0o700, exist_ok=True)
+        # Written next to the old secret first, so a failed write never leaves the panel without a secret
+        tmp_path = f'{path}.tmp'
+        fd = os.open(tmp_path, os.O_WRONLY | os.O_CREAT | os.O_TRUNC, 0o600)
+        with os.fdopen(fd, 'w') as f:
+            f.write(secrets.token_urlsafe(64))
+        os.replace(tmp_path, path)
+
+        # The sessions signed with the old secret cannot be verified anymore
+        deleted, _ = Session.objects.all().delete()
+        self.stdout.write(self.style.SUCCESS(f'The secret key has been rotated and {deleted} sessions have been signed out.'))
+
+        if not options.get('restart'):
+            self.stdout.write(self.style.WARNING(f'Restart the {PANEL_SERVICE} service for the new secret to take effect.'))
+            return
+        try:
+            check_call(['/usr/bin/systemctl', 'restart', PANEL_SERVICE], stdout=DEVNULL, stderr=DEVNULL)
+        except (CalledProcessError, FileNotFoundError):
+            raise CommandError(f'The {PANEL_SERVICE} service cannot be restarted, restart it to use the new secret.')
+        self.stdout.write(self.style.SUCCESS(f'The {PANEL_SERVICE} service has been restarted.'))
@@ -0,0 +1,51 @@
+import time, requests
+from django.core.management.base import BaseCommand
+
+
+class Command(BaseCommand):
+    help = 'Check the websites of a FastCP panel from this server and report the results back as an external probe.'
+
+    def add_arguments(self, parser):
+        parser.add_argument('--panel', required=True, help='URL of the panel, i.e. https://panel.example.com:8899.')
+        parser.add_argument('--token', required=True, help='The token the probe was registered with.')
+        parser.add_argument('--insecure', action='store_true', help='Do not verify the SSL certificate of the panel.')
+
+    def check_target(self, target: dict) -> dict:
+        """Requests a target URL and returns the result."""
+        result = {'id': target.get('id'), 'status_code': None, 'ttfb': None, 'latency': None, 'error': None}
+        start = time.monotonic()
+        try:
+            res = requests.get(target.get('url'), timeout=30, allow_redirects=False, stream=True)
+            result['ttfb'] = int(res.elapsed.total_seconds() * 1000)
+            res.content
+            result['latency'] = int((time.monotonic() - start) * 1000)
+            result['status_code'] = res.status_code
+        except requests.RequestException as e:
+            result['error'] = str(e)[:255]
+        return result
+
+    def handle(self, *args, **options):
+        panel = options.get('panel').rstrip('/')
+        headers = {'Authorization': f'Bearer {options.get("token")}'}
+        verify = not options.get('insecure')
+
+        try:
+            res = requests.get(f'{panel}/api/probes/targets/', headers=headers, verify=verify, timeout=30)
+            res.raise_for_status()
+            targets = res.json().get('targets')
+        except (requests.RequestException, ValueError) as e:
+            self.stdout.write(self.style.ERROR(f'Cannot fetch the targets from the panel: {e}'))
+            return
+
+        results = []
+        for target in targets:
+            result = self.check_target(target)
+            results.append(result)
+            self.stdout.write(f'{target.get("url")}: {result.get("status_code") or result.get("error")} in {result.get("latency")}ms')
+
+        try:
+            res = requests.post(f'{panel}/api/probes/results/', json={'results': results}, headers=headers, verify=verify, timeout=30)
+            res.raise_for_status()
+            self.stdout.write(self.style.SUCCESS(f'Reported {res.json().get("recorded")} results to the panel.'))
+        except (requests.RequestException, ValueError) as e:
+            self.stdout.write(self.style.ERROR(f'Cannot report the results to the panel: {e}'))
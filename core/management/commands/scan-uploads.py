@@ -0,0 +1,54 @@
+import os, select, time
+from subprocess import Popen, PIPE, DEVNULL
+from django.core.management.base import BaseCommand
+from core.utils import malware
+
+
+class Command(BaseCommand):
+    help = 'Watch the public directories of the websites with upload scanning enabled and quarantine the malicious PHP files dropped in their uploads directories, i.e. over SFTP or by a vulnerable plugin.'
+
+    # How often the watched websites are reloaded, in seconds
+    reload_every = 60
+
+    def add_arguments(self, parser):
+        parser.add_argument('--inotifywait', default='/usr/bin/inotifywait', help='Path of inotifywait from inotify-tools.')
+
+    def watch(self, roots: dict, inotifywait: str) -> None:
+        """Watches the roots until the watched websites change."""
+        proc = Popen(
+            [inotifywait, '-m', '-r', '-q', '-e', 'close_write,moved_to', '--format', '%w%f', *roots.keys()],
+            # Unbuffered, so select doesn't miss the lines read ahead into a buffer
+            stdout=PIPE, stderr=DEVNULL, bufsize=0
+        )
+        started = time.monotonic()
+        try:
+            while proc.poll() is None:
+                ready, _, _ = select.select([proc.stdout], [], [], 5)
+                if ready:
+                    path = proc.stdout.readline().decode(errors='replace').rstrip('\n')
+                    root = next((r for r in roots if path.startswith(r + os.sep)), None)
+                    item = malware.scan_and_quarantine(path, roots.get(root)) if root else None
+                    if item:
+                        self.stdout.write(f'Quarantined {path} detected as {item.signature}')
+                if time.monotonic() - started >= self.reload_every:
+                    if set(malware.scan_roots()) != set(roots):
+                        return
+                    started = time.monotonic()
+        finally:
+            if proc.poll() is None:
+                proc.terminate()
+                proc.wait()
+
+    def handle(self, *args, **options):
+        inotifywait = options.get('inotifywait')
+        if not os.path.exists(inotifywait):
+            self.stdout.write(self.style.ERROR(f'{inotifywait} was not found, install inotify-tools.'))
+            return
+
+        while True:
+            roots = malware.scan_roots()
+            if not roots:
+                time.sleep(self.reload_every)
+                continue
+            self.stdout.write(f'Scanning the uploads of {len(roots)} websites.')
+            self.watch(roots, inotifywait)
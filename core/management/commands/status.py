@@ -0,0 +1,124 @@
+import json
+from datetime import timedelta
+from django.core.management.base import BaseCommand
+from django.db import connection, DatabaseError
+from django.db.migrations.executor import MigrationExecutor
+from django.db.models import Count, Max
+from django.utils import timezone
+from core.models import User, Website, Job
+from core.utils import health
+
+
+# The jobs that back the data up
+BACKUP_KINDS = ['server_backup', 'export_website']
+
+
+class Command(BaseCommand):
+    help = 'Show the health of the panel, the services, the websites and the last backup of each user, read from the panel database and systemd directly. It needs neither the web panel nor a sign in, for troubleshooting when the panel is down.'
+    requires_system_checks = []
+
+    def add_arguments(self, parser):
+        parser.add_argument('--json', action='store_true', help='Print the status as JSON.')
+
+    def database_status(self) -> dict:
+        try:
+            executor = MigrationExecutor(connection)
+            pending = executor.migration_plan(executor.loader.graph.leaf_nodes())
+            return {'ok': True, 'pending_migrations': [f'{m.app_label}.{m.name}' for m, backwards in pending]}
+        except DatabaseError as e:
+            return {'ok': False, 'error': str(e)}
+
+    def collect(self) -> dict:
+        status = {
+            'time': timezone.now().isoformat(),
+            'panel': {'service': health.PANEL_SERVICE, 'active': health.service_is_active(health.PANEL_SERVICE)},
+            'database': self.database_status()
+        }
+        if not status['database'].get('ok'):
+            return status
+
+        # The columns added by pending migrations are missing during a failed upgrade, so a section whose
+        # queries fail reports the error and the others are still shown
+        status['errors'] = {}
+        for section, func in [('services', self.services), ('websites', self.websites), ('jobs', self.jobs), ('last_backups', self.last_backups)]:
+            try:
+                status[section] = func()
+            except DatabaseError as e:
+                status['errors'][section] = str(e)
+        return status
+
+    def services(self) -> dict:
+        services = health.check_services()
+        return {'total': len(services), 'failing': sorted(s for s, active in services.items() if not active)}
+
+    def websites(self) -> dict:
+        return {
+            'total': Website.objects.count(),
+            'over_quota': list(Website.objects.filter(quota_exceeded=True).values_list('label', flat=True)),
+            'by_user': dict(User.objects.annotate(n=Count('websites')).filter(n__gt=0).order_by('username').values_list('username', 'n'))
+        }
+
+    def jobs(self) -> dict:
+        day_ago = timezone.now() - timedelta(days=1)
+        return {
+            'running': Job.objects.filter(state='running').count(),
+            'queued': Job.objects.filter(state='queued').count(),
+            'failed_last_day': Job.objects.filter(state='failed', finished__gte=day_ago).count()
+        }
+
+    def last_backups(self) -> dict:
+        last_backups = dict(
+            Job.objects.filter(kind__in=BACKUP_KINDS, state='done').values('user__username').annotate(last=Max('finished')).values_list('user__username', 'last')
+        )
+        return {
+            user: last_backups.get(user).isoformat() if last_backups.get(user) else None
+            for user in User.objects.order_by('username').values_list('username', flat=True)
+        }
+
+    def handle(self, *args, **options):
+        status = self.collect()
+        if options.get('json'):
+            self.stdout.write(json.dumps(status, indent=2))
+            return
+
+        panel = status.get('panel')
+        style = self.style.SUCCESS if panel.get('active') else self.style.ERROR
+        self.stdout.write(style(f'Panel service {panel.get("service")}: {"active" if panel.get("active") else "not active"}'))
+
+        database = status.get('database')
+        if not database.get('ok'):
+            self.stdout.write(self.style.ERROR(f'Database: cannot be read, {database.get("error")}'))
+            return
+        pending = database.get('pending_migrations')
+        if pending:
+            self.stdout.write(self.style.WARNING(f'Database: {len(pending)} migrations are not applied, run manage.py migrate'))
+        else:
+            self.stdout.write(self.style.SUCCESS('Database: ok'))
+
+        for section, error in status.get('errors').items():
+            self.stdout.write(self.style.ERROR(f'{section.replace("_", " ").capitalize()}: cannot be read, {error}'))
+
+        services = status.get('services')
+        if services and services.get('failing'):
+            self.stdout.write(self.style.ERROR(f'Services: {", ".join(services.get("failing"))} of {services.get("total")} are not active'))
+        elif services:
+            self.stdout.write(self.style.SUCCESS(f'Services: all {services.get("total")} are active'))
+
+        websites = status.get('websites')
+        if websites:
+            self.stdout.write(f'Websites: {websites.get("total")}')
+            for username, count in websites.get('by_user').items():
+                self.stdout.write(f'    {username}: {count}')
+            if websites.get('over_quota'):
+                self.stdout.write(self.style.WARNING(f'    Over the traffic quota: {", ".join(websites.get("over_quota"))}'))
+
+        jobs = status.get('jobs')
+        if jobs:
+            self.stdout.write(f'Jobs: {jobs.get("running")} running, {jobs.get("queued")} queued, {jobs.get("failed_last_day")} failed in the last day')
+
+        last_backups = status.get('last_backups')
+        if last_backups is not None:
+            self.stdout.write('Last backups:')
+            for username, last in last_backups.items():
+                line = f'    {username}: {last or "never"}'
+                self.stdout.write(self.style.WARNING(line) if not last else line)
@@ -0,0 +1,24 @@
+import sys
+from django.core.checks import ERROR
+from django.core.management.base import BaseCommand
+from core.checks import fastcp_config_check
+
+
+class Command(BaseCommand):
+    help = 'Validate the FastCP settings from the environment and show what to fix.'
+    requires_system_checks = []
+
+    def handle(self, *args, **options):
+        messages = fastcp_config_check(None)
+        if not messages:
+            self.stdout.write(self.style.SUCCESS('The configuration is valid.'))
+            return
+
+        for message in messages:
+            style = self.style.ERROR if message.level >= ERROR else self.style.WARNING
+            self.stdout.write(style(f'[{message.id}] {message.msg}'))
+            if message.hint:
+                self.stdout.write(f'    {message.hint}')
+
+        if any(message.level >= ERROR for message in messages):
+            sys.exit(1)
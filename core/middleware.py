@@ -0,0 +1,246 @@
+import time, logging
+from datetime import datetime
+from django.conf import settings
+from django.contrib.auth import logout
+from django.http import JsonResponse, HttpResponseForbidden
+from django.utils.cache import patch_vary_headers
+from django.utils.text import compress_string
+from core.models import LoginDevice
+from core.utils import demo, audit
+
+try:
+    import zstandard
+except ImportError:
+    zstandard = None
+
+
+class ProxyHeadersMiddleware:
+    """Proxy headers middleware.
+    
+    When the panel runs behind a reverse proxy listed in FASTCP_TRUSTED_PROXIES, or behind a proxy that
+    connects over the unix socket, the client IP is taken from the X-Forwarded-For header. The X-Forwarded-* headers sent by anyone else are dropped, so they
+    cannot spoof the host, the scheme or the IP the panel sees. So is their X-Request-ID, so they cannot
+    forge the request IDs of the audit log.
+    """
+    FORWARDED_HEADERS = ['HTTP_X_FORWARDED_FOR', 'HTTP_X_FORWARDED_HOST', 'HTTP_X_FORWARDED_PROTO', 'HTTP_X_FORWARDED_PORT',
+                         'HTTP_X_REQUEST_ID']
+    
+    def __init__(self, get_response):
+        self.get_response = get_response
+
+    def __call__(self, request):
+        trusted = settings.FASTCP_TRUSTED_PROXIES
+        # Only the local processes allowed to open the unix socket can connect over it
+        over_socket = settings.FASTCP_UNIX_SOCKET and not request.META.get('REMOTE_ADDR')
+        if over_socket or request.META.get('REMOTE_ADDR') in trusted:
+            # The rightmost address that isn't a trusted proxy is the client
+            chain = [ip.strip() for ip in request.META.get('HTTP_X_FORWARDED_FOR', '').split(',') if ip.strip()]
+            for ip in reversed(chain):
+                if ip not in trusted:
+                    request.META['REMOTE_ADDR'] = ip
+                    break
+        else:
+            for header in self.FORWARDED_HEADERS:
+                request.META.pop(header, None)
+        return self.get_response(request)
+
+
+class SessionTimeoutMiddleware:
+    """Session timeout middleware.
+    
+    Enforces the session lifetimes on the server side. A session is ended if it has been idle for longer
+    than the idle timeout or if it is older than the absolute lifetime, no matter how active it is.
+    Sessions started with "remember me" are not subject to the idle timeout and get a longer lifetime.
+    """
+    def __init__(self, get_response):
+        self.get_response = get_response
+
+    def __call__(self, request):
+        if request.user.is_authenticated:
+            session = request.session
+            now = datetime.now().timestamp()
+            login_time = session.get('login_time', now)
+            last_activity = session.get('last_activity', now)
+            
+            if session.get('remember_me'):
+                max_age = settings.FASTCP_SESSION_REMEMBER_DAYS * 86400
+                idle = False
+            else:
+                max_age = settings.FASTCP_SESSION_MAX_HOURS * 3600
+                idle = now - last_activity > settings.FASTCP_SESSION_IDLE_MINS * 60
+            
+            if idle or now - login_time > max_age:
+                logout(request)
+            else:
+                session['login_time'] = login_time
+                session['last_activity'] = now
+
+        return self.get_response(request)
+
+
+class RevokedDeviceMiddleware:
+    """Revoked device middleware.
+    
+    Ends the sessions that were started from a device the user has revoked since.
+    """
+    def __init__(self, get_response):
+        self.get_response = get_response
+
+    def __call__(self, request):
+        device_id = request.user.is_authenticated and request.session.get('device_id')
+        if device_id and LoginDevice.objects.filter(id=device_id, revoked=True).exists():
+            logout(request)
+        return self.get_response(request)
+
+
+
+class DemoModeMiddleware:
+    """Demo mode middleware.
+    
+    Added in demo mode only. The API requests that would change anything are not run, a simulated success
+    response is returned instead, so the panel can be clicked through without touching the server. The
+    Django admin cannot change anything either. Signing in and out still works.
+    """
+    SAFE_METHODS = ['GET', 'HEAD', 'OPTIONS']
+    
+    def __init__(self, get_response):
+        self.get_response = get_response
+
+    def __call__(self, request):
+        return self.get_response(request)
+
+    def process_view(self, request, view_func, view_args, view_kwargs):
+        match = request.resolver_match
+        # The sign in and out views of the core app are included with the core namespace
+        if request.method in self.SAFE_METHODS or (match and 'sites' in match.app_names):
+            return None
+        if match and 'api' in match.app_names:
+            return JsonResponse(demo.simulated_result(request, view_kwargs))
+        return HttpResponseForbidden('FastCP is running in demo mode, nothing can be changed.')
+
+# Vue compiles the in-page templates at runtime, which needs unsafe-eval
+CSP_DIRECTIVES = {
+    'default-src': ["'self'"],
+    'script-src': ["'self'", "'unsafe-inline'", "'unsafe-eval'", 'https://cdnjs.cloudflare.com', 'https://unpkg.com'],
+    'style-src': ["'self'", "'unsafe-inline'", 'https://fonts.googleapis.com', 'https://cdnjs.cloudflare.com', 'https://unpkg.com'],
+    'font-src': ["'self'", 'data:', 'https://fonts.gstatic.com'],
+    'img-src': ["'self'", 'data:', 'https:'],
+    'connect-src': ["'self'"],
+    'frame-ancestors': ["'none'"],
+    'base-uri': ["'self'"],
+    'form-action': ["'self'"],
+}
+
+
+class SecurityHeadersMiddleware:
+    """Security headers middleware.
+    
+    Adds the Content Security Policy and the cross-origin isolation headers to the panel responses.
+    FASTCP_CSP_MODE selects the rollout: in report-only mode (the default) browsers only report the
+    violations, in enforce mode they block them and off disables these headers altogether.
+    """
+    def __init__(self, get_response):
+        self.get_response = get_response
+        directives = dict(CSP_DIRECTIVES)
+        if settings.FASTCP_CSP_REPORT_URI:
+            directives['report-uri'] = [settings.FASTCP_CSP_REPORT_URI]
+        self.policy = '; '.join([f'{key} {" ".join(values)}' for key, values in directives.items()])
+
+    def __call__(self, request):
+        response = self.get_response(request)
+        mode = settings.FASTCP_CSP_MODE
+        if mode not in ['enforce', 'report-only']:
+            return response
+        
+        suffix = '-Report-Only' if mode == 'report-only' else ''
+        response.setdefault(f'Content-Security-Policy{suffix}', self.policy)
+        # require-corp would block the CDN assets allowed above, they don't send Cross-Origin-Resource-Policy
+        response.setdefault(f'Cross-Origin-Embedder-Policy{suffix}', 'credentialless')
+        response.setdefault('Cross-Origin-Opener-Policy', 'same-origin')
+        return response
+
+
+
+class CompressionMiddleware:
+    """Compression middleware.
+    
+    Compresses the JSON responses of the API with zstd, if the zstandard package is installed and the client
+    accepts it, or with gzip. The HTML pages are left alone as they carry the CSRF token, which compression
+    would expose to BREACH, and the streams are left alone so their events aren't held back in a buffer.
+    The static files are compressed ahead by WhiteNoise.
+    """
+    MIN_LENGTH = 500
+    
+    def __init__(self, get_response):
+        self.get_response = get_response
+
+    def encoding(self, request) -> str:
+        accepted = [e.split(';')[0].strip() for e in request.META.get('HTTP_ACCEPT_ENCODING', '').split(',')]
+        if zstandard and 'zstd' in accepted:
+            return 'zstd'
+        if 'gzip' in accepted:
+            return 'gzip'
+        return None
+
+    def __call__(self, request):
+        response = self.get_response(request)
+        if response.streaming or response.has_header('Content-Encoding') or len(response.content) < self.MIN_LENGTH:
+            return response
+        if not response.get('Content-Type', '').startswith('application/json'):
+            return response
+        
+        patch_vary_headers(response, ('Accept-Encoding',))
+        encoding = self.encoding(request)
+        if not encoding:
+            return response
+        
+        if encoding == 'zstd':
+            compressed = zstandard.ZstdCompressor(level=3).compress(response.content)
+        else:
+            compressed = compress_string(response.content)
+        if len(compressed) >= len(response.content):
+            return response
+        response.content = compressed
+        response['Content-Length'] = str(len(compressed))
+        response['Content-Encoding'] = encoding
+        # The compressed body differs byte for byte, so the ETag can only be weak
+        etag = response.get('ETag')
+        if etag and etag.startswith('"'):
+            response['ETag'] = f'W/{etag}'
+        return response
+
+class AccessLogMiddleware:
+    """Access log middleware.
+    
+    Logs every panel request to the fastcp.access logger, and the requests that change something, made by
+    signed in users, to the fastcp.audit logger and to the audit table. The request bodies are never logged
+    as they may carry passwords. Both loggers are shipped off the server when a remote syslog endpoint is set.
+
+    Each request gets an ID, returned in the X-Request-ID header, that the audit entries of the request and
+    of the jobs it started are tagged with. An X-Request-ID set by a proxy in front of the panel is kept.
+    """
+    SAFE_METHODS = ['GET', 'HEAD', 'OPTIONS']
+    
+    def __init__(self, get_response):
+        self.get_response = get_response
+        self.access = logging.getLogger('fastcp.access')
+        self.audit = logging.getLogger('fastcp.audit')
+
+    def __call__(self, request):
+        start = time.monotonic()
+        request.request_id = audit.request_id_for(request)
+        audit.set_request_id(request.request_id)
+        try:
+            response = self.get_response(request)
+        finally:
+            audit.set_request_id(None)
+        response['X-Request-ID'] = request.request_id
+        ms = int((time.monotonic() - start) * 1000)
+        ip = request.META.get('REMOTE_ADDR') or 'unix'
+        user = request.user.username if getattr(request, 'user', None) and request.user.is_authenticated else '-'
+        path = request.get_full_path()
+        self.access.info(f'{ip} {user} "{request.method} {path}" {response.status_code} {ms}ms "{request.META.get("HTTP_USER_AGENT", "-")}"')
+        if request.method not in self.SAFE_METHODS and user != '-':
+            self.audit.info(f'user={user} ip={ip} action="{request.method} {request.path}" status={response.status_code} request_id={request.request_id}')
+            audit.record_request(request, response)
+        return response
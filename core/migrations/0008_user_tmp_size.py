@@ -0,0 +1,18 @@
+# Generated by Django 3.2.6 on 2026-10-16 09:12
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0007_auto_20210915_1518'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='user',
+            name='tmp_size',
+            field=models.IntegerField(default=0),
+        ),
+    ]
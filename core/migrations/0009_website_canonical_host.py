@@ -0,0 +1,23 @@
+# Generated by Django 3.2.6 on 2026-10-16 11:40
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0008_user_tmp_size'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='canonical_host',
+            field=models.CharField(choices=[('none', 'No preference'), ('www', 'Prefer www'), ('apex', 'Prefer non-www')], default='none', max_length=10),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='force_https',
+            field=models.BooleanField(default=False),
+        ),
+    ]
@@ -0,0 +1,24 @@
+# Generated by Django 3.2.6 on 2026-10-16 12:25
+
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0009_website_canonical_host'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='mirror_percent',
+            field=models.IntegerField(default=0),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='mirror_to',
+            field=models.ForeignKey(blank=True, null=True, on_delete=django.db.models.deletion.SET_NULL, related_name='mirrored_from', to='core.website'),
+        ),
+    ]
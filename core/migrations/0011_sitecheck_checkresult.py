@@ -0,0 +1,40 @@
+# Generated by Django 3.2.6 on 2026-10-16 13:05
+
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0010_website_mirror'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='SiteCheck',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('path', models.CharField(default='/', max_length=255)),
+                ('max_ms', models.IntegerField(default=3000)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('website', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='checks', to='core.website')),
+            ],
+            options={
+                'unique_together': {('website', 'path')},
+            },
+        ),
+        migrations.CreateModel(
+            name='CheckResult',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('source', models.CharField(default='local', max_length=50)),
+                ('status_code', models.IntegerField(blank=True, null=True)),
+                ('ttfb', models.IntegerField(blank=True, null=True)),
+                ('latency', models.IntegerField(blank=True, null=True)),
+                ('error', models.CharField(blank=True, max_length=255, null=True)),
+                ('created', models.DateTimeField(auto_now_add=True, db_index=True)),
+                ('site_check', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='results', to='core.sitecheck')),
+            ],
+        ),
+    ]
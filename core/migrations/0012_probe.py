@@ -0,0 +1,23 @@
+# Generated by Django 3.2.6 on 2026-10-16 13:50
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0011_sitecheck_checkresult'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='Probe',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('name', models.SlugField(unique=True)),
+                ('token_hash', models.CharField(max_length=64, unique=True)),
+                ('last_seen', models.DateTimeField(blank=True, null=True)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+            ],
+        ),
+    ]
@@ -0,0 +1,18 @@
+# Generated by Django 3.2.6 on 2026-10-16 14:30
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0012_probe'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='domain',
+            name='redirect_to',
+            field=models.CharField(blank=True, max_length=100, null=True),
+        ),
+    ]
@@ -0,0 +1,23 @@
+# Generated by Django 3.2.6 on 2026-10-17 09:10
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0013_domain_redirect_to'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='dev_extension',
+            field=models.CharField(choices=[('xdebug', 'Xdebug'), ('pcov', 'PCOV')], default='xdebug', max_length=10),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='dev_mode_until',
+            field=models.DateTimeField(blank=True, null=True),
+        ),
+    ]
@@ -0,0 +1,28 @@
+# Generated by Django 3.2.6 on 2026-10-17 09:55
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0014_website_dev_mode'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='debug_ips',
+            field=models.TextField(blank=True, null=True),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='debug_key',
+            field=models.CharField(blank=True, max_length=64, null=True),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='debug_mode',
+            field=models.BooleanField(default=False),
+        ),
+    ]
@@ -0,0 +1,29 @@
+# Generated by Django 3.2.6 on 2026-10-17 10:40
+
+from django.conf import settings
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0015_website_debug_mode'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='LoginDevice',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('token_hash', models.CharField(max_length=64, unique=True)),
+                ('label', models.CharField(blank=True, max_length=50, null=True)),
+                ('user_agent', models.CharField(blank=True, max_length=255, null=True)),
+                ('ip_addr', models.GenericIPAddressField(blank=True, null=True)),
+                ('revoked', models.BooleanField(default=False)),
+                ('last_seen', models.DateTimeField(auto_now=True)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('user', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='devices', to=settings.AUTH_USER_MODEL)),
+            ],
+        ),
+    ]
@@ -0,0 +1,18 @@
+# Generated by Django 3.2.6 on 2026-10-17 14:20
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0017_notification_preferences'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='backend',
+            field=models.CharField(blank=True, choices=[('apache', 'NGINX + Apache'), ('nginx', 'NGINX only')], max_length=10, null=True),
+        ),
+    ]
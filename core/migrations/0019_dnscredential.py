@@ -0,0 +1,36 @@
+# Generated by Django 3.2.6 on 2026-10-17 15:05
+
+from django.conf import settings
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0018_website_backend'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='DnsCredential',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('provider', models.CharField(choices=[('cloudflare', 'Cloudflare'), ('route53', 'Amazon Route 53'), ('digitalocean', 'DigitalOcean')], max_length=20)),
+                ('label', models.CharField(max_length=50)),
+                ('credentials', models.TextField()),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('user', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='dns_credentials', to=settings.AUTH_USER_MODEL)),
+            ],
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='dns_credential',
+            field=models.ForeignKey(blank=True, null=True, on_delete=django.db.models.deletion.SET_NULL, related_name='websites', to='core.dnscredential'),
+        ),
+        migrations.AddField(
+            model_name='domain',
+            name='wildcard',
+            field=models.BooleanField(default=False),
+        ),
+    ]
@@ -0,0 +1,39 @@
+# Generated by Django 3.2.6 on 2026-10-17 15:40
+
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0019_dnscredential'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='MailDomain',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('domain', models.CharField(max_length=100, unique=True)),
+                ('dkim_selector', models.CharField(default='fastcp', max_length=30)),
+                ('dkim_public_key', models.TextField(blank=True, null=True)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('website', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='mail_domains', to='core.website')),
+            ],
+        ),
+        migrations.CreateModel(
+            name='Mailbox',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('local_part', models.CharField(max_length=64)),
+                ('password_hash', models.CharField(max_length=255)),
+                ('quota_mb', models.IntegerField(default=1024)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('mail_domain', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='mailboxes', to='core.maildomain')),
+            ],
+            options={
+                'unique_together': {('mail_domain', 'local_part')},
+            },
+        ),
+    ]
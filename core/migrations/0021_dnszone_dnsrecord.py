@@ -0,0 +1,40 @@
+# Generated by Django 3.2.6 on 2026-10-17 16:30
+
+from django.conf import settings
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0020_maildomain_mailbox'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='DnsZone',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('domain', models.CharField(max_length=100, unique=True)),
+                ('backend', models.CharField(choices=[('local', 'Local BIND'), ('external', 'External DNS provider')], default='local', max_length=10)),
+                ('serial', models.BigIntegerField(default=0)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('dns_credential', models.ForeignKey(blank=True, null=True, on_delete=django.db.models.deletion.SET_NULL, related_name='zones', to='core.dnscredential')),
+                ('user', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='dns_zones', to=settings.AUTH_USER_MODEL)),
+            ],
+        ),
+        migrations.CreateModel(
+            name='DnsRecord',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('name', models.CharField(default='@', max_length=255)),
+                ('type', models.CharField(max_length=10)),
+                ('value', models.TextField()),
+                ('ttl', models.IntegerField(default=3600)),
+                ('provider_ref', models.TextField(blank=True, null=True)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('zone', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='records', to='core.dnszone')),
+            ],
+        ),
+    ]
@@ -0,0 +1,25 @@
+# Generated by Django 3.2.6 on 2026-10-17 17:05
+
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0021_dnszone_dnsrecord'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='SftpAccount',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('username', models.CharField(max_length=50, unique=True)),
+                ('password_hash', models.CharField(max_length=255)),
+                ('read_only', models.BooleanField(default=False)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('website', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='sftp_accounts', to='core.website')),
+            ],
+        ),
+    ]
@@ -0,0 +1,23 @@
+# Generated by Django 3.2.6 on 2026-10-17 18:40
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0024_job'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='acl_profile',
+            field=models.CharField(choices=[('strict', 'Owner only'), ('shared-group', 'Shared with a group of collaborators'), ('www-data', 'Readable by www-data')], default='strict', max_length=20),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='acl_group',
+            field=models.CharField(blank=True, max_length=32, null=True),
+        ),
+    ]
@@ -0,0 +1,18 @@
+# Generated by Django 3.2.6 on 2026-10-17 19:10
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0025_website_acl_profile'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='watch_ownership',
+            field=models.BooleanField(default=False),
+        ),
+    ]
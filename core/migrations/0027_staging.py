@@ -0,0 +1,26 @@
+# Generated by Django 3.2.6 on 2026-10-17 19:45
+
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0026_website_watch_ownership'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='Staging',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('pushed', models.DateTimeField(blank=True, null=True)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('database', models.ForeignKey(blank=True, null=True, on_delete=django.db.models.deletion.SET_NULL, related_name='stagings', to='core.database')),
+                ('production', models.OneToOneField(on_delete=django.db.models.deletion.CASCADE, related_name='staging', to='core.website')),
+                ('staging_database', models.OneToOneField(blank=True, null=True, on_delete=django.db.models.deletion.SET_NULL, related_name='staging_of', to='core.database')),
+                ('website', models.OneToOneField(on_delete=django.db.models.deletion.CASCADE, related_name='staging_of', to='core.website')),
+            ],
+        ),
+    ]
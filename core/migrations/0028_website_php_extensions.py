@@ -0,0 +1,18 @@
+# Generated by Django 3.2.6 on 2026-10-17 20:05
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0027_staging'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='php_extensions',
+            field=models.TextField(blank=True, null=True),
+        ),
+    ]
@@ -0,0 +1,30 @@
+# Generated by Django 3.2.6 on 2026-10-17 20:30
+
+from django.conf import settings
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0028_website_php_extensions'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='OomEvent',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('pid', models.IntegerField()),
+                ('process', models.CharField(max_length=100)),
+                ('cgroup', models.BooleanField(default=False)),
+                ('rss_kb', models.IntegerField(default=0)),
+                ('occurred', models.DateTimeField(db_index=True)),
+                ('user', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='oom_events', to=settings.AUTH_USER_MODEL)),
+            ],
+            options={
+                'unique_together': {('user', 'pid', 'occurred')},
+            },
+        ),
+    ]
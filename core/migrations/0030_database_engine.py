@@ -0,0 +1,18 @@
+# Generated by Django 3.2.6 on 2026-10-17 21:10
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0029_oomevent'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='database',
+            name='engine',
+            field=models.CharField(choices=[('mysql', 'MySQL'), ('postgresql', 'PostgreSQL')], default='mysql', max_length=20),
+        ),
+    ]
@@ -0,0 +1,28 @@
+# Generated by Django 3.2.6 on 2026-10-17 21:50
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0030_database_engine'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='user',
+            name='ui_theme',
+            field=models.CharField(choices=[('system', 'Follow the system'), ('light', 'Light'), ('dark', 'Dark')], default='system', max_length=10),
+        ),
+        migrations.AddField(
+            model_name='user',
+            name='ui_density',
+            field=models.CharField(choices=[('comfortable', 'Comfortable'), ('compact', 'Compact')], default='comfortable', max_length=15),
+        ),
+        migrations.AddField(
+            model_name='user',
+            name='ui_landing_page',
+            field=models.CharField(choices=[('/', 'Dashboard'), ('/websites', 'Websites'), ('/databases', 'Databases'), ('/users', 'SSH users')], default='/', max_length=30),
+        ),
+    ]
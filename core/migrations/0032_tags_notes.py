@@ -0,0 +1,33 @@
+# Generated by Django 3.2.6 on 2026-10-17 22:30
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0031_user_ui_preferences'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='user',
+            name='tags',
+            field=models.CharField(blank=True, max_length=700, null=True),
+        ),
+        migrations.AddField(
+            model_name='user',
+            name='notes',
+            field=models.TextField(blank=True, null=True),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='tags',
+            field=models.CharField(blank=True, max_length=700, null=True),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='notes',
+            field=models.TextField(blank=True, null=True),
+        ),
+    ]
@@ -0,0 +1,26 @@
+# Generated by Django 3.2.6 on 2026-10-17 23:20
+
+from django.conf import settings
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0032_tags_notes'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='NotificationChannel',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('kind', models.CharField(choices=[('webhook', 'Webhook'), ('slack', 'Slack'), ('discord', 'Discord')], max_length=10)),
+                ('url', models.URLField(max_length=500)),
+                ('events', models.TextField(blank=True, null=True)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('user', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='notification_channels', to=settings.AUTH_USER_MODEL)),
+            ],
+        ),
+    ]
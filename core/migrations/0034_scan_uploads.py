@@ -0,0 +1,32 @@
+# Generated by Django 3.2.6 on 2026-10-17 23:40
+
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0033_notificationchannel'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='scan_uploads',
+            field=models.BooleanField(default=False),
+        ),
+        migrations.CreateModel(
+            name='QuarantinedFile',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('path', models.CharField(max_length=1000)),
+                ('quarantine_path', models.CharField(max_length=1000)),
+                ('signature', models.CharField(max_length=255)),
+                ('size', models.BigIntegerField(default=0)),
+                ('restored', models.BooleanField(default=False)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('website', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='quarantined_files', to='core.website')),
+            ],
+        ),
+    ]
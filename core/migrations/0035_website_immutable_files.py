@@ -0,0 +1,18 @@
+# Generated by Django 3.2.6 on 2026-10-17 23:55
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0034_scan_uploads'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='immutable_files',
+            field=models.TextField(blank=True, null=True),
+        ),
+    ]
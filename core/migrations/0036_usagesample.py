@@ -0,0 +1,30 @@
+# Generated by Django 3.2.6 on 2026-10-18 00:10
+
+from django.conf import settings
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0035_website_immutable_files'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='UsageSample',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('resolution', models.CharField(choices=[('5min', '5 minutes'), ('hour', 'Hour')], default='5min', max_length=10)),
+                ('time', models.DateTimeField(db_index=True)),
+                ('cpu_seconds', models.FloatField(default=0)),
+                ('memory_bytes', models.BigIntegerField(default=0)),
+                ('disk_bytes', models.BigIntegerField(default=0)),
+                ('bytes_sent', models.BigIntegerField(default=0)),
+                ('requests', models.BigIntegerField(default=0)),
+                ('user', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='usage_samples', to=settings.AUTH_USER_MODEL)),
+                ('website', models.ForeignKey(blank=True, null=True, on_delete=django.db.models.deletion.CASCADE, related_name='usage_samples', to='core.website')),
+            ],
+        ),
+    ]
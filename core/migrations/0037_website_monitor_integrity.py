@@ -0,0 +1,18 @@
+# Generated by Django 3.2.6 on 2026-10-18 00:25
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0036_usagesample'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='monitor_integrity',
+            field=models.BooleanField(default=False),
+        ),
+    ]
@@ -0,0 +1,37 @@
+# Generated by Django 3.2.6 on 2026-10-18 00:40
+
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0037_website_monitor_integrity'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='traffic_quota_gb',
+            field=models.IntegerField(default=0),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='quota_exceeded',
+            field=models.BooleanField(default=False),
+        ),
+        migrations.CreateModel(
+            name='TrafficMonth',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('month', models.DateField()),
+                ('bytes_sent', models.BigIntegerField(default=0)),
+                ('requests', models.BigIntegerField(default=0)),
+                ('website', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='traffic_months', to='core.website')),
+            ],
+            options={
+                'unique_together': {('website', 'month')},
+            },
+        ),
+    ]
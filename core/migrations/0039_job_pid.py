@@ -0,0 +1,18 @@
+# Generated by Django 3.2.6 on 2026-10-18 00:55
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0038_traffic'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='job',
+            name='pid',
+            field=models.IntegerField(blank=True, null=True),
+        ),
+    ]
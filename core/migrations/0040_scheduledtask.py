@@ -0,0 +1,28 @@
+# Generated by Django 3.2.6 on 2026-10-18 01:10
+
+from django.conf import settings
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0039_job_pid'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='ScheduledTask',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('name', models.CharField(max_length=50)),
+                ('command', models.TextField()),
+                ('schedule_type', models.CharField(choices=[('calendar', 'Calendar'), ('interval', 'Interval'), ('boot', 'After boot')], default='calendar', max_length=10)),
+                ('schedule', models.CharField(max_length=100)),
+                ('enabled', models.BooleanField(default=True)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('user', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='scheduled_tasks', to=settings.AUTH_USER_MODEL)),
+            ],
+        ),
+    ]
@@ -0,0 +1,33 @@
+# Generated by Django 3.2.6 on 2026-10-18 01:42
+
+from django.conf import settings
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0040_scheduledtask'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='AuditEntry',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('username', models.CharField(max_length=150)),
+                ('ip', models.GenericIPAddressField(blank=True, null=True)),
+                ('source', models.CharField(choices=[('api', 'API'), ('job', 'Background job')], default='api', max_length=10)),
+                ('method', models.CharField(blank=True, max_length=10, null=True)),
+                ('path', models.CharField(blank=True, max_length=255, null=True)),
+                ('action', models.CharField(max_length=150)),
+                ('target', models.CharField(blank=True, max_length=255, null=True)),
+                ('status', models.IntegerField(blank=True, null=True)),
+                ('result', models.CharField(choices=[('success', 'Success'), ('failure', 'Failure')], max_length=10)),
+                ('request_id', models.CharField(db_index=True, max_length=64)),
+                ('created', models.DateTimeField(auto_now_add=True, db_index=True)),
+                ('user', models.ForeignKey(blank=True, null=True, on_delete=django.db.models.deletion.SET_NULL, related_name='audit_entries', to=settings.AUTH_USER_MODEL)),
+            ],
+        ),
+    ]
@@ -0,0 +1,33 @@
+# Generated by Django 3.2.6 on 2026-10-18 02:15
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0041_auditentry'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='waf_enabled',
+            field=models.BooleanField(default=False),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='waf_mode',
+            field=models.CharField(choices=[('block', 'Block the attacks'), ('detect', 'Only log the attacks')], default='block', max_length=10),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='waf_paranoia',
+            field=models.IntegerField(default=1),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='waf_disabled_rules',
+            field=models.TextField(blank=True, null=True),
+        ),
+    ]
@@ -0,0 +1,18 @@
+# Generated by Django 3.2.6 on 2026-10-18 02:40
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0042_website_waf'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='seo_checks',
+            field=models.BooleanField(default=False),
+        ),
+    ]
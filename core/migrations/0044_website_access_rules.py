@@ -0,0 +1,28 @@
+# Generated by Django 3.2.6 on 2026-10-18 03:05
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0043_website_seo_checks'),
+    ]
+
+    operations = [
+        migrations.AddField(
+            model_name='website',
+            name='allowed_ips',
+            field=models.TextField(blank=True, null=True),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='denied_ips',
+            field=models.TextField(blank=True, null=True),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='blocked_countries',
+            field=models.TextField(blank=True, null=True),
+        ),
+    ]
@@ -0,0 +1,28 @@
+# Generated by Django 3.2.6 on 2026-10-18 03:30
+
+from django.db import migrations, models
+import django.db.models.deletion
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0044_website_access_rules'),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name='ProtectedPath',
+            fields=[
+                ('id', models.BigAutoField(auto_created=True, primary_key=True, serialize=False, verbose_name='ID')),
+                ('path', models.CharField(default='/', max_length=255)),
+                ('username', models.CharField(max_length=50)),
+                ('password_hash', models.CharField(max_length=255)),
+                ('created', models.DateTimeField(auto_now_add=True)),
+                ('website', models.ForeignKey(on_delete=django.db.models.deletion.CASCADE, related_name='protected_paths', to='core.website')),
+            ],
+            options={
+                'unique_together': {('website', 'path', 'username')},
+            },
+        ),
+    ]
@@ -0,0 +1,28 @@
+# Generated by Django 3.2.6 on 2026-10-18 04:50
+
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0046_snippet'),
+    ]
+
+    operations = [
+        migrations.AlterField(
+            model_name='website',
+            name='backend',
+            field=models.CharField(blank=True, choices=[('apache', 'NGINX + Apache'), ('nginx', 'NGINX only'), ('proxy', 'NGINX reverse proxy to an app')], max_length=10, null=True),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='proxy_upstream',
+            field=models.CharField(blank=True, max_length=255, null=True),
+        ),
+        migrations.AddField(
+            model_name='website',
+            name='app_command',
+            field=models.TextField(blank=True, null=True),
+        ),
+    ]
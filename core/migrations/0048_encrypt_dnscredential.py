@@ -0,0 +1,29 @@
+# Generated by Django 3.2.6 on 2026-10-18 06:12
+
+from django.db import migrations
+from core.utils import vault
+
+
+def encrypt_credentials(apps, schema_editor):
+    DnsCredential = apps.get_model('core', 'DnsCredential')
+    for credential in DnsCredential.objects.exclude(credentials__startswith=vault.PREFIX):
+        credential.credentials = vault.encrypt(credential.credentials)
+        credential.save(update_fields=['credentials'])
+
+
+def decrypt_credentials(apps, schema_editor):
+    DnsCredential = apps.get_model('core', 'DnsCredential')
+    for credential in DnsCredential.objects.filter(credentials__startswith=vault.PREFIX):
+        credential.credentials = vault.decrypt(credential.credentials)
+        credential.save(update_fields=['credentials'])
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ('core', '0047_website_proxy_app'),
+    ]
+
+    operations = [
+        migrations.RunPython(encrypt_credentials, decrypt_credentials),
+    ]
@@ -3,7 +3,8 @@ from django.template.defaultfilters import slugify
 from django.conf import settings
 from api.websites.services.get_php_versions import PhpVersionListService
 from django.contrib.auth.models import AbstractUser, BaseUserManager
-import os
+from core.utils import vault
+import os, json
 
 
 class FastcpUserManager(BaseUserManager):
@@ -34,6 +35,26 @@ class FastcpUserManager(BaseUserManager):
             is_active=True
         )
 
+
+UI_THEME_CHOICES = (
+    ('system', 'Follow the system'),
+    ('light', 'Light'),
+    ('dark', 'Dark'),
+)
+
+UI_DENSITY_CHOICES = (
+    ('comfortable', 'Comfortable'),
+    ('compact', 'Compact'),
+)
+
+UI_LANDING_PAGE_CHOICES = (
+    ('/', 'Dashboard'),
+    ('/websites', 'Websites'),
+    ('/databases', 'Databases'),
+    ('/users', 'SSH users'),
+)
+
+
 class User(AbstractUser):
     """User model.
     
@@ -50,6 +71,14 @@ class User(AbstractUser):
     max_sites = models.IntegerField(default=10) # Max number of websites a user can create
     storage_used = models.FloatField(default=0) # Used storage in Bytes (1024 bytes == 1kb)
     max_storage = models.FloatField(default=1024) # Max storage in Bytes a user can consume (1024 bytes == 1kb)
+    tmp_size = models.IntegerField(default=0) # Size in MBs of the tmpfs mounted on user's tmp dir, 0 means no tmpfs
+    quiet_hours_start = models.TimeField(null=True, blank=True) # No notification emails are sent from start to end
+    quiet_hours_end = models.TimeField(null=True, blank=True)
+    ui_theme = models.CharField(choices=UI_THEME_CHOICES, max_length=10, default='system')
+    ui_density = models.CharField(choices=UI_DENSITY_CHOICES, max_length=15, default='comfortable')
+    ui_landing_page = models.CharField(choices=UI_LANDING_PAGE_CHOICES, max_length=30, default='/')
+    tags = models.CharField(max_length=700, null=True, blank=True) # Comma separated, i.e. client IDs
+    notes = models.TextField(null=True, blank=True)
     
     # More customizations
     REQUIRED_FIELDS = []
@@ -79,11 +108,112 @@ class Notification(models.Model):
         return self.title
 
 
+class NotificationPreference(models.Model):
+    """NotificationPreference model holds the channels a user wants to be notified through per event."""
+    user = models.ForeignKey(User, related_name='notification_preferences', on_delete=models.CASCADE)
+    event = models.CharField(max_length=30)
+    panel = models.BooleanField(default=True)
+    email = models.BooleanField(default=False)
+    
+    class Meta:
+        unique_together = ['user', 'event']
+    
+    def __str__(self):
+        return f'{self.user} {self.event}'
+
+
+NOTIFICATION_CHANNEL_CHOICES = (
+    ('webhook', 'Webhook'),
+    ('slack', 'Slack'),
+    ('discord', 'Discord'),
+)
+
+
+class NotificationChannel(models.Model):
+    """NotificationChannel model holds the webhooks a user wants the notifications posted to."""
+    user = models.ForeignKey(User, related_name='notification_channels', on_delete=models.CASCADE)
+    kind = models.CharField(choices=NOTIFICATION_CHANNEL_CHOICES, max_length=10)
+    url = models.URLField(max_length=500)
+    events = models.TextField(null=True, blank=True) # Comma separated events, all events if empty
+    created = models.DateTimeField(auto_now_add=True)
+    
+    def __str__(self):
+        return f'{self.get_kind_display()} of {self.user}'
+
+
+class LoginDevice(models.Model):
+    """LoginDevice model holds the devices users have signed in to the panel from."""
+    user = models.ForeignKey(User, related_name='devices', on_delete=models.CASCADE)
+    token_hash = models.CharField(max_length=64, unique=True)
+    label = models.CharField(max_length=50, null=True, blank=True)
+    user_agent = models.CharField(max_length=255, null=True, blank=True)
+    ip_addr = models.GenericIPAddressField(null=True, blank=True)
+    revoked = models.BooleanField(default=False)
+    last_seen = models.DateTimeField(auto_now=True)
+    created = models.DateTimeField(auto_now_add=True)
+    
+    def __str__(self):
+        return self.label or self.user_agent or str(self.id)
+
+
+DNS_PROVIDER_CHOICES = (
+    ('cloudflare', 'Cloudflare'),
+    ('route53', 'Amazon Route 53'),
+    ('digitalocean', 'DigitalOcean'),
+)
+
+
+class DnsCredential(models.Model):
+    """DnsCredential model holds the DNS provider API credentials used for the DNS-01 SSL challenges."""
+    user = models.ForeignKey(User, related_name='dns_credentials', on_delete=models.CASCADE)
+    provider = models.CharField(choices=DNS_PROVIDER_CHOICES, max_length=20)
+    label = models.CharField(max_length=50)
+    credentials = models.TextField() # Encrypted JSON of the provider specific keys, never returned by the API
+    created = models.DateTimeField(auto_now_add=True)
+    
+    def get_credentials(self) -> dict:
+        return json.loads(vault.decrypt(self.credentials))
+    
+    def set_credentials(self, credentials: dict) -> None:
+        self.credentials = vault.encrypt(json.dumps(credentials))
+    
+    def __str__(self):
+        return self.label
+
+
 php_versions = PhpVersionListService().get_php_versions()
 
 PHP_CHOICES = ()
 for v in php_versions:
     PHP_CHOICES += ((v, f'PHP {v}'),)
+
+DEV_EXTENSION_CHOICES = (
+    ('xdebug', 'Xdebug'),
+    ('pcov', 'PCOV'),
+)
+
+BACKEND_CHOICES = (
+    ('apache', 'NGINX + Apache'),
+    ('nginx', 'NGINX only'),
+    ('proxy', 'NGINX reverse proxy to an app'),
+)
+
+ACL_PROFILE_CHOICES = (
+    ('strict', 'Owner only'),
+    ('shared-group', 'Shared with a group of collaborators'),
+    ('www-data', 'Readable by www-data'),
+)
+
+WAF_MODE_CHOICES = (
+    ('block', 'Block the attacks'),
+    ('detect', 'Only log the attacks'),
+)
+
+CANONICAL_HOST_CHOICES = (
+    ('none', 'No preference'),
+    ('www', 'Prefer www'),
+    ('apex', 'Prefer non-www'),
+)
     
 class Website(models.Model):
     """Website model holds the websites owned by users."""
@@ -93,6 +223,38 @@ class Website(models.Model):
     slug = models.SlugField(max_length=50, unique=True, null=True, blank=True)
     php = models.CharField(choices=PHP_CHOICES, max_length=20)
     is_wp = models.BooleanField(default=False)
+    force_https = models.BooleanField(default=False)
+    canonical_host = models.CharField(choices=CANONICAL_HOST_CHOICES, max_length=10, default='none')
+    mirror_to = models.ForeignKey('self', related_name='mirrored_from', null=True, blank=True, on_delete=models.SET_NULL)
+    mirror_percent = models.IntegerField(default=0)
+    dev_extension = models.CharField(choices=DEV_EXTENSION_CHOICES, max_length=10, default='xdebug')
+    dev_mode_until = models.DateTimeField(null=True, blank=True)
+    debug_mode = models.BooleanField(default=False)
+    debug_ips = models.TextField(null=True, blank=True)
+    debug_key = models.CharField(max_length=64, null=True, blank=True)
+    backend = models.CharField(choices=BACKEND_CHOICES, max_length=10, null=True, blank=True) # None means the server default
+    dns_credential = models.ForeignKey(DnsCredential, related_name='websites', null=True, blank=True, on_delete=models.SET_NULL)
+    acl_profile = models.CharField(choices=ACL_PROFILE_CHOICES, max_length=20, default='strict')
+    acl_group = models.CharField(max_length=32, null=True, blank=True) # The collaborators group of the shared-group profile
+    watch_ownership = models.BooleanField(default=False) # Fix the ownership of new files as they are created
+    php_extensions = models.TextField(null=True, blank=True) # Comma separated extensions loaded in the pool of the website only
+    scan_uploads = models.BooleanField(default=False) # Scan the PHP files dropped in the uploads directories and quarantine malware
+    immutable_files = models.TextField(null=True, blank=True) # Comma separated files of the public directory locked with chattr +i
+    monitor_integrity = models.BooleanField(default=False) # Compare the PHP files with a known good baseline periodically
+    traffic_quota_gb = models.IntegerField(default=0) # Monthly traffic quota, 0 means unlimited
+    quota_exceeded = models.BooleanField(default=False) # Serving the quota exceeded page until the next month
+    waf_enabled = models.BooleanField(default=False) # Filter the requests with ModSecurity and the OWASP Core Rule Set
+    waf_mode = models.CharField(choices=WAF_MODE_CHOICES, max_length=10, default='block')
+    waf_paranoia = models.IntegerField(default=1) # CRS paranoia level from 1 to 4, higher levels catch more with more false positives
+    waf_disabled_rules = models.TextField(null=True, blank=True) # Comma separated CRS rule IDs that cause false positives
+    seo_checks = models.BooleanField(default=False) # Check the sitemap, robots.txt, noindex and HTTPS daily
+    allowed_ips = models.TextField(null=True, blank=True) # Comma separated IPs and CIDR networks, only these are served if set
+    denied_ips = models.TextField(null=True, blank=True) # Comma separated IPs and CIDR networks that are refused
+    blocked_countries = models.TextField(null=True, blank=True) # Comma separated ISO country codes refused with the MaxMind GeoIP2 database
+    proxy_upstream = models.CharField(max_length=255, null=True, blank=True) # The local port or unix socket of the app of the proxy backend, i.e. 127.0.0.1:20000
+    app_command = models.TextField(null=True, blank=True) # The command systemd keeps the app of the proxy backend running with, None if it runs elsewhere
+    tags = models.CharField(max_length=700, null=True, blank=True) # Comma separated, i.e. client IDs
+    notes = models.TextField(null=True, blank=True)
     created = models.DateTimeField(auto_now_add=True)
     
     def save(self, *args, **kwargs):
@@ -123,6 +285,47 @@ class Website(models.Model):
             'ip_addr': settings.SERVER_IP_ADDR
         }
     
+    def host_redirects(self) -> list:
+        """Returns the (source, target) domain pairs to redirect.
+        
+        Domains with an explicit redirect, like the old domain after a domain change, come first. Then the
+        domains are redirected as per the canonical host preference, but only if their www or non-www
+        counterpart belongs to this website as well.
+        """
+        domains = set(self.domains.filter(redirect_to__isnull=True).values_list('domain', flat=True))
+        redirects = list(self.domains.filter(redirect_to__isnull=False).order_by('domain').values_list('domain', 'redirect_to'))
+        for domain in sorted(domains):
+            if self.canonical_host == 'www' and not domain.startswith('www.') and f'www.{domain}' in domains:
+                redirects.append((domain, f'www.{domain}'))
+            elif self.canonical_host == 'apex' and domain.startswith('www.') and domain[4:] in domains:
+                redirects.append((domain, domain[4:]))
+        return redirects
+    
+    def mirror_config(self) -> dict:
+        """Returns the traffic mirroring details for the vhost or None if mirroring is off."""
+        if not self.mirror_to or self.mirror_percent <= 0:
+            return None
+        
+        target = self.mirror_to.domains.first()
+        if not target:
+            return None
+        
+        return {
+            'var': f'$fastcp_mirror_{self.slug.replace("-", "_")}',
+            'percent': min(self.mirror_percent, 100),
+            'host': target.domain,
+            'backend': self.mirror_to.get_backend(),
+            'https': self.mirror_to.has_ssl
+        }
+    
+    def get_backend(self) -> str:
+        """Returns the web server backend of the website, falls back to the server default."""
+        return self.backend or settings.FASTCP_WEBSERVER_BACKEND
+    
+    def debug_allowed_ips(self) -> list:
+        """Returns the IPs allowed to see the debug error pages."""
+        return list(filter(None, [ip.strip() for ip in (self.debug_ips or '').split(',')]))
+    
     def needs_ssl(self) -> bool:
         """Check either website needs SSL or not."""
         return self.domains.filter(ssl=False).count() > 0
@@ -136,16 +339,374 @@ class Domain(models.Model):
     ssl_error = models.TextField(null=True, blank=True)
     ssl_retries = models.IntegerField(default=0)
     ssl_attempted = models.DateTimeField(null=True, blank=True)
+    redirect_to = models.CharField(max_length=100, null=True, blank=True)
+    wildcard = models.BooleanField(default=False) # Serve and get SSL for the subdomains too, needs DNS-01
     
     def __str__(self):
         return self.domain
 
+DATABASE_ENGINE_CHOICES = (
+    ('mysql', 'MySQL'),
+    ('postgresql', 'PostgreSQL'),
+)
+
+
 class Database(models.Model):
-    """Database model holds the MySQL databases."""
+    """Database model holds the MySQL and PostgreSQL databases."""
     user = models.ForeignKey(User, related_name='databases', on_delete=models.CASCADE)
     name = models.SlugField(max_length=50, unique=True)
     username = models.SlugField(max_length=50, unique=True)
+    engine = models.CharField(choices=DATABASE_ENGINE_CHOICES, max_length=20, default='mysql')
+    created = models.DateTimeField(auto_now_add=True)
+    
+    def __str__(self):
+        return self.name
+
+
+class SiteCheck(models.Model):
+    """SiteCheck model holds the URLs of a website that are checked periodically."""
+    website = models.ForeignKey(Website, related_name='checks', on_delete=models.CASCADE)
+    path = models.CharField(max_length=255, default='/')
+    max_ms = models.IntegerField(default=3000)
+    created = models.DateTimeField(auto_now_add=True)
+    
+    class Meta:
+        unique_together = ['website', 'path']
+    
+    def __str__(self):
+        return f'{self.website} {self.path}'
+
+class Probe(models.Model):
+    """Probe model holds the external probes that run the site checks from outside the server."""
+    name = models.SlugField(max_length=50, unique=True)
+    token_hash = models.CharField(max_length=64, unique=True)
+    last_seen = models.DateTimeField(null=True, blank=True)
+    created = models.DateTimeField(auto_now_add=True)
+    
+    def __str__(self):
+        return self.name
+
+class CheckResult(models.Model):
+    """CheckResult model holds the outcome of a single run of a site check."""
+    site_check = models.ForeignKey(SiteCheck, related_name='results', on_delete=models.CASCADE)
+    source = models.CharField(max_length=50, default='local')
+    status_code = models.IntegerField(null=True, blank=True)
+    ttfb = models.IntegerField(null=True, blank=True)
+    latency = models.IntegerField(null=True, blank=True)
+    error = models.CharField(max_length=255, null=True, blank=True)
+    created = models.DateTimeField(auto_now_add=True, db_index=True)
+    
+    @property
+    def healthy(self) -> bool:
+        """A check is healthy if the URL responded without a server error in time."""
+        return self.status_code is not None and self.status_code < 500 and (self.latency or 0) <= self.site_check.max_ms
+
+
+
+class MailDomain(models.Model):
+    """MailDomain model holds the domains of the websites that receive emails on this server."""
+    website = models.ForeignKey(Website, related_name='mail_domains', on_delete=models.CASCADE)
+    domain = models.CharField(max_length=100, unique=True)
+    dkim_selector = models.CharField(max_length=30, default='fastcp')
+    dkim_public_key = models.TextField(null=True, blank=True)
+    created = models.DateTimeField(auto_now_add=True)
+    
+    def __str__(self):
+        return self.domain
+
+
+class Mailbox(models.Model):
+    """Mailbox model holds the mailboxes of the mail domains, served by Postfix and Dovecot."""
+    mail_domain = models.ForeignKey(MailDomain, related_name='mailboxes', on_delete=models.CASCADE)
+    local_part = models.CharField(max_length=64)
+    password_hash = models.CharField(max_length=255)
+    quota_mb = models.IntegerField(default=1024) # 0 means unlimited
+    created = models.DateTimeField(auto_now_add=True)
+    
+    class Meta:
+        unique_together = ['mail_domain', 'local_part']
+    
+    @property
+    def address(self) -> str:
+        return f'{self.local_part}@{self.mail_domain.domain}'
+    
+    def __str__(self):
+        return self.address
+
+
+DNS_BACKEND_CHOICES = (
+    ('local', 'Local BIND'),
+    ('external', 'External DNS provider'),
+)
+
+DNS_RECORD_TYPES = ['A', 'AAAA', 'CNAME', 'MX', 'TXT', 'NS', 'SRV', 'CAA']
+
+
+class DnsZone(models.Model):
+    """DnsZone model holds the DNS zones of the domains, served by the local BIND or an external provider."""
+    user = models.ForeignKey(User, related_name='dns_zones', on_delete=models.CASCADE)
+    domain = models.CharField(max_length=100, unique=True)
+    backend = models.CharField(choices=DNS_BACKEND_CHOICES, max_length=10, default='local')
+    dns_credential = models.ForeignKey(DnsCredential, related_name='zones', null=True, blank=True, on_delete=models.SET_NULL)
+    serial = models.BigIntegerField(default=0)
+    created = models.DateTimeField(auto_now_add=True)
+    
+    def __str__(self):
+        return self.domain
+
+
+class DnsRecord(models.Model):
+    """DnsRecord model holds the records of a DNS zone. The name is relative to the zone, @ is the apex."""
+    zone = models.ForeignKey(DnsZone, related_name='records', on_delete=models.CASCADE)
+    name = models.CharField(max_length=255, default='@')
+    type = models.CharField(max_length=10)
+    value = models.TextField()
+    ttl = models.IntegerField(default=3600)
+    provider_ref = models.TextField(null=True, blank=True) # JSON reference of the record at the external provider
+    created = models.DateTimeField(auto_now_add=True)
+    
+    @property
+    def fqdn(self) -> str:
+        return self.zone.domain if self.name == '@' else f'{self.name}.{self.zone.domain}'
+    
+    def __str__(self):
+        return f'{self.fqdn} {self.type} {self.value}'
+
+
+class SftpAccount(models.Model):
+    """SftpAccount model holds the virtual SFTP accounts of the websites. They are served by ProFTPD as the
+    owner of the website and are jailed to its public directory, no system user is created for them."""
+    website = models.ForeignKey(Website, related_name='sftp_accounts', on_delete=models.CASCADE)
+    username = models.CharField(max_length=50, unique=True)
+    password_hash = models.CharField(max_length=255)
+    read_only = models.BooleanField(default=False)
     created = models.DateTimeField(auto_now_add=True)
     
     def __str__(self):
-        return self.name
\ No newline at end of file
+        return self.username
+
+
+class ProtectedPath(models.Model):
+    """ProtectedPath model holds the HTTP basic auth credentials of the paths of the websites. The paths with
+    several credentials accept any of them, and the passwords are stored as bcrypt hashes."""
+    website = models.ForeignKey(Website, related_name='protected_paths', on_delete=models.CASCADE)
+    path = models.CharField(max_length=255, default='/')
+    username = models.CharField(max_length=50)
+    password_hash = models.CharField(max_length=255)
+    created = models.DateTimeField(auto_now_add=True)
+
+    class Meta:
+        unique_together = ['website', 'path', 'username']
+
+    def __str__(self):
+        return f'{self.username}@{self.path}'
+
+
+class Snippet(models.Model):
+    """Snippet model holds the custom NGINX directives of the websites, i.e. headers and redirects. They are
+    included in the server blocks of the website and only kept if NGINX accepts them."""
+    website = models.ForeignKey(Website, related_name='snippets', on_delete=models.CASCADE)
+    name = models.SlugField(max_length=50)
+    content = models.TextField()
+    enabled = models.BooleanField(default=True)
+    created = models.DateTimeField(auto_now_add=True)
+    updated = models.DateTimeField(auto_now=True)
+
+    class Meta:
+        unique_together = ['website', 'name']
+
+    def __str__(self):
+        return self.name
+
+
+OPERATION_STATE_CHOICES = (
+    ('running', 'Running'),
+    ('done', 'Done'),
+    ('failed', 'Failed'),
+    ('recovered', 'Recovered'),
+)
+
+
+class Operation(models.Model):
+    """Operation model is the journal of the multi-step system operations, so an operation interrupted by a
+    crash can be resumed from the step it stopped at."""
+    kind = models.CharField(max_length=30)
+    target = models.CharField(max_length=100)
+    params = models.TextField() # JSON of what the steps need, the target object may be gone on replay
+    completed = models.IntegerField(default=0) # Number of the steps done
+    state = models.CharField(choices=OPERATION_STATE_CHOICES, max_length=10, default='running')
+    pid = models.IntegerField(null=True, blank=True)
+    error = models.TextField(null=True, blank=True)
+    started = models.DateTimeField(auto_now_add=True)
+    finished = models.DateTimeField(null=True, blank=True)
+    
+    def __str__(self):
+        return f'{self.kind} {self.target}'
+
+
+JOB_STATE_CHOICES = (
+    ('queued', 'Queued'),
+    ('running', 'Running'),
+    ('done', 'Done'),
+    ('failed', 'Failed'),
+)
+
+
+class Job(models.Model):
+    """Job model holds the long running tasks that run in the background, along with their progress and
+    results, so the API can return right away and the panel can poll the job."""
+    user = models.ForeignKey(User, related_name='jobs', on_delete=models.CASCADE)
+    kind = models.CharField(max_length=30)
+    target = models.CharField(max_length=100)
+    params = models.TextField(default='{}')
+    state = models.CharField(choices=JOB_STATE_CHOICES, max_length=10, default='queued')
+    progress = models.IntegerField(default=0)
+    total = models.IntegerField(default=0)
+    results = models.TextField(null=True, blank=True) # JSON of the job specific results
+    error = models.TextField(null=True, blank=True)
+    pid = models.IntegerField(null=True, blank=True) # The panel process running the job, a dead one means the job was cut off
+    created = models.DateTimeField(auto_now_add=True)
+    finished = models.DateTimeField(null=True, blank=True)
+    
+    def __str__(self):
+        return f'{self.kind} {self.target}'
+
+
+class Staging(models.Model):
+    """Staging model links a staging copy of a website, and of its database if any, to the production website."""
+    production = models.OneToOneField(Website, related_name='staging', on_delete=models.CASCADE)
+    website = models.OneToOneField(Website, related_name='staging_of', on_delete=models.CASCADE)
+    database = models.ForeignKey(Database, related_name='stagings', null=True, blank=True, on_delete=models.SET_NULL)
+    staging_database = models.OneToOneField(Database, related_name='staging_of', null=True, blank=True, on_delete=models.SET_NULL)
+    pushed = models.DateTimeField(null=True, blank=True)
+    created = models.DateTimeField(auto_now_add=True)
+    
+    def __str__(self):
+        return f'{self.website} of {self.production}'
+
+
+class OomEvent(models.Model):
+    """OomEvent model holds the processes of users killed by the kernel OOM killer."""
+    user = models.ForeignKey(User, related_name='oom_events', on_delete=models.CASCADE)
+    pid = models.IntegerField()
+    process = models.CharField(max_length=100)
+    cgroup = models.BooleanField(default=False) # Killed for hitting a memory limit rather than the server running out of memory
+    rss_kb = models.IntegerField(default=0)
+    occurred = models.DateTimeField(db_index=True)
+    
+    class Meta:
+        unique_together = ['user', 'pid', 'occurred']
+    
+    def __str__(self):
+        return f'{self.process} ({self.pid}) of {self.user}'
+
+
+class QuarantinedFile(models.Model):
+    """QuarantinedFile model holds the uploaded files moved out of a website because they look malicious."""
+    website = models.ForeignKey(Website, related_name='quarantined_files', on_delete=models.CASCADE)
+    path = models.CharField(max_length=1000) # Where the file was found
+    quarantine_path = models.CharField(max_length=1000)
+    signature = models.CharField(max_length=255)
+    size = models.BigIntegerField(default=0)
+    restored = models.BooleanField(default=False) # Restored as a false positive, the same file is not quarantined again
+    created = models.DateTimeField(auto_now_add=True)
+    
+    def __str__(self):
+        return f'{self.path} ({self.signature})'
+
+
+USAGE_RESOLUTION_CHOICES = (
+    ('5min', '5 minutes'),
+    ('hour', 'Hour'),
+)
+
+
+class UsageSample(models.Model):
+    """UsageSample model holds the resource usage of a user, or of a website if set, over an interval."""
+    user = models.ForeignKey(User, related_name='usage_samples', on_delete=models.CASCADE)
+    website = models.ForeignKey(Website, related_name='usage_samples', null=True, blank=True, on_delete=models.CASCADE)
+    resolution = models.CharField(choices=USAGE_RESOLUTION_CHOICES, max_length=10, default='5min')
+    time = models.DateTimeField(db_index=True) # The start of the interval
+    cpu_seconds = models.FloatField(default=0) # CPU time used in the interval
+    memory_bytes = models.BigIntegerField(default=0)
+    disk_bytes = models.BigIntegerField(default=0)
+    bytes_sent = models.BigIntegerField(default=0) # Response bytes sent in the interval
+    requests = models.BigIntegerField(default=0)
+    
+    def __str__(self):
+        return f'{self.website or self.user} at {self.time}'
+
+
+class TrafficMonth(models.Model):
+    """TrafficMonth model holds the traffic a website served in a month."""
+    website = models.ForeignKey(Website, related_name='traffic_months', on_delete=models.CASCADE)
+    month = models.DateField() # The first day of the month
+    bytes_sent = models.BigIntegerField(default=0)
+    requests = models.BigIntegerField(default=0)
+    
+    class Meta:
+        unique_together = ['website', 'month']
+    
+    def __str__(self):
+        return f'{self.website} in {self.month:%Y-%m}'
+
+
+TASK_SCHEDULE_CHOICES = (
+    ('calendar', 'Calendar'),
+    ('interval', 'Interval'),
+    ('boot', 'After boot'),
+)
+
+
+class ScheduledTask(models.Model):
+    """ScheduledTask model holds the commands of the SSH users run by systemd timers, as an alternative to crontab."""
+    user = models.ForeignKey(User, related_name='scheduled_tasks', on_delete=models.CASCADE)
+    name = models.CharField(max_length=50)
+    command = models.TextField()
+    schedule_type = models.CharField(choices=TASK_SCHEDULE_CHOICES, max_length=10, default='calendar')
+    schedule = models.CharField(max_length=100) # OnCalendar expression, or the seconds of the interval or after boot
+    enabled = models.BooleanField(default=True)
+    created = models.DateTimeField(auto_now_add=True)
+    
+    def __str__(self):
+        return f'{self.name} of {self.user}'
+
+
+AUDIT_SOURCE_CHOICES = (
+    ('api', 'API'),
+    ('job', 'Background job'),
+)
+
+AUDIT_RESULT_CHOICES = (
+    ('success', 'Success'),
+    ('failure', 'Failure'),
+)
+
+
+class AuditEntry(models.Model):
+    """AuditEntry model records the API calls that changed something and the background jobs they started.
+    The entries are append only, they cannot be changed or deleted once saved."""
+    user = models.ForeignKey(User, related_name='audit_entries', null=True, blank=True, on_delete=models.SET_NULL)
+    username = models.CharField(max_length=150) # Kept when the user is deleted
+    ip = models.GenericIPAddressField(null=True, blank=True)
+    source = models.CharField(choices=AUDIT_SOURCE_CHOICES, max_length=10, default='api')
+    method = models.CharField(max_length=10, null=True, blank=True)
+    path = models.CharField(max_length=255, null=True, blank=True)
+    action = models.CharField(max_length=150) # The view name, i.e. api:websites:website-detail, or the job kind
+    target = models.CharField(max_length=255, null=True, blank=True)
+    status = models.IntegerField(null=True, blank=True)
+    result = models.CharField(choices=AUDIT_RESULT_CHOICES, max_length=10)
+    request_id = models.CharField(max_length=64, db_index=True)
+    created = models.DateTimeField(auto_now_add=True, db_index=True)
+    
+    def save(self, *args, **kwargs):
+        if self.pk:
+            raise ValueError('Audit entries cannot be changed.')
+        super().save(*args, **kwargs)
+    
+    def delete(self, *args, **kwargs):
+        raise ValueError('Audit entries cannot be deleted.')
+    
+    def __str__(self):
+        return f'{self.action} by {self.username}'
+
@@ -1,13 +1,16 @@
+import os, sys, copy, logging
 import django.dispatch
+from django.core.signals import got_request_exception
 from django.db.models.signals import (
-    post_save, pre_delete
+    post_save, pre_delete, post_delete, pre_migrate
 )
 from django.dispatch import receiver
-from core.models import Website, User, Database
+from core.models import Website, User, Database, ScheduledTask
 from core.utils import system as fcpsys
-from core.utils import filesystem
+from core.utils import filesystem, webservers, mail, sftp, telemetry, timers, schema, proxyapps, logfiles
 
 
+logger = logging.getLogger('fastcp.signals')
 
 # This signal will be sent when PHP version
 # of a website is updated.
@@ -22,14 +25,17 @@ install_wp = django.dispatch.Signal()
 def update_php_handler(sender, **kwargs):
     """Update PHP conf.
     
-    Update the PHP-FPM pool configuration for the specified website.
+    Update the PHP-FPM pool configuration for the specified website. The pool of the previous version is
+    only removed once the new one has been written, so the website keeps working if it's rejected.
     """
-    filesystem.delete_fpm_conf(sender)
-    old_version = sender.php
-    new_version = kwargs.get('new_version')
-    sender.php = new_version
+    previous = copy.copy(sender)
+    sender.php = kwargs.get('new_version')
+    if not filesystem.generate_fpm_conf(sender):
+        sender.php = previous.php
+        return False
     sender.save()
-    filesystem.generate_fpm_conf(sender)
+    filesystem.delete_fpm_conf(previous)
+    return True
 
 update_php.connect(update_php_handler, dispatch_uid='update-php-conf')
 
@@ -49,25 +55,33 @@ def domains_updated_handler(sender, **kwargs):
     
     Update the vhost conf files once a website's domains are updated.
     """
-    # Create NGINX vhost
-    filesystem.create_nginx_vhost(sender)
-    
-    if not kwargs.get('only_nginx'):
-        filesystem.create_apache_vhost(sender)
+    # Create the vhosts of the web server backend of the website
+    webservers.get_backend(sender).write_vhosts(sender, only_nginx=kwargs.get('only_nginx', False))
     
 domains_updated.connect(domains_updated_handler, dispatch_uid='domains-updated')
 
 @receiver(post_save, sender=Website)
 def setup_website(sender, instance=None, created=False, **kwargs):
     """Executes when a website is created at first. We will create the data."""
-    if created:
-        fcpsys.setup_website(instance)
+    if created and not fcpsys.setup_website(instance):
+        # The admins have been notified of the rejected pool already
+        logger.error('The PHP-FPM pool of %s cannot be written.', instance)
 
 
 @receiver(pre_delete, sender=Website)
 def delete_website(sender, instance=None, **kwargs):
     """Executes when a website is deleted. We will clean the data then."""
+    proxyapps.remove_unit(instance)
     fcpsys.delete_website(instance)
+
+
+@receiver(post_delete, sender=Website)
+def sync_mail_and_sftp(sender, instance=None, **kwargs):
+    """Executes after a website is deleted, its mail domains and SFTP accounts are gone so the mail and SFTP configs are synced."""
+    if os.path.exists(mail.POSTFIX_DOMAINS_PATH):
+        mail.sync_mail_config()
+    if os.path.exists(sftp.PROFTPD_PASSWD_PATH):
+        sftp.sync_sftp_accounts()
     
 
 def create_user_handler(sender, **kwargs):
@@ -77,6 +91,8 @@ def create_user_handler(sender, **kwargs):
     sender.save()
     if not sender.is_superuser:
         fcpsys.setup_user(sender, password=kwargs.get('password'))
+        # The logs of the new user are rotated as the user
+        logfiles.write_logrotate()
 create_user.connect(create_user_handler, dispatch_uid='create-user')
     
 
@@ -106,7 +122,26 @@ def delete_user_data(sender=None, instance=None, **kwargs):
 def delete_database(sender=None, instance=None, **kwargs):
     fcpsys.drop_db(instance)
 
+@receiver(pre_delete, sender=ScheduledTask)
+def delete_task_units(sender=None, instance=None, **kwargs):
+    timers.remove_units(instance)
+
 def create_database_handler(sender, **kwargs):
     """Create the database in the system"""
     fcpsys.create_database(sender, password=kwargs.get('password'))
-create_db.connect(create_database_handler)
\ No newline at end of file
+create_db.connect(create_database_handler)
+
+
+@receiver(got_request_exception)
+def report_crash(sender=None, **kwargs):
+    """Records the unhandled exceptions of requests for the opt-in crash reports."""
+    telemetry.record_crash(sys.exc_info()[1])
+
+
+@receiver(pre_migrate)
+def backup_before_migrate(sender=None, plan=None, **kwargs):
+    """Backs the panel database up before the migrations change it. The signal is sent for each app, the
+    backup is taken once, for the core app."""
+    if sender.name == 'core' and plan:
+        schema.backup_database()
+
@@ -1,2 +1,2 @@
 /*! For license information please see fastcp.js.LICENSE.txt */
-(()=>{var t={9669:(t,e,n)=>{t.exports=n(1609)},5448:(t,e,n)=>{"use strict";var r=n(4867),a=n(6026),s=n(4372),i=n(5327),o=n(4097),c=n(4109),l=n(7985),u=n(5061);t.exports=function(t){return new Promise((function(e,n){var d=t.data,f=t.headers;r.isFormData(d)&&delete f["Content-Type"];var p=new XMLHttpRequest;if(t.auth){var v=t.auth.username||"",h=t.auth.password?unescape(encodeURIComponent(t.auth.password)):"";f.Authorization="Basic "+btoa(v+":"+h)}var m=o(t.baseURL,t.url);if(p.open(t.method.toUpperCase(),i(m,t.params,t.paramsSerializer),!0),p.timeout=t.timeout,p.onreadystatechange=function(){if(p&&4===p.readyState&&(0!==p.status||p.responseURL&&0===p.responseURL.indexOf("file:"))){var r="getAllResponseHeaders"in p?c(p.getAllResponseHeaders()):null,s={data:t.responseType&&"text"!==t.responseType?p.response:p.responseText,status:p.status,statusText:p.statusText,headers:r,config:t,request:p};a(e,n,s),p=null}},p.onabort=function(){p&&(n(u("Request aborted",t,"ECONNABORTED",p)),p=null)},p.onerror=function(){n(u("Network Error",t,null,p)),p=null},p.ontimeout=function(){var e="timeout of "+t.timeout+"ms exceeded";t.timeoutErrorMessage&&(e=t.timeoutErrorMessage),n(u(e,t,"ECONNABORTED",p)),p=null},r.isStandardBrowserEnv()){var g=(t.withCredentials||l(m))&&t.xsrfCookieName?s.read(t.xsrfCookieName):void 0;g&&(f[t.xsrfHeaderName]=g)}if("setRequestHeader"in p&&r.forEach(f,(function(t,e){void 0===d&&"content-type"===e.toLowerCase()?delete f[e]:p.setRequestHeader(e,t)})),r.isUndefined(t.withCredentials)||(p.withCredentials=!!t.withCredentials),t.responseType)try{p.responseType=t.responseType}catch(e){if("json"!==t.responseType)throw e}"function"==typeof t.onDownloadProgress&&p.addEventListener("progress",t.onDownloadProgress),"function"==typeof t.onUploadProgress&&p.upload&&p.upload.addEventListener("progress",t.onUploadProgress),t.cancelToken&&t.cancelToken.promise.then((function(t){p&&(p.abort(),n(t),p=null)})),d||(d=null),p.send(d)}))}},1609:(t,e,n)=>{"use strict";var r=n(4867),a=n(1849),s=n(321),i=n(7185);function o(t){var e=new s(t),n=a(s.prototype.request,e);return r.extend(n,s.prototype,e),r.extend(n,e),n}var c=o(n(5655));c.Axios=s,c.create=function(t){return o(i(c.defaults,t))},c.Cancel=n(5263),c.CancelToken=n(4972),c.isCancel=n(6502),c.all=function(t){return Promise.all(t)},c.spread=n(8713),c.isAxiosError=n(6268),t.exports=c,t.exports.default=c},5263:t=>{"use strict";function e(t){this.message=t}e.prototype.toString=function(){return"Cancel"+(this.message?": "+this.message:"")},e.prototype.__CANCEL__=!0,t.exports=e},4972:(t,e,n)=>{"use strict";var r=n(5263);function a(t){if("function"!=typeof t)throw new TypeError("executor must be a function.");var e;this.promise=new Promise((function(t){e=t}));var n=this;t((function(t){n.reason||(n.reason=new r(t),e(n.reason))}))}a.prototype.throwIfRequested=function(){if(this.reason)throw this.reason},a.source=function(){var t;return{token:new a((function(e){t=e})),cancel:t}},t.exports=a},6502:t=>{"use strict";t.exports=function(t){return!(!t||!t.__CANCEL__)}},321:(t,e,n)=>{"use strict";var r=n(4867),a=n(5327),s=n(782),i=n(3572),o=n(7185);function c(t){this.defaults=t,this.interceptors={request:new s,response:new s}}c.prototype.request=function(t){"string"==typeof t?(t=arguments[1]||{}).url=arguments[0]:t=t||{},(t=o(this.defaults,t)).method?t.method=t.method.toLowerCase():this.defaults.method?t.method=this.defaults.method.toLowerCase():t.method="get";var e=[i,void 0],n=Promise.resolve(t);for(this.interceptors.request.forEach((function(t){e.unshift(t.fulfilled,t.rejected)})),this.interceptors.response.forEach((function(t){e.push(t.fulfilled,t.rejected)}));e.length;)n=n.then(e.shift(),e.shift());return n},c.prototype.getUri=function(t){return t=o(this.defaults,t),a(t.url,t.params,t.paramsSerializer).replace(/^\?/,"")},r.forEach(["delete","get","head","options"],(function(t){c.prototype[t]=function(e,n){return this.request(o(n||{},{method:t,url:e,data:(n||{}).data}))}})),r.forEach(["post","put","patch"],(function(t){c.prototype[t]=function(e,n,r){return this.request(o(r||{},{method:t,url:e,data:n}))}})),t.exports=c},782:(t,e,n)=>{"use strict";var r=n(4867);function a(){this.handlers=[]}a.prototype.use=function(t,e){return this.handlers.push({fulfilled:t,rejected:e}),this.handlers.length-1},a.prototype.eject=function(t){this.handlers[t]&&(this.handlers[t]=null)},a.prototype.forEach=function(t){r.forEach(this.handlers,(function(e){null!==e&&t(e)}))},t.exports=a},4097:(t,e,n)=>{"use strict";var r=n(1793),a=n(7303);t.exports=function(t,e){return t&&!r(e)?a(t,e):e}},5061:(t,e,n)=>{"use strict";var r=n(481);t.exports=function(t,e,n,a,s){var i=new Error(t);return r(i,e,n,a,s)}},3572:(t,e,n)=>{"use strict";var r=n(4867),a=n(8527),s=n(6502),i=n(5655);function o(t){t.cancelToken&&t.cancelToken.throwIfRequested()}t.exports=function(t){return o(t),t.headers=t.headers||{},t.data=a(t.data,t.headers,t.transformRequest),t.headers=r.merge(t.headers.common||{},t.headers[t.method]||{},t.headers),r.forEach(["delete","get","head","post","put","patch","common"],(function(e){delete t.headers[e]})),(t.adapter||i.adapter)(t).then((function(e){return o(t),e.data=a(e.data,e.headers,t.transformResponse),e}),(function(e){return s(e)||(o(t),e&&e.response&&(e.response.data=a(e.response.data,e.response.headers,t.transformResponse))),Promise.reject(e)}))}},481:t=>{"use strict";t.exports=function(t,e,n,r,a){return t.config=e,n&&(t.code=n),t.request=r,t.response=a,t.isAxiosError=!0,t.toJSON=function(){return{message:this.message,name:this.name,description:this.description,number:this.number,fileName:this.fileName,lineNumber:this.lineNumber,columnNumber:this.columnNumber,stack:this.stack,config:this.config,code:this.code}},t}},7185:(t,e,n)=>{"use strict";var r=n(4867);t.exports=function(t,e){e=e||{};var n={},a=["url","method","data"],s=["headers","auth","proxy","params"],i=["baseURL","transformRequest","transformResponse","paramsSerializer","timeout","timeoutMessage","withCredentials","adapter","responseType","xsrfCookieName","xsrfHeaderName","onUploadProgress","onDownloadProgress","decompress","maxContentLength","maxBodyLength","maxRedirects","transport","httpAgent","httpsAgent","cancelToken","socketPath","responseEncoding"],o=["validateStatus"];function c(t,e){return r.isPlainObject(t)&&r.isPlainObject(e)?r.merge(t,e):r.isPlainObject(e)?r.merge({},e):r.isArray(e)?e.slice():e}function l(a){r.isUndefined(e[a])?r.isUndefined(t[a])||(n[a]=c(void 0,t[a])):n[a]=c(t[a],e[a])}r.forEach(a,(function(t){r.isUndefined(e[t])||(n[t]=c(void 0,e[t]))})),r.forEach(s,l),r.forEach(i,(function(a){r.isUndefined(e[a])?r.isUndefined(t[a])||(n[a]=c(void 0,t[a])):n[a]=c(void 0,e[a])})),r.forEach(o,(function(r){r in e?n[r]=c(t[r],e[r]):r in t&&(n[r]=c(void 0,t[r]))}));var u=a.concat(s).concat(i).concat(o),d=Object.keys(t).concat(Object.keys(e)).filter((function(t){return-1===u.indexOf(t)}));return r.forEach(d,l),n}},6026:(t,e,n)=>{"use strict";var r=n(5061);t.exports=function(t,e,n){var a=n.config.validateStatus;n.status&&a&&!a(n.status)?e(r("Request failed with status code "+n.status,n.config,null,n.request,n)):t(n)}},8527:(t,e,n)=>{"use strict";var r=n(4867);t.exports=function(t,e,n){return r.forEach(n,(function(n){t=n(t,e)})),t}},5655:(t,e,n)=>{"use strict";var r=n(4155),a=n(4867),s=n(6016),i={"Content-Type":"application/x-www-form-urlencoded"};function o(t,e){!a.isUndefined(t)&&a.isUndefined(t["Content-Type"])&&(t["Content-Type"]=e)}var c,l={adapter:(("undefined"!=typeof XMLHttpRequest||void 0!==r&&"[object process]"===Object.prototype.toString.call(r))&&(c=n(5448)),c),transformRequest:[function(t,e){return s(e,"Accept"),s(e,"Content-Type"),a.isFormData(t)||a.isArrayBuffer(t)||a.isBuffer(t)||a.isStream(t)||a.isFile(t)||a.isBlob(t)?t:a.isArrayBufferView(t)?t.buffer:a.isURLSearchParams(t)?(o(e,"application/x-www-form-urlencoded;charset=utf-8"),t.toString()):a.isObject(t)?(o(e,"application/json;charset=utf-8"),JSON.stringify(t)):t}],transformResponse:[function(t){if("string"==typeof t)try{t=JSON.parse(t)}catch(t){}return t}],timeout:0,xsrfCookieName:"XSRF-TOKEN",xsrfHeaderName:"X-XSRF-TOKEN",maxContentLength:-1,maxBodyLength:-1,validateStatus:function(t){return t>=200&&t<300}};l.headers={common:{Accept:"application/json, text/plain, */*"}},a.forEach(["delete","get","head"],(function(t){l.headers[t]={}})),a.forEach(["post","put","patch"],(function(t){l.headers[t]=a.merge(i)})),t.exports=l},1849:t=>{"use strict";t.exports=function(t,e){return function(){for(var n=new Array(arguments.length),r=0;r<n.length;r++)n[r]=arguments[r];return t.apply(e,n)}}},5327:(t,e,n)=>{"use strict";var r=n(4867);function a(t){return encodeURIComponent(t).replace(/%3A/gi,":").replace(/%24/g,"$").replace(/%2C/gi,",").replace(/%20/g,"+").replace(/%5B/gi,"[").replace(/%5D/gi,"]")}t.exports=function(t,e,n){if(!e)return t;var s;if(n)s=n(e);else if(r.isURLSearchParams(e))s=e.toString();else{var i=[];r.forEach(e,(function(t,e){null!=t&&(r.isArray(t)?e+="[]":t=[t],r.forEach(t,(function(t){r.isDate(t)?t=t.toISOString():r.isObject(t)&&(t=JSON.stringify(t)),i.push(a(e)+"="+a(t))})))})),s=i.join("&")}if(s){var o=t.indexOf("#");-1!==o&&(t=t.slice(0,o)),t+=(-1===t.indexOf("?")?"?":"&")+s}return t}},7303:t=>{"use strict";t.exports=function(t,e){return e?t.replace(/\/+$/,"")+"/"+e.replace(/^\/+/,""):t}},4372:(t,e,n)=>{"use strict";var r=n(4867);t.exports=r.isStandardBrowserEnv()?{write:function(t,e,n,a,s,i){var o=[];o.push(t+"="+encodeURIComponent(e)),r.isNumber(n)&&o.push("expires="+new Date(n).toGMTString()),r.isString(a)&&o.push("path="+a),r.isString(s)&&o.push("domain="+s),!0===i&&o.push("secure"),document.cookie=o.join("; ")},read:function(t){var e=document.cookie.match(new RegExp("(^|;\\s*)("+t+")=([^;]*)"));return e?decodeURIComponent(e[3]):null},remove:function(t){this.write(t,"",Date.now()-864e5)}}:{write:function(){},read:function(){return null},remove:function(){}}},1793:t=>{"use strict";t.exports=function(t){return/^([a-z][a-z\d\+\-\.]*:)?\/\//i.test(t)}},6268:t=>{"use strict";t.exports=function(t){return"object"==typeof t&&!0===t.isAxiosError}},7985:(t,e,n)=>{"use strict";var r=n(4867);t.exports=r.isStandardBrowserEnv()?function(){var t,e=/(msie|trident)/i.test(navigator.userAgent),n=document.createElement("a");function a(t){var r=t;return e&&(n.setAttribute("href",r),r=n.href),n.setAttribute("href",r),{href:n.href,protocol:n.protocol?n.protocol.replace(/:$/,""):"",host:n.host,search:n.search?n.search.replace(/^\?/,""):"",hash:n.hash?n.hash.replace(/^#/,""):"",hostname:n.hostname,port:n.port,pathname:"/"===n.pathname.charAt(0)?n.pathname:"/"+n.pathname}}return t=a(window.location.href),function(e){var n=r.isString(e)?a(e):e;return n.protocol===t.protocol&&n.host===t.host}}():function(){return!0}},6016:(t,e,n)=>{"use strict";var r=n(4867);t.exports=function(t,e){r.forEach(t,(function(n,r){r!==e&&r.toUpperCase()===e.toUpperCase()&&(t[e]=n,delete t[r])}))}},4109:(t,e,n)=>{"use strict";var r=n(4867),a=["age","authorization","content-length","content-type","etag","expires","from","host","if-modified-since","if-unmodified-since","last-modified","location","max-forwards","proxy-authorization","referer","retry-after","user-agent"];t.exports=function(t){var e,n,s,i={};return t?(r.forEach(t.split("\n"),(function(t){if(s=t.indexOf(":"),e=r.trim(t.substr(0,s)).toLowerCase(),n=r.trim(t.substr(s+1)),e){if(i[e]&&a.indexOf(e)>=0)return;i[e]="set-cookie"===e?(i[e]?i[e]:[]).concat([n]):i[e]?i[e]+", "+n:n}})),i):i}},8713:t=>{"use strict";t.exports=function(t){return function(e){return t.apply(null,e)}}},4867:(t,e,n)=>{"use strict";var r=n(1849),a=Object.prototype.toString;function s(t){return"[object Array]"===a.call(t)}function i(t){return void 0===t}function o(t){return null!==t&&"object"==typeof t}function c(t){if("[object Object]"!==a.call(t))return!1;var e=Object.getPrototypeOf(t);return null===e||e===Object.prototype}function l(t){return"[object Function]"===a.call(t)}function u(t,e){if(null!=t)if("object"!=typeof t&&(t=[t]),s(t))for(var n=0,r=t.length;n<r;n++)e.call(null,t[n],n,t);else for(var a in t)Object.prototype.hasOwnProperty.call(t,a)&&e.call(null,t[a],a,t)}t.exports={isArray:s,isArrayBuffer:function(t){return"[object ArrayBuffer]"===a.call(t)},isBuffer:function(t){return null!==t&&!i(t)&&null!==t.constructor&&!i(t.constructor)&&"function"==typeof t.constructor.isBuffer&&t.constructor.isBuffer(t)},isFormData:function(t){return"undefined"!=typeof FormData&&t instanceof FormData},isArrayBufferView:function(t){return"undefined"!=typeof ArrayBuffer&&ArrayBuffer.isView?ArrayBuffer.isView(t):t&&t.buffer&&t.buffer instanceof ArrayBuffer},isString:function(t){return"string"==typeof t},isNumber:function(t){return"number"==typeof t},isObject:o,isPlainObject:c,isUndefined:i,isDate:function(t){return"[object Date]"===a.call(t)},isFile:function(t){return"[object File]"===a.call(t)},isBlob:function(t){return"[object Blob]"===a.call(t)},isFunction:l,isStream:function(t){return o(t)&&l(t.pipe)},isURLSearchParams:function(t){return"undefined"!=typeof URLSearchParams&&t instanceof URLSearchParams},isStandardBrowserEnv:function(){return("undefined"==typeof navigator||"ReactNative"!==navigator.product&&"NativeScript"!==navigator.product&&"NS"!==navigator.product)&&("undefined"!=typeof window&&"undefined"!=typeof document)},forEach:u,merge:function t(){var e={};function n(n,r){c(e[r])&&c(n)?e[r]=t(e[r],n):c(n)?e[r]=t({},n):s(n)?e[r]=n.slice():e[r]=n}for(var r=0,a=arguments.length;r<a;r++)u(arguments[r],n);return e},extend:function(t,e,n){return u(e,(function(e,a){t[a]=n&&"function"==typeof e?r(e,n):e})),t},trim:function(t){return t.replace(/^\s*/,"").replace(/\s*$/,"")},stripBOM:function(t){return 65279===t.charCodeAt(0)&&(t=t.slice(1)),t}}},9147:(t,e,n)=>{window.axios=n(9669),window.axios.defaults.xsrfHeaderName="X-CSRFToken",window.axios.defaults.xsrfCookieName="csrftoken",window.axios.defaults.headers.common["X-Requested-With"]="XMLHttpRequest"},6369:(t,e,n)=>{"use strict";n.d(e,{Z:()=>s});var r=n(3645),a=n.n(r)()((function(t){return t[1]}));a.push([t.id,".vld-overlay,.vld-shown{overflow:hidden}.vld-overlay{align-items:center;bottom:0;display:none;justify-content:center;left:0;position:absolute;right:0;top:0;z-index:9999}.vld-overlay.is-active{display:flex}.vld-overlay.is-full-page{position:fixed;z-index:9999}.vld-overlay .vld-background{background:#fff;bottom:0;left:0;opacity:.5;position:absolute;right:0;top:0}.vld-overlay .vld-icon,.vld-parent{position:relative}",""]);const s=a},5476:(t,e,n)=>{"use strict";n.d(e,{Z:()=>s});var r=n(3645),a=n.n(r)()((function(t){return t[1]}));a.push([t.id,"tbody tr[data-v-67504978]{cursor:pointer}tbody tr[data-v-67504978]:hover{background-color:#ebfaff!important}.file-toolbar[data-v-67504978]{font-size:15px}.file-toolbar a[data-v-67504978]{text-decoration:none}select[data-v-67504978]{height:30px}",""]);const s=a},3645:t=>{"use strict";t.exports=function(t){var e=[];return e.toString=function(){return this.map((function(e){var n=t(e);return e[2]?"@media ".concat(e[2]," {").concat(n,"}"):n})).join("")},e.i=function(t,n,r){"string"==typeof t&&(t=[[null,t,""]]);var a={};if(r)for(var s=0;s<this.length;s++){var i=this[s][0];null!=i&&(a[i]=!0)}for(var o=0;o<t.length;o++){var c=[].concat(t[o]);r&&a[c[0]]||(n&&(c[2]?c[2]="".concat(n," and ").concat(c[2]):c[2]=n),e.push(c))}},e}},4155:t=>{var e,n,r=t.exports={};function a(){throw new Error("setTimeout has not been defined")}function s(){throw new Error("clearTimeout has not been defined")}function i(t){if(e===setTimeout)return setTimeout(t,0);if((e===a||!e)&&setTimeout)return e=setTimeout,setTimeout(t,0);try{return e(t,0)}catch(n){try{return e.call(null,t,0)}catch(n){return e.call(this,t,0)}}}!function(){try{e="function"==typeof setTimeout?setTimeout:a}catch(t){e=a}try{n="function"==typeof clearTimeout?clearTimeout:s}catch(t){n=s}}();var o,c=[],l=!1,u=-1;function d(){l&&o&&(l=!1,o.length?c=o.concat(c):u=-1,c.length&&f())}function f(){if(!l){var t=i(d);l=!0;for(var e=c.length;e;){for(o=c,c=[];++u<e;)o&&o[u].run();u=-1,e=c.length}o=null,l=!1,function(t){if(n===clearTimeout)return clearTimeout(t);if((n===s||!n)&&clearTimeout)return n=clearTimeout,clearTimeout(t);try{n(t)}catch(e){try{return n.call(null,t)}catch(e){return n.call(this,t)}}}(t)}}function p(t,e){this.fun=t,this.array=e}function v(){}r.nextTick=function(t){var e=new Array(arguments.length-1);if(arguments.length>1)for(var n=1;n<arguments.length;n++)e[n-1]=arguments[n];c.push(new p(t,e)),1!==c.length||l||i(f)},p.prototype.run=function(){this.fun.apply(null,this.array)},r.title="browser",r.browser=!0,r.env={},r.argv=[],r.version="",r.versions={},r.on=v,r.addListener=v,r.once=v,r.off=v,r.removeListener=v,r.removeAllListeners=v,r.emit=v,r.prependListener=v,r.prependOnceListener=v,r.listeners=function(t){return[]},r.binding=function(t){throw new Error("process.binding is not supported")},r.cwd=function(){return"/"},r.chdir=function(t){throw new Error("process.chdir is not supported")},r.umask=function(){return 0}},3379:(t,e,n)=>{"use strict";var r,a=function(){return void 0===r&&(r=Boolean(window&&document&&document.all&&!window.atob)),r},s=function(){var t={};return function(e){if(void 0===t[e]){var n=document.querySelector(e);if(window.HTMLIFrameElement&&n instanceof window.HTMLIFrameElement)try{n=n.contentDocument.head}catch(t){n=null}t[e]=n}return t[e]}}(),i=[];function o(t){for(var e=-1,n=0;n<i.length;n++)if(i[n].identifier===t){e=n;break}return e}function c(t,e){for(var n={},r=[],a=0;a<t.length;a++){var s=t[a],c=e.base?s[0]+e.base:s[0],l=n[c]||0,u="".concat(c," ").concat(l);n[c]=l+1;var d=o(u),f={css:s[1],media:s[2],sourceMap:s[3]};-1!==d?(i[d].references++,i[d].updater(f)):i.push({identifier:u,updater:m(f,e),references:1}),r.push(u)}return r}function l(t){var e=document.createElement("style"),r=t.attributes||{};if(void 0===r.nonce){var a=n.nc;a&&(r.nonce=a)}if(Object.keys(r).forEach((function(t){e.setAttribute(t,r[t])})),"function"==typeof t.insert)t.insert(e);else{var i=s(t.insert||"head");if(!i)throw new Error("Couldn't find a style target. This probably means that the value for the 'insert' parameter is invalid.");i.appendChild(e)}return e}var u,d=(u=[],function(t,e){return u[t]=e,u.filter(Boolean).join("\n")});function f(t,e,n,r){var a=n?"":r.media?"@media ".concat(r.media," {").concat(r.css,"}"):r.css;if(t.styleSheet)t.styleSheet.cssText=d(e,a);else{var s=document.createTextNode(a),i=t.childNodes;i[e]&&t.removeChild(i[e]),i.length?t.insertBefore(s,i[e]):t.appendChild(s)}}function p(t,e,n){var r=n.css,a=n.media,s=n.sourceMap;if(a?t.setAttribute("media",a):t.removeAttribute("media"),s&&"undefined"!=typeof btoa&&(r+="\n/*# sourceMappingURL=data:application/json;base64,".concat(btoa(unescape(encodeURIComponent(JSON.stringify(s))))," */")),t.styleSheet)t.styleSheet.cssText=r;else{for(;t.firstChild;)t.removeChild(t.firstChild);t.appendChild(document.createTextNode(r))}}var v=null,h=0;function m(t,e){var n,r,a;if(e.singleton){var s=h++;n=v||(v=l(e)),r=f.bind(null,n,s,!1),a=f.bind(null,n,s,!0)}else n=l(e),r=p.bind(null,n,e),a=function(){!function(t){if(null===t.parentNode)return!1;t.parentNode.removeChild(t)}(n)};return r(t),function(e){if(e){if(e.css===t.css&&e.media===t.media&&e.sourceMap===t.sourceMap)return;r(t=e)}else a()}}t.exports=function(t,e){(e=e||{}).singleton||"boolean"==typeof e.singleton||(e.singleton=a());var n=c(t=t||[],e);return function(t){if(t=t||[],"[object Array]"===Object.prototype.toString.call(t)){for(var r=0;r<n.length;r++){var a=o(n[r]);i[a].references--}for(var s=c(t,e),l=0;l<n.length;l++){var u=o(n[l]);0===i[u].references&&(i[u].updater(),i.splice(u,1))}n=s}}}},7479:(t,e,n)=>{"use strict";n.d(e,{Z:()=>i});var r=n(5203),a=n(3109);const s={components:{DatabasesTable:r.Z,WebsitesTable:a.Z},data:function(){return{resources:!1}},mounted:function(){this.$store.state.user&&this.$store.state.user.is_root&&this.getStats()},created:function(){this.EventBus.$on("doSearch",this.browseWebsites)},beforeDestroy:function(){this.EventBus.$off("doSearch",this.browseWebsites)},methods:{browseWebsites:function(){this.$router.push({name:"websites"})},getStats:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/stats/common/").then((function(e){t.$store.commit("setBusy",!1),t.resources=e.data})).catch((function(e){t.$store.commit("setBusy",!1)}))}}};const i=(0,n(1900).Z)(s,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[t.$store.state.user&&t.$store.state.user.is_root&&t.resources?n("div",{staticClass:"row"},[n("div",{staticClass:"col-xl-3 col-md-6 mb-4"},[n("router-link",{staticClass:"card text-decoration-none border-left-primary shadow h-100 py-2",attrs:{to:{name:"websites"}}},[n("div",{staticClass:"card-body"},[n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col mr-2"},[n("div",{staticClass:"text-xs font-weight-bold text-primary text-uppercase mb-1"},[t._v("\n                                    Websites\n                                ")]),t._v(" "),n("div",{staticClass:"h5 mb-0 font-weight-bold text-gray-800"},[t._v("\n                                    "+t._s(t.resources.stats.websites)+"\n                                ")])]),t._v(" "),n("div",{staticClass:"col-auto"},[n("i",{staticClass:"fas fa-globe fa-2x text-gray-300"})])])])])],1),t._v(" "),n("div",{staticClass:"col-xl-3 col-md-6 mb-4"},[n("router-link",{staticClass:"card text-decoration-none border-left-primary shadow h-100 py-2",attrs:{to:{name:"databases"}}},[n("div",{staticClass:"card-body"},[n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col mr-2"},[n("div",{staticClass:"text-xs font-weight-bold text-primary text-uppercase mb-1"},[t._v("\n                                    Databases\n                                ")]),t._v(" "),n("div",{staticClass:"h5 mb-0 font-weight-bold text-gray-800"},[t._v("\n                                    "+t._s(t.resources.stats.databases)+"\n                                ")])]),t._v(" "),n("div",{staticClass:"col-auto"},[n("i",{staticClass:"fas fa-database fa-2x text-gray-300"})])])])])],1),t._v(" "),n("div",{staticClass:"col-xl-3 col-md-6 mb-4"},[n("router-link",{staticClass:"card text-decoration-none border-left-primary shadow h-100 py-2",attrs:{to:{name:"hardware"}}},[n("div",{staticClass:"card-body"},[n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col mr-2"},[n("div",{staticClass:"text-xs font-weight-bold text-primary text-uppercase mb-1"},[t._v("\n                                    Storage ("+t._s(t._f("prettyBytes")(t.resources.disk.total))+")\n                                ")]),t._v(" "),n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col-auto"},[n("div",{staticClass:"h5 mb-0 mr-3 font-weight-bold text-gray-800"},[t._v("\n                                            "+t._s(t.resources.disk.percent)+"%\n                                        ")])]),t._v(" "),n("div",{staticClass:"col"},[n("div",{staticClass:"progress progress-sm mr-2"},[n("div",{staticClass:"progress-bar bg-primary",style:"width: "+t.resources.disk.percent+"%",attrs:{role:"progressbar","aria-valuenow":t.resources.disk.percent,"aria-valuemin":"0","aria-valuemax":"100"}})])])])]),t._v(" "),n("div",{staticClass:"col-auto"},[n("i",{staticClass:"fas fa-hdd fa-2x text-gray-300"})])])])])],1),t._v(" "),n("div",{staticClass:"col-xl-3 col-md-6 mb-4"},[n("router-link",{staticClass:"card text-decoration-none border-left-primary shadow h-100 py-2",attrs:{to:{name:"hardware"}}},[n("div",{staticClass:"card-body"},[n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col mr-2"},[n("div",{staticClass:"text-xs font-weight-bold text-primary text-uppercase mb-1"},[t._v("\n                                    RAM ("+t._s(t._f("prettyBytes")(t.resources.ram.memory.total))+")\n                                ")]),t._v(" "),n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col-auto"},[n("div",{staticClass:"h5 mb-0 mr-3 font-weight-bold text-gray-800"},[t._v("\n                                            "+t._s(t.resources.ram.memory.percent)+"%\n                                        ")])]),t._v(" "),n("div",{staticClass:"col"},[n("div",{staticClass:"progress progress-sm mr-2"},[n("div",{staticClass:"progress-bar bg-primary",style:"width: "+t.resources.ram.memory.percent+"%",attrs:{role:"progressbar","aria-valuenow":t.resources.ram.memory.percent,"aria-valuemin":"0","aria-valuemax":"100"}})])])])]),t._v(" "),n("div",{staticClass:"col-auto"},[n("i",{staticClass:"fas fa-memory fa-2x text-gray-300"})])])])])],1)]):t._e()]),t._v(" "),n("websites-table",{staticClass:"mb-3"}),t._v(" "),n("databases-table")],1)}),[],!1,null,null,null).exports},2858:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{name:"",username:"",password:"",errors:{},create:!1,ssh_user:"",users:[]}},created:function(){this.password=this.genRandPassword(),this.$store.state.user&&this.$store.state.user.is_root&&this.getUsers(),this.EventBus.$on("userCreated",this.handleUserCreated)},methods:{handleUserCreated:function(t){this.create=!1,this.ssh_user=t,this.getUsers()},getUsers:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"",e=arguments.length>1&&void 0!==arguments[1]&&arguments[1],n=this;n.errors={},e&&e(!0),n.$store.commit("setBusy",!0),axios.get("/ssh-users/?q=".concat(t)).then((function(t){n.$store.commit("setBusy",!1);for(var r=[],a=0;a<t.data.results.length;a++)r.push(t.data.results[a].username);e&&e(!1),n.users=r})).catch((function(t){n.$store.commit("setBusy",!1),toastr.error("SSH users list cannot be obtained."),e&&e(!1)}))},createDatabase:function(){var t=this;t.errors={},t.$store.commit("setBusy",!0);var e=new FormData;e.append("name",t.name),e.append("ssh_user",t.ssh_user),e.append("username",t.username),e.append("password",t.password),axios.post("/databases/",e).then((function(e){t.$store.commit("setBusy",!1),toastr.success("Databases has been created successfully."),t.$router.push({name:"databases"})})).catch((function(e){t.$store.commit("setBusy",!1),t.errors=e.response.data}))}},watch:{create:function(t,e){t&&(this.user_pass=this.genRandPassword(),this.ssh_user=""),this.errors={}}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"card"},[n("div",{staticClass:"card-header"},[n("h4",{staticClass:"float-left mt-1"},[t._v("Create Database")]),t._v(" "),n("router-link",{staticClass:"btn mt-1 btn-sm float-right btn-warning",attrs:{to:{name:"databases"}}},[n("i",{staticClass:"fas fa-times"}),t._v(" Cancel\n                ")])],1),t._v(" "),n("div",{staticClass:"card-body"},[n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-6"},[t.$store.state.user&&t.$store.state.user.is_root?n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"user"}},[t._v("\n                                SSH User\n                                "),n("small",[n("a",{staticClass:"text-primary text-decoration-none",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.create=!t.create}}},[t.create?n("span",[t._v("Cancel")]):n("span",[n("i",{staticClass:"fas fa-plus"}),t._v(" Create\n                                        ")])])])]),t._v(" "),t.create?n("usercreate-component"):n("v-select",{attrs:{options:t.users},on:{search:t.getUsers},model:{value:t.ssh_user,callback:function(e){t.ssh_user=e},expression:"ssh_user"}}),t._v(" "),!t.create&&t.errors.user?n("p",{staticClass:"text-danger"},[t._v(t._s(t.errors.user[0]))]):t._e()],1):t._e(),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"name"}},[t._v("Database Name")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.name,expression:"name"}],staticClass:"form-control",class:{"is-invalid":t.errors.name},attrs:{id:"name",type:"text",placeholder:"Database name..."},domProps:{value:t.name},on:{input:function(e){e.target.composing||(t.name=e.target.value)}}}),t._v(" "),t.errors.name?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.name[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"username"}},[t._v("Database Username")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.username,expression:"username"}],staticClass:"form-control",class:{"is-invalid":t.errors.username},attrs:{id:"username",type:"text",placeholder:"Database username..."},domProps:{value:t.username},on:{input:function(e){e.target.composing||(t.username=e.target.value)}}}),t._v(" "),t.errors.username?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.username[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"password"}},[t._v("User Password")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.password,expression:"password"}],staticClass:"form-control",class:{"is-invalid":t.errors.password},attrs:{id:"password",type:"text",placeholder:"Database user password..."},domProps:{value:t.password},on:{input:function(e){e.target.composing||(t.password=e.target.value)}}}),t._v(" "),t.errors.password?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.password[0]))]):t._e()])])])]),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-primary",on:{click:function(e){return t.createDatabase()}}},[t._v("Create")])])])])])}),[],!1,null,null,null).exports},9263:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{database:!1,del:!1,reset:!1,change_php:!1,php_versions:[],del_dom:!1,new_domain:"",new_password:!1,add_dom:!1,errors:{}}},created:function(){this.getDatabase()},methods:{resetPassword:function(){var t=this;t.new_password=!1,t.$store.commit("setBusy",!0),axios.post("/databases/".concat(t.$route.params.id,"/reset-password/")).then((function(e){t.$store.commit("setBusy",!1),toastr.success("The password has been updated."),t.new_password=e.data.new_password,t.reset=!1})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("MySQL password cannot be updated.")}))},getDatabase:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/databases/".concat(t.$route.params.id,"/")).then((function(e){t.$store.commit("setBusy",!1),t.database=e.data})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("Cannot fetch the database data.")}))},deleteDatabase:function(){var t=this;t.$store.commit("setBusy",!0),axios.delete("/databases/".concat(t.$route.params.id,"/")).then((function(e){toastr.error("The database and associated data has been deleted."),t.$router.push({name:"databases"})})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("An error occured while trying to delete this database.")}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",[t._v("Manage Database: #"+t._s(t.$route.params.id))])])]),t._v(" "),t.database?n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-8"},[n("div",{staticClass:"card mb-3"},[n("div",{staticClass:"card-header bg-primary text-light"},[t._v("\n                        General Details\n                        "),n("a",{staticClass:"btn btn-sm btn-outline-warning float-right",attrs:{href:t.PMA_URL,target:"_blank"}},[n("i",{staticClass:"fas fa-database"}),t._v(" phpMyAdmin\n                        ")])]),t._v(" "),n("div",{staticClass:"card-body"},[t.new_password?n("p",[t._v("New password is "),n("small",{staticClass:"text-info font-weight-bold"},[t._v(t._s(t.new_password))]),t._v(" and it is shown to you this single time. "),n("a",{staticClass:"text-danger text-decoration-none",staticStyle:{"font-size":"14px"},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.new_password=!1}}},[n("i",{staticClass:"fas fa-times-circle"}),t._v(" Hide")])]):t._e(),t._v(" "),n("div",{staticClass:"responsive-table"},[n("table",{staticClass:"table table-borderless table-striped"},[n("tbody",[n("tr",[n("td",[t._v("Database Name")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.database.name)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",{staticStyle:{width:"40%"}},[t._v("Database User")]),t._v(" "),n("td",[n("span",[t._v(t._s(t.database.username))]),t._v(" "),n("small",[t.reset?t._e():n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!0}}},[n("i",{staticClass:"fas fa-redo"}),t._v(" Reset\n                                                    Password\n                                                ")]),t._v(" "),t.reset?n("a",{staticClass:"text-success",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!1}}},[t._v("\n                                                    Cancel\n                                                ")]):t._e(),t._v(" "),t.reset?n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.resetPassword()}}},[t._v("\n                                                    Reset\n                                                ")]):t._e()])])])])])])])]),t._v(" "),n("div",{staticClass:"card mt-3"},[n("div",{staticClass:"card-header text-light",class:{"bg-secondary":!t.del,"bg-danger":t.del}},[t._v("\n                        Danger Zone\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[t._v("\n                        Do you want to delete this database? You can do so here. Beware\n                        that this action is irreversible and you cannot undo once the\n                        database is deleted. Entire data associated to this database\n                        will be permanently lost.\n                    ")]),t._v(" "),n("div",{staticClass:"card-footer"},[t.del?t._e():n("button",{staticClass:"btn btn-secondary",on:{click:function(e){t.del=!0}}},[t._v("\n                            Delete Database\n                        ")]),t._v(" "),t.del?n("button",{staticClass:"btn btn-success",on:{click:function(e){t.del=!1}}},[t._v("\n                            Don't Delete\n                        ")]):t._e(),t._v(" "),t.del?n("button",{staticClass:"btn btn-danger",on:{click:function(e){return t.deleteDatabase()}}},[t._v("\n                            Confirm Deletion\n                        ")]):t._e()])])])]):t._e()])])}),[],!1,null,null,null).exports},3798:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={components:{DatabasesTable:n(5203).Z}};const a=(0,n(1900).Z)(r,(function(){var t=this.$createElement,e=this._self._c||t;return e("div",{staticClass:"row"},[e("databases-table",{attrs:{pagination:!0}})],1)}),[],!1,null,null,null).exports},5203:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={props:["pagination"],data:function(){return{data:!1}},created:function(){this.getDatabases(),"databases"==this.$route.name&&this.EventBus.$on("doSearch",this.getDatabases)},beforeDestroy:function(){this.EventBus.$off("doSearch",this.getDatabases)},methods:{getDatabases:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;if(null!=t){var e=document.getElementById("search-input").value,n=this;n.$store.commit("setBusy",!0),axios.get("/databases/?page=".concat(t,"&q=").concat(e)).then((function(t){n.data=t.data,n.$store.commit("setBusy",!1)})).catch((function(t){n.$store.commit("setBusy",!1)}))}}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",{staticClass:"float-left"},[t._v("Databases "),t.data?n("span",[t._v("("+t._s(t.data.count)+")")]):t._e()]),t._v(" "),t.pagination?n("router-link",{staticClass:"btn mb-1 btn-sm float-right btn-secondary",attrs:{to:{name:"createdb"}}},[n("i",{staticClass:"fas fa-plus"}),t._v(" Add New\n            ")]):n("router-link",{staticClass:"btn mb-1 btn-sm float-right btn-outline-primary",attrs:{to:{name:"databases"}}},[n("i",{staticClass:"fas fa-list"}),t._v(" All Databases\n            ")])],1)]),t._v(" "),t.data&&t.data.count>0?n("div",{staticClass:"table-responsive"},[n("table",{staticClass:"table table-bordered"},[t._m(0),t._v(" "),n("tbody",t._l(t.data.results,(function(e){return n("tr",{key:e.id},[n("td",[t._v(t._s(e.name))]),t._v(" "),n("td",[t._v(t._s(e.username))]),t._v(" "),n("td",[t._v("\n                        "+t._s(e.created)+"\n                    ")]),t._v(" "),n("td",{staticClass:"text-right"},[n("router-link",{staticClass:"btn btn-sm btn-outline-primary",attrs:{to:{name:"database",params:{id:e.id}}}},[n("i",{staticClass:"fas fa-cog"}),t._v(" Manage\n                        ")])],1)])})),0)])]):n("div",{staticClass:"text-muted mb-3 border border-muted rounded p-5 text-center"},[t._v("\n        No databases found.\n    ")]),t._v(" "),t.pagination&&t.data&&t.data.count>0?n("nav",{attrs:{"aria-label":"Database Pagination"}},[n("ul",{staticClass:"pagination float-right"},[n("li",{staticClass:"page-item",class:{disabled:!t.data.links.previous},on:{click:function(e){return t.getDatabases(t.data.links.previous)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Previous")])]),t._v(" "),n("li",{staticClass:"page-item",class:{disabled:!t.data.links.next},on:{click:function(e){return t.getDatabases(t.data.links.next)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Next")])])])]):t._e()])}),[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("thead",{staticClass:"bg-primary text-white"},[n("tr",[n("th",{staticStyle:{width:"30%"}},[t._v("Name")]),t._v(" "),n("th",{staticStyle:{width:"20%"}},[t._v("\n                        Username\n                    ")]),t._v(" "),n("th",{attrs:{colspan:"2"}},[t._v("\n                        Created\n                    ")])])])}],!1,null,null,null).exports},162:(t,e,n)=>{"use strict";n.d(e,{Z:()=>l});function r(t,e,n){return e in t?Object.defineProperty(t,e,{value:n,enumerable:!0,configurable:!0,writable:!0}):t[e]=n,t}const a={data:function(){var t;return r(t={files:!1,edit:!1,edit_content:"",remote_upl:"",remote_url:"",bad_file:!1,saving:!1,rename:!1,selected:[],move_selected:[],errors:{},selall:!1,del:!1,create:!1,itemname:"",itemtype:"file",extract:!1,move:!1,copy:!1},"rename",!1),r(t,"new_name",""),r(t,"old_name",""),r(t,"edit_permissions",""),r(t,"new_permissions",""),r(t,"web_root",""),r(t,"website_name",""),t},created:function(){this.getWebsite(),this.EventBus.$on("doSearch",this.getFiles)},beforeDestroy:function(){this.EventBus.$off("doSearch",this.getFiles)},methods:{browseSegment:function(t){if(!(t<4)){for(var e="",n=0;n<this.files.segments.length;n++)n<=t&&(e+="/".concat(this.files.segments[n][1]));this.$store.commit("setPath",e),this.getFiles()}},getWebsite:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/websites/".concat(t.$route.params.id,"/")).then((function(e){t.$store.commit("setBusy",!1),e.data&&e.data.metadata.path?(t.web_root=e.data.metadata.path,t.website_name=e.data.label,t.$store.commit("setPath",e.data.metadata.path),t.getFiles()):toastr.error("Website root path cannot be obtained.")})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("Files listing cannot be obtained.")}))},chooseFiles:function(){document.getElementById("fileUpload").click()},clearSelected:function(){this.selected=[],this.selall=!1},editFile:function(t){var e=this;e.edit=t,e.bad_file=!1,axios.get("/file-manager/file-manipulation/?path=".concat(t.path)).then((function(t){e.edit_content=t.data.content})).catch((function(t){e.bad_file=!0,e.edit_content="File manager cannot edit this file."}))},saveFile:function(){var t=this,e=new FormData;e.append("content",t.edit_content),e.append("path",t.edit.path),axios.put("/file-manager/file-manipulation/",e).then((function(e){toastr.success("File content has been updated."),t.saving=!1})).catch((function(e){toastr.error("Error occured. File cannot be saved."),t.saving=!1}))},getFiles:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;if(null!=t){var e=document.getElementById("search-input").value,n=this;n.$store.commit("setBusy",!0);var r=n.$store.state.path;null!=r&&(r=encodeURIComponent(r),axios.get("/file-manager/files/?page=".concat(t,"&search=").concat(e,"&path=").concat(r)).then((function(t){n.files=t.data,n.$store.commit("setBusy",!1)})).catch((function(t){toastr.error("Directory listing cannot be retrieved."),n.$store.commit("setBusy",!1)})))}},browseFile:function(t){"directory"==t.file_type&&(this.$store.commit("setPath",t.path),this.getFiles())},compressFiles:function(){var t=this;if(0!=t.selected.length){t.$store.commit("setBusy",!0);var e=new FormData;e.append("path",t.$store.state.path),e.append("paths",t.selected),axios.post("/file-manager/generate-archive/",e).then((function(e){toastr.info("The archive for selected files has been generated."),t.$store.commit("setBusy",!1),t.getFiles(),t.clearSelected()})).catch((function(e){toastr.error("Something went wrong. Archive cannot be generated."),t.$store.commit("setBusy",!1)}))}},goBack:function(){var t=this,e=t.$store.state.path;if(t.selected=[],t.selall=!1,e!=t.web_root){var n=e.substring(0,e.lastIndexOf("/"));t.$store.commit("setPath",n),t.getFiles()}},goHome:function(){this.$store.state.path!=this.web_root&&(this.$store.commit("setPath",this.web_root),this.getFiles(),this.clearSelected())},selectItem:function(t){this.selected.includes(t)?this.selected.splice(this.selected.indexOf(t),1):this.selected.push(t)},createItem:function(){var t=this,e=new FormData;e.append("item_name",t.itemname),e.append("item_type",t.itemtype),e.append("path",t.$store.state.path),t.$store.commit("setBusy",!0),axios.post("/file-manager/file-manipulation/",e).then((function(e){t.$store.commit("setBusy",!1),toastr.success("Iteam has been created successfully."),t.create=!1,t.itemname="",t.itemtype="file",t.getFiles()})).catch((function(e){e.response&&e.response.data.error?toastr.error(e.response.data.error):toastr.error("Item with this name cannot be created."),t.$store.commit("setBusy",!1)}))},deleteItems:function(){var t=this;if(0!=t.selected.length){t.$store.commit("setBusy",!0);var e=new FormData;e.append("paths",t.selected),axios.post("/file-manager/delete-items/",e).then((function(e){toastr.info("The selected items have been deleted."),t.$store.commit("setBusy",!1),t.del=!1,t.getFiles(),t.clearSelected()})).catch((function(e){toastr.error("Something went wrong. Files cannot be deleted."),t.$store.commit("setBusy",!1)}))}else t.del=!1},isZip:function(t){if(!t)return!1;if(1!=t.length)return!1;var e=t[0],n=!1;if(this.files&&this.files.results.length)for(var r=0;r<this.files.results.length;r++)e==this.files.results[r].path&&(n=this.files.results[r]);return!(!n||"zip"!=n.name.slice(-3))||void 0},extractArchive:function(){var t=this,e=t.selected[0];if(e){t.$store.commit("setBusy",!0);var n=new FormData;n.append("path",e),n.append("root_path",t.$store.state.path),axios.post("/file-manager/extract-archive/",n).then((function(e){toastr.info("The archive contents have been extracted."),t.$store.commit("setBusy",!1),t.extract=!1,t.getFiles(),t.clearSelected()})).catch((function(e){toastr.error("Something went wrong. Archive cannot be extracted."),t.$store.commit("setBusy",!1)}))}},handleFileUpload:function(){var t=this,e=this.$refs.file.files[0],n=new FormData;n.append("file",e),n.append("path",t.$store.state.path),t.$store.commit("setBusy",!0),axios.post("/file-manager/upload-files/",n,{headers:{"Content-Type":"multipart/form-data"}}).then((function(e){t.$store.commit("setBusy",!1),toastr.success("Files have been uploaded successfully."),document.getElementById("fileUpload").value="",t.getFiles()})).catch((function(e){t.$store.commit("setBusy",!1),e.response&&e.response.data.error?toastr.error(e.response.data.error):toastr.error("File upload failed. Maybe it already exists.")}))},validDestination:function(){return!this.move_selected.includes(this.$store.state.path)},moveItems:function(){var t=this;t.$store.commit("setBusy",!0);var e=new FormData;if(t.copy)var n="copy";else n="move";e.append("path",t.$store.state.path),e.append("paths",t.move_selected),e.append("action",n),axios.post("/file-manager/move-items/",e).then((function(e){if(t.$store.commit("setBusy",!1),t.copy)var n="copied";else n="moved";toastr.success("Items have been ".concat(n," successfully.")),t.move=!1,t.copy=!1,t.selected=[],t.move_selected=[],t.getFiles()})).catch((function(e){toastr.error("An error occured while moving some items."),t.$store.commit("setBusy",!1)}))},prepareRename:function(){if(1==this.selected.length){this.rename=this.selected[0];for(var t=0;t<this.files.results.length;t++)this.files.results[t].path==this.rename&&(this.old_name=this.files.results[t].name,this.new_name=this.old_name)}},renameItem:function(){var t=this,e=new FormData;e.append("path",t.$store.state.path),e.append("new_name",t.new_name),e.append("old_name",t.old_name),t.$store.commit("setBusy",!0),axios.post("/file-manager/rename-item/",e).then((function(e){toastr.success("The item has been renamed successfully."),t.getFiles(),t.rename=!1,t.new_name="",t.old_name="",t.$store.commit("setBusy",!1)})).catch((function(e){toastr.error("Error occured, the item cannot be renamed."),t.$store.commit("setBusy",!1)}))},updatePermissions:function(){var t=this,e=new FormData;e.append("path",t.edit_permissions),e.append("permissions",t.new_permissions),axios.post("/file-manager/update-permissions/",e).then((function(e){t.new_permissions="",t.edit_permissions="",t.getFiles(),toastr.success("Permissions have been successfully updated.")})).catch((function(t){toastr.error("Permissions cannot be updated for this item.")}))},fetchRemote:function(){var t=this;t.errors={};var e=new FormData;e.append("path",t.$store.state.path),e.append("remote_url",t.remote_url),t.$store.commit("setBusy",!0),axios.post("/file-manager/remote-fetch/",e).then((function(e){t.$store.commit("setBusy",!1),t.remote_url="",t.remote_upl=!1,toastr.success("Remote file has been successfully downloaded."),t.getFiles()})).catch((function(e){toastr.error("Remote file cannot be downloaded."),e.response&&e.response.data&&(t.errors=e.response.data),t.$store.commit("setBusy",!1)}))}},watch:{selall:function(t,e){var n=[];if(1==t&&this.files&&this.files.results.length)for(var r=0;r<this.files.results.length;r++)n.push(this.files.results[r].path);this.selected=n}}};var s=n(3379),i=n.n(s),o=n(5476),c={insert:"head",singleton:!1};i()(o.Z,c);o.Z.locals;const l=(0,n(1900).Z)(a,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return t.files?n("div",{staticClass:"row"},[n("div",{staticClass:"col-12 mb-2"},[n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("h4",[t._v("File Manager ("+t._s(t.files.count)+")")]),t._v(" "),t.files.segments.length?n("p",{staticClass:"d-block"},[t._v("\n                    Browsing: ~/apps"),t._l(t.files.segments,(function(e){return e[0]>3?n("a",{key:e[0],staticClass:"text-info",attrs:{href:"javascript:void(0)"},on:{click:function(n){return t.browseSegment(e[0])}}},[t._v("/"+t._s(e[1]))]):t._e()}))],2):t._e(),t._v(" "),(t.move||t.copy)&&t.move_selected.length>0?n("p",{staticClass:"border p-1 file-toolbar rounded"},[t._v("\n                    You are going to "),t.move?n("span",[t._v("move")]):n("span",[t._v("copy")]),t._v(" "+t._s(t.move_selected.length)+" "),1==t.move_selected.length?n("span",[t._v("item")]):n("span",[t._v("items")]),t._v(". Now go to the destination directory and click confirm.\n                    "),n("button",{staticClass:"btn btn-sm btn-danger",attrs:{disabled:!t.validDestination()},on:{click:function(e){return t.moveItems()}}},[t._v("Confirm")]),t._v(" "),n("button",{staticClass:"btn btn-sm btn-primary",on:{click:function(e){t.move_selected=[],t.move=!1}}},[t._v("Cancel")])]):t._e(),t._v(" "),t.del?n("p",{staticClass:"border p-1 file-toolbar rounded"},[t._v("\n                    Do you really want to permanently delete the selected\n                    "+t._s(t.selected.length)+" "),1==t.selected.length?n("span",[t._v("item")]):n("span",[t._v("items")]),t._v("?\n                    "),n("a",{staticClass:"text-success mr-1",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.del=!1,t.confirm=!1}}},[t._v("Cancel")]),t._v(" "),n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.deleteItems()}}},[t._v("Confirm")])]):t.extract?n("p",{staticClass:"border p-1 file-toolbar rounded"},[t._v("\n                    Do you really want to extract the selected archive's content in\n                    the current directory?\n                    "),n("a",{staticClass:"text-success mr-1",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.del=!1,t.confirm=!1}}},[t._v("Cancel")]),t._v(" "),n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.extractArchive()}}},[t._v("Confirm")])]):t.create?n("p",[n("input",{directives:[{name:"model",rawName:"v-model",value:t.itemname,expression:"itemname"}],attrs:{type:"text",placeholder:"Name"},domProps:{value:t.itemname},on:{input:function(e){e.target.composing||(t.itemname=e.target.value)}}}),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model",value:t.itemtype,expression:"itemtype"}],on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,(function(t){return t.selected})).map((function(t){return"_value"in t?t._value:t.value}));t.itemtype=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:"file"}},[t._v("File")]),t._v(" "),n("option",{attrs:{value:"directory"}},[t._v("Directory")])]),t._v(" "),n("button",{staticClass:"btn btn-info btn-sm",attrs:{disabled:!t.itemname},on:{click:function(e){return t.createItem()}}},[t._v("\n                        Create\n                    ")]),t._v(" "),n("button",{staticClass:"btn btn-warning btn-sm",on:{click:function(e){t.create=!1}}},[t._v("\n                        Cancel\n                    ")])]):t.remote_upl?n("p",[n("input",{directives:[{name:"model",rawName:"v-model",value:t.remote_url,expression:"remote_url"}],staticStyle:{"max-width":"inherit",width:"80%"},attrs:{type:"url",placeholder:"Enter a remote public URL to download any file over your server network."},domProps:{value:t.remote_url},on:{input:function(e){e.target.composing||(t.remote_url=e.target.value)}}}),t._v(" "),n("button",{staticClass:"btn btn-info btn-sm",attrs:{disabled:!t.remote_url},on:{click:function(e){return t.fetchRemote()}}},[t._v("\n                        Fetch\n                    ")]),t._v(" "),n("button",{staticClass:"btn btn-warning btn-sm",on:{click:function(e){t.remote_upl=!1}}},[t._v("\n                        Cancel\n                    ")]),t._v(" "),t.errors.remote_url?n("small",{staticClass:"text-danger d-block mt-0"},[t._v(t._s(t.errors.remote_url[0]))]):t._e()]):t._e(),t._v(" "),n("p",{staticClass:"border mb-1 mt-1 file-toolbar rounded"},[n("nav",{staticClass:"navbar navbar-expand-lg navbar-light bg-light"},[t._m(0),t._v(" "),n("div",{staticClass:"collapse navbar-collapse",attrs:{id:"navbarNavAltMarkup"}},[n("div",{staticClass:"navbar-nav"},[n("a",{staticClass:"nav-item nav-link",class:{disabled:t.$store.state.path==t.web_root},attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.goHome()}}},[n("i",{staticClass:"fas fa-home"}),t._v(" Home\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:t.$store.state.path==t.web_root},attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.goBack()}}},[n("i",{staticClass:"fas fa-arrow-left"}),t._v(" Back\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.chooseFiles()}}},[n("i",{staticClass:"fas fa-upload"}),t._v(" Upload\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.remote_upl=!t.remote_upl}}},[n("i",{staticClass:"fas fa-globe"}),t._v(" Remote Fetch\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.create=!0}}},[n("i",{staticClass:"fas fa-plus"}),t._v(" Create\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.getFiles()}}},[n("i",{staticClass:"fas fa-redo"}),t._v(" Refresh\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:0==t.selected.length},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.selected.length>0&&(t.move=!0,t.copy=!1,t.move_selected=t.selected,t.selall=!1,t.selected=[])}}},[n("i",{staticClass:"fas fa-arrows-alt"}),t._v(" Move\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:0==t.selected.length},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.selected.length>0&&(t.copy=!0,t.move=!1,t.move_selected=t.selected,t.selall=!1,t.selected=[])}}},[n("i",{staticClass:"fas fa-copy"}),t._v(" Copy\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:1!=t.selected.length},attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.prepareRename()}}},[n("i",{staticClass:"fas fa-retweet"}),t._v(" Rename\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:0==t.selected.length},attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.compressFiles()}}},[n("i",{staticClass:"fas fa-archive"}),t._v(" Compress\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:!t.isZip(t.selected)},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.selected.length>0&&(t.extract=!0)}}},[n("i",{staticClass:"fas fa-file-archive"}),t._v(" Extract\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:0==t.selected.length},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.selected.length>0&&(t.del=!0)}}},[n("i",{staticClass:"fas fa-trash"}),t._v(" Delete\n                                ")])])])])])])])]),t._v(" "),n("div",{staticClass:"col-12"},[n("div",{staticClass:"table-responsive"},[t.files.count>0?n("table",{staticClass:"table table-striped"},[n("thead",{staticClass:"bg-primary text-white"},[n("tr",[t.move_selected.length?t._e():n("th",{staticStyle:{width:"2%"}},[n("input",{directives:[{name:"model",rawName:"v-model",value:t.selall,expression:"selall"}],attrs:{type:"checkbox"},domProps:{checked:Array.isArray(t.selall)?t._i(t.selall,null)>-1:t.selall},on:{change:function(e){var n=t.selall,r=e.target,a=!!r.checked;if(Array.isArray(n)){var s=t._i(n,null);r.checked?s<0&&(t.selall=n.concat([null])):s>-1&&(t.selall=n.slice(0,s).concat(n.slice(s+1)))}else t.selall=a}}})]),t._v(" "),n("th",{staticStyle:{width:"25%"}},[t._v("File name")]),t._v(" "),n("th",{staticStyle:{width:"10%"}},[t._v("Size")]),t._v(" "),n("th",{staticStyle:{width:"25%"}},[t._v("Permissions")]),t._v(" "),n("th",{attrs:{colspan:"2"}},[t._v("Modified")])])]),t._v(" "),n("tbody",t._l(t.files.results,(function(e){return n("tr",{key:e.path},[t.move_selected.length?t._e():n("td",[n("input",{attrs:{type:"checkbox"},domProps:{checked:t.selected.includes(e.path)},on:{click:function(n){return t.selectItem(e.path)}}})]),t._v(" "),n("td",[t.rename==e.path?n("span",[n("input",{directives:[{name:"model",rawName:"v-model",value:t.new_name,expression:"new_name"}],attrs:{type:"text"},domProps:{value:t.new_name},on:{input:function(e){e.target.composing||(t.new_name=e.target.value)}}}),t._v(" "),n("button",{staticClass:"btn btn-warning btn-sm",on:{click:function(e){return t.renameItem()}}},[t._v("Save")]),t._v(" "),n("button",{staticClass:"btn btn-sm btn-primary",on:{click:function(e){t.rename=!1,t.selected=[]}}},[t._v("Cancel")])]):n("span",{on:{click:function(n){return t.browseFile(e)}}},["directory"==e.file_type?n("i",{staticClass:"fas text-warning fa-folder"}):n("i",{staticClass:"fas fa-file"}),t._v(" "+t._s(e.name)+"\n                            ")])]),t._v(" "),n("td",[t._v(t._s(t._f("prettyBytes")(e.size)))]),t._v(" "),n("td",[t.edit_permissions==e.path?n("span",[n("input",{directives:[{name:"model",rawName:"v-model",value:t.new_permissions,expression:"new_permissions"}],attrs:{type:"text"},domProps:{value:t.new_permissions},on:{input:function(e){e.target.composing||(t.new_permissions=e.target.value)}}}),t._v(" "),n("button",{staticClass:"btn btn-warning btn-sm",on:{click:function(e){return t.updatePermissions()}}},[t._v("Save")]),t._v(" "),n("button",{staticClass:"btn btn-sm btn-primary",on:{click:function(e){t.edit_permissions=!1,t.new_permissions=""}}},[t._v("Cancel")])]):n("span",{on:{click:function(n){t.edit_permissions=e.path,t.new_permissions=e.permissions}}},[t._v("\n                                "+t._s(e.permissions)+"\n                            ")])]),t._v(" "),n("td",[t._v(t._s(e.modified))]),t._v(" "),n("td",{staticClass:"text-right"},["file"==e.file_type?n("button",{staticClass:"btn btn-sm btn-outline-info",attrs:{"data-toggle":"modal","data-target":"#fileEditModal"},on:{click:function(n){return t.editFile(e)}}},[n("i",{staticClass:"fas fa-edit"})]):t._e(),t._v(" "),"file"==e.file_type?n("a",{staticClass:"btn btn-sm btn-outline-primary",attrs:{href:"/dashboard/download-file/?path="+encodeURIComponent(e.path),target:"_blank",rel:"nofollow noopener"}},[n("i",{staticClass:"fas fa-download"})]):t._e()])])})),0)]):n("div",{staticClass:"text-muted mb-3 border border-muted rounded p-5 text-center"},[t._v("\n                No files or directories found here.\n                "),n("a",{staticClass:"text-decoration-none",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.create=!0}}},[t._v("\n                    Create \n                ")]),t._v(" a file or a directory or\n                "),n("a",{staticClass:"text-decoration-none",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.chooseFiles()}}},[t._v("\n                    upload \n                ")]),t._v(" files.\n            ")]),t._v(" "),t.files&&t.files.count>0?n("nav",{attrs:{"aria-label":"Pagination"}},[n("ul",{staticClass:"pagination float-right"},[n("li",{staticClass:"page-item",class:{disabled:!t.files.links.previous},on:{click:function(e){return t.getFiles(t.files.links.previous)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Previous")])]),t._v(" "),n("li",{staticClass:"page-item",class:{disabled:!t.files.links.next},on:{click:function(e){return t.getFiles(t.files.links.next)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Next")])])])]):t._e()])]),t._v(" "),n("div",{staticClass:"modal fade",attrs:{id:"fileEditModal",tabindex:"-1",role:"dialog","aria-labelledby":"fileEditModal","aria-hidden":"true"}},[n("div",{staticClass:"modal-dialog modal-lg",attrs:{role:"document"}},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[n("h5",{staticClass:"modal-title",attrs:{id:"editLabel"}},[t._v("Edit "+t._s(t.edit.name))]),t._v(" "),t._m(1)]),t._v(" "),n("div",{staticClass:"modal-body"},[n("textarea",{directives:[{name:"model",rawName:"v-model",value:t.edit_content,expression:"edit_content"}],staticClass:"form-control",attrs:{disabled:t.bad_file,rows:"20"},domProps:{value:t.edit_content},on:{input:function(e){e.target.composing||(t.edit_content=e.target.value)}}})]),t._v(" "),n("div",{staticClass:"modal-footer"},[n("button",{staticClass:"btn btn-secondary",attrs:{type:"button","data-dismiss":"modal"}},[t._v("\n                        Close\n                    ")]),t._v(" "),n("button",{staticClass:"btn btn-primary",attrs:{disabled:t.bad_file||t.saving,type:"button"},on:{click:function(e){return t.saveFile()}}},[t._v("\n                        Save Changes\n                    ")])])])])]),t._v(" "),n("input",{ref:"file",attrs:{id:"fileUpload",type:"file",hidden:""},on:{change:function(e){return t.handleFileUpload()}}})]):t._e()}),[function(){var t=this.$createElement,e=this._self._c||t;return e("button",{staticClass:"navbar-toggler",attrs:{type:"button","data-toggle":"collapse","data-target":"#navbarNavAltMarkup","aria-controls":"navbarNavAltMarkup","aria-expanded":"false","aria-label":"Toggle navigation"}},[e("span",{staticClass:"navbar-toggler-icon"})])},function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],!1,null,"67504978",null).exports},6116:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{ssh_user:"",user_pass:"",errors:{}}},created:function(){this.user_pass=this.genRandPassword()},methods:{createUser:function(){var t=this;t.$store.commit("setBusy",!0);var e=new FormData;e.append("username",t.ssh_user),e.append("password",t.user_pass),axios.post("/ssh-users/",e).then((function(e){toastr.success("SSH user has been created successfully."),t.$store.commit("setBusy",!1),t.EventBus.$emit("userCreated",e.data.username)})).catch((function(e){t.$store.commit("setBusy",!1),t.errors=e.response.data}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"input-group"},[n("input",{directives:[{name:"model",rawName:"v-model",value:t.ssh_user,expression:"ssh_user"}],staticClass:"form-control",class:{"is-invalid":t.errors.username},attrs:{placeholder:"Username",type:"text"},domProps:{value:t.ssh_user},on:{input:function(e){e.target.composing||(t.ssh_user=e.target.value)}}}),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.user_pass,expression:"user_pass"}],staticClass:"form-control",class:{"is-invalid":t.errors.password},attrs:{type:"text",placeholder:"Password"},domProps:{value:t.user_pass},on:{input:function(e){e.target.composing||(t.user_pass=e.target.value)}}}),t._v(" "),n("div",{staticClass:"input-group-append"},[n("button",{staticClass:"btn",class:{"btn-outline-secondary":!t.errors.username,"btn-outline-danger":t.errors.username},attrs:{type:"button"},on:{click:function(e){return t.createUser()}}},[t._v("\n            Creat User\n        ")])]),t._v(" "),t.errors.username?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.username[0]))]):t._e()])}),[],!1,null,null,null).exports},9062:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{info:!1}},mounted:function(){this.getHardwareInfo()},methods:{getHardwareInfo:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/stats/hardware/").then((function(e){t.$store.commit("setBusy",!1),t.info=e.data})).catch((function(e){t.$store.commit("setBusy",!1)}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return t.info?n("div",{staticClass:"row"},[t._m(0),t._v(" "),n("div",{staticClass:"col-md-12"},[n("div",{staticClass:"table-responsive"},[n("table",{staticClass:"table table-striped table-bordered"},[n("tbody",[n("tr",[n("td",{staticStyle:{width:"40%"}},[t._v("CPU Cores")]),t._v(" "),n("td",[t._v(t._s(t.info.cpu.physical)+" physical "),n("span",{staticClass:"badge badge-secondary"},[t._v(t._s(t.info.cpu.logical)+" virtual")])])]),t._v(" "),n("tr",[n("td",[t._v("CPU Load")]),t._v(" "),n("td",[t._v(t._s(t._f("floatformat")((t.info.cpu.load[0]+t.info.cpu.load[1]+t.info.cpu.load[2])/3/t.info.cpu.logical*100))+"%")])]),t._v(" "),n("tr",[n("td",[t._v("RAM")]),t._v(" "),n("td",[t._v(t._s(t._f("prettyBytes")(t.info.ram.memory.total))+" "),n("span",{staticClass:"badge badge-secondary"},[t._v(t._s(t._f("floatformat")(t.info.ram.memory.percent))+"% used")])])]),t._v(" "),n("tr",[n("td",[t._v("Swap")]),t._v(" "),n("td",[t._v(t._s(t._f("prettyBytes")(t.info.ram.swap.total))+" "),n("span",{staticClass:"badge badge-secondary"},[t._v(t._s(t._f("floatformat")(t.info.ram.swap.percent))+"% used")])])]),t._v(" "),n("tr",[n("td",[t._v("Storage")]),t._v(" "),n("td",[t._v(t._s(t._f("prettyBytes")(t.info.disk.total))+" "),n("span",{staticClass:"badge badge-secondary"},[t._v(t._s(t._f("floatformat")(t.info.disk.percent))+"% used")])])]),t._v(" "),n("tr",[n("td",[t._v("Uptime")]),t._v(" "),n("td",[n("span",{staticClass:"badge badge-secondary"},[t._v(t._s(t.info.uptime))])])])])])])])]):t._e()}),[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"col-12"},[n("h4",{staticClass:"float-left"},[t._v("Hardware Details")])])}],!1,null,null,null).exports},5650:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{errors:{},username:"",max_sites:10,max_dbs:10,max_storage:"",is_active:!0,password:""}},created:function(){this.password=this.genRandPassword()},methods:{createUser:function(){var t=this;t.$store.commit("setBusy",!0);var e=new FormData;e.append("username",t.username),e.append("password",t.password),e.append("max_sites",t.max_sites),e.append("max_dbs",t.max_dbs),e.append("max_storage",t.max_storage),e.append("is_active",t.is_active),axios.post("/ssh-users/",e).then((function(e){toastr.success("SSH user has been created successfully."),t.$store.commit("setBusy",!1),t.$router.push({name:"users"})})).catch((function(e){t.$store.commit("setBusy",!1),e.response&&(t.errors=e.response.data),toastr.error("SSH user cannot be created.")}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"card"},[n("div",{staticClass:"card-header"},[n("h4",{staticClass:"float-left mt-1"},[t._v("Create User")]),t._v(" "),n("router-link",{staticClass:"btn mt-1 btn-sm float-right btn-warning",attrs:{to:{name:"users"}}},[n("i",{staticClass:"fas fa-times"}),t._v(" Cancel\n                ")])],1),t._v(" "),n("div",{staticClass:"card-body"},[n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-6"},[n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"username"}},[t._v("Username")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.username,expression:"username"}],staticClass:"form-control",class:{"is-invalid":t.errors.username},attrs:{id:"username",type:"text",placeholder:"SSH username"},domProps:{value:t.username},on:{input:function(e){e.target.composing||(t.username=e.target.value)}}}),t._v(" "),t.errors.username?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.username[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"password"}},[t._v("Password")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.password,expression:"password"}],staticClass:"form-control",class:{"is-invalid":t.errors.password},attrs:{id:"password",type:"text",placeholder:"SSH & control panel login password"},domProps:{value:t.password},on:{input:function(e){e.target.composing||(t.password=e.target.value)}}}),t._v(" "),t.errors.password?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.password[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Websites")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.max_sites,expression:"max_sites"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_sites},attrs:{id:"max_sites",type:"text",placeholder:"Max. websites allowed..."},domProps:{value:t.max_sites},on:{input:function(e){e.target.composing||(t.max_sites=e.target.value)}}}),t._v(" "),t.errors.max_sites?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_sites[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Databases")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.max_dbs,expression:"max_dbs"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_dbs},attrs:{id:"max_dbs",type:"text",placeholder:"Max. websites allowed..."},domProps:{value:t.max_dbs},on:{input:function(e){e.target.composing||(t.max_dbs=e.target.value)}}}),t._v(" "),t.errors.max_dbs?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_dbs[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Storage")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.max_storage,expression:"max_storage"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_storage},attrs:{id:"max_dbs",type:"text",placeholder:"Max. storage allowed in bytes..."},domProps:{value:t.max_storage},on:{input:function(e){e.target.composing||(t.max_storage=e.target.value)}}}),t._v(" "),t.errors.max_storage?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_storage[0]))]):t._e()])])])]),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-primary",on:{click:function(e){return t.createUser()}}},[t._v("Create User")])])])])])}),[],!1,null,null,null).exports},5002:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{user:!1,del:!1,reset:!1,errors:{},new_password:!1}},created:function(){this.getUser()},methods:{saveSettings:function(){var t=this;t.$store.commit("setBusy",!0);var e=new FormData;e.append("is_active",t.user.is_active),e.append("max_dbs",t.user.max_dbs),e.append("max_sites",t.user.max_sites),e.append("max_storage",t.user.max_storage),axios.patch("/ssh-users/".concat(t.user.id,"/"),e).then((function(e){t.$store.commit("setBusy",!1),t.getUser(),toastr.success("User settings have been updated.")})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("User settings cannot be updated.")}))},resetPassword:function(){var t=this;t.$store.commit("setBusy",!0),t.new_password=!1,axios.post("/ssh-users/".concat(t.$route.params.id,"/reset-password/")).then((function(e){t.$store.commit("setBusy",!1),toastr.success("SSH/SFTP password has been updated."),t.new_password=e.data.new_password,t.reset=!1})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("SSH/SFTP password cannot be updated.")}))},getUser:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/ssh-users/".concat(t.$route.params.id,"/")).then((function(e){t.$store.commit("setBusy",!1),t.user=e.data})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("Cannot fetch the user data.")}))},deleteUser:function(){var t=this;t.$store.commit("setBusy",!0),axios.delete("/ssh-users/".concat(t.$route.params.id,"/")).then((function(e){toastr.error("The user and associated data has been deleted."),t.$router.push({name:"users"})})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("An error occured while trying to delete this user.")}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return t.user?n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",[t._v("Manage User: "+t._s(t.user.username))])])]),t._v(" "),t.user?n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-8"},[n("div",{staticClass:"card mb-3"},[n("div",{staticClass:"card-header bg-primary text-light"},[t._v("\n                        General Details\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[t.new_password?n("p",[t._v("New password is "),n("small",{staticClass:"text-info font-weight-bold"},[t._v(t._s(t.new_password))]),t._v(" and it is shown to you this single time. "),n("a",{staticClass:"text-danger text-decoration-none",staticStyle:{"font-size":"14px"},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.new_password=!1}}},[n("i",{staticClass:"fas fa-times-circle"}),t._v(" Hide")])]):t._e(),t._v(" "),n("div",{staticClass:"responsive-table"},[n("table",{staticClass:"table table-borderless table-striped"},[n("tbody",[n("tr",[n("td",{staticStyle:{width:"40%"}},[t._v("User Name")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.user.username)+"\n                                            "),n("small",[t.reset?t._e():n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!0}}},[n("i",{staticClass:"fas fa-redo"}),t._v(" Reset\n                                                    Password\n                                                ")]),t._v(" "),t.reset?n("a",{staticClass:"text-success",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!1}}},[t._v("\n                                                    Cancel\n                                                ")]):t._e(),t._v(" "),t.reset?n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.resetPassword()}}},[t._v("\n                                                    Reset\n                                                ")]):t._e()])])]),t._v(" "),n("tr",[n("td",[t._v("Unix ID")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.user.uid)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",[t._v("Status")]),t._v(" "),n("td",[t.user.is_active?n("span",{staticClass:"text-success"},[n("i",{staticClass:"fas fa-check-circle"}),t._v(" Active\n                                            ")]):n("span",{staticClass:"text-danger"},[n("i",{staticClass:"fas fa-times-circle"}),t._v(" Suspended\n                                            ")])])]),t._v(" "),n("tr",[n("td",[t._v("Websites")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.user.total_sites)+"/"+t._s(t.user.max_sites)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",[t._v("Databases")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.user.total_dbs)+"/"+t._s(t.user.max_dbs)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",[t._v("Storage Used")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t._f("prettyBytes")(t.user.storage_used))+"/"+t._s(t._f("prettyBytes")(t.user.max_storage))+"\n                                        ")])])])])])])]),t._v(" "),n("div",{staticClass:"card mt-3"},[n("div",{staticClass:"card-header bg-primary text-light"},[t._v("\n                        Updated User\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-6"},[n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("User Status")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model",value:t.user.is_active,expression:"user.is_active"}],staticClass:"form-control",class:{"is-invalid":t.errors.is_active},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,(function(t){return t.selected})).map((function(t){return"_value"in t?t._value:t.value}));t.$set(t.user,"is_active",e.target.multiple?n:n[0])}}},[n("option",{domProps:{value:!0}},[t._v("Active")]),t._v(" "),n("option",{domProps:{value:!1}},[t._v("Suspended")])]),t._v(" "),t.errors.is_active?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.is_active[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Websites")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.user.max_sites,expression:"user.max_sites"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_sites},attrs:{id:"max_sites",type:"text",placeholder:"Max. websites allowed..."},domProps:{value:t.user.max_sites},on:{input:function(e){e.target.composing||t.$set(t.user,"max_sites",e.target.value)}}}),t._v(" "),t.errors.max_sites?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_sites[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Databases")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.user.max_dbs,expression:"user.max_dbs"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_dbs},attrs:{id:"max_dbs",type:"text",placeholder:"Max. websites allowed..."},domProps:{value:t.user.max_dbs},on:{input:function(e){e.target.composing||t.$set(t.user,"max_dbs",e.target.value)}}}),t._v(" "),t.errors.max_dbs?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_dbs[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Storage")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.user.max_storage,expression:"user.max_storage"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_storage},attrs:{id:"max_dbs",type:"text",placeholder:"Max. storage allowed in bytes..."},domProps:{value:t.user.max_storage},on:{input:function(e){e.target.composing||t.$set(t.user,"max_storage",e.target.value)}}}),t._v(" "),t.errors.max_storage?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_storage[0]))]):t._e()])])])]),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-primary",on:{click:function(e){return t.saveSettings()}}},[t._v("Save Settings")])])]),t._v(" "),n("div",{staticClass:"card mt-3"},[n("div",{staticClass:"card-header text-light",class:{"bg-secondary":!t.del,"bg-danger":t.del}},[t._v("\n                        Danger Zone\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[t._v("\n                        Do you want to delete this user? You can do so here. Beware\n                        that this action is irreversible and you cannot undo once the\n                        user is deleted. Entire data including websites and databases associated to this user\n                        will be permanently deleted.\n                    ")]),t._v(" "),n("div",{staticClass:"card-footer"},[t.del?t._e():n("button",{staticClass:"btn btn-secondary",on:{click:function(e){t.del=!0}}},[t._v("\n                            Delete User\n                        ")]),t._v(" "),t.del?n("button",{staticClass:"btn btn-success",on:{click:function(e){t.del=!1}}},[t._v("\n                            Don't Delete\n                        ")]):t._e(),t._v(" "),t.del?n("button",{staticClass:"btn btn-danger",on:{click:function(e){return t.deleteUser()}}},[t._v("\n                            Confirm Deletion\n                        ")]):t._e()])])])]):t._e()])]):t._e()}),[],!1,null,null,null).exports},1220:(t,e,n)=>{"use strict";n.d(e,{Z:()=>i});const r={props:["pagination"],data:function(){return{data:!1}},created:function(){this.getUsers(),"users"==this.$route.name&&this.EventBus.$on("doSearch",this.getUsers)},beforeDestroy:function(){this.EventBus.$off("doSearch",this.getUsers)},methods:{getUsers:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;if(null!=t){var e=document.getElementById("search-input").value,n=this;n.$store.commit("setBusy",!0),axios.get("/ssh-users/?page=".concat(t,"&q=").concat(e)).then((function(t){n.data=t.data,n.$store.commit("setBusy",!1)})).catch((function(t){n.$store.commit("setBusy",!1)}))}}}};var a=n(1900);const s={components:{UsersTable:(0,a.Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",{staticClass:"float-left"},[t._v("SSH/SFTP Users "),t.data?n("span",[t._v("("+t._s(t.data.count)+")")]):t._e()]),t._v(" "),t.pagination?n("router-link",{staticClass:"btn mb-1 btn-sm float-right btn-secondary",attrs:{to:{name:"createuser"}}},[n("i",{staticClass:"fas fa-plus"}),t._v(" Add New\n            ")]):t._e()],1)]),t._v(" "),t.data&&t.data.count>0?n("div",{staticClass:"table-responsive"},[n("table",{staticClass:"table table-bordered"},[t._m(0),t._v(" "),n("tbody",t._l(t.data.results,(function(e){return n("tr",{key:e.id},[n("td",[t._v(t._s(e.username)),e.uid?n("span",{staticClass:"font-weight-bold text-secondary"},[t._v(" ("+t._s(e.uid)+")")]):t._e()]),t._v(" "),n("td",[t._v(t._s(e.total_dbs)+"/"+t._s(e.max_dbs))]),t._v(" "),n("td",[t._v(t._s(e.total_sites)+"/"+t._s(e.max_sites))]),t._v(" "),n("td",[e.is_active?n("span",{staticClass:"text-success"},[n("i",{staticClass:"fas fa-check-circle"}),t._v(" Active\n                        ")]):n("span",{staticClass:"text-danger"},[n("i",{staticClass:"fas fa-times-circle"}),t._v(" Suspended\n                        ")])]),t._v(" "),n("td",[t._v("\n                        "+t._s(e.date_joined)+"\n                    ")]),t._v(" "),n("td",{staticClass:"text-right"},[n("router-link",{staticClass:"btn btn-sm btn-outline-primary",attrs:{to:{name:"user",params:{id:e.id}}}},[n("i",{staticClass:"fas fa-cog"}),t._v(" Settings\n                        ")])],1)])})),0)])]):n("div",{staticClass:"text-muted mb-3 border border-muted rounded p-5 text-center"},[t._v("\n        No users found.\n    ")]),t._v(" "),t.pagination&&t.data&&t.data.count>0?n("nav",{attrs:{"aria-label":"User Pagination"}},[n("ul",{staticClass:"pagination float-right"},[n("li",{staticClass:"page-item",class:{disabled:!t.data.links.previous},on:{click:function(e){return t.getUsers(t.data.links.previous)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Previous")])]),t._v(" "),n("li",{staticClass:"page-item",class:{disabled:!t.data.links.next},on:{click:function(e){return t.getUsers(t.data.links.next)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Next")])])])]):t._e()])}),[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("thead",{staticClass:"bg-primary text-white"},[n("tr",[n("th",{staticStyle:{width:"20%"}},[t._v("\n                        Username & ID\n                    ")]),t._v(" "),n("th",[t._v("\n                        Databases\n                    ")]),t._v(" "),n("th",[t._v("\n                        Websites\n                    ")]),t._v(" "),n("th",[t._v("\n                        Status\n                    ")]),t._v(" "),n("th",{attrs:{colspan:"2"}},[t._v("\n                        Created\n                    ")])])])}],!1,null,null,null).exports}};const i=(0,a.Z)(s,(function(){var t=this.$createElement,e=this._self._c||t;return e("div",{staticClass:"row"},[e("users-table",{attrs:{pagination:!0}})],1)}),[],!1,null,null,null).exports},1001:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{label:"",php:"",php_versions:[],domains:"",errors:{},users:[],ssh_user:"",create:!1,website_type:"blank",wpuser:"admin",email:"",password:""}},created:function(){this.getPhpVersions(),this.$store.state.user&&this.$store.state.user.is_root&&this.getUsers(),this.EventBus.$on("userCreated",this.handleUserCreated)},methods:{handleUserCreated:function(t){this.create=!1,this.ssh_user=t,this.getUsers()},getUsers:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"",e=arguments.length>1&&void 0!==arguments[1]&&arguments[1],n=this;n.errors={},e&&e(!0),n.$store.commit("setBusy",!0),axios.get("/ssh-users/?q=".concat(t)).then((function(t){n.$store.commit("setBusy",!1);for(var r=[],a=0;a<t.data.results.length;a++)r.push(t.data.results[a].username);e&&e(!1),n.users=r})).catch((function(t){n.$store.commit("setBusy",!1),toastr.error("SSH users list cannot be obtained."),e&&e(!1)}))},getPhpVersions:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/websites/php-versions/").then((function(e){t.php_versions=e.data.php_versions,t.php=t.php_versions[0],t.$store.commit("setBusy",!1)})).catch((function(e){toastr.error("Supported PHP versions list cannot be retrieved."),t.$store.commit("setBusy",!1)}))},createWebsite:function(){var t=this;t.errors={},t.$store.commit("setBusy",!0);var e=new FormData;e.append("label",t.label),e.append("php",t.php),e.append("ssh_user",t.ssh_user),e.append("domains",t.domains),e.append("website_type",t.website_type),axios.post("/websites/",e).then((function(e){t.$store.commit("setBusy",!1),toastr.success("Website has been added successfully."),t.$router.push({name:"websites"})})).catch((function(e){t.$store.commit("setBusy",!1),t.errors=e.response.data}))}},watch:{create:function(t,e){t&&(this.user_pass=this.genRandPassword(),this.ssh_user=""),this.errors={}}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"card"},[n("div",{staticClass:"card-header"},[n("h4",{staticClass:"float-left mt-1"},[t._v("Deploy Website")]),t._v(" "),n("router-link",{staticClass:"btn mt-1 btn-sm float-right btn-warning",attrs:{to:{name:"websites"}}},[n("i",{staticClass:"fas fa-times"}),t._v(" Cancel\n                ")])],1),t._v(" "),n("div",{staticClass:"card-body"},[n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-6"},[n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"label"}},[t._v("Website Label")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.label,expression:"label"}],staticClass:"form-control",class:{"is-invalid":t.errors.label},attrs:{id:"label",type:"text",placeholder:"A unique label to identify your website..."},domProps:{value:t.label},on:{input:function(e){e.target.composing||(t.label=e.target.value)}}}),t._v(" "),t.errors.label?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.label[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"php"}},[t._v("PHP Version")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model",value:t.php,expression:"php"}],staticClass:"form-control",class:{"is-invalid":t.errors.php},attrs:{id:"php"},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,(function(t){return t.selected})).map((function(t){return"_value"in t?t._value:t.value}));t.php=e.target.multiple?n:n[0]}}},t._l(t.php_versions,(function(e){return n("option",{key:e,domProps:{value:e}},[t._v("PHP "+t._s(e))])})),0),t._v(" "),t.errors.php?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.php[0]))]):t._e()]),t._v(" "),t.$store.state.user&&t.$store.state.user.is_root?n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"user"}},[t._v("\n                                SSH User\n                                "),n("small",[n("a",{staticClass:"text-primary text-decoration-none",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.create=!t.create}}},[t.create?n("span",[t._v("Cancel")]):n("span",[n("i",{staticClass:"fas fa-plus"}),t._v(" Create\n                                        ")])])])]),t._v(" "),t.create?n("usercreate-component"):n("v-select",{attrs:{options:t.users},on:{search:t.getUsers},model:{value:t.ssh_user,callback:function(e){t.ssh_user=e},expression:"ssh_user"}}),t._v(" "),!t.create&&t.errors.username?n("p",{staticClass:"text-danger"},[t._v(t._s(t.errors.username))]):t._e()],1):t._e(),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"website_type"}},[t._v("Website Type")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model",value:t.website_type,expression:"website_type"}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,(function(t){return t.selected})).map((function(t){return"_value"in t?t._value:t.value}));t.website_type=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:"blank"}},[t._v("Blank PHP Website")]),t._v(" "),n("option",{attrs:{value:"wordpress"}},[t._v("WordPress Website")])]),t._v(" "),"wordpress"==t.website_type?n("p",{staticClass:"text-info"},[t._v("\n                                Once the website is deployed, visit the primary domain to complete the WordPress installation.\n                            ")]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"domains"}},[t._v("Domains")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.domains,expression:"domains"}],staticClass:"form-control",class:{"is-invalid":t.errors.domains},attrs:{id:"domains",type:"text",placeholder:"Comma-separated domains list, i.e. example.com, www.example.com"},domProps:{value:t.domains},on:{input:function(e){e.target.composing||(t.domains=e.target.value)}}}),t._v(" "),t.errors.domains?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.domains[0]))]):t._e()])])])]),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-primary",attrs:{disabled:t.create},on:{click:function(e){return t.createWebsite()}}},[t._v("Deploy Now")])])])])])}),[],!1,null,null,null).exports},8959:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{website:!1,del:!1,reset:!1,change_php:!1,php_versions:[],del_dom:!1,new_domain:"",add_dom:!1,refresh_ssl:!1,new_password:!1,errors:{}}},created:function(){this.getWebsite(),this.getPhpVersions()},methods:{addDomain:function(){var t=this;t.$store.commit("setBusy",!0),t.errors={};var e=new FormData;e.append("domain",t.new_domain),axios.post("/websites/".concat(t.$route.params.id,"/add-domain/"),e).then((function(e){t.add_dom=!1,t.new_domain="",t.$store.commit("setbusy",!1),toastr.success("Domain has been added successfully."),t.getWebsite()})).catch((function(e){e.response&&e.response.data.errors?t.errors=e.response.data.errors:toastr.error("An error occurred and the domain cannot be added."),t.$store.commit("setBusy",!1)}))},getPhpVersions:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/websites/php-versions/").then((function(e){t.php_versions=e.data.php_versions,t.$store.commit("setBusy",!1)})).catch((function(e){toastr.error("Supported PHP versions list cannot be retrieved."),t.$store.commit("setBusy",!1)}))},changePhp:function(){var t=this;t.$store.commit("setBusy",!0);var e=new FormData;e.append("php",t.website.php),axios.post("/websites/".concat(t.$route.params.id,"/change-php/"),e).then((function(e){t.$store.commit("setBusy",!1),toastr.success("PHP version has been updated."),t.change_php=!1})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("PHP version cannot be updated.")}))},resetPassword:function(){var t=this;t.$store.commit("setBusy",!0),axios.post("/ssh-users/".concat(t.website.user,"/reset-password/")).then((function(e){t.$store.commit("setBusy",!1),toastr.success("SSH/SFTP password has been updated."),t.new_password=e.data.new_password,t.reset=!1})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("SSH/SFTP password cannot be updated.")}))},getWebsite:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/websites/".concat(t.$route.params.id,"/")).then((function(e){t.$store.commit("setBusy",!1),t.website=e.data})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("Cannot fetch the website data.")}))},deleteWebsite:function(){var t=this;t.$store.commit("setBusy",!0),axios.delete("/websites/".concat(t.$route.params.id,"/")).then((function(e){toastr.error("The website and associated data has been deleted."),t.$router.push({name:"websites"})})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("An error occured while trying to delete this website.")}))},deleteDomain:function(t){var e=this;e.$store.commit("setBusy",!0),axios.delete("/websites/".concat(e.$route.params.id,"/delete-domain/").concat(t,"/")).then((function(t){e.$store.commit("setBusy",!1),e.getWebsite(),toastr.success("Domain has been deleted.")})).catch((function(t){e.$store.commit("setBusy",!1),t.response&&t.response.data.message?toastr.error(t.response.data.message):toastr.error("Domain cannot be deleted.")}))},refreshSslCerts:function(){var t=this;t.$store.commit("setBusy",!0),axios.post("/websites/".concat(t.$route.params.id,"/refresh-ssl/")).then((function(e){t.$store.commit("setBusy",!1),t.getWebsite(),toastr.success(e.data.message),t.refresh_ssl=!1})).catch((function(e){t.$store.commit("setBusy",!1),e.response&&e.response.data.message?toastr.error(e.response.data.message):toastr.error("SSL certificates cannot be refreshed.")}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",[t._v("Manage Website: #"+t._s(t.$route.params.id))])])]),t._v(" "),t.website?n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-8"},[n("div",{staticClass:"card mb-3"},[n("div",{staticClass:"card-header bg-primary text-light"},[t._v("\n                        General Details\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[t.new_password?n("p",[t._v("New password is "),n("small",{staticClass:"text-info font-weight-bold"},[t._v(t._s(t.new_password))]),t._v(" and it is shown to you this single time. "),n("a",{staticClass:"text-danger text-decoration-none",staticStyle:{"font-size":"14px"},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.new_password=!1}}},[n("i",{staticClass:"fas fa-times-circle"}),t._v(" Hide")])]):t._e(),t._v(" "),n("div",{staticClass:"responsive-table"},[n("table",{staticClass:"table table-borderless table-striped"},[n("tbody",[n("tr",[n("td",{staticStyle:{width:"40%"}},[t._v("SSH/SFTP User")]),t._v(" "),n("td",[n("span",[t._v(t._s(t.website.metadata.user))]),t._v(" "),n("small",[t.reset?t._e():n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!0}}},[n("i",{staticClass:"fas fa-redo"}),t._v(" Reset\n                                                    Password\n                                                ")]),t._v(" "),t.reset?n("a",{staticClass:"text-success",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!1}}},[t._v("\n                                                    Cancel\n                                                ")]):t._e(),t._v(" "),t.reset?n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.resetPassword()}}},[t._v("\n                                                    Reset\n                                                ")]):t._e()])])]),t._v(" "),n("tr",[n("td",[t._v("SFTP/SSH Host")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.website.metadata.ip_addr)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",[t._v("Web Root")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.website.metadata.path)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",[t._v("PHP Version")]),t._v(" "),n("td",[t.change_php?n("select",{directives:[{name:"model",rawName:"v-model",value:t.website.php,expression:"website.php"}],on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,(function(t){return t.selected})).map((function(t){return"_value"in t?t._value:t.value}));t.$set(t.website,"php",e.target.multiple?n:n[0])}}},t._l(t.php_versions,(function(e){return n("option",{key:e,domProps:{value:e}},[t._v("PHP "+t._s(e))])})),0):n("a",[n("i",{staticClass:"fab fa-php"}),t._v(" PHP\n                                                "+t._s(t.website.php)+"\n                                                "),n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.change_php=!0}}},[t._v("Change")])]),t._v(" "),t.change_php?n("button",{staticClass:"btn btn-primary btn-sm",staticStyle:{"font-size":"12px"},on:{click:function(e){t.change_php=!1}}},[t._v("Cancel")]):t._e(),t._v(" "),t.change_php?n("button",{staticClass:"btn btn-danger btn-sm",staticStyle:{"font-size":"12px"},on:{click:function(e){return t.changePhp()}}},[t._v("Update")]):t._e()])])])])])])]),t._v(" "),t.add_dom?n("div",{staticClass:"card mb-3"},[n("div",{staticClass:"card-body"},[n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"domain"}},[t._v("Domain Name")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.new_domain,expression:"new_domain"}],staticClass:"form-control",class:{"is-invalid":t.errors.domain},attrs:{type:"text",placeholder:"Add a new domain to this website."},domProps:{value:t.new_domain},on:{input:function(e){e.target.composing||(t.new_domain=e.target.value)}}}),t._v(" "),t.errors.domain?n("small",{staticClass:"d-block invalid-feedback"},[t._v("\n                                "+t._s(t.errors.domain[0])+"\n                            ")]):t._e()])]),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-primary",on:{click:function(e){return t.addDomain()}}},[t._v("\n                            Add Domain\n                        ")]),t._v(" "),n("button",{staticClass:"btn btn-success",on:{click:function(e){t.add_dom=!1}}},[t._v("Cancel")])])]):t._e(),t._v(" "),t.refresh_ssl?n("div",{staticClass:"card mb-3"},[n("div",{staticClass:"card-header"},[t._v("\n                        Refresh SSL Certificates\n                    ")]),t._v(" "),t._m(0),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-warning",on:{click:function(e){return t.refreshSslCerts()}}},[t._v("\n                            Refresh SSL\n                        ")]),t._v(" "),n("button",{staticClass:"btn btn-primary",on:{click:function(e){t.refresh_ssl=!1}}},[t._v("\n                            Cancel\n                        ")])])]):t._e(),t._v(" "),n("div",{staticClass:"card"},[n("div",{staticClass:"card-header text-light bg-primary"},[t._v("\n                        Domains ("+t._s(t.website.domains.length)+")\n                        "),n("button",{staticClass:"btn btn-primary float-right",on:{click:function(e){t.add_dom=!t.add_dom}}},[n("i",{staticClass:"fas fa-plus"}),t._v(" Add\n                        ")]),t._v(" "),n("button",{staticClass:"btn btn-primary float-right",on:{click:function(e){t.refresh_ssl=!t.refresh_ssl}}},[n("i",{staticClass:"fas fa-lock"}),t._v(" Refresh SSL\n                        ")])]),t._v(" "),n("div",{staticClass:"card-body"},[n("div",{staticClass:"table-responsive"},[n("table",{staticClass:"table table-borderless table-striped"},[n("tbody",t._l(t.website.domains,(function(e){return n("tr",{key:e.domain},[n("td",{staticStyle:{width:"40%"}},[t._v(t._s(e.domain))]),t._v(" "),n("td",{staticClass:"font-weight-bold",class:{"text-success":e.ssl,"text-muted":!e.ssl},staticStyle:{width:"20%"}},[e.ssl?n("span",[n("i",{staticClass:"fas fa-lock"}),t._v(" HTTPS\n                                            ")]):n("span",[n("i",{staticClass:"fas fa-unlock"}),t._v(" HTTP\n                                            ")])]),t._v(" "),n("td",{staticClass:"text-right"},[t.del_dom!=e.id?n("button",{staticClass:"btn btn-sm btn-warning",on:{click:function(n){t.del_dom=e.id}}},[t._v("\n                                                Delete\n                                            ")]):t._e(),t._v(" "),t.del_dom==e.id?n("button",{staticClass:"btn btn-sm btn-danger",on:{click:function(n){return t.deleteDomain(e.id)}}},[t._v("\n                                                Confirm\n                                            ")]):t._e(),t._v(" "),t.del_dom==e.id?n("button",{staticClass:"btn btn-sm btn-success",on:{click:function(e){t.del_dom=!1}}},[t._v("\n                                                Cancel\n                                            ")]):t._e()])])})),0)])])])]),t._v(" "),n("div",{staticClass:"card mt-3"},[n("div",{staticClass:"card-header text-light",class:{"bg-secondary":!t.del,"bg-danger":t.del}},[t._v("\n                        Danger Zone\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[t._v("\n                        Do you want to delete this website? You can do so here. Beware\n                        that this action is irreversible and you cannot undo once the\n                        website is deleted. Entire data associated to this website\n                        will be permanently lost.\n                    ")]),t._v(" "),n("div",{staticClass:"card-footer"},[t.del?t._e():n("button",{staticClass:"btn btn-secondary",on:{click:function(e){t.del=!0}}},[t._v("\n                            Delete Website\n                        ")]),t._v(" "),t.del?n("button",{staticClass:"btn btn-success",on:{click:function(e){t.del=!1}}},[t._v("\n                            Don't Delete\n                        ")]):t._e(),t._v(" "),t.del?n("button",{staticClass:"btn btn-danger",on:{click:function(e){return t.deleteWebsite()}}},[t._v("\n                            Confirm Deletion\n                        ")]):t._e()])])])]):t._e()])])}),[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"card-body"},[n("p",[t._v("FastCP automatically obtains SSL certificates and automatically renews them for domains that point to this server. When a new domain is added, it may take up to 1 hour before an SSL certificate is obtained.")]),t._v(" "),n("p",[t._v("But if you are in a hurry and need to activate SSL certificates right away, you can request a refresh below. If SSL can't be activated, ensure that the domains are resolving to this server's IP.")])])}],!1,null,null,null).exports},8629:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={components:{WebsitesTable:n(3109).Z}};const a=(0,n(1900).Z)(r,(function(){var t=this.$createElement,e=this._self._c||t;return e("div",{staticClass:"row"},[e("websites-table",{attrs:{pagination:!0}})],1)}),[],!1,null,null,null).exports},3109:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={props:["pagination"],data:function(){return{data:!1}},created:function(){this.getWebsites(),"websites"==this.$route.name&&this.EventBus.$on("doSearch",this.getWebsites)},beforeDestroy:function(){this.EventBus.$off("doSearch",this.getWebsites)},methods:{getWebsites:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;if(null!=t){var e=document.getElementById("search-input").value,n=this;n.$store.commit("setBusy",!0),axios.get("/websites/?page=".concat(t,"&q=").concat(e)).then((function(t){n.data=t.data,n.$store.commit("setBusy",!1)})).catch((function(t){n.$store.commit("setBusy",!1)}))}}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",{staticClass:"float-left"},[t._v("Websites "),t.data?n("span",[t._v("("+t._s(t.data.count)+")")]):t._e()]),t._v(" "),t.pagination?n("router-link",{staticClass:"btn mb-1 btn-sm float-right btn-outline-primary",attrs:{to:{name:"deploysite"}}},[n("i",{staticClass:"fas fa-plus"}),t._v(" Deploy New\n            ")]):n("router-link",{staticClass:"btn mb-1 btn-sm float-right btn-outline-primary",attrs:{to:{name:"websites"}}},[n("i",{staticClass:"fas fa-list"}),t._v(" All Websites\n            ")])],1)]),t._v(" "),t.data&&t.data.count>0?n("div",{staticClass:"table-responsive"},[n("table",{staticClass:"table table-bordered"},[t._m(0),t._v(" "),n("tbody",t._l(t.data.results,(function(e){return n("tr",{key:e.id},[n("td",[t._v(t._s(e.label))]),t._v(" "),n("td",[n("i",{staticClass:"fab fa-php"}),t._v(" "+t._s(e.php))]),t._v(" "),n("td",[e.has_ssl?n("span",{staticClass:"text-success"},[n("i",{staticClass:"fas fa-lock"}),t._v(" HTTPS")]):n("span",{staticClass:"text-warning"},[n("i",{staticClass:"fas fa-unlock"}),t._v(" HTTP")])]),t._v(" "),n("td",{staticClass:"text-right"},[n("router-link",{staticClass:"btn btn-sm btn-warning",attrs:{to:{name:"filemanager",params:{id:e.id}}}},[n("i",{staticClass:"fas fa-folder"}),t._v(" Files\n                        ")]),t._v(" "),n("router-link",{staticClass:"btn btn-sm btn-primary",attrs:{to:{name:"website",params:{id:e.id}}}},[n("i",{staticClass:"fas fa-cog"}),t._v(" Settings\n                        ")])],1)])})),0)])]):n("div",{staticClass:"text-muted mb-3 border border-muted rounded p-5 text-center"},[t._v("\n        No websites found.\n    ")]),t._v(" "),t.pagination&&t.data&&t.data.count>0?n("nav",{attrs:{"aria-label":"Pagination"}},[n("ul",{staticClass:"pagination float-right"},[n("li",{staticClass:"page-item",class:{disabled:!t.data.links.previous},on:{click:function(e){return t.getWebsites(t.data.links.previous)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Previous")])]),t._v(" "),n("li",{staticClass:"page-item",class:{disabled:!t.data.links.next},on:{click:function(e){return t.getWebsites(t.data.links.next)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Next")])])])]):t._e()])}),[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("thead",{staticClass:"bg-primary text-white"},[n("tr",[n("th",{staticStyle:{width:"30%"}},[t._v("Label")]),t._v(" "),n("th",{staticStyle:{width:"20%"}},[t._v("\n                        PHP Version\n                    ")]),t._v(" "),n("th",{attrs:{colspan:"2"}},[t._v("\n                        SSL\n                    ")])])])}],!1,null,null,null).exports},1900:(t,e,n)=>{"use strict";function r(t,e,n,r,a,s,i,o){var c,l="function"==typeof t?t.options:t;if(e&&(l.render=e,l.staticRenderFns=n,l._compiled=!0),r&&(l.functional=!0),s&&(l._scopeId="data-v-"+s),i?(c=function(t){(t=t||this.$vnode&&this.$vnode.ssrContext||this.parent&&this.parent.$vnode&&this.parent.$vnode.ssrContext)||"undefined"==typeof __VUE_SSR_CONTEXT__||(t=__VUE_SSR_CONTEXT__),a&&a.call(this,t),t&&t._registeredComponents&&t._registeredComponents.add(i)},l._ssrRegister=c):a&&(c=o?function(){a.call(this,(l.functional?this.parent:this).$root.$options.shadowRoot)}:a),c)if(l.functional){l._injectStyles=c;var u=l.render;l.render=function(t,e){return c.call(e),u(t,e)}}else{var d=l.beforeCreate;l.beforeCreate=d?[].concat(d,c):[c]}return{exports:t,options:l}}n.d(e,{Z:()=>r})},7398:function(t){"undefined"!=typeof self&&self,t.exports=function(t){var e={};function n(r){if(e[r])return e[r].exports;var a=e[r]={i:r,l:!1,exports:{}};return t[r].call(a.exports,a,a.exports,n),a.l=!0,a.exports}return n.m=t,n.c=e,n.d=function(t,e,r){n.o(t,e)||Object.defineProperty(t,e,{enumerable:!0,get:r})},n.r=function(t){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(t,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(t,"__esModule",{value:!0})},n.t=function(t,e){if(1&e&&(t=n(t)),8&e)return t;if(4&e&&"object"==typeof t&&t&&t.__esModule)return t;var r=Object.create(null);if(n.r(r),Object.defineProperty(r,"default",{enumerable:!0,value:t}),2&e&&"string"!=typeof t)for(var a in t)n.d(r,a,function(e){return t[e]}.bind(null,a));return r},n.n=function(t){var e=t&&t.__esModule?function(){return t.default}:function(){return t};return n.d(e,"a",e),e},n.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},n.p="",n(n.s=1)}([function(t,e,n){},function(t,e,n){"use strict";n.r(e);var r="undefined"!=typeof window?window.HTMLElement:Object,a={mounted:function(){this.enforceFocus&&document.addEventListener("focusin",this.focusIn)},methods:{focusIn:function(t){if(this.isActive&&t.target!==this.$el&&!this.$el.contains(t.target)){var e=this.container?this.container:this.isFullPage?null:this.$el.parentElement;(this.isFullPage||e&&e.contains(t.target))&&(t.preventDefault(),this.$el.focus())}}},beforeDestroy:function(){document.removeEventListener("focusin",this.focusIn)}};function s(t,e,n,r,a,s,i,o){var c,l="function"==typeof t?t.options:t;if(e&&(l.render=e,l.staticRenderFns=n,l._compiled=!0),r&&(l.functional=!0),s&&(l._scopeId="data-v-"+s),i?(c=function(t){(t=t||this.$vnode&&this.$vnode.ssrContext||this.parent&&this.parent.$vnode&&this.parent.$vnode.ssrContext)||"undefined"==typeof __VUE_SSR_CONTEXT__||(t=__VUE_SSR_CONTEXT__),a&&a.call(this,t),t&&t._registeredComponents&&t._registeredComponents.add(i)},l._ssrRegister=c):a&&(c=o?function(){a.call(this,(l.functional?this.parent:this).$root.$options.shadowRoot)}:a),c)if(l.functional){l._injectStyles=c;var u=l.render;l.render=function(t,e){return c.call(e),u(t,e)}}else{var d=l.beforeCreate;l.beforeCreate=d?[].concat(d,c):[c]}return{exports:t,options:l}}var i=s({name:"spinner",props:{color:{type:String,default:"#000"},height:{type:Number,default:64},width:{type:Number,default:64}}},(function(){var t=this.$createElement,e=this._self._c||t;return e("svg",{attrs:{viewBox:"0 0 38 38",xmlns:"http://www.w3.org/2000/svg",width:this.width,height:this.height,stroke:this.color}},[e("g",{attrs:{fill:"none","fill-rule":"evenodd"}},[e("g",{attrs:{transform:"translate(1 1)","stroke-width":"2"}},[e("circle",{attrs:{"stroke-opacity":".25",cx:"18",cy:"18",r:"18"}}),e("path",{attrs:{d:"M36 18c0-9.94-8.06-18-18-18"}},[e("animateTransform",{attrs:{attributeName:"transform",type:"rotate",from:"0 18 18",to:"360 18 18",dur:"0.8s",repeatCount:"indefinite"}})],1)])])])}),[],!1,null,null,null).exports,o=s({name:"dots",props:{color:{type:String,default:"#000"},height:{type:Number,default:240},width:{type:Number,default:60}}},(function(){var t=this.$createElement,e=this._self._c||t;return e("svg",{attrs:{viewBox:"0 0 120 30",xmlns:"http://www.w3.org/2000/svg",fill:this.color,width:this.width,height:this.height}},[e("circle",{attrs:{cx:"15",cy:"15",r:"15"}},[e("animate",{attrs:{attributeName:"r",from:"15",to:"15",begin:"0s",dur:"0.8s",values:"15;9;15",calcMode:"linear",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"fill-opacity",from:"1",to:"1",begin:"0s",dur:"0.8s",values:"1;.5;1",calcMode:"linear",repeatCount:"indefinite"}})]),e("circle",{attrs:{cx:"60",cy:"15",r:"9","fill-opacity":"0.3"}},[e("animate",{attrs:{attributeName:"r",from:"9",to:"9",begin:"0s",dur:"0.8s",values:"9;15;9",calcMode:"linear",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"fill-opacity",from:"0.5",to:"0.5",begin:"0s",dur:"0.8s",values:".5;1;.5",calcMode:"linear",repeatCount:"indefinite"}})]),e("circle",{attrs:{cx:"105",cy:"15",r:"15"}},[e("animate",{attrs:{attributeName:"r",from:"15",to:"15",begin:"0s",dur:"0.8s",values:"15;9;15",calcMode:"linear",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"fill-opacity",from:"1",to:"1",begin:"0s",dur:"0.8s",values:"1;.5;1",calcMode:"linear",repeatCount:"indefinite"}})])])}),[],!1,null,null,null).exports,c=s({name:"bars",props:{color:{type:String,default:"#000"},height:{type:Number,default:40},width:{type:Number,default:40}}},(function(){var t=this.$createElement,e=this._self._c||t;return e("svg",{attrs:{xmlns:"http://www.w3.org/2000/svg",viewBox:"0 0 30 30",height:this.height,width:this.width,fill:this.color}},[e("rect",{attrs:{x:"0",y:"13",width:"4",height:"5"}},[e("animate",{attrs:{attributeName:"height",attributeType:"XML",values:"5;21;5",begin:"0s",dur:"0.6s",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"y",attributeType:"XML",values:"13; 5; 13",begin:"0s",dur:"0.6s",repeatCount:"indefinite"}})]),e("rect",{attrs:{x:"10",y:"13",width:"4",height:"5"}},[e("animate",{attrs:{attributeName:"height",attributeType:"XML",values:"5;21;5",begin:"0.15s",dur:"0.6s",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"y",attributeType:"XML",values:"13; 5; 13",begin:"0.15s",dur:"0.6s",repeatCount:"indefinite"}})]),e("rect",{attrs:{x:"20",y:"13",width:"4",height:"5"}},[e("animate",{attrs:{attributeName:"height",attributeType:"XML",values:"5;21;5",begin:"0.3s",dur:"0.6s",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"y",attributeType:"XML",values:"13; 5; 13",begin:"0.3s",dur:"0.6s",repeatCount:"indefinite"}})])])}),[],!1,null,null,null).exports,l=s({name:"vue-loading",mixins:[a],props:{active:Boolean,programmatic:Boolean,container:[Object,Function,r],isFullPage:{type:Boolean,default:!0},enforceFocus:{type:Boolean,default:!0},lockScroll:{type:Boolean,default:!1},transition:{type:String,default:"fade"},canCancel:Boolean,onCancel:{type:Function,default:function(){}},color:String,backgroundColor:String,blur:{type:String,default:"2px"},opacity:Number,width:Number,height:Number,zIndex:Number,loader:{type:String,default:"spinner"}},data:function(){return{isActive:this.active}},components:{Spinner:i,Dots:o,Bars:c},beforeMount:function(){this.programmatic&&(this.container?(this.isFullPage=!1,this.container.appendChild(this.$el)):document.body.appendChild(this.$el))},mounted:function(){this.programmatic&&(this.isActive=!0),document.addEventListener("keyup",this.keyPress)},methods:{cancel:function(){this.canCancel&&this.isActive&&(this.hide(),this.onCancel.apply(null,arguments))},hide:function(){var t=this;this.$emit("hide"),this.$emit("update:active",!1),this.programmatic&&(this.isActive=!1,setTimeout((function(){var e;t.$destroy(),void 0!==(e=t.$el).remove?e.remove():e.parentNode.removeChild(e)}),150))},disableScroll:function(){this.isFullPage&&this.lockScroll&&document.body.classList.add("vld-shown")},enableScroll:function(){this.isFullPage&&this.lockScroll&&document.body.classList.remove("vld-shown")},keyPress:function(t){27===t.keyCode&&this.cancel()}},watch:{active:function(t){this.isActive=t},isActive:function(t){t?this.disableScroll():this.enableScroll()}},computed:{bgStyle:function(){return{background:this.backgroundColor,opacity:this.opacity,backdropFilter:"blur(".concat(this.blur,")")}}},beforeDestroy:function(){document.removeEventListener("keyup",this.keyPress)}},(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("transition",{attrs:{name:t.transition}},[n("div",{directives:[{name:"show",rawName:"v-show",value:t.isActive,expression:"isActive"}],staticClass:"vld-overlay is-active",class:{"is-full-page":t.isFullPage},style:{zIndex:t.zIndex},attrs:{tabindex:"0","aria-busy":t.isActive,"aria-label":"Loading"}},[n("div",{staticClass:"vld-background",style:t.bgStyle,on:{click:function(e){return e.preventDefault(),t.cancel(e)}}}),n("div",{staticClass:"vld-icon"},[t._t("before"),t._t("default",[n(t.loader,{tag:"component",attrs:{color:t.color,width:t.width,height:t.height}})]),t._t("after")],2)])])}),[],!1,null,null,null).exports,u=function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{};return{show:function(){var r=arguments.length>0&&void 0!==arguments[0]?arguments[0]:e,a=arguments.length>1&&void 0!==arguments[1]?arguments[1]:n,s={programmatic:!0},i=Object.assign({},e,r,s),o=new(t.extend(l))({el:document.createElement("div"),propsData:i}),c=Object.assign({},n,a);return Object.keys(c).map((function(t){o.$slots[t]=c[t]})),o}}};n(0),l.install=function(t){var e=u(t,arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},arguments.length>2&&void 0!==arguments[2]?arguments[2]:{});t.$loading=e,t.prototype.$loading=e},e.default=l}]).default}},e={};function n(r){var a=e[r];if(void 0!==a)return a.exports;var s=e[r]={id:r,exports:{}};return t[r].call(s.exports,s,s.exports,n),s.exports}n.n=t=>{var e=t&&t.__esModule?()=>t.default:()=>t;return n.d(e,{a:e}),e},n.d=(t,e)=>{for(var r in e)n.o(e,r)&&!n.o(t,r)&&Object.defineProperty(t,r,{enumerable:!0,get:e[r]})},n.g=function(){if("object"==typeof globalThis)return globalThis;try{return this||new Function("return this")()}catch(t){if("object"==typeof window)return window}}(),n.o=(t,e)=>Object.prototype.hasOwnProperty.call(t,e),(()=>{"use strict";var t=Object.freeze({});function e(t){return null==t}function r(t){return null!=t}function a(t){return!0===t}function s(t){return"string"==typeof t||"number"==typeof t||"symbol"==typeof t||"boolean"==typeof t}function i(t){return null!==t&&"object"==typeof t}var o=Object.prototype.toString;function c(t){return"[object Object]"===o.call(t)}function l(t){return"[object RegExp]"===o.call(t)}function u(t){var e=parseFloat(String(t));return e>=0&&Math.floor(e)===e&&isFinite(t)}function d(t){return r(t)&&"function"==typeof t.then&&"function"==typeof t.catch}function f(t){return null==t?"":Array.isArray(t)||c(t)&&t.toString===o?JSON.stringify(t,null,2):String(t)}function p(t){var e=parseFloat(t);return isNaN(e)?t:e}function v(t,e){for(var n=Object.create(null),r=t.split(","),a=0;a<r.length;a++)n[r[a]]=!0;return e?function(t){return n[t.toLowerCase()]}:function(t){return n[t]}}var h=v("slot,component",!0),m=v("key,ref,slot,slot-scope,is");function g(t,e){if(t.length){var n=t.indexOf(e);if(n>-1)return t.splice(n,1)}}var _=Object.prototype.hasOwnProperty;function y(t,e){return _.call(t,e)}function b(t){var e=Object.create(null);return function(n){return e[n]||(e[n]=t(n))}}var w=/-(\w)/g,C=b((function(t){return t.replace(w,(function(t,e){return e?e.toUpperCase():""}))})),x=b((function(t){return t.charAt(0).toUpperCase()+t.slice(1)})),$=/\B([A-Z])/g,k=b((function(t){return t.replace($,"-$1").toLowerCase()}));var S=Function.prototype.bind?function(t,e){return t.bind(e)}:function(t,e){function n(n){var r=arguments.length;return r?r>1?t.apply(e,arguments):t.call(e,n):t.call(e)}return n._length=t.length,n};function A(t,e){e=e||0;for(var n=t.length-e,r=new Array(n);n--;)r[n]=t[n+e];return r}function O(t,e){for(var n in e)t[n]=e[n];return t}function E(t){for(var e={},n=0;n<t.length;n++)t[n]&&O(e,t[n]);return e}function T(t,e,n){}var P=function(t,e,n){return!1},j=function(t){return t};function N(t,e){if(t===e)return!0;var n=i(t),r=i(e);if(!n||!r)return!n&&!r&&String(t)===String(e);try{var a=Array.isArray(t),s=Array.isArray(e);if(a&&s)return t.length===e.length&&t.every((function(t,n){return N(t,e[n])}));if(t instanceof Date&&e instanceof Date)return t.getTime()===e.getTime();if(a||s)return!1;var o=Object.keys(t),c=Object.keys(e);return o.length===c.length&&o.every((function(n){return N(t[n],e[n])}))}catch(t){return!1}}function B(t,e){for(var n=0;n<t.length;n++)if(N(t[n],e))return n;return-1}function D(t){var e=!1;return function(){e||(e=!0,t.apply(this,arguments))}}var M="data-server-rendered",R=["component","directive","filter"],L=["beforeCreate","created","beforeMount","mounted","beforeUpdate","updated","beforeDestroy","destroyed","activated","deactivated","errorCaptured","serverPrefetch"],F={optionMergeStrategies:Object.create(null),silent:!1,productionTip:!1,devtools:!1,performance:!1,errorHandler:null,warnHandler:null,ignoredElements:[],keyCodes:Object.create(null),isReservedTag:P,isReservedAttr:P,isUnknownElement:P,getTagNamespace:T,parsePlatformTagName:j,mustUseProp:P,async:!0,_lifecycleHooks:L},U=/a-zA-Z\u00B7\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u037D\u037F-\u1FFF\u200C-\u200D\u203F-\u2040\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD/;function I(t){var e=(t+"").charCodeAt(0);return 36===e||95===e}function H(t,e,n,r){Object.defineProperty(t,e,{value:n,enumerable:!!r,writable:!0,configurable:!0})}var Z=new RegExp("[^"+U.source+".$_\\d]");var q,V="__proto__"in{},W="undefined"!=typeof window,z="undefined"!=typeof WXEnvironment&&!!WXEnvironment.platform,G=z&&WXEnvironment.platform.toLowerCase(),J=W&&window.navigator.userAgent.toLowerCase(),K=J&&/msie|trident/.test(J),X=J&&J.indexOf("msie 9.0")>0,Y=J&&J.indexOf("edge/")>0,Q=(J&&J.indexOf("android"),J&&/iphone|ipad|ipod|ios/.test(J)||"ios"===G),tt=(J&&/chrome\/\d+/.test(J),J&&/phantomjs/.test(J),J&&J.match(/firefox\/(\d+)/)),et={}.watch,nt=!1;if(W)try{var rt={};Object.defineProperty(rt,"passive",{get:function(){nt=!0}}),window.addEventListener("test-passive",null,rt)}catch(t){}var at=function(){return void 0===q&&(q=!W&&!z&&void 0!==n.g&&(n.g.process&&"server"===n.g.process.env.VUE_ENV)),q},st=W&&window.__VUE_DEVTOOLS_GLOBAL_HOOK__;function it(t){return"function"==typeof t&&/native code/.test(t.toString())}var ot,ct="undefined"!=typeof Symbol&&it(Symbol)&&"undefined"!=typeof Reflect&&it(Reflect.ownKeys);ot="undefined"!=typeof Set&&it(Set)?Set:function(){function t(){this.set=Object.create(null)}return t.prototype.has=function(t){return!0===this.set[t]},t.prototype.add=function(t){this.set[t]=!0},t.prototype.clear=function(){this.set=Object.create(null)},t}();var lt=T,ut=0,dt=function(){this.id=ut++,this.subs=[]};dt.prototype.addSub=function(t){this.subs.push(t)},dt.prototype.removeSub=function(t){g(this.subs,t)},dt.prototype.depend=function(){dt.target&&dt.target.addDep(this)},dt.prototype.notify=function(){var t=this.subs.slice();for(var e=0,n=t.length;e<n;e++)t[e].update()},dt.target=null;var ft=[];function pt(t){ft.push(t),dt.target=t}function vt(){ft.pop(),dt.target=ft[ft.length-1]}var ht=function(t,e,n,r,a,s,i,o){this.tag=t,this.data=e,this.children=n,this.text=r,this.elm=a,this.ns=void 0,this.context=s,this.fnContext=void 0,this.fnOptions=void 0,this.fnScopeId=void 0,this.key=e&&e.key,this.componentOptions=i,this.componentInstance=void 0,this.parent=void 0,this.raw=!1,this.isStatic=!1,this.isRootInsert=!0,this.isComment=!1,this.isCloned=!1,this.isOnce=!1,this.asyncFactory=o,this.asyncMeta=void 0,this.isAsyncPlaceholder=!1},mt={child:{configurable:!0}};mt.child.get=function(){return this.componentInstance},Object.defineProperties(ht.prototype,mt);var gt=function(t){void 0===t&&(t="");var e=new ht;return e.text=t,e.isComment=!0,e};function _t(t){return new ht(void 0,void 0,void 0,String(t))}function yt(t){var e=new ht(t.tag,t.data,t.children&&t.children.slice(),t.text,t.elm,t.context,t.componentOptions,t.asyncFactory);return e.ns=t.ns,e.isStatic=t.isStatic,e.key=t.key,e.isComment=t.isComment,e.fnContext=t.fnContext,e.fnOptions=t.fnOptions,e.fnScopeId=t.fnScopeId,e.asyncMeta=t.asyncMeta,e.isCloned=!0,e}var bt=Array.prototype,wt=Object.create(bt);["push","pop","shift","unshift","splice","sort","reverse"].forEach((function(t){var e=bt[t];H(wt,t,(function(){for(var n=[],r=arguments.length;r--;)n[r]=arguments[r];var a,s=e.apply(this,n),i=this.__ob__;switch(t){case"push":case"unshift":a=n;break;case"splice":a=n.slice(2)}return a&&i.observeArray(a),i.dep.notify(),s}))}));var Ct=Object.getOwnPropertyNames(wt),xt=!0;function $t(t){xt=t}var kt=function(t){this.value=t,this.dep=new dt,this.vmCount=0,H(t,"__ob__",this),Array.isArray(t)?(V?function(t,e){t.__proto__=e}(t,wt):function(t,e,n){for(var r=0,a=n.length;r<a;r++){var s=n[r];H(t,s,e[s])}}(t,wt,Ct),this.observeArray(t)):this.walk(t)};function St(t,e){var n;if(i(t)&&!(t instanceof ht))return y(t,"__ob__")&&t.__ob__ instanceof kt?n=t.__ob__:xt&&!at()&&(Array.isArray(t)||c(t))&&Object.isExtensible(t)&&!t._isVue&&(n=new kt(t)),e&&n&&n.vmCount++,n}function At(t,e,n,r,a){var s=new dt,i=Object.getOwnPropertyDescriptor(t,e);if(!i||!1!==i.configurable){var o=i&&i.get,c=i&&i.set;o&&!c||2!==arguments.length||(n=t[e]);var l=!a&&St(n);Object.defineProperty(t,e,{enumerable:!0,configurable:!0,get:function(){var e=o?o.call(t):n;return dt.target&&(s.depend(),l&&(l.dep.depend(),Array.isArray(e)&&Tt(e))),e},set:function(e){var r=o?o.call(t):n;e===r||e!=e&&r!=r||o&&!c||(c?c.call(t,e):n=e,l=!a&&St(e),s.notify())}})}}function Ot(t,e,n){if(Array.isArray(t)&&u(e))return t.length=Math.max(t.length,e),t.splice(e,1,n),n;if(e in t&&!(e in Object.prototype))return t[e]=n,n;var r=t.__ob__;return t._isVue||r&&r.vmCount?n:r?(At(r.value,e,n),r.dep.notify(),n):(t[e]=n,n)}function Et(t,e){if(Array.isArray(t)&&u(e))t.splice(e,1);else{var n=t.__ob__;t._isVue||n&&n.vmCount||y(t,e)&&(delete t[e],n&&n.dep.notify())}}function Tt(t){for(var e=void 0,n=0,r=t.length;n<r;n++)(e=t[n])&&e.__ob__&&e.__ob__.dep.depend(),Array.isArray(e)&&Tt(e)}kt.prototype.walk=function(t){for(var e=Object.keys(t),n=0;n<e.length;n++)At(t,e[n])},kt.prototype.observeArray=function(t){for(var e=0,n=t.length;e<n;e++)St(t[e])};var Pt=F.optionMergeStrategies;function jt(t,e){if(!e)return t;for(var n,r,a,s=ct?Reflect.ownKeys(e):Object.keys(e),i=0;i<s.length;i++)"__ob__"!==(n=s[i])&&(r=t[n],a=e[n],y(t,n)?r!==a&&c(r)&&c(a)&&jt(r,a):Ot(t,n,a));return t}function Nt(t,e,n){return n?function(){var r="function"==typeof e?e.call(n,n):e,a="function"==typeof t?t.call(n,n):t;return r?jt(r,a):a}:e?t?function(){return jt("function"==typeof e?e.call(this,this):e,"function"==typeof t?t.call(this,this):t)}:e:t}function Bt(t,e){var n=e?t?t.concat(e):Array.isArray(e)?e:[e]:t;return n?function(t){for(var e=[],n=0;n<t.length;n++)-1===e.indexOf(t[n])&&e.push(t[n]);return e}(n):n}function Dt(t,e,n,r){var a=Object.create(t||null);return e?O(a,e):a}Pt.data=function(t,e,n){return n?Nt(t,e,n):e&&"function"!=typeof e?t:Nt(t,e)},L.forEach((function(t){Pt[t]=Bt})),R.forEach((function(t){Pt[t+"s"]=Dt})),Pt.watch=function(t,e,n,r){if(t===et&&(t=void 0),e===et&&(e=void 0),!e)return Object.create(t||null);if(!t)return e;var a={};for(var s in O(a,t),e){var i=a[s],o=e[s];i&&!Array.isArray(i)&&(i=[i]),a[s]=i?i.concat(o):Array.isArray(o)?o:[o]}return a},Pt.props=Pt.methods=Pt.inject=Pt.computed=function(t,e,n,r){if(!t)return e;var a=Object.create(null);return O(a,t),e&&O(a,e),a},Pt.provide=Nt;var Mt=function(t,e){return void 0===e?t:e};function Rt(t,e,n){if("function"==typeof e&&(e=e.options),function(t,e){var n=t.props;if(n){var r,a,s={};if(Array.isArray(n))for(r=n.length;r--;)"string"==typeof(a=n[r])&&(s[C(a)]={type:null});else if(c(n))for(var i in n)a=n[i],s[C(i)]=c(a)?a:{type:a};t.props=s}}(e),function(t,e){var n=t.inject;if(n){var r=t.inject={};if(Array.isArray(n))for(var a=0;a<n.length;a++)r[n[a]]={from:n[a]};else if(c(n))for(var s in n){var i=n[s];r[s]=c(i)?O({from:s},i):{from:i}}}}(e),function(t){var e=t.directives;if(e)for(var n in e){var r=e[n];"function"==typeof r&&(e[n]={bind:r,update:r})}}(e),!e._base&&(e.extends&&(t=Rt(t,e.extends,n)),e.mixins))for(var r=0,a=e.mixins.length;r<a;r++)t=Rt(t,e.mixins[r],n);var s,i={};for(s in t)o(s);for(s in e)y(t,s)||o(s);function o(r){var a=Pt[r]||Mt;i[r]=a(t[r],e[r],n,r)}return i}function Lt(t,e,n,r){if("string"==typeof n){var a=t[e];if(y(a,n))return a[n];var s=C(n);if(y(a,s))return a[s];var i=x(s);return y(a,i)?a[i]:a[n]||a[s]||a[i]}}function Ft(t,e,n,r){var a=e[t],s=!y(n,t),i=n[t],o=Zt(Boolean,a.type);if(o>-1)if(s&&!y(a,"default"))i=!1;else if(""===i||i===k(t)){var c=Zt(String,a.type);(c<0||o<c)&&(i=!0)}if(void 0===i){i=function(t,e,n){if(!y(e,"default"))return;var r=e.default;0;if(t&&t.$options.propsData&&void 0===t.$options.propsData[n]&&void 0!==t._props[n])return t._props[n];return"function"==typeof r&&"Function"!==It(e.type)?r.call(t):r}(r,a,t);var l=xt;$t(!0),St(i),$t(l)}return i}var Ut=/^\s*function (\w+)/;function It(t){var e=t&&t.toString().match(Ut);return e?e[1]:""}function Ht(t,e){return It(t)===It(e)}function Zt(t,e){if(!Array.isArray(e))return Ht(e,t)?0:-1;for(var n=0,r=e.length;n<r;n++)if(Ht(e[n],t))return n;return-1}function qt(t,e,n){pt();try{if(e)for(var r=e;r=r.$parent;){var a=r.$options.errorCaptured;if(a)for(var s=0;s<a.length;s++)try{if(!1===a[s].call(r,t,e,n))return}catch(t){Wt(t,r,"errorCaptured hook")}}Wt(t,e,n)}finally{vt()}}function Vt(t,e,n,r,a){var s;try{(s=n?t.apply(e,n):t.call(e))&&!s._isVue&&d(s)&&!s._handled&&(s.catch((function(t){return qt(t,r,a+" (Promise/async)")})),s._handled=!0)}catch(t){qt(t,r,a)}return s}function Wt(t,e,n){if(F.errorHandler)try{return F.errorHandler.call(null,t,e,n)}catch(e){e!==t&&zt(e,null,"config.errorHandler")}zt(t,e,n)}function zt(t,e,n){if(!W&&!z||"undefined"==typeof console)throw t;console.error(t)}var Gt,Jt=!1,Kt=[],Xt=!1;function Yt(){Xt=!1;var t=Kt.slice(0);Kt.length=0;for(var e=0;e<t.length;e++)t[e]()}if("undefined"!=typeof Promise&&it(Promise)){var Qt=Promise.resolve();Gt=function(){Qt.then(Yt),Q&&setTimeout(T)},Jt=!0}else if(K||"undefined"==typeof MutationObserver||!it(MutationObserver)&&"[object MutationObserverConstructor]"!==MutationObserver.toString())Gt="undefined"!=typeof setImmediate&&it(setImmediate)?function(){setImmediate(Yt)}:function(){setTimeout(Yt,0)};else{var te=1,ee=new MutationObserver(Yt),ne=document.createTextNode(String(te));ee.observe(ne,{characterData:!0}),Gt=function(){te=(te+1)%2,ne.data=String(te)},Jt=!0}function re(t,e){var n;if(Kt.push((function(){if(t)try{t.call(e)}catch(t){qt(t,e,"nextTick")}else n&&n(e)})),Xt||(Xt=!0,Gt()),!t&&"undefined"!=typeof Promise)return new Promise((function(t){n=t}))}var ae=new ot;function se(t){ie(t,ae),ae.clear()}function ie(t,e){var n,r,a=Array.isArray(t);if(!(!a&&!i(t)||Object.isFrozen(t)||t instanceof ht)){if(t.__ob__){var s=t.__ob__.dep.id;if(e.has(s))return;e.add(s)}if(a)for(n=t.length;n--;)ie(t[n],e);else for(n=(r=Object.keys(t)).length;n--;)ie(t[r[n]],e)}}var oe=b((function(t){var e="&"===t.charAt(0),n="~"===(t=e?t.slice(1):t).charAt(0),r="!"===(t=n?t.slice(1):t).charAt(0);return{name:t=r?t.slice(1):t,once:n,capture:r,passive:e}}));function ce(t,e){function n(){var t=arguments,r=n.fns;if(!Array.isArray(r))return Vt(r,null,arguments,e,"v-on handler");for(var a=r.slice(),s=0;s<a.length;s++)Vt(a[s],null,t,e,"v-on handler")}return n.fns=t,n}function le(t,n,r,s,i,o){var c,l,u,d;for(c in t)l=t[c],u=n[c],d=oe(c),e(l)||(e(u)?(e(l.fns)&&(l=t[c]=ce(l,o)),a(d.once)&&(l=t[c]=i(d.name,l,d.capture)),r(d.name,l,d.capture,d.passive,d.params)):l!==u&&(u.fns=l,t[c]=u));for(c in n)e(t[c])&&s((d=oe(c)).name,n[c],d.capture)}function ue(t,n,s){var i;t instanceof ht&&(t=t.data.hook||(t.data.hook={}));var o=t[n];function c(){s.apply(this,arguments),g(i.fns,c)}e(o)?i=ce([c]):r(o.fns)&&a(o.merged)?(i=o).fns.push(c):i=ce([o,c]),i.merged=!0,t[n]=i}function de(t,e,n,a,s){if(r(e)){if(y(e,n))return t[n]=e[n],s||delete e[n],!0;if(y(e,a))return t[n]=e[a],s||delete e[a],!0}return!1}function fe(t){return s(t)?[_t(t)]:Array.isArray(t)?ve(t):void 0}function pe(t){return r(t)&&r(t.text)&&!1===t.isComment}function ve(t,n){var i,o,c,l,u=[];for(i=0;i<t.length;i++)e(o=t[i])||"boolean"==typeof o||(l=u[c=u.length-1],Array.isArray(o)?o.length>0&&(pe((o=ve(o,(n||"")+"_"+i))[0])&&pe(l)&&(u[c]=_t(l.text+o[0].text),o.shift()),u.push.apply(u,o)):s(o)?pe(l)?u[c]=_t(l.text+o):""!==o&&u.push(_t(o)):pe(o)&&pe(l)?u[c]=_t(l.text+o.text):(a(t._isVList)&&r(o.tag)&&e(o.key)&&r(n)&&(o.key="__vlist"+n+"_"+i+"__"),u.push(o)));return u}function he(t,e){if(t){for(var n=Object.create(null),r=ct?Reflect.ownKeys(t):Object.keys(t),a=0;a<r.length;a++){var s=r[a];if("__ob__"!==s){for(var i=t[s].from,o=e;o;){if(o._provided&&y(o._provided,i)){n[s]=o._provided[i];break}o=o.$parent}if(!o)if("default"in t[s]){var c=t[s].default;n[s]="function"==typeof c?c.call(e):c}else 0}}return n}}function me(t,e){if(!t||!t.length)return{};for(var n={},r=0,a=t.length;r<a;r++){var s=t[r],i=s.data;if(i&&i.attrs&&i.attrs.slot&&delete i.attrs.slot,s.context!==e&&s.fnContext!==e||!i||null==i.slot)(n.default||(n.default=[])).push(s);else{var o=i.slot,c=n[o]||(n[o]=[]);"template"===s.tag?c.push.apply(c,s.children||[]):c.push(s)}}for(var l in n)n[l].every(ge)&&delete n[l];return n}function ge(t){return t.isComment&&!t.asyncFactory||" "===t.text}function _e(t){return t.isComment&&t.asyncFactory}function ye(e,n,r){var a,s=Object.keys(n).length>0,i=e?!!e.$stable:!s,o=e&&e.$key;if(e){if(e._normalized)return e._normalized;if(i&&r&&r!==t&&o===r.$key&&!s&&!r.$hasNormal)return r;for(var c in a={},e)e[c]&&"$"!==c[0]&&(a[c]=be(n,c,e[c]))}else a={};for(var l in n)l in a||(a[l]=we(n,l));return e&&Object.isExtensible(e)&&(e._normalized=a),H(a,"$stable",i),H(a,"$key",o),H(a,"$hasNormal",s),a}function be(t,e,n){var r=function(){var t=arguments.length?n.apply(null,arguments):n({}),e=(t=t&&"object"==typeof t&&!Array.isArray(t)?[t]:fe(t))&&t[0];return t&&(!e||1===t.length&&e.isComment&&!_e(e))?void 0:t};return n.proxy&&Object.defineProperty(t,e,{get:r,enumerable:!0,configurable:!0}),r}function we(t,e){return function(){return t[e]}}function Ce(t,e){var n,a,s,o,c;if(Array.isArray(t)||"string"==typeof t)for(n=new Array(t.length),a=0,s=t.length;a<s;a++)n[a]=e(t[a],a);else if("number"==typeof t)for(n=new Array(t),a=0;a<t;a++)n[a]=e(a+1,a);else if(i(t))if(ct&&t[Symbol.iterator]){n=[];for(var l=t[Symbol.iterator](),u=l.next();!u.done;)n.push(e(u.value,n.length)),u=l.next()}else for(o=Object.keys(t),n=new Array(o.length),a=0,s=o.length;a<s;a++)c=o[a],n[a]=e(t[c],c,a);return r(n)||(n=[]),n._isVList=!0,n}function xe(t,e,n,r){var a,s=this.$scopedSlots[t];s?(n=n||{},r&&(n=O(O({},r),n)),a=s(n)||("function"==typeof e?e():e)):a=this.$slots[t]||("function"==typeof e?e():e);var i=n&&n.slot;return i?this.$createElement("template",{slot:i},a):a}function $e(t){return Lt(this.$options,"filters",t)||j}function ke(t,e){return Array.isArray(t)?-1===t.indexOf(e):t!==e}function Se(t,e,n,r,a){var s=F.keyCodes[e]||n;return a&&r&&!F.keyCodes[e]?ke(a,r):s?ke(s,t):r?k(r)!==e:void 0===t}function Ae(t,e,n,r,a){if(n)if(i(n)){var s;Array.isArray(n)&&(n=E(n));var o=function(i){if("class"===i||"style"===i||m(i))s=t;else{var o=t.attrs&&t.attrs.type;s=r||F.mustUseProp(e,o,i)?t.domProps||(t.domProps={}):t.attrs||(t.attrs={})}var c=C(i),l=k(i);c in s||l in s||(s[i]=n[i],a&&((t.on||(t.on={}))["update:"+i]=function(t){n[i]=t}))};for(var c in n)o(c)}else;return t}function Oe(t,e){var n=this._staticTrees||(this._staticTrees=[]),r=n[t];return r&&!e||Te(r=n[t]=this.$options.staticRenderFns[t].call(this._renderProxy,null,this),"__static__"+t,!1),r}function Ee(t,e,n){return Te(t,"__once__"+e+(n?"_"+n:""),!0),t}function Te(t,e,n){if(Array.isArray(t))for(var r=0;r<t.length;r++)t[r]&&"string"!=typeof t[r]&&Pe(t[r],e+"_"+r,n);else Pe(t,e,n)}function Pe(t,e,n){t.isStatic=!0,t.key=e,t.isOnce=n}function je(t,e){if(e)if(c(e)){var n=t.on=t.on?O({},t.on):{};for(var r in e){var a=n[r],s=e[r];n[r]=a?[].concat(a,s):s}}else;return t}function Ne(t,e,n,r){e=e||{$stable:!n};for(var a=0;a<t.length;a++){var s=t[a];Array.isArray(s)?Ne(s,e,n):s&&(s.proxy&&(s.fn.proxy=!0),e[s.key]=s.fn)}return r&&(e.$key=r),e}function Be(t,e){for(var n=0;n<e.length;n+=2){var r=e[n];"string"==typeof r&&r&&(t[e[n]]=e[n+1])}return t}function De(t,e){return"string"==typeof t?e+t:t}function Me(t){t._o=Ee,t._n=p,t._s=f,t._l=Ce,t._t=xe,t._q=N,t._i=B,t._m=Oe,t._f=$e,t._k=Se,t._b=Ae,t._v=_t,t._e=gt,t._u=Ne,t._g=je,t._d=Be,t._p=De}function Re(e,n,r,s,i){var o,c=this,l=i.options;y(s,"_uid")?(o=Object.create(s))._original=s:(o=s,s=s._original);var u=a(l._compiled),d=!u;this.data=e,this.props=n,this.children=r,this.parent=s,this.listeners=e.on||t,this.injections=he(l.inject,s),this.slots=function(){return c.$slots||ye(e.scopedSlots,c.$slots=me(r,s)),c.$slots},Object.defineProperty(this,"scopedSlots",{enumerable:!0,get:function(){return ye(e.scopedSlots,this.slots())}}),u&&(this.$options=l,this.$slots=this.slots(),this.$scopedSlots=ye(e.scopedSlots,this.$slots)),l._scopeId?this._c=function(t,e,n,r){var a=qe(o,t,e,n,r,d);return a&&!Array.isArray(a)&&(a.fnScopeId=l._scopeId,a.fnContext=s),a}:this._c=function(t,e,n,r){return qe(o,t,e,n,r,d)}}function Le(t,e,n,r,a){var s=yt(t);return s.fnContext=n,s.fnOptions=r,e.slot&&((s.data||(s.data={})).slot=e.slot),s}function Fe(t,e){for(var n in e)t[C(n)]=e[n]}Me(Re.prototype);var Ue={init:function(t,e){if(t.componentInstance&&!t.componentInstance._isDestroyed&&t.data.keepAlive){var n=t;Ue.prepatch(n,n)}else{(t.componentInstance=function(t,e){var n={_isComponent:!0,_parentVnode:t,parent:e},a=t.data.inlineTemplate;r(a)&&(n.render=a.render,n.staticRenderFns=a.staticRenderFns);return new t.componentOptions.Ctor(n)}(t,tn)).$mount(e?t.elm:void 0,e)}},prepatch:function(e,n){var r=n.componentOptions;!function(e,n,r,a,s){0;var i=a.data.scopedSlots,o=e.$scopedSlots,c=!!(i&&!i.$stable||o!==t&&!o.$stable||i&&e.$scopedSlots.$key!==i.$key||!i&&e.$scopedSlots.$key),l=!!(s||e.$options._renderChildren||c);e.$options._parentVnode=a,e.$vnode=a,e._vnode&&(e._vnode.parent=a);if(e.$options._renderChildren=s,e.$attrs=a.data.attrs||t,e.$listeners=r||t,n&&e.$options.props){$t(!1);for(var u=e._props,d=e.$options._propKeys||[],f=0;f<d.length;f++){var p=d[f],v=e.$options.props;u[p]=Ft(p,v,n,e)}$t(!0),e.$options.propsData=n}r=r||t;var h=e.$options._parentListeners;e.$options._parentListeners=r,Qe(e,r,h),l&&(e.$slots=me(s,a.context),e.$forceUpdate());0}(n.componentInstance=e.componentInstance,r.propsData,r.listeners,n,r.children)},insert:function(t){var e,n=t.context,r=t.componentInstance;r._isMounted||(r._isMounted=!0,sn(r,"mounted")),t.data.keepAlive&&(n._isMounted?((e=r)._inactive=!1,cn.push(e)):rn(r,!0))},destroy:function(t){var e=t.componentInstance;e._isDestroyed||(t.data.keepAlive?an(e,!0):e.$destroy())}},Ie=Object.keys(Ue);function He(n,s,o,c,l){if(!e(n)){var u=o.$options._base;if(i(n)&&(n=u.extend(n)),"function"==typeof n){var f;if(e(n.cid)&&void 0===(n=function(t,n){if(a(t.error)&&r(t.errorComp))return t.errorComp;if(r(t.resolved))return t.resolved;var s=ze;s&&r(t.owners)&&-1===t.owners.indexOf(s)&&t.owners.push(s);if(a(t.loading)&&r(t.loadingComp))return t.loadingComp;if(s&&!r(t.owners)){var o=t.owners=[s],c=!0,l=null,u=null;s.$on("hook:destroyed",(function(){return g(o,s)}));var f=function(t){for(var e=0,n=o.length;e<n;e++)o[e].$forceUpdate();t&&(o.length=0,null!==l&&(clearTimeout(l),l=null),null!==u&&(clearTimeout(u),u=null))},p=D((function(e){t.resolved=Ge(e,n),c?o.length=0:f(!0)})),v=D((function(e){r(t.errorComp)&&(t.error=!0,f(!0))})),h=t(p,v);return i(h)&&(d(h)?e(t.resolved)&&h.then(p,v):d(h.component)&&(h.component.then(p,v),r(h.error)&&(t.errorComp=Ge(h.error,n)),r(h.loading)&&(t.loadingComp=Ge(h.loading,n),0===h.delay?t.loading=!0:l=setTimeout((function(){l=null,e(t.resolved)&&e(t.error)&&(t.loading=!0,f(!1))}),h.delay||200)),r(h.timeout)&&(u=setTimeout((function(){u=null,e(t.resolved)&&v(null)}),h.timeout)))),c=!1,t.loading?t.loadingComp:t.resolved}}(f=n,u)))return function(t,e,n,r,a){var s=gt();return s.asyncFactory=t,s.asyncMeta={data:e,context:n,children:r,tag:a},s}(f,s,o,c,l);s=s||{},On(n),r(s.model)&&function(t,e){var n=t.model&&t.model.prop||"value",a=t.model&&t.model.event||"input";(e.attrs||(e.attrs={}))[n]=e.model.value;var s=e.on||(e.on={}),i=s[a],o=e.model.callback;r(i)?(Array.isArray(i)?-1===i.indexOf(o):i!==o)&&(s[a]=[o].concat(i)):s[a]=o}(n.options,s);var p=function(t,n,a){var s=n.options.props;if(!e(s)){var i={},o=t.attrs,c=t.props;if(r(o)||r(c))for(var l in s){var u=k(l);de(i,c,l,u,!0)||de(i,o,l,u,!1)}return i}}(s,n);if(a(n.options.functional))return function(e,n,a,s,i){var o=e.options,c={},l=o.props;if(r(l))for(var u in l)c[u]=Ft(u,l,n||t);else r(a.attrs)&&Fe(c,a.attrs),r(a.props)&&Fe(c,a.props);var d=new Re(a,c,i,s,e),f=o.render.call(null,d._c,d);if(f instanceof ht)return Le(f,a,d.parent,o);if(Array.isArray(f)){for(var p=fe(f)||[],v=new Array(p.length),h=0;h<p.length;h++)v[h]=Le(p[h],a,d.parent,o);return v}}(n,p,s,o,c);var v=s.on;if(s.on=s.nativeOn,a(n.options.abstract)){var h=s.slot;s={},h&&(s.slot=h)}!function(t){for(var e=t.hook||(t.hook={}),n=0;n<Ie.length;n++){var r=Ie[n],a=e[r],s=Ue[r];a===s||a&&a._merged||(e[r]=a?Ze(s,a):s)}}(s);var m=n.options.name||l;return new ht("vue-component-"+n.cid+(m?"-"+m:""),s,void 0,void 0,void 0,o,{Ctor:n,propsData:p,listeners:v,tag:l,children:c},f)}}}function Ze(t,e){var n=function(n,r){t(n,r),e(n,r)};return n._merged=!0,n}function qe(t,e,n,o,c,l){return(Array.isArray(n)||s(n))&&(c=o,o=n,n=void 0),a(l)&&(c=2),function(t,e,n,a,s){if(r(n)&&r(n.__ob__))return gt();r(n)&&r(n.is)&&(e=n.is);if(!e)return gt();0;Array.isArray(a)&&"function"==typeof a[0]&&((n=n||{}).scopedSlots={default:a[0]},a.length=0);2===s?a=fe(a):1===s&&(a=function(t){for(var e=0;e<t.length;e++)if(Array.isArray(t[e]))return Array.prototype.concat.apply([],t);return t}(a));var o,c;if("string"==typeof e){var l;c=t.$vnode&&t.$vnode.ns||F.getTagNamespace(e),o=F.isReservedTag(e)?new ht(F.parsePlatformTagName(e),n,a,void 0,void 0,t):n&&n.pre||!r(l=Lt(t.$options,"components",e))?new ht(e,n,a,void 0,void 0,t):He(l,n,t,a,e)}else o=He(e,n,t,a);return Array.isArray(o)?o:r(o)?(r(c)&&Ve(o,c),r(n)&&function(t){i(t.style)&&se(t.style);i(t.class)&&se(t.class)}(n),o):gt()}(t,e,n,o,c)}function Ve(t,n,s){if(t.ns=n,"foreignObject"===t.tag&&(n=void 0,s=!0),r(t.children))for(var i=0,o=t.children.length;i<o;i++){var c=t.children[i];r(c.tag)&&(e(c.ns)||a(s)&&"svg"!==c.tag)&&Ve(c,n,s)}}var We,ze=null;function Ge(t,e){return(t.__esModule||ct&&"Module"===t[Symbol.toStringTag])&&(t=t.default),i(t)?e.extend(t):t}function Je(t){if(Array.isArray(t))for(var e=0;e<t.length;e++){var n=t[e];if(r(n)&&(r(n.componentOptions)||_e(n)))return n}}function Ke(t,e){We.$on(t,e)}function Xe(t,e){We.$off(t,e)}function Ye(t,e){var n=We;return function r(){var a=e.apply(null,arguments);null!==a&&n.$off(t,r)}}function Qe(t,e,n){We=t,le(e,n||{},Ke,Xe,Ye,t),We=void 0}var tn=null;function en(t){var e=tn;return tn=t,function(){tn=e}}function nn(t){for(;t&&(t=t.$parent);)if(t._inactive)return!0;return!1}function rn(t,e){if(e){if(t._directInactive=!1,nn(t))return}else if(t._directInactive)return;if(t._inactive||null===t._inactive){t._inactive=!1;for(var n=0;n<t.$children.length;n++)rn(t.$children[n]);sn(t,"activated")}}function an(t,e){if(!(e&&(t._directInactive=!0,nn(t))||t._inactive)){t._inactive=!0;for(var n=0;n<t.$children.length;n++)an(t.$children[n]);sn(t,"deactivated")}}function sn(t,e){pt();var n=t.$options[e],r=e+" hook";if(n)for(var a=0,s=n.length;a<s;a++)Vt(n[a],t,null,t,r);t._hasHookEvent&&t.$emit("hook:"+e),vt()}var on=[],cn=[],ln={},un=!1,dn=!1,fn=0;var pn=0,vn=Date.now;if(W&&!K){var hn=window.performance;hn&&"function"==typeof hn.now&&vn()>document.createEvent("Event").timeStamp&&(vn=function(){return hn.now()})}function mn(){var t,e;for(pn=vn(),dn=!0,on.sort((function(t,e){return t.id-e.id})),fn=0;fn<on.length;fn++)(t=on[fn]).before&&t.before(),e=t.id,ln[e]=null,t.run();var n=cn.slice(),r=on.slice();fn=on.length=cn.length=0,ln={},un=dn=!1,function(t){for(var e=0;e<t.length;e++)t[e]._inactive=!0,rn(t[e],!0)}(n),function(t){var e=t.length;for(;e--;){var n=t[e],r=n.vm;r._watcher===n&&r._isMounted&&!r._isDestroyed&&sn(r,"updated")}}(r),st&&F.devtools&&st.emit("flush")}var gn=0,_n=function(t,e,n,r,a){this.vm=t,a&&(t._watcher=this),t._watchers.push(this),r?(this.deep=!!r.deep,this.user=!!r.user,this.lazy=!!r.lazy,this.sync=!!r.sync,this.before=r.before):this.deep=this.user=this.lazy=this.sync=!1,this.cb=n,this.id=++gn,this.active=!0,this.dirty=this.lazy,this.deps=[],this.newDeps=[],this.depIds=new ot,this.newDepIds=new ot,this.expression="","function"==typeof e?this.getter=e:(this.getter=function(t){if(!Z.test(t)){var e=t.split(".");return function(t){for(var n=0;n<e.length;n++){if(!t)return;t=t[e[n]]}return t}}}(e),this.getter||(this.getter=T)),this.value=this.lazy?void 0:this.get()};_n.prototype.get=function(){var t;pt(this);var e=this.vm;try{t=this.getter.call(e,e)}catch(t){if(!this.user)throw t;qt(t,e,'getter for watcher "'+this.expression+'"')}finally{this.deep&&se(t),vt(),this.cleanupDeps()}return t},_n.prototype.addDep=function(t){var e=t.id;this.newDepIds.has(e)||(this.newDepIds.add(e),this.newDeps.push(t),this.depIds.has(e)||t.addSub(this))},_n.prototype.cleanupDeps=function(){for(var t=this.deps.length;t--;){var e=this.deps[t];this.newDepIds.has(e.id)||e.removeSub(this)}var n=this.depIds;this.depIds=this.newDepIds,this.newDepIds=n,this.newDepIds.clear(),n=this.deps,this.deps=this.newDeps,this.newDeps=n,this.newDeps.length=0},_n.prototype.update=function(){this.lazy?this.dirty=!0:this.sync?this.run():function(t){var e=t.id;if(null==ln[e]){if(ln[e]=!0,dn){for(var n=on.length-1;n>fn&&on[n].id>t.id;)n--;on.splice(n+1,0,t)}else on.push(t);un||(un=!0,re(mn))}}(this)},_n.prototype.run=function(){if(this.active){var t=this.get();if(t!==this.value||i(t)||this.deep){var e=this.value;if(this.value=t,this.user){var n='callback for watcher "'+this.expression+'"';Vt(this.cb,this.vm,[t,e],this.vm,n)}else this.cb.call(this.vm,t,e)}}},_n.prototype.evaluate=function(){this.value=this.get(),this.dirty=!1},_n.prototype.depend=function(){for(var t=this.deps.length;t--;)this.deps[t].depend()},_n.prototype.teardown=function(){if(this.active){this.vm._isBeingDestroyed||g(this.vm._watchers,this);for(var t=this.deps.length;t--;)this.deps[t].removeSub(this);this.active=!1}};var yn={enumerable:!0,configurable:!0,get:T,set:T};function bn(t,e,n){yn.get=function(){return this[e][n]},yn.set=function(t){this[e][n]=t},Object.defineProperty(t,n,yn)}function wn(t){t._watchers=[];var e=t.$options;e.props&&function(t,e){var n=t.$options.propsData||{},r=t._props={},a=t.$options._propKeys=[];t.$parent&&$t(!1);var s=function(s){a.push(s);var i=Ft(s,e,n,t);At(r,s,i),s in t||bn(t,"_props",s)};for(var i in e)s(i);$t(!0)}(t,e.props),e.methods&&function(t,e){t.$options.props;for(var n in e)t[n]="function"!=typeof e[n]?T:S(e[n],t)}(t,e.methods),e.data?function(t){var e=t.$options.data;c(e=t._data="function"==typeof e?function(t,e){pt();try{return t.call(e,e)}catch(t){return qt(t,e,"data()"),{}}finally{vt()}}(e,t):e||{})||(e={});var n=Object.keys(e),r=t.$options.props,a=(t.$options.methods,n.length);for(;a--;){var s=n[a];0,r&&y(r,s)||I(s)||bn(t,"_data",s)}St(e,!0)}(t):St(t._data={},!0),e.computed&&function(t,e){var n=t._computedWatchers=Object.create(null),r=at();for(var a in e){var s=e[a],i="function"==typeof s?s:s.get;0,r||(n[a]=new _n(t,i||T,T,Cn)),a in t||xn(t,a,s)}}(t,e.computed),e.watch&&e.watch!==et&&function(t,e){for(var n in e){var r=e[n];if(Array.isArray(r))for(var a=0;a<r.length;a++)Sn(t,n,r[a]);else Sn(t,n,r)}}(t,e.watch)}var Cn={lazy:!0};function xn(t,e,n){var r=!at();"function"==typeof n?(yn.get=r?$n(e):kn(n),yn.set=T):(yn.get=n.get?r&&!1!==n.cache?$n(e):kn(n.get):T,yn.set=n.set||T),Object.defineProperty(t,e,yn)}function $n(t){return function(){var e=this._computedWatchers&&this._computedWatchers[t];if(e)return e.dirty&&e.evaluate(),dt.target&&e.depend(),e.value}}function kn(t){return function(){return t.call(this,this)}}function Sn(t,e,n,r){return c(n)&&(r=n,n=n.handler),"string"==typeof n&&(n=t[n]),t.$watch(e,n,r)}var An=0;function On(t){var e=t.options;if(t.super){var n=On(t.super);if(n!==t.superOptions){t.superOptions=n;var r=function(t){var e,n=t.options,r=t.sealedOptions;for(var a in n)n[a]!==r[a]&&(e||(e={}),e[a]=n[a]);return e}(t);r&&O(t.extendOptions,r),(e=t.options=Rt(n,t.extendOptions)).name&&(e.components[e.name]=t)}}return e}function En(t){this._init(t)}function Tn(t){t.cid=0;var e=1;t.extend=function(t){t=t||{};var n=this,r=n.cid,a=t._Ctor||(t._Ctor={});if(a[r])return a[r];var s=t.name||n.options.name;var i=function(t){this._init(t)};return(i.prototype=Object.create(n.prototype)).constructor=i,i.cid=e++,i.options=Rt(n.options,t),i.super=n,i.options.props&&function(t){var e=t.options.props;for(var n in e)bn(t.prototype,"_props",n)}(i),i.options.computed&&function(t){var e=t.options.computed;for(var n in e)xn(t.prototype,n,e[n])}(i),i.extend=n.extend,i.mixin=n.mixin,i.use=n.use,R.forEach((function(t){i[t]=n[t]})),s&&(i.options.components[s]=i),i.superOptions=n.options,i.extendOptions=t,i.sealedOptions=O({},i.options),a[r]=i,i}}function Pn(t){return t&&(t.Ctor.options.name||t.tag)}function jn(t,e){return Array.isArray(t)?t.indexOf(e)>-1:"string"==typeof t?t.split(",").indexOf(e)>-1:!!l(t)&&t.test(e)}function Nn(t,e){var n=t.cache,r=t.keys,a=t._vnode;for(var s in n){var i=n[s];if(i){var o=i.name;o&&!e(o)&&Bn(n,s,r,a)}}}function Bn(t,e,n,r){var a=t[e];!a||r&&a.tag===r.tag||a.componentInstance.$destroy(),t[e]=null,g(n,e)}!function(e){e.prototype._init=function(e){var n=this;n._uid=An++,n._isVue=!0,e&&e._isComponent?function(t,e){var n=t.$options=Object.create(t.constructor.options),r=e._parentVnode;n.parent=e.parent,n._parentVnode=r;var a=r.componentOptions;n.propsData=a.propsData,n._parentListeners=a.listeners,n._renderChildren=a.children,n._componentTag=a.tag,e.render&&(n.render=e.render,n.staticRenderFns=e.staticRenderFns)}(n,e):n.$options=Rt(On(n.constructor),e||{},n),n._renderProxy=n,n._self=n,function(t){var e=t.$options,n=e.parent;if(n&&!e.abstract){for(;n.$options.abstract&&n.$parent;)n=n.$parent;n.$children.push(t)}t.$parent=n,t.$root=n?n.$root:t,t.$children=[],t.$refs={},t._watcher=null,t._inactive=null,t._directInactive=!1,t._isMounted=!1,t._isDestroyed=!1,t._isBeingDestroyed=!1}(n),function(t){t._events=Object.create(null),t._hasHookEvent=!1;var e=t.$options._parentListeners;e&&Qe(t,e)}(n),function(e){e._vnode=null,e._staticTrees=null;var n=e.$options,r=e.$vnode=n._parentVnode,a=r&&r.context;e.$slots=me(n._renderChildren,a),e.$scopedSlots=t,e._c=function(t,n,r,a){return qe(e,t,n,r,a,!1)},e.$createElement=function(t,n,r,a){return qe(e,t,n,r,a,!0)};var s=r&&r.data;At(e,"$attrs",s&&s.attrs||t,null,!0),At(e,"$listeners",n._parentListeners||t,null,!0)}(n),sn(n,"beforeCreate"),function(t){var e=he(t.$options.inject,t);e&&($t(!1),Object.keys(e).forEach((function(n){At(t,n,e[n])})),$t(!0))}(n),wn(n),function(t){var e=t.$options.provide;e&&(t._provided="function"==typeof e?e.call(t):e)}(n),sn(n,"created"),n.$options.el&&n.$mount(n.$options.el)}}(En),function(t){var e={get:function(){return this._data}},n={get:function(){return this._props}};Object.defineProperty(t.prototype,"$data",e),Object.defineProperty(t.prototype,"$props",n),t.prototype.$set=Ot,t.prototype.$delete=Et,t.prototype.$watch=function(t,e,n){var r=this;if(c(e))return Sn(r,t,e,n);(n=n||{}).user=!0;var a=new _n(r,t,e,n);if(n.immediate){var s='callback for immediate watcher "'+a.expression+'"';pt(),Vt(e,r,[a.value],r,s),vt()}return function(){a.teardown()}}}(En),function(t){var e=/^hook:/;t.prototype.$on=function(t,n){var r=this;if(Array.isArray(t))for(var a=0,s=t.length;a<s;a++)r.$on(t[a],n);else(r._events[t]||(r._events[t]=[])).push(n),e.test(t)&&(r._hasHookEvent=!0);return r},t.prototype.$once=function(t,e){var n=this;function r(){n.$off(t,r),e.apply(n,arguments)}return r.fn=e,n.$on(t,r),n},t.prototype.$off=function(t,e){var n=this;if(!arguments.length)return n._events=Object.create(null),n;if(Array.isArray(t)){for(var r=0,a=t.length;r<a;r++)n.$off(t[r],e);return n}var s,i=n._events[t];if(!i)return n;if(!e)return n._events[t]=null,n;for(var o=i.length;o--;)if((s=i[o])===e||s.fn===e){i.splice(o,1);break}return n},t.prototype.$emit=function(t){var e=this,n=e._events[t];if(n){n=n.length>1?A(n):n;for(var r=A(arguments,1),a='event handler for "'+t+'"',s=0,i=n.length;s<i;s++)Vt(n[s],e,r,e,a)}return e}}(En),function(t){t.prototype._update=function(t,e){var n=this,r=n.$el,a=n._vnode,s=en(n);n._vnode=t,n.$el=a?n.__patch__(a,t):n.__patch__(n.$el,t,e,!1),s(),r&&(r.__vue__=null),n.$el&&(n.$el.__vue__=n),n.$vnode&&n.$parent&&n.$vnode===n.$parent._vnode&&(n.$parent.$el=n.$el)},t.prototype.$forceUpdate=function(){this._watcher&&this._watcher.update()},t.prototype.$destroy=function(){var t=this;if(!t._isBeingDestroyed){sn(t,"beforeDestroy"),t._isBeingDestroyed=!0;var e=t.$parent;!e||e._isBeingDestroyed||t.$options.abstract||g(e.$children,t),t._watcher&&t._watcher.teardown();for(var n=t._watchers.length;n--;)t._watchers[n].teardown();t._data.__ob__&&t._data.__ob__.vmCount--,t._isDestroyed=!0,t.__patch__(t._vnode,null),sn(t,"destroyed"),t.$off(),t.$el&&(t.$el.__vue__=null),t.$vnode&&(t.$vnode.parent=null)}}}(En),function(t){Me(t.prototype),t.prototype.$nextTick=function(t){return re(t,this)},t.prototype._render=function(){var t,e=this,n=e.$options,r=n.render,a=n._parentVnode;a&&(e.$scopedSlots=ye(a.data.scopedSlots,e.$slots,e.$scopedSlots)),e.$vnode=a;try{ze=e,t=r.call(e._renderProxy,e.$createElement)}catch(n){qt(n,e,"render"),t=e._vnode}finally{ze=null}return Array.isArray(t)&&1===t.length&&(t=t[0]),t instanceof ht||(t=gt()),t.parent=a,t}}(En);var Dn=[String,RegExp,Array],Mn={KeepAlive:{name:"keep-alive",abstract:!0,props:{include:Dn,exclude:Dn,max:[String,Number]},methods:{cacheVNode:function(){var t=this,e=t.cache,n=t.keys,r=t.vnodeToCache,a=t.keyToCache;if(r){var s=r.tag,i=r.componentInstance,o=r.componentOptions;e[a]={name:Pn(o),tag:s,componentInstance:i},n.push(a),this.max&&n.length>parseInt(this.max)&&Bn(e,n[0],n,this._vnode),this.vnodeToCache=null}}},created:function(){this.cache=Object.create(null),this.keys=[]},destroyed:function(){for(var t in this.cache)Bn(this.cache,t,this.keys)},mounted:function(){var t=this;this.cacheVNode(),this.$watch("include",(function(e){Nn(t,(function(t){return jn(e,t)}))})),this.$watch("exclude",(function(e){Nn(t,(function(t){return!jn(e,t)}))}))},updated:function(){this.cacheVNode()},render:function(){var t=this.$slots.default,e=Je(t),n=e&&e.componentOptions;if(n){var r=Pn(n),a=this.include,s=this.exclude;if(a&&(!r||!jn(a,r))||s&&r&&jn(s,r))return e;var i=this.cache,o=this.keys,c=null==e.key?n.Ctor.cid+(n.tag?"::"+n.tag:""):e.key;i[c]?(e.componentInstance=i[c].componentInstance,g(o,c),o.push(c)):(this.vnodeToCache=e,this.keyToCache=c),e.data.keepAlive=!0}return e||t&&t[0]}}};!function(t){var e={get:function(){return F}};Object.defineProperty(t,"config",e),t.util={warn:lt,extend:O,mergeOptions:Rt,defineReactive:At},t.set=Ot,t.delete=Et,t.nextTick=re,t.observable=function(t){return St(t),t},t.options=Object.create(null),R.forEach((function(e){t.options[e+"s"]=Object.create(null)})),t.options._base=t,O(t.options.components,Mn),function(t){t.use=function(t){var e=this._installedPlugins||(this._installedPlugins=[]);if(e.indexOf(t)>-1)return this;var n=A(arguments,1);return n.unshift(this),"function"==typeof t.install?t.install.apply(t,n):"function"==typeof t&&t.apply(null,n),e.push(t),this}}(t),function(t){t.mixin=function(t){return this.options=Rt(this.options,t),this}}(t),Tn(t),function(t){R.forEach((function(e){t[e]=function(t,n){return n?("component"===e&&c(n)&&(n.name=n.name||t,n=this.options._base.extend(n)),"directive"===e&&"function"==typeof n&&(n={bind:n,update:n}),this.options[e+"s"][t]=n,n):this.options[e+"s"][t]}}))}(t)}(En),Object.defineProperty(En.prototype,"$isServer",{get:at}),Object.defineProperty(En.prototype,"$ssrContext",{get:function(){return this.$vnode&&this.$vnode.ssrContext}}),Object.defineProperty(En,"FunctionalRenderContext",{value:Re}),En.version="2.6.14";var Rn=v("style,class"),Ln=v("input,textarea,option,select,progress"),Fn=function(t,e,n){return"value"===n&&Ln(t)&&"button"!==e||"selected"===n&&"option"===t||"checked"===n&&"input"===t||"muted"===n&&"video"===t},Un=v("contenteditable,draggable,spellcheck"),In=v("events,caret,typing,plaintext-only"),Hn=v("allowfullscreen,async,autofocus,autoplay,checked,compact,controls,declare,default,defaultchecked,defaultmuted,defaultselected,defer,disabled,enabled,formnovalidate,hidden,indeterminate,inert,ismap,itemscope,loop,multiple,muted,nohref,noresize,noshade,novalidate,nowrap,open,pauseonexit,readonly,required,reversed,scoped,seamless,selected,sortable,truespeed,typemustmatch,visible"),Zn="http://www.w3.org/1999/xlink",qn=function(t){return":"===t.charAt(5)&&"xlink"===t.slice(0,5)},Vn=function(t){return qn(t)?t.slice(6,t.length):""},Wn=function(t){return null==t||!1===t};function zn(t){for(var e=t.data,n=t,a=t;r(a.componentInstance);)(a=a.componentInstance._vnode)&&a.data&&(e=Gn(a.data,e));for(;r(n=n.parent);)n&&n.data&&(e=Gn(e,n.data));return function(t,e){if(r(t)||r(e))return Jn(t,Kn(e));return""}(e.staticClass,e.class)}function Gn(t,e){return{staticClass:Jn(t.staticClass,e.staticClass),class:r(t.class)?[t.class,e.class]:e.class}}function Jn(t,e){return t?e?t+" "+e:t:e||""}function Kn(t){return Array.isArray(t)?function(t){for(var e,n="",a=0,s=t.length;a<s;a++)r(e=Kn(t[a]))&&""!==e&&(n&&(n+=" "),n+=e);return n}(t):i(t)?function(t){var e="";for(var n in t)t[n]&&(e&&(e+=" "),e+=n);return e}(t):"string"==typeof t?t:""}var Xn={svg:"http://www.w3.org/2000/svg",math:"http://www.w3.org/1998/Math/MathML"},Yn=v("html,body,base,head,link,meta,style,title,address,article,aside,footer,header,h1,h2,h3,h4,h5,h6,hgroup,nav,section,div,dd,dl,dt,figcaption,figure,picture,hr,img,li,main,ol,p,pre,ul,a,b,abbr,bdi,bdo,br,cite,code,data,dfn,em,i,kbd,mark,q,rp,rt,rtc,ruby,s,samp,small,span,strong,sub,sup,time,u,var,wbr,area,audio,map,track,video,embed,object,param,source,canvas,script,noscript,del,ins,caption,col,colgroup,table,thead,tbody,td,th,tr,button,datalist,fieldset,form,input,label,legend,meter,optgroup,option,output,progress,select,textarea,details,dialog,menu,menuitem,summary,content,element,shadow,template,blockquote,iframe,tfoot"),Qn=v("svg,animate,circle,clippath,cursor,defs,desc,ellipse,filter,font-face,foreignobject,g,glyph,image,line,marker,mask,missing-glyph,path,pattern,polygon,polyline,rect,switch,symbol,text,textpath,tspan,use,view",!0),tr=function(t){return Yn(t)||Qn(t)};function er(t){return Qn(t)?"svg":"math"===t?"math":void 0}var nr=Object.create(null);var rr=v("text,number,password,search,email,tel,url");function ar(t){if("string"==typeof t){var e=document.querySelector(t);return e||document.createElement("div")}return t}var sr=Object.freeze({createElement:function(t,e){var n=document.createElement(t);return"select"!==t||e.data&&e.data.attrs&&void 0!==e.data.attrs.multiple&&n.setAttribute("multiple","multiple"),n},createElementNS:function(t,e){return document.createElementNS(Xn[t],e)},createTextNode:function(t){return document.createTextNode(t)},createComment:function(t){return document.createComment(t)},insertBefore:function(t,e,n){t.insertBefore(e,n)},removeChild:function(t,e){t.removeChild(e)},appendChild:function(t,e){t.appendChild(e)},parentNode:function(t){return t.parentNode},nextSibling:function(t){return t.nextSibling},tagName:function(t){return t.tagName},setTextContent:function(t,e){t.textContent=e},setStyleScope:function(t,e){t.setAttribute(e,"")}}),ir={create:function(t,e){or(e)},update:function(t,e){t.data.ref!==e.data.ref&&(or(t,!0),or(e))},destroy:function(t){or(t,!0)}};function or(t,e){var n=t.data.ref;if(r(n)){var a=t.context,s=t.componentInstance||t.elm,i=a.$refs;e?Array.isArray(i[n])?g(i[n],s):i[n]===s&&(i[n]=void 0):t.data.refInFor?Array.isArray(i[n])?i[n].indexOf(s)<0&&i[n].push(s):i[n]=[s]:i[n]=s}}var cr=new ht("",{},[]),lr=["create","activate","update","remove","destroy"];function ur(t,n){return t.key===n.key&&t.asyncFactory===n.asyncFactory&&(t.tag===n.tag&&t.isComment===n.isComment&&r(t.data)===r(n.data)&&function(t,e){if("input"!==t.tag)return!0;var n,a=r(n=t.data)&&r(n=n.attrs)&&n.type,s=r(n=e.data)&&r(n=n.attrs)&&n.type;return a===s||rr(a)&&rr(s)}(t,n)||a(t.isAsyncPlaceholder)&&e(n.asyncFactory.error))}function dr(t,e,n){var a,s,i={};for(a=e;a<=n;++a)r(s=t[a].key)&&(i[s]=a);return i}var fr={create:pr,update:pr,destroy:function(t){pr(t,cr)}};function pr(t,e){(t.data.directives||e.data.directives)&&function(t,e){var n,r,a,s=t===cr,i=e===cr,o=hr(t.data.directives,t.context),c=hr(e.data.directives,e.context),l=[],u=[];for(n in c)r=o[n],a=c[n],r?(a.oldValue=r.value,a.oldArg=r.arg,gr(a,"update",e,t),a.def&&a.def.componentUpdated&&u.push(a)):(gr(a,"bind",e,t),a.def&&a.def.inserted&&l.push(a));if(l.length){var d=function(){for(var n=0;n<l.length;n++)gr(l[n],"inserted",e,t)};s?ue(e,"insert",d):d()}u.length&&ue(e,"postpatch",(function(){for(var n=0;n<u.length;n++)gr(u[n],"componentUpdated",e,t)}));if(!s)for(n in o)c[n]||gr(o[n],"unbind",t,t,i)}(t,e)}var vr=Object.create(null);function hr(t,e){var n,r,a=Object.create(null);if(!t)return a;for(n=0;n<t.length;n++)(r=t[n]).modifiers||(r.modifiers=vr),a[mr(r)]=r,r.def=Lt(e.$options,"directives",r.name);return a}function mr(t){return t.rawName||t.name+"."+Object.keys(t.modifiers||{}).join(".")}function gr(t,e,n,r,a){var s=t.def&&t.def[e];if(s)try{s(n.elm,t,n,r,a)}catch(r){qt(r,n.context,"directive "+t.name+" "+e+" hook")}}var _r=[ir,fr];function yr(t,n){var a=n.componentOptions;if(!(r(a)&&!1===a.Ctor.options.inheritAttrs||e(t.data.attrs)&&e(n.data.attrs))){var s,i,o=n.elm,c=t.data.attrs||{},l=n.data.attrs||{};for(s in r(l.__ob__)&&(l=n.data.attrs=O({},l)),l)i=l[s],c[s]!==i&&br(o,s,i,n.data.pre);for(s in(K||Y)&&l.value!==c.value&&br(o,"value",l.value),c)e(l[s])&&(qn(s)?o.removeAttributeNS(Zn,Vn(s)):Un(s)||o.removeAttribute(s))}}function br(t,e,n,r){r||t.tagName.indexOf("-")>-1?wr(t,e,n):Hn(e)?Wn(n)?t.removeAttribute(e):(n="allowfullscreen"===e&&"EMBED"===t.tagName?"true":e,t.setAttribute(e,n)):Un(e)?t.setAttribute(e,function(t,e){return Wn(e)||"false"===e?"false":"contenteditable"===t&&In(e)?e:"true"}(e,n)):qn(e)?Wn(n)?t.removeAttributeNS(Zn,Vn(e)):t.setAttributeNS(Zn,e,n):wr(t,e,n)}function wr(t,e,n){if(Wn(n))t.removeAttribute(e);else{if(K&&!X&&"TEXTAREA"===t.tagName&&"placeholder"===e&&""!==n&&!t.__ieph){var r=function(e){e.stopImmediatePropagation(),t.removeEventListener("input",r)};t.addEventListener("input",r),t.__ieph=!0}t.setAttribute(e,n)}}var Cr={create:yr,update:yr};function xr(t,n){var a=n.elm,s=n.data,i=t.data;if(!(e(s.staticClass)&&e(s.class)&&(e(i)||e(i.staticClass)&&e(i.class)))){var o=zn(n),c=a._transitionClasses;r(c)&&(o=Jn(o,Kn(c))),o!==a._prevClass&&(a.setAttribute("class",o),a._prevClass=o)}}var $r,kr,Sr,Ar,Or,Er,Tr={create:xr,update:xr},Pr=/[\w).+\-_$\]]/;function jr(t){var e,n,r,a,s,i=!1,o=!1,c=!1,l=!1,u=0,d=0,f=0,p=0;for(r=0;r<t.length;r++)if(n=e,e=t.charCodeAt(r),i)39===e&&92!==n&&(i=!1);else if(o)34===e&&92!==n&&(o=!1);else if(c)96===e&&92!==n&&(c=!1);else if(l)47===e&&92!==n&&(l=!1);else if(124!==e||124===t.charCodeAt(r+1)||124===t.charCodeAt(r-1)||u||d||f){switch(e){case 34:o=!0;break;case 39:i=!0;break;case 96:c=!0;break;case 40:f++;break;case 41:f--;break;case 91:d++;break;case 93:d--;break;case 123:u++;break;case 125:u--}if(47===e){for(var v=r-1,h=void 0;v>=0&&" "===(h=t.charAt(v));v--);h&&Pr.test(h)||(l=!0)}}else void 0===a?(p=r+1,a=t.slice(0,r).trim()):m();function m(){(s||(s=[])).push(t.slice(p,r).trim()),p=r+1}if(void 0===a?a=t.slice(0,r).trim():0!==p&&m(),s)for(r=0;r<s.length;r++)a=Nr(a,s[r]);return a}function Nr(t,e){var n=e.indexOf("(");if(n<0)return'_f("'+e+'")('+t+")";var r=e.slice(0,n),a=e.slice(n+1);return'_f("'+r+'")('+t+(")"!==a?","+a:a)}function Br(t,e){console.error("[Vue compiler]: "+t)}function Dr(t,e){return t?t.map((function(t){return t[e]})).filter((function(t){return t})):[]}function Mr(t,e,n,r,a){(t.props||(t.props=[])).push(Vr({name:e,value:n,dynamic:a},r)),t.plain=!1}function Rr(t,e,n,r,a){(a?t.dynamicAttrs||(t.dynamicAttrs=[]):t.attrs||(t.attrs=[])).push(Vr({name:e,value:n,dynamic:a},r)),t.plain=!1}function Lr(t,e,n,r){t.attrsMap[e]=n,t.attrsList.push(Vr({name:e,value:n},r))}function Fr(t,e,n,r,a,s,i,o){(t.directives||(t.directives=[])).push(Vr({name:e,rawName:n,value:r,arg:a,isDynamicArg:s,modifiers:i},o)),t.plain=!1}function Ur(t,e,n){return n?"_p("+e+',"'+t+'")':t+e}function Ir(e,n,r,a,s,i,o,c){var l;(a=a||t).right?c?n="("+n+")==='click'?'contextmenu':("+n+")":"click"===n&&(n="contextmenu",delete a.right):a.middle&&(c?n="("+n+")==='click'?'mouseup':("+n+")":"click"===n&&(n="mouseup")),a.capture&&(delete a.capture,n=Ur("!",n,c)),a.once&&(delete a.once,n=Ur("~",n,c)),a.passive&&(delete a.passive,n=Ur("&",n,c)),a.native?(delete a.native,l=e.nativeEvents||(e.nativeEvents={})):l=e.events||(e.events={});var u=Vr({value:r.trim(),dynamic:c},o);a!==t&&(u.modifiers=a);var d=l[n];Array.isArray(d)?s?d.unshift(u):d.push(u):l[n]=d?s?[u,d]:[d,u]:u,e.plain=!1}function Hr(t,e,n){var r=Zr(t,":"+e)||Zr(t,"v-bind:"+e);if(null!=r)return jr(r);if(!1!==n){var a=Zr(t,e);if(null!=a)return JSON.stringify(a)}}function Zr(t,e,n){var r;if(null!=(r=t.attrsMap[e]))for(var a=t.attrsList,s=0,i=a.length;s<i;s++)if(a[s].name===e){a.splice(s,1);break}return n&&delete t.attrsMap[e],r}function qr(t,e){for(var n=t.attrsList,r=0,a=n.length;r<a;r++){var s=n[r];if(e.test(s.name))return n.splice(r,1),s}}function Vr(t,e){return e&&(null!=e.start&&(t.start=e.start),null!=e.end&&(t.end=e.end)),t}function Wr(t,e,n){var r=n||{},a=r.number,s="$$v",i=s;r.trim&&(i="(typeof $$v === 'string'? $$v.trim(): $$v)"),a&&(i="_n("+i+")");var o=zr(e,i);t.model={value:"("+e+")",expression:JSON.stringify(e),callback:"function ($$v) {"+o+"}"}}function zr(t,e){var n=function(t){if(t=t.trim(),$r=t.length,t.indexOf("[")<0||t.lastIndexOf("]")<$r-1)return(Ar=t.lastIndexOf("."))>-1?{exp:t.slice(0,Ar),key:'"'+t.slice(Ar+1)+'"'}:{exp:t,key:null};kr=t,Ar=Or=Er=0;for(;!Jr();)Kr(Sr=Gr())?Yr(Sr):91===Sr&&Xr(Sr);return{exp:t.slice(0,Or),key:t.slice(Or+1,Er)}}(t);return null===n.key?t+"="+e:"$set("+n.exp+", "+n.key+", "+e+")"}function Gr(){return kr.charCodeAt(++Ar)}function Jr(){return Ar>=$r}function Kr(t){return 34===t||39===t}function Xr(t){var e=1;for(Or=Ar;!Jr();)if(Kr(t=Gr()))Yr(t);else if(91===t&&e++,93===t&&e--,0===e){Er=Ar;break}}function Yr(t){for(var e=t;!Jr()&&(t=Gr())!==e;);}var Qr,ta="__r";function ea(t,e,n){var r=Qr;return function a(){var s=e.apply(null,arguments);null!==s&&aa(t,a,n,r)}}var na=Jt&&!(tt&&Number(tt[1])<=53);function ra(t,e,n,r){if(na){var a=pn,s=e;e=s._wrapper=function(t){if(t.target===t.currentTarget||t.timeStamp>=a||t.timeStamp<=0||t.target.ownerDocument!==document)return s.apply(this,arguments)}}Qr.addEventListener(t,e,nt?{capture:n,passive:r}:n)}function aa(t,e,n,r){(r||Qr).removeEventListener(t,e._wrapper||e,n)}function sa(t,n){if(!e(t.data.on)||!e(n.data.on)){var a=n.data.on||{},s=t.data.on||{};Qr=n.elm,function(t){if(r(t.__r)){var e=K?"change":"input";t[e]=[].concat(t.__r,t[e]||[]),delete t.__r}r(t.__c)&&(t.change=[].concat(t.__c,t.change||[]),delete t.__c)}(a),le(a,s,ra,aa,ea,n.context),Qr=void 0}}var ia,oa={create:sa,update:sa};function ca(t,n){if(!e(t.data.domProps)||!e(n.data.domProps)){var a,s,i=n.elm,o=t.data.domProps||{},c=n.data.domProps||{};for(a in r(c.__ob__)&&(c=n.data.domProps=O({},c)),o)a in c||(i[a]="");for(a in c){if(s=c[a],"textContent"===a||"innerHTML"===a){if(n.children&&(n.children.length=0),s===o[a])continue;1===i.childNodes.length&&i.removeChild(i.childNodes[0])}if("value"===a&&"PROGRESS"!==i.tagName){i._value=s;var l=e(s)?"":String(s);la(i,l)&&(i.value=l)}else if("innerHTML"===a&&Qn(i.tagName)&&e(i.innerHTML)){(ia=ia||document.createElement("div")).innerHTML="<svg>"+s+"</svg>";for(var u=ia.firstChild;i.firstChild;)i.removeChild(i.firstChild);for(;u.firstChild;)i.appendChild(u.firstChild)}else if(s!==o[a])try{i[a]=s}catch(t){}}}}function la(t,e){return!t.composing&&("OPTION"===t.tagName||function(t,e){var n=!0;try{n=document.activeElement!==t}catch(t){}return n&&t.value!==e}(t,e)||function(t,e){var n=t.value,a=t._vModifiers;if(r(a)){if(a.number)return p(n)!==p(e);if(a.trim)return n.trim()!==e.trim()}return n!==e}(t,e))}var ua={create:ca,update:ca},da=b((function(t){var e={},n=/:(.+)/;return t.split(/;(?![^(]*\))/g).forEach((function(t){if(t){var r=t.split(n);r.length>1&&(e[r[0].trim()]=r[1].trim())}})),e}));function fa(t){var e=pa(t.style);return t.staticStyle?O(t.staticStyle,e):e}function pa(t){return Array.isArray(t)?E(t):"string"==typeof t?da(t):t}var va,ha=/^--/,ma=/\s*!important$/,ga=function(t,e,n){if(ha.test(e))t.style.setProperty(e,n);else if(ma.test(n))t.style.setProperty(k(e),n.replace(ma,""),"important");else{var r=ya(e);if(Array.isArray(n))for(var a=0,s=n.length;a<s;a++)t.style[r]=n[a];else t.style[r]=n}},_a=["Webkit","Moz","ms"],ya=b((function(t){if(va=va||document.createElement("div").style,"filter"!==(t=C(t))&&t in va)return t;for(var e=t.charAt(0).toUpperCase()+t.slice(1),n=0;n<_a.length;n++){var r=_a[n]+e;if(r in va)return r}}));function ba(t,n){var a=n.data,s=t.data;if(!(e(a.staticStyle)&&e(a.style)&&e(s.staticStyle)&&e(s.style))){var i,o,c=n.elm,l=s.staticStyle,u=s.normalizedStyle||s.style||{},d=l||u,f=pa(n.data.style)||{};n.data.normalizedStyle=r(f.__ob__)?O({},f):f;var p=function(t,e){var n,r={};if(e)for(var a=t;a.componentInstance;)(a=a.componentInstance._vnode)&&a.data&&(n=fa(a.data))&&O(r,n);(n=fa(t.data))&&O(r,n);for(var s=t;s=s.parent;)s.data&&(n=fa(s.data))&&O(r,n);return r}(n,!0);for(o in d)e(p[o])&&ga(c,o,"");for(o in p)(i=p[o])!==d[o]&&ga(c,o,null==i?"":i)}}var wa={create:ba,update:ba},Ca=/\s+/;function xa(t,e){if(e&&(e=e.trim()))if(t.classList)e.indexOf(" ")>-1?e.split(Ca).forEach((function(e){return t.classList.add(e)})):t.classList.add(e);else{var n=" "+(t.getAttribute("class")||"")+" ";n.indexOf(" "+e+" ")<0&&t.setAttribute("class",(n+e).trim())}}function $a(t,e){if(e&&(e=e.trim()))if(t.classList)e.indexOf(" ")>-1?e.split(Ca).forEach((function(e){return t.classList.remove(e)})):t.classList.remove(e),t.classList.length||t.removeAttribute("class");else{for(var n=" "+(t.getAttribute("class")||"")+" ",r=" "+e+" ";n.indexOf(r)>=0;)n=n.replace(r," ");(n=n.trim())?t.setAttribute("class",n):t.removeAttribute("class")}}function ka(t){if(t){if("object"==typeof t){var e={};return!1!==t.css&&O(e,Sa(t.name||"v")),O(e,t),e}return"string"==typeof t?Sa(t):void 0}}var Sa=b((function(t){return{enterClass:t+"-enter",enterToClass:t+"-enter-to",enterActiveClass:t+"-enter-active",leaveClass:t+"-leave",leaveToClass:t+"-leave-to",leaveActiveClass:t+"-leave-active"}})),Aa=W&&!X,Oa="transition",Ea="animation",Ta="transition",Pa="transitionend",ja="animation",Na="animationend";Aa&&(void 0===window.ontransitionend&&void 0!==window.onwebkittransitionend&&(Ta="WebkitTransition",Pa="webkitTransitionEnd"),void 0===window.onanimationend&&void 0!==window.onwebkitanimationend&&(ja="WebkitAnimation",Na="webkitAnimationEnd"));var Ba=W?window.requestAnimationFrame?window.requestAnimationFrame.bind(window):setTimeout:function(t){return t()};function Da(t){Ba((function(){Ba(t)}))}function Ma(t,e){var n=t._transitionClasses||(t._transitionClasses=[]);n.indexOf(e)<0&&(n.push(e),xa(t,e))}function Ra(t,e){t._transitionClasses&&g(t._transitionClasses,e),$a(t,e)}function La(t,e,n){var r=Ua(t,e),a=r.type,s=r.timeout,i=r.propCount;if(!a)return n();var o=a===Oa?Pa:Na,c=0,l=function(){t.removeEventListener(o,u),n()},u=function(e){e.target===t&&++c>=i&&l()};setTimeout((function(){c<i&&l()}),s+1),t.addEventListener(o,u)}var Fa=/\b(transform|all)(,|$)/;function Ua(t,e){var n,r=window.getComputedStyle(t),a=(r[Ta+"Delay"]||"").split(", "),s=(r[Ta+"Duration"]||"").split(", "),i=Ia(a,s),o=(r[ja+"Delay"]||"").split(", "),c=(r[ja+"Duration"]||"").split(", "),l=Ia(o,c),u=0,d=0;return e===Oa?i>0&&(n=Oa,u=i,d=s.length):e===Ea?l>0&&(n=Ea,u=l,d=c.length):d=(n=(u=Math.max(i,l))>0?i>l?Oa:Ea:null)?n===Oa?s.length:c.length:0,{type:n,timeout:u,propCount:d,hasTransform:n===Oa&&Fa.test(r[Ta+"Property"])}}function Ia(t,e){for(;t.length<e.length;)t=t.concat(t);return Math.max.apply(null,e.map((function(e,n){return Ha(e)+Ha(t[n])})))}function Ha(t){return 1e3*Number(t.slice(0,-1).replace(",","."))}function Za(t,n){var a=t.elm;r(a._leaveCb)&&(a._leaveCb.cancelled=!0,a._leaveCb());var s=ka(t.data.transition);if(!e(s)&&!r(a._enterCb)&&1===a.nodeType){for(var o=s.css,c=s.type,l=s.enterClass,u=s.enterToClass,d=s.enterActiveClass,f=s.appearClass,v=s.appearToClass,h=s.appearActiveClass,m=s.beforeEnter,g=s.enter,_=s.afterEnter,y=s.enterCancelled,b=s.beforeAppear,w=s.appear,C=s.afterAppear,x=s.appearCancelled,$=s.duration,k=tn,S=tn.$vnode;S&&S.parent;)k=S.context,S=S.parent;var A=!k._isMounted||!t.isRootInsert;if(!A||w||""===w){var O=A&&f?f:l,E=A&&h?h:d,T=A&&v?v:u,P=A&&b||m,j=A&&"function"==typeof w?w:g,N=A&&C||_,B=A&&x||y,M=p(i($)?$.enter:$);0;var R=!1!==o&&!X,L=Wa(j),F=a._enterCb=D((function(){R&&(Ra(a,T),Ra(a,E)),F.cancelled?(R&&Ra(a,O),B&&B(a)):N&&N(a),a._enterCb=null}));t.data.show||ue(t,"insert",(function(){var e=a.parentNode,n=e&&e._pending&&e._pending[t.key];n&&n.tag===t.tag&&n.elm._leaveCb&&n.elm._leaveCb(),j&&j(a,F)})),P&&P(a),R&&(Ma(a,O),Ma(a,E),Da((function(){Ra(a,O),F.cancelled||(Ma(a,T),L||(Va(M)?setTimeout(F,M):La(a,c,F)))}))),t.data.show&&(n&&n(),j&&j(a,F)),R||L||F()}}}function qa(t,n){var a=t.elm;r(a._enterCb)&&(a._enterCb.cancelled=!0,a._enterCb());var s=ka(t.data.transition);if(e(s)||1!==a.nodeType)return n();if(!r(a._leaveCb)){var o=s.css,c=s.type,l=s.leaveClass,u=s.leaveToClass,d=s.leaveActiveClass,f=s.beforeLeave,v=s.leave,h=s.afterLeave,m=s.leaveCancelled,g=s.delayLeave,_=s.duration,y=!1!==o&&!X,b=Wa(v),w=p(i(_)?_.leave:_);0;var C=a._leaveCb=D((function(){a.parentNode&&a.parentNode._pending&&(a.parentNode._pending[t.key]=null),y&&(Ra(a,u),Ra(a,d)),C.cancelled?(y&&Ra(a,l),m&&m(a)):(n(),h&&h(a)),a._leaveCb=null}));g?g(x):x()}function x(){C.cancelled||(!t.data.show&&a.parentNode&&((a.parentNode._pending||(a.parentNode._pending={}))[t.key]=t),f&&f(a),y&&(Ma(a,l),Ma(a,d),Da((function(){Ra(a,l),C.cancelled||(Ma(a,u),b||(Va(w)?setTimeout(C,w):La(a,c,C)))}))),v&&v(a,C),y||b||C())}}function Va(t){return"number"==typeof t&&!isNaN(t)}function Wa(t){if(e(t))return!1;var n=t.fns;return r(n)?Wa(Array.isArray(n)?n[0]:n):(t._length||t.length)>1}function za(t,e){!0!==e.data.show&&Za(e)}var Ga=function(t){var n,i,o={},c=t.modules,l=t.nodeOps;for(n=0;n<lr.length;++n)for(o[lr[n]]=[],i=0;i<c.length;++i)r(c[i][lr[n]])&&o[lr[n]].push(c[i][lr[n]]);function u(t){var e=l.parentNode(t);r(e)&&l.removeChild(e,t)}function d(t,e,n,s,i,c,u){if(r(t.elm)&&r(c)&&(t=c[u]=yt(t)),t.isRootInsert=!i,!function(t,e,n,s){var i=t.data;if(r(i)){var c=r(t.componentInstance)&&i.keepAlive;if(r(i=i.hook)&&r(i=i.init)&&i(t,!1),r(t.componentInstance))return f(t,e),p(n,t.elm,s),a(c)&&function(t,e,n,a){var s,i=t;for(;i.componentInstance;)if(r(s=(i=i.componentInstance._vnode).data)&&r(s=s.transition)){for(s=0;s<o.activate.length;++s)o.activate[s](cr,i);e.push(i);break}p(n,t.elm,a)}(t,e,n,s),!0}}(t,e,n,s)){var d=t.data,v=t.children,m=t.tag;r(m)?(t.elm=t.ns?l.createElementNS(t.ns,m):l.createElement(m,t),_(t),h(t,v,e),r(d)&&g(t,e),p(n,t.elm,s)):a(t.isComment)?(t.elm=l.createComment(t.text),p(n,t.elm,s)):(t.elm=l.createTextNode(t.text),p(n,t.elm,s))}}function f(t,e){r(t.data.pendingInsert)&&(e.push.apply(e,t.data.pendingInsert),t.data.pendingInsert=null),t.elm=t.componentInstance.$el,m(t)?(g(t,e),_(t)):(or(t),e.push(t))}function p(t,e,n){r(t)&&(r(n)?l.parentNode(n)===t&&l.insertBefore(t,e,n):l.appendChild(t,e))}function h(t,e,n){if(Array.isArray(e)){0;for(var r=0;r<e.length;++r)d(e[r],n,t.elm,null,!0,e,r)}else s(t.text)&&l.appendChild(t.elm,l.createTextNode(String(t.text)))}function m(t){for(;t.componentInstance;)t=t.componentInstance._vnode;return r(t.tag)}function g(t,e){for(var a=0;a<o.create.length;++a)o.create[a](cr,t);r(n=t.data.hook)&&(r(n.create)&&n.create(cr,t),r(n.insert)&&e.push(t))}function _(t){var e;if(r(e=t.fnScopeId))l.setStyleScope(t.elm,e);else for(var n=t;n;)r(e=n.context)&&r(e=e.$options._scopeId)&&l.setStyleScope(t.elm,e),n=n.parent;r(e=tn)&&e!==t.context&&e!==t.fnContext&&r(e=e.$options._scopeId)&&l.setStyleScope(t.elm,e)}function y(t,e,n,r,a,s){for(;r<=a;++r)d(n[r],s,t,e,!1,n,r)}function b(t){var e,n,a=t.data;if(r(a))for(r(e=a.hook)&&r(e=e.destroy)&&e(t),e=0;e<o.destroy.length;++e)o.destroy[e](t);if(r(e=t.children))for(n=0;n<t.children.length;++n)b(t.children[n])}function w(t,e,n){for(;e<=n;++e){var a=t[e];r(a)&&(r(a.tag)?(C(a),b(a)):u(a.elm))}}function C(t,e){if(r(e)||r(t.data)){var n,a=o.remove.length+1;for(r(e)?e.listeners+=a:e=function(t,e){function n(){0==--n.listeners&&u(t)}return n.listeners=e,n}(t.elm,a),r(n=t.componentInstance)&&r(n=n._vnode)&&r(n.data)&&C(n,e),n=0;n<o.remove.length;++n)o.remove[n](t,e);r(n=t.data.hook)&&r(n=n.remove)?n(t,e):e()}else u(t.elm)}function x(t,e,n,a){for(var s=n;s<a;s++){var i=e[s];if(r(i)&&ur(t,i))return s}}function $(t,n,s,i,c,u){if(t!==n){r(n.elm)&&r(i)&&(n=i[c]=yt(n));var f=n.elm=t.elm;if(a(t.isAsyncPlaceholder))r(n.asyncFactory.resolved)?A(t.elm,n,s):n.isAsyncPlaceholder=!0;else if(a(n.isStatic)&&a(t.isStatic)&&n.key===t.key&&(a(n.isCloned)||a(n.isOnce)))n.componentInstance=t.componentInstance;else{var p,v=n.data;r(v)&&r(p=v.hook)&&r(p=p.prepatch)&&p(t,n);var h=t.children,g=n.children;if(r(v)&&m(n)){for(p=0;p<o.update.length;++p)o.update[p](t,n);r(p=v.hook)&&r(p=p.update)&&p(t,n)}e(n.text)?r(h)&&r(g)?h!==g&&function(t,n,a,s,i){var o,c,u,f=0,p=0,v=n.length-1,h=n[0],m=n[v],g=a.length-1,_=a[0],b=a[g],C=!i;for(;f<=v&&p<=g;)e(h)?h=n[++f]:e(m)?m=n[--v]:ur(h,_)?($(h,_,s,a,p),h=n[++f],_=a[++p]):ur(m,b)?($(m,b,s,a,g),m=n[--v],b=a[--g]):ur(h,b)?($(h,b,s,a,g),C&&l.insertBefore(t,h.elm,l.nextSibling(m.elm)),h=n[++f],b=a[--g]):ur(m,_)?($(m,_,s,a,p),C&&l.insertBefore(t,m.elm,h.elm),m=n[--v],_=a[++p]):(e(o)&&(o=dr(n,f,v)),e(c=r(_.key)?o[_.key]:x(_,n,f,v))?d(_,s,t,h.elm,!1,a,p):ur(u=n[c],_)?($(u,_,s,a,p),n[c]=void 0,C&&l.insertBefore(t,u.elm,h.elm)):d(_,s,t,h.elm,!1,a,p),_=a[++p]);f>v?y(t,e(a[g+1])?null:a[g+1].elm,a,p,g,s):p>g&&w(n,f,v)}(f,h,g,s,u):r(g)?(r(t.text)&&l.setTextContent(f,""),y(f,null,g,0,g.length-1,s)):r(h)?w(h,0,h.length-1):r(t.text)&&l.setTextContent(f,""):t.text!==n.text&&l.setTextContent(f,n.text),r(v)&&r(p=v.hook)&&r(p=p.postpatch)&&p(t,n)}}}function k(t,e,n){if(a(n)&&r(t.parent))t.parent.data.pendingInsert=e;else for(var s=0;s<e.length;++s)e[s].data.hook.insert(e[s])}var S=v("attrs,class,staticClass,staticStyle,key");function A(t,e,n,s){var i,o=e.tag,c=e.data,l=e.children;if(s=s||c&&c.pre,e.elm=t,a(e.isComment)&&r(e.asyncFactory))return e.isAsyncPlaceholder=!0,!0;if(r(c)&&(r(i=c.hook)&&r(i=i.init)&&i(e,!0),r(i=e.componentInstance)))return f(e,n),!0;if(r(o)){if(r(l))if(t.hasChildNodes())if(r(i=c)&&r(i=i.domProps)&&r(i=i.innerHTML)){if(i!==t.innerHTML)return!1}else{for(var u=!0,d=t.firstChild,p=0;p<l.length;p++){if(!d||!A(d,l[p],n,s)){u=!1;break}d=d.nextSibling}if(!u||d)return!1}else h(e,l,n);if(r(c)){var v=!1;for(var m in c)if(!S(m)){v=!0,g(e,n);break}!v&&c.class&&se(c.class)}}else t.data!==e.text&&(t.data=e.text);return!0}return function(t,n,s,i){if(!e(n)){var c,u=!1,f=[];if(e(t))u=!0,d(n,f);else{var p=r(t.nodeType);if(!p&&ur(t,n))$(t,n,f,null,null,i);else{if(p){if(1===t.nodeType&&t.hasAttribute(M)&&(t.removeAttribute(M),s=!0),a(s)&&A(t,n,f))return k(n,f,!0),t;c=t,t=new ht(l.tagName(c).toLowerCase(),{},[],void 0,c)}var v=t.elm,h=l.parentNode(v);if(d(n,f,v._leaveCb?null:h,l.nextSibling(v)),r(n.parent))for(var g=n.parent,_=m(n);g;){for(var y=0;y<o.destroy.length;++y)o.destroy[y](g);if(g.elm=n.elm,_){for(var C=0;C<o.create.length;++C)o.create[C](cr,g);var x=g.data.hook.insert;if(x.merged)for(var S=1;S<x.fns.length;S++)x.fns[S]()}else or(g);g=g.parent}r(h)?w([t],0,0):r(t.tag)&&b(t)}}return k(n,f,u),n.elm}r(t)&&b(t)}}({nodeOps:sr,modules:[Cr,Tr,oa,ua,wa,W?{create:za,activate:za,remove:function(t,e){!0!==t.data.show?qa(t,e):e()}}:{}].concat(_r)});X&&document.addEventListener("selectionchange",(function(){var t=document.activeElement;t&&t.vmodel&&ns(t,"input")}));var Ja={inserted:function(t,e,n,r){"select"===n.tag?(r.elm&&!r.elm._vOptions?ue(n,"postpatch",(function(){Ja.componentUpdated(t,e,n)})):Ka(t,e,n.context),t._vOptions=[].map.call(t.options,Qa)):("textarea"===n.tag||rr(t.type))&&(t._vModifiers=e.modifiers,e.modifiers.lazy||(t.addEventListener("compositionstart",ts),t.addEventListener("compositionend",es),t.addEventListener("change",es),X&&(t.vmodel=!0)))},componentUpdated:function(t,e,n){if("select"===n.tag){Ka(t,e,n.context);var r=t._vOptions,a=t._vOptions=[].map.call(t.options,Qa);if(a.some((function(t,e){return!N(t,r[e])})))(t.multiple?e.value.some((function(t){return Ya(t,a)})):e.value!==e.oldValue&&Ya(e.value,a))&&ns(t,"change")}}};function Ka(t,e,n){Xa(t,e,n),(K||Y)&&setTimeout((function(){Xa(t,e,n)}),0)}function Xa(t,e,n){var r=e.value,a=t.multiple;if(!a||Array.isArray(r)){for(var s,i,o=0,c=t.options.length;o<c;o++)if(i=t.options[o],a)s=B(r,Qa(i))>-1,i.selected!==s&&(i.selected=s);else if(N(Qa(i),r))return void(t.selectedIndex!==o&&(t.selectedIndex=o));a||(t.selectedIndex=-1)}}function Ya(t,e){return e.every((function(e){return!N(e,t)}))}function Qa(t){return"_value"in t?t._value:t.value}function ts(t){t.target.composing=!0}function es(t){t.target.composing&&(t.target.composing=!1,ns(t.target,"input"))}function ns(t,e){var n=document.createEvent("HTMLEvents");n.initEvent(e,!0,!0),t.dispatchEvent(n)}function rs(t){return!t.componentInstance||t.data&&t.data.transition?t:rs(t.componentInstance._vnode)}var as={model:Ja,show:{bind:function(t,e,n){var r=e.value,a=(n=rs(n)).data&&n.data.transition,s=t.__vOriginalDisplay="none"===t.style.display?"":t.style.display;r&&a?(n.data.show=!0,Za(n,(function(){t.style.display=s}))):t.style.display=r?s:"none"},update:function(t,e,n){var r=e.value;!r!=!e.oldValue&&((n=rs(n)).data&&n.data.transition?(n.data.show=!0,r?Za(n,(function(){t.style.display=t.__vOriginalDisplay})):qa(n,(function(){t.style.display="none"}))):t.style.display=r?t.__vOriginalDisplay:"none")},unbind:function(t,e,n,r,a){a||(t.style.display=t.__vOriginalDisplay)}}},ss={name:String,appear:Boolean,css:Boolean,mode:String,type:String,enterClass:String,leaveClass:String,enterToClass:String,leaveToClass:String,enterActiveClass:String,leaveActiveClass:String,appearClass:String,appearActiveClass:String,appearToClass:String,duration:[Number,String,Object]};function is(t){var e=t&&t.componentOptions;return e&&e.Ctor.options.abstract?is(Je(e.children)):t}function os(t){var e={},n=t.$options;for(var r in n.propsData)e[r]=t[r];var a=n._parentListeners;for(var s in a)e[C(s)]=a[s];return e}function cs(t,e){if(/\d-keep-alive$/.test(e.tag))return t("keep-alive",{props:e.componentOptions.propsData})}var ls=function(t){return t.tag||_e(t)},us=function(t){return"show"===t.name},ds={name:"transition",props:ss,abstract:!0,render:function(t){var e=this,n=this.$slots.default;if(n&&(n=n.filter(ls)).length){0;var r=this.mode;0;var a=n[0];if(function(t){for(;t=t.parent;)if(t.data.transition)return!0}(this.$vnode))return a;var i=is(a);if(!i)return a;if(this._leaving)return cs(t,a);var o="__transition-"+this._uid+"-";i.key=null==i.key?i.isComment?o+"comment":o+i.tag:s(i.key)?0===String(i.key).indexOf(o)?i.key:o+i.key:i.key;var c=(i.data||(i.data={})).transition=os(this),l=this._vnode,u=is(l);if(i.data.directives&&i.data.directives.some(us)&&(i.data.show=!0),u&&u.data&&!function(t,e){return e.key===t.key&&e.tag===t.tag}(i,u)&&!_e(u)&&(!u.componentInstance||!u.componentInstance._vnode.isComment)){var d=u.data.transition=O({},c);if("out-in"===r)return this._leaving=!0,ue(d,"afterLeave",(function(){e._leaving=!1,e.$forceUpdate()})),cs(t,a);if("in-out"===r){if(_e(i))return l;var f,p=function(){f()};ue(c,"afterEnter",p),ue(c,"enterCancelled",p),ue(d,"delayLeave",(function(t){f=t}))}}return a}}},fs=O({tag:String,moveClass:String},ss);function ps(t){t.elm._moveCb&&t.elm._moveCb(),t.elm._enterCb&&t.elm._enterCb()}function vs(t){t.data.newPos=t.elm.getBoundingClientRect()}function hs(t){var e=t.data.pos,n=t.data.newPos,r=e.left-n.left,a=e.top-n.top;if(r||a){t.data.moved=!0;var s=t.elm.style;s.transform=s.WebkitTransform="translate("+r+"px,"+a+"px)",s.transitionDuration="0s"}}delete fs.mode;var ms={Transition:ds,TransitionGroup:{props:fs,beforeMount:function(){var t=this,e=this._update;this._update=function(n,r){var a=en(t);t.__patch__(t._vnode,t.kept,!1,!0),t._vnode=t.kept,a(),e.call(t,n,r)}},render:function(t){for(var e=this.tag||this.$vnode.data.tag||"span",n=Object.create(null),r=this.prevChildren=this.children,a=this.$slots.default||[],s=this.children=[],i=os(this),o=0;o<a.length;o++){var c=a[o];if(c.tag)if(null!=c.key&&0!==String(c.key).indexOf("__vlist"))s.push(c),n[c.key]=c,(c.data||(c.data={})).transition=i;else;}if(r){for(var l=[],u=[],d=0;d<r.length;d++){var f=r[d];f.data.transition=i,f.data.pos=f.elm.getBoundingClientRect(),n[f.key]?l.push(f):u.push(f)}this.kept=t(e,null,l),this.removed=u}return t(e,null,s)},updated:function(){var t=this.prevChildren,e=this.moveClass||(this.name||"v")+"-move";t.length&&this.hasMove(t[0].elm,e)&&(t.forEach(ps),t.forEach(vs),t.forEach(hs),this._reflow=document.body.offsetHeight,t.forEach((function(t){if(t.data.moved){var n=t.elm,r=n.style;Ma(n,e),r.transform=r.WebkitTransform=r.transitionDuration="",n.addEventListener(Pa,n._moveCb=function t(r){r&&r.target!==n||r&&!/transform$/.test(r.propertyName)||(n.removeEventListener(Pa,t),n._moveCb=null,Ra(n,e))})}})))},methods:{hasMove:function(t,e){if(!Aa)return!1;if(this._hasMove)return this._hasMove;var n=t.cloneNode();t._transitionClasses&&t._transitionClasses.forEach((function(t){$a(n,t)})),xa(n,e),n.style.display="none",this.$el.appendChild(n);var r=Ua(n);return this.$el.removeChild(n),this._hasMove=r.hasTransform}}}};En.config.mustUseProp=Fn,En.config.isReservedTag=tr,En.config.isReservedAttr=Rn,En.config.getTagNamespace=er,En.config.isUnknownElement=function(t){if(!W)return!0;if(tr(t))return!1;if(t=t.toLowerCase(),null!=nr[t])return nr[t];var e=document.createElement(t);return t.indexOf("-")>-1?nr[t]=e.constructor===window.HTMLUnknownElement||e.constructor===window.HTMLElement:nr[t]=/HTMLUnknownElement/.test(e.toString())},O(En.options.directives,as),O(En.options.components,ms),En.prototype.__patch__=W?Ga:T,En.prototype.$mount=function(t,e){return function(t,e,n){var r;return t.$el=e,t.$options.render||(t.$options.render=gt),sn(t,"beforeMount"),r=function(){t._update(t._render(),n)},new _n(t,r,T,{before:function(){t._isMounted&&!t._isDestroyed&&sn(t,"beforeUpdate")}},!0),n=!1,null==t.$vnode&&(t._isMounted=!0,sn(t,"mounted")),t}(this,t=t&&W?ar(t):void 0,e)},W&&setTimeout((function(){F.devtools&&st&&st.emit("init",En)}),0);var gs=/\{\{((?:.|\r?\n)+?)\}\}/g,_s=/[-.*+?^${}()|[\]\/\\]/g,ys=b((function(t){var e=t[0].replace(_s,"\\$&"),n=t[1].replace(_s,"\\$&");return new RegExp(e+"((?:.|\\n)+?)"+n,"g")}));var bs={staticKeys:["staticClass"],transformNode:function(t,e){e.warn;var n=Zr(t,"class");n&&(t.staticClass=JSON.stringify(n));var r=Hr(t,"class",!1);r&&(t.classBinding=r)},genData:function(t){var e="";return t.staticClass&&(e+="staticClass:"+t.staticClass+","),t.classBinding&&(e+="class:"+t.classBinding+","),e}};var ws,Cs={staticKeys:["staticStyle"],transformNode:function(t,e){e.warn;var n=Zr(t,"style");n&&(t.staticStyle=JSON.stringify(da(n)));var r=Hr(t,"style",!1);r&&(t.styleBinding=r)},genData:function(t){var e="";return t.staticStyle&&(e+="staticStyle:"+t.staticStyle+","),t.styleBinding&&(e+="style:("+t.styleBinding+"),"),e}},xs=function(t){return(ws=ws||document.createElement("div")).innerHTML=t,ws.textContent},$s=v("area,base,br,col,embed,frame,hr,img,input,isindex,keygen,link,meta,param,source,track,wbr"),ks=v("colgroup,dd,dt,li,options,p,td,tfoot,th,thead,tr,source"),Ss=v("address,article,aside,base,blockquote,body,caption,col,colgroup,dd,details,dialog,div,dl,dt,fieldset,figcaption,figure,footer,form,h1,h2,h3,h4,h5,h6,head,header,hgroup,hr,html,legend,li,menuitem,meta,optgroup,option,param,rp,rt,source,style,summary,tbody,td,tfoot,th,thead,title,tr,track"),As=/^\s*([^\s"'<>\/=]+)(?:\s*(=)\s*(?:"([^"]*)"+|'([^']*)'+|([^\s"'=<>`]+)))?/,Os=/^\s*((?:v-[\w-]+:|@|:|#)\[[^=]+?\][^\s"'<>\/=]*)(?:\s*(=)\s*(?:"([^"]*)"+|'([^']*)'+|([^\s"'=<>`]+)))?/,Es="[a-zA-Z_][\\-\\.0-9_a-zA-Z"+U.source+"]*",Ts="((?:"+Es+"\\:)?"+Es+")",Ps=new RegExp("^<"+Ts),js=/^\s*(\/?)>/,Ns=new RegExp("^<\\/"+Ts+"[^>]*>"),Bs=/^<!DOCTYPE [^>]+>/i,Ds=/^<!\--/,Ms=/^<!\[/,Rs=v("script,style,textarea",!0),Ls={},Fs={"&lt;":"<","&gt;":">","&quot;":'"',"&amp;":"&","&#10;":"\n","&#9;":"\t","&#39;":"'"},Us=/&(?:lt|gt|quot|amp|#39);/g,Is=/&(?:lt|gt|quot|amp|#39|#10|#9);/g,Hs=v("pre,textarea",!0),Zs=function(t,e){return t&&Hs(t)&&"\n"===e[0]};function qs(t,e){var n=e?Is:Us;return t.replace(n,(function(t){return Fs[t]}))}var Vs,Ws,zs,Gs,Js,Ks,Xs,Ys,Qs=/^@|^v-on:/,ti=/^v-|^@|^:|^#/,ei=/([\s\S]*?)\s+(?:in|of)\s+([\s\S]*)/,ni=/,([^,\}\]]*)(?:,([^,\}\]]*))?$/,ri=/^\(|\)$/g,ai=/^\[.*\]$/,si=/:(.*)$/,ii=/^:|^\.|^v-bind:/,oi=/\.[^.\]]+(?=[^\]]*$)/g,ci=/^v-slot(:|$)|^#/,li=/[\r\n]/,ui=/[ \f\t\r\n]+/g,di=b(xs),fi="_empty_";function pi(t,e,n){return{type:1,tag:t,attrsList:e,attrsMap:bi(e),rawAttrsMap:{},parent:n,children:[]}}function vi(t,e){Vs=e.warn||Br,Ks=e.isPreTag||P,Xs=e.mustUseProp||P,Ys=e.getTagNamespace||P;var n=e.isReservedTag||P;(function(t){return!(!(t.component||t.attrsMap[":is"]||t.attrsMap["v-bind:is"])&&(t.attrsMap.is?n(t.attrsMap.is):n(t.tag)))}),zs=Dr(e.modules,"transformNode"),Gs=Dr(e.modules,"preTransformNode"),Js=Dr(e.modules,"postTransformNode"),Ws=e.delimiters;var r,a,s=[],i=!1!==e.preserveWhitespace,o=e.whitespace,c=!1,l=!1;function u(t){if(d(t),c||t.processed||(t=hi(t,e)),s.length||t===r||r.if&&(t.elseif||t.else)&&gi(r,{exp:t.elseif,block:t}),a&&!t.forbidden)if(t.elseif||t.else)i=t,(o=function(t){for(var e=t.length;e--;){if(1===t[e].type)return t[e];t.pop()}}(a.children))&&o.if&&gi(o,{exp:i.elseif,block:i});else{if(t.slotScope){var n=t.slotTarget||'"default"';(a.scopedSlots||(a.scopedSlots={}))[n]=t}a.children.push(t),t.parent=a}var i,o;t.children=t.children.filter((function(t){return!t.slotScope})),d(t),t.pre&&(c=!1),Ks(t.tag)&&(l=!1);for(var u=0;u<Js.length;u++)Js[u](t,e)}function d(t){if(!l)for(var e;(e=t.children[t.children.length-1])&&3===e.type&&" "===e.text;)t.children.pop()}return function(t,e){for(var n,r,a=[],s=e.expectHTML,i=e.isUnaryTag||P,o=e.canBeLeftOpenTag||P,c=0;t;){if(n=t,r&&Rs(r)){var l=0,u=r.toLowerCase(),d=Ls[u]||(Ls[u]=new RegExp("([\\s\\S]*?)(</"+u+"[^>]*>)","i")),f=t.replace(d,(function(t,n,r){return l=r.length,Rs(u)||"noscript"===u||(n=n.replace(/<!\--([\s\S]*?)-->/g,"$1").replace(/<!\[CDATA\[([\s\S]*?)]]>/g,"$1")),Zs(u,n)&&(n=n.slice(1)),e.chars&&e.chars(n),""}));c+=t.length-f.length,t=f,S(u,c-l,c)}else{var p=t.indexOf("<");if(0===p){if(Ds.test(t)){var v=t.indexOf("--\x3e");if(v>=0){e.shouldKeepComment&&e.comment(t.substring(4,v),c,c+v+3),x(v+3);continue}}if(Ms.test(t)){var h=t.indexOf("]>");if(h>=0){x(h+2);continue}}var m=t.match(Bs);if(m){x(m[0].length);continue}var g=t.match(Ns);if(g){var _=c;x(g[0].length),S(g[1],_,c);continue}var y=$();if(y){k(y),Zs(y.tagName,t)&&x(1);continue}}var b=void 0,w=void 0,C=void 0;if(p>=0){for(w=t.slice(p);!(Ns.test(w)||Ps.test(w)||Ds.test(w)||Ms.test(w)||(C=w.indexOf("<",1))<0);)p+=C,w=t.slice(p);b=t.substring(0,p)}p<0&&(b=t),b&&x(b.length),e.chars&&b&&e.chars(b,c-b.length,c)}if(t===n){e.chars&&e.chars(t);break}}function x(e){c+=e,t=t.substring(e)}function $(){var e=t.match(Ps);if(e){var n,r,a={tagName:e[1],attrs:[],start:c};for(x(e[0].length);!(n=t.match(js))&&(r=t.match(Os)||t.match(As));)r.start=c,x(r[0].length),r.end=c,a.attrs.push(r);if(n)return a.unarySlash=n[1],x(n[0].length),a.end=c,a}}function k(t){var n=t.tagName,c=t.unarySlash;s&&("p"===r&&Ss(n)&&S(r),o(n)&&r===n&&S(n));for(var l=i(n)||!!c,u=t.attrs.length,d=new Array(u),f=0;f<u;f++){var p=t.attrs[f],v=p[3]||p[4]||p[5]||"",h="a"===n&&"href"===p[1]?e.shouldDecodeNewlinesForHref:e.shouldDecodeNewlines;d[f]={name:p[1],value:qs(v,h)}}l||(a.push({tag:n,lowerCasedTag:n.toLowerCase(),attrs:d,start:t.start,end:t.end}),r=n),e.start&&e.start(n,d,l,t.start,t.end)}function S(t,n,s){var i,o;if(null==n&&(n=c),null==s&&(s=c),t)for(o=t.toLowerCase(),i=a.length-1;i>=0&&a[i].lowerCasedTag!==o;i--);else i=0;if(i>=0){for(var l=a.length-1;l>=i;l--)e.end&&e.end(a[l].tag,n,s);a.length=i,r=i&&a[i-1].tag}else"br"===o?e.start&&e.start(t,[],!0,n,s):"p"===o&&(e.start&&e.start(t,[],!1,n,s),e.end&&e.end(t,n,s))}S()}(t,{warn:Vs,expectHTML:e.expectHTML,isUnaryTag:e.isUnaryTag,canBeLeftOpenTag:e.canBeLeftOpenTag,shouldDecodeNewlines:e.shouldDecodeNewlines,shouldDecodeNewlinesForHref:e.shouldDecodeNewlinesForHref,shouldKeepComment:e.comments,outputSourceRange:e.outputSourceRange,start:function(t,n,i,o,d){var f=a&&a.ns||Ys(t);K&&"svg"===f&&(n=function(t){for(var e=[],n=0;n<t.length;n++){var r=t[n];wi.test(r.name)||(r.name=r.name.replace(Ci,""),e.push(r))}return e}(n));var p,v=pi(t,n,a);f&&(v.ns=f),"style"!==(p=v).tag&&("script"!==p.tag||p.attrsMap.type&&"text/javascript"!==p.attrsMap.type)||at()||(v.forbidden=!0);for(var h=0;h<Gs.length;h++)v=Gs[h](v,e)||v;c||(!function(t){null!=Zr(t,"v-pre")&&(t.pre=!0)}(v),v.pre&&(c=!0)),Ks(v.tag)&&(l=!0),c?function(t){var e=t.attrsList,n=e.length;if(n)for(var r=t.attrs=new Array(n),a=0;a<n;a++)r[a]={name:e[a].name,value:JSON.stringify(e[a].value)},null!=e[a].start&&(r[a].start=e[a].start,r[a].end=e[a].end);else t.pre||(t.plain=!0)}(v):v.processed||(mi(v),function(t){var e=Zr(t,"v-if");if(e)t.if=e,gi(t,{exp:e,block:t});else{null!=Zr(t,"v-else")&&(t.else=!0);var n=Zr(t,"v-else-if");n&&(t.elseif=n)}}(v),function(t){null!=Zr(t,"v-once")&&(t.once=!0)}(v)),r||(r=v),i?u(v):(a=v,s.push(v))},end:function(t,e,n){var r=s[s.length-1];s.length-=1,a=s[s.length-1],u(r)},chars:function(t,e,n){if(a&&(!K||"textarea"!==a.tag||a.attrsMap.placeholder!==t)){var r,s,u,d=a.children;if(t=l||t.trim()?"script"===(r=a).tag||"style"===r.tag?t:di(t):d.length?o?"condense"===o&&li.test(t)?"":" ":i?" ":"":"")l||"condense"!==o||(t=t.replace(ui," ")),!c&&" "!==t&&(s=function(t,e){var n=e?ys(e):gs;if(n.test(t)){for(var r,a,s,i=[],o=[],c=n.lastIndex=0;r=n.exec(t);){(a=r.index)>c&&(o.push(s=t.slice(c,a)),i.push(JSON.stringify(s)));var l=jr(r[1].trim());i.push("_s("+l+")"),o.push({"@binding":l}),c=a+r[0].length}return c<t.length&&(o.push(s=t.slice(c)),i.push(JSON.stringify(s))),{expression:i.join("+"),tokens:o}}}(t,Ws))?u={type:2,expression:s.expression,tokens:s.tokens,text:t}:" "===t&&d.length&&" "===d[d.length-1].text||(u={type:3,text:t}),u&&d.push(u)}},comment:function(t,e,n){if(a){var r={type:3,text:t,isComment:!0};0,a.children.push(r)}}}),r}function hi(t,e){var n;!function(t){var e=Hr(t,"key");if(e){t.key=e}}(t),t.plain=!t.key&&!t.scopedSlots&&!t.attrsList.length,function(t){var e=Hr(t,"ref");e&&(t.ref=e,t.refInFor=function(t){var e=t;for(;e;){if(void 0!==e.for)return!0;e=e.parent}return!1}(t))}(t),function(t){var e;"template"===t.tag?(e=Zr(t,"scope"),t.slotScope=e||Zr(t,"slot-scope")):(e=Zr(t,"slot-scope"))&&(t.slotScope=e);var n=Hr(t,"slot");n&&(t.slotTarget='""'===n?'"default"':n,t.slotTargetDynamic=!(!t.attrsMap[":slot"]&&!t.attrsMap["v-bind:slot"]),"template"===t.tag||t.slotScope||Rr(t,"slot",n,function(t,e){return t.rawAttrsMap[":"+e]||t.rawAttrsMap["v-bind:"+e]||t.rawAttrsMap[e]}(t,"slot")));if("template"===t.tag){var r=qr(t,ci);if(r){0;var a=_i(r),s=a.name,i=a.dynamic;t.slotTarget=s,t.slotTargetDynamic=i,t.slotScope=r.value||fi}}else{var o=qr(t,ci);if(o){0;var c=t.scopedSlots||(t.scopedSlots={}),l=_i(o),u=l.name,d=l.dynamic,f=c[u]=pi("template",[],t);f.slotTarget=u,f.slotTargetDynamic=d,f.children=t.children.filter((function(t){if(!t.slotScope)return t.parent=f,!0})),f.slotScope=o.value||fi,t.children=[],t.plain=!1}}}(t),"slot"===(n=t).tag&&(n.slotName=Hr(n,"name")),function(t){var e;(e=Hr(t,"is"))&&(t.component=e);null!=Zr(t,"inline-template")&&(t.inlineTemplate=!0)}(t);for(var r=0;r<zs.length;r++)t=zs[r](t,e)||t;return function(t){var e,n,r,a,s,i,o,c,l=t.attrsList;for(e=0,n=l.length;e<n;e++){if(r=a=l[e].name,s=l[e].value,ti.test(r))if(t.hasBindings=!0,(i=yi(r.replace(ti,"")))&&(r=r.replace(oi,"")),ii.test(r))r=r.replace(ii,""),s=jr(s),(c=ai.test(r))&&(r=r.slice(1,-1)),i&&(i.prop&&!c&&"innerHtml"===(r=C(r))&&(r="innerHTML"),i.camel&&!c&&(r=C(r)),i.sync&&(o=zr(s,"$event"),c?Ir(t,'"update:"+('+r+")",o,null,!1,0,l[e],!0):(Ir(t,"update:"+C(r),o,null,!1,0,l[e]),k(r)!==C(r)&&Ir(t,"update:"+k(r),o,null,!1,0,l[e])))),i&&i.prop||!t.component&&Xs(t.tag,t.attrsMap.type,r)?Mr(t,r,s,l[e],c):Rr(t,r,s,l[e],c);else if(Qs.test(r))r=r.replace(Qs,""),(c=ai.test(r))&&(r=r.slice(1,-1)),Ir(t,r,s,i,!1,0,l[e],c);else{var u=(r=r.replace(ti,"")).match(si),d=u&&u[1];c=!1,d&&(r=r.slice(0,-(d.length+1)),ai.test(d)&&(d=d.slice(1,-1),c=!0)),Fr(t,r,a,s,d,c,i,l[e])}else Rr(t,r,JSON.stringify(s),l[e]),!t.component&&"muted"===r&&Xs(t.tag,t.attrsMap.type,r)&&Mr(t,r,"true",l[e])}}(t),t}function mi(t){var e;if(e=Zr(t,"v-for")){var n=function(t){var e=t.match(ei);if(!e)return;var n={};n.for=e[2].trim();var r=e[1].trim().replace(ri,""),a=r.match(ni);a?(n.alias=r.replace(ni,"").trim(),n.iterator1=a[1].trim(),a[2]&&(n.iterator2=a[2].trim())):n.alias=r;return n}(e);n&&O(t,n)}}function gi(t,e){t.ifConditions||(t.ifConditions=[]),t.ifConditions.push(e)}function _i(t){var e=t.name.replace(ci,"");return e||"#"!==t.name[0]&&(e="default"),ai.test(e)?{name:e.slice(1,-1),dynamic:!0}:{name:'"'+e+'"',dynamic:!1}}function yi(t){var e=t.match(oi);if(e){var n={};return e.forEach((function(t){n[t.slice(1)]=!0})),n}}function bi(t){for(var e={},n=0,r=t.length;n<r;n++)e[t[n].name]=t[n].value;return e}var wi=/^xmlns:NS\d+/,Ci=/^NS\d+:/;function xi(t){return pi(t.tag,t.attrsList.slice(),t.parent)}var $i=[bs,Cs,{preTransformNode:function(t,e){if("input"===t.tag){var n,r=t.attrsMap;if(!r["v-model"])return;if((r[":type"]||r["v-bind:type"])&&(n=Hr(t,"type")),r.type||n||!r["v-bind"]||(n="("+r["v-bind"]+").type"),n){var a=Zr(t,"v-if",!0),s=a?"&&("+a+")":"",i=null!=Zr(t,"v-else",!0),o=Zr(t,"v-else-if",!0),c=xi(t);mi(c),Lr(c,"type","checkbox"),hi(c,e),c.processed=!0,c.if="("+n+")==='checkbox'"+s,gi(c,{exp:c.if,block:c});var l=xi(t);Zr(l,"v-for",!0),Lr(l,"type","radio"),hi(l,e),gi(c,{exp:"("+n+")==='radio'"+s,block:l});var u=xi(t);return Zr(u,"v-for",!0),Lr(u,":type",n),hi(u,e),gi(c,{exp:a,block:u}),i?c.else=!0:o&&(c.elseif=o),c}}}}];var ki,Si,Ai={expectHTML:!0,modules:$i,directives:{model:function(t,e,n){n;var r=e.value,a=e.modifiers,s=t.tag,i=t.attrsMap.type;if(t.component)return Wr(t,r,a),!1;if("select"===s)!function(t,e,n){var r='var $$selectedVal = Array.prototype.filter.call($event.target.options,function(o){return o.selected}).map(function(o){var val = "_value" in o ? o._value : o.value;return '+(n&&n.number?"_n(val)":"val")+"});";r=r+" "+zr(e,"$event.target.multiple ? $$selectedVal : $$selectedVal[0]"),Ir(t,"change",r,null,!0)}(t,r,a);else if("input"===s&&"checkbox"===i)!function(t,e,n){var r=n&&n.number,a=Hr(t,"value")||"null",s=Hr(t,"true-value")||"true",i=Hr(t,"false-value")||"false";Mr(t,"checked","Array.isArray("+e+")?_i("+e+","+a+")>-1"+("true"===s?":("+e+")":":_q("+e+","+s+")")),Ir(t,"change","var $$a="+e+",$$el=$event.target,$$c=$$el.checked?("+s+"):("+i+");if(Array.isArray($$a)){var $$v="+(r?"_n("+a+")":a)+",$$i=_i($$a,$$v);if($$el.checked){$$i<0&&("+zr(e,"$$a.concat([$$v])")+")}else{$$i>-1&&("+zr(e,"$$a.slice(0,$$i).concat($$a.slice($$i+1))")+")}}else{"+zr(e,"$$c")+"}",null,!0)}(t,r,a);else if("input"===s&&"radio"===i)!function(t,e,n){var r=n&&n.number,a=Hr(t,"value")||"null";Mr(t,"checked","_q("+e+","+(a=r?"_n("+a+")":a)+")"),Ir(t,"change",zr(e,a),null,!0)}(t,r,a);else if("input"===s||"textarea"===s)!function(t,e,n){var r=t.attrsMap.type;0;var a=n||{},s=a.lazy,i=a.number,o=a.trim,c=!s&&"range"!==r,l=s?"change":"range"===r?ta:"input",u="$event.target.value";o&&(u="$event.target.value.trim()");i&&(u="_n("+u+")");var d=zr(e,u);c&&(d="if($event.target.composing)return;"+d);Mr(t,"value","("+e+")"),Ir(t,l,d,null,!0),(o||i)&&Ir(t,"blur","$forceUpdate()")}(t,r,a);else{if(!F.isReservedTag(s))return Wr(t,r,a),!1}return!0},text:function(t,e){e.value&&Mr(t,"textContent","_s("+e.value+")",e)},html:function(t,e){e.value&&Mr(t,"innerHTML","_s("+e.value+")",e)}},isPreTag:function(t){return"pre"===t},isUnaryTag:$s,mustUseProp:Fn,canBeLeftOpenTag:ks,isReservedTag:tr,getTagNamespace:er,staticKeys:function(t){return t.reduce((function(t,e){return t.concat(e.staticKeys||[])}),[]).join(",")}($i)},Oi=b((function(t){return v("type,tag,attrsList,attrsMap,plain,parent,children,attrs,start,end,rawAttrsMap"+(t?","+t:""))}));function Ei(t,e){t&&(ki=Oi(e.staticKeys||""),Si=e.isReservedTag||P,Ti(t),Pi(t,!1))}function Ti(t){if(t.static=function(t){if(2===t.type)return!1;if(3===t.type)return!0;return!(!t.pre&&(t.hasBindings||t.if||t.for||h(t.tag)||!Si(t.tag)||function(t){for(;t.parent;){if("template"!==(t=t.parent).tag)return!1;if(t.for)return!0}return!1}(t)||!Object.keys(t).every(ki)))}(t),1===t.type){if(!Si(t.tag)&&"slot"!==t.tag&&null==t.attrsMap["inline-template"])return;for(var e=0,n=t.children.length;e<n;e++){var r=t.children[e];Ti(r),r.static||(t.static=!1)}if(t.ifConditions)for(var a=1,s=t.ifConditions.length;a<s;a++){var i=t.ifConditions[a].block;Ti(i),i.static||(t.static=!1)}}}function Pi(t,e){if(1===t.type){if((t.static||t.once)&&(t.staticInFor=e),t.static&&t.children.length&&(1!==t.children.length||3!==t.children[0].type))return void(t.staticRoot=!0);if(t.staticRoot=!1,t.children)for(var n=0,r=t.children.length;n<r;n++)Pi(t.children[n],e||!!t.for);if(t.ifConditions)for(var a=1,s=t.ifConditions.length;a<s;a++)Pi(t.ifConditions[a].block,e)}}var ji=/^([\w$_]+|\([^)]*?\))\s*=>|^function(?:\s+[\w$]+)?\s*\(/,Ni=/\([^)]*?\);*$/,Bi=/^[A-Za-z_$][\w$]*(?:\.[A-Za-z_$][\w$]*|\['[^']*?']|\["[^"]*?"]|\[\d+]|\[[A-Za-z_$][\w$]*])*$/,Di={esc:27,tab:9,enter:13,space:32,up:38,left:37,right:39,down:40,delete:[8,46]},Mi={esc:["Esc","Escape"],tab:"Tab",enter:"Enter",space:[" ","Spacebar"],up:["Up","ArrowUp"],left:["Left","ArrowLeft"],right:["Right","ArrowRight"],down:["Down","ArrowDown"],delete:["Backspace","Delete","Del"]},Ri=function(t){return"if("+t+")return null;"},Li={stop:"$event.stopPropagation();",prevent:"$event.preventDefault();",self:Ri("$event.target !== $event.currentTarget"),ctrl:Ri("!$event.ctrlKey"),shift:Ri("!$event.shiftKey"),alt:Ri("!$event.altKey"),meta:Ri("!$event.metaKey"),left:Ri("'button' in $event && $event.button !== 0"),middle:Ri("'button' in $event && $event.button !== 1"),right:Ri("'button' in $event && $event.button !== 2")};function Fi(t,e){var n=e?"nativeOn:":"on:",r="",a="";for(var s in t){var i=Ui(t[s]);t[s]&&t[s].dynamic?a+=s+","+i+",":r+='"'+s+'":'+i+","}return r="{"+r.slice(0,-1)+"}",a?n+"_d("+r+",["+a.slice(0,-1)+"])":n+r}function Ui(t){if(!t)return"function(){}";if(Array.isArray(t))return"["+t.map((function(t){return Ui(t)})).join(",")+"]";var e=Bi.test(t.value),n=ji.test(t.value),r=Bi.test(t.value.replace(Ni,""));if(t.modifiers){var a="",s="",i=[];for(var o in t.modifiers)if(Li[o])s+=Li[o],Di[o]&&i.push(o);else if("exact"===o){var c=t.modifiers;s+=Ri(["ctrl","shift","alt","meta"].filter((function(t){return!c[t]})).map((function(t){return"$event."+t+"Key"})).join("||"))}else i.push(o);return i.length&&(a+=function(t){return"if(!$event.type.indexOf('key')&&"+t.map(Ii).join("&&")+")return null;"}(i)),s&&(a+=s),"function($event){"+a+(e?"return "+t.value+".apply(null, arguments)":n?"return ("+t.value+").apply(null, arguments)":r?"return "+t.value:t.value)+"}"}return e||n?t.value:"function($event){"+(r?"return "+t.value:t.value)+"}"}function Ii(t){var e=parseInt(t,10);if(e)return"$event.keyCode!=="+e;var n=Di[t],r=Mi[t];return"_k($event.keyCode,"+JSON.stringify(t)+","+JSON.stringify(n)+",$event.key,"+JSON.stringify(r)+")"}var Hi={on:function(t,e){t.wrapListeners=function(t){return"_g("+t+","+e.value+")"}},bind:function(t,e){t.wrapData=function(n){return"_b("+n+",'"+t.tag+"',"+e.value+","+(e.modifiers&&e.modifiers.prop?"true":"false")+(e.modifiers&&e.modifiers.sync?",true":"")+")"}},cloak:T},Zi=function(t){this.options=t,this.warn=t.warn||Br,this.transforms=Dr(t.modules,"transformCode"),this.dataGenFns=Dr(t.modules,"genData"),this.directives=O(O({},Hi),t.directives);var e=t.isReservedTag||P;this.maybeComponent=function(t){return!!t.component||!e(t.tag)},this.onceId=0,this.staticRenderFns=[],this.pre=!1};function qi(t,e){var n=new Zi(e);return{render:"with(this){return "+(t?"script"===t.tag?"null":Vi(t,n):'_c("div")')+"}",staticRenderFns:n.staticRenderFns}}function Vi(t,e){if(t.parent&&(t.pre=t.pre||t.parent.pre),t.staticRoot&&!t.staticProcessed)return Wi(t,e);if(t.once&&!t.onceProcessed)return zi(t,e);if(t.for&&!t.forProcessed)return Ki(t,e);if(t.if&&!t.ifProcessed)return Gi(t,e);if("template"!==t.tag||t.slotTarget||e.pre){if("slot"===t.tag)return function(t,e){var n=t.slotName||'"default"',r=to(t,e),a="_t("+n+(r?",function(){return "+r+"}":""),s=t.attrs||t.dynamicAttrs?ro((t.attrs||[]).concat(t.dynamicAttrs||[]).map((function(t){return{name:C(t.name),value:t.value,dynamic:t.dynamic}}))):null,i=t.attrsMap["v-bind"];!s&&!i||r||(a+=",null");s&&(a+=","+s);i&&(a+=(s?"":",null")+","+i);return a+")"}(t,e);var n;if(t.component)n=function(t,e,n){var r=e.inlineTemplate?null:to(e,n,!0);return"_c("+t+","+Xi(e,n)+(r?","+r:"")+")"}(t.component,t,e);else{var r;(!t.plain||t.pre&&e.maybeComponent(t))&&(r=Xi(t,e));var a=t.inlineTemplate?null:to(t,e,!0);n="_c('"+t.tag+"'"+(r?","+r:"")+(a?","+a:"")+")"}for(var s=0;s<e.transforms.length;s++)n=e.transforms[s](t,n);return n}return to(t,e)||"void 0"}function Wi(t,e){t.staticProcessed=!0;var n=e.pre;return t.pre&&(e.pre=t.pre),e.staticRenderFns.push("with(this){return "+Vi(t,e)+"}"),e.pre=n,"_m("+(e.staticRenderFns.length-1)+(t.staticInFor?",true":"")+")"}function zi(t,e){if(t.onceProcessed=!0,t.if&&!t.ifProcessed)return Gi(t,e);if(t.staticInFor){for(var n="",r=t.parent;r;){if(r.for){n=r.key;break}r=r.parent}return n?"_o("+Vi(t,e)+","+e.onceId+++","+n+")":Vi(t,e)}return Wi(t,e)}function Gi(t,e,n,r){return t.ifProcessed=!0,Ji(t.ifConditions.slice(),e,n,r)}function Ji(t,e,n,r){if(!t.length)return r||"_e()";var a=t.shift();return a.exp?"("+a.exp+")?"+s(a.block)+":"+Ji(t,e,n,r):""+s(a.block);function s(t){return n?n(t,e):t.once?zi(t,e):Vi(t,e)}}function Ki(t,e,n,r){var a=t.for,s=t.alias,i=t.iterator1?","+t.iterator1:"",o=t.iterator2?","+t.iterator2:"";return t.forProcessed=!0,(r||"_l")+"(("+a+"),function("+s+i+o+"){return "+(n||Vi)(t,e)+"})"}function Xi(t,e){var n="{",r=function(t,e){var n=t.directives;if(!n)return;var r,a,s,i,o="directives:[",c=!1;for(r=0,a=n.length;r<a;r++){s=n[r],i=!0;var l=e.directives[s.name];l&&(i=!!l(t,s,e.warn)),i&&(c=!0,o+='{name:"'+s.name+'",rawName:"'+s.rawName+'"'+(s.value?",value:("+s.value+"),expression:"+JSON.stringify(s.value):"")+(s.arg?",arg:"+(s.isDynamicArg?s.arg:'"'+s.arg+'"'):"")+(s.modifiers?",modifiers:"+JSON.stringify(s.modifiers):"")+"},")}if(c)return o.slice(0,-1)+"]"}(t,e);r&&(n+=r+","),t.key&&(n+="key:"+t.key+","),t.ref&&(n+="ref:"+t.ref+","),t.refInFor&&(n+="refInFor:true,"),t.pre&&(n+="pre:true,"),t.component&&(n+='tag:"'+t.tag+'",');for(var a=0;a<e.dataGenFns.length;a++)n+=e.dataGenFns[a](t);if(t.attrs&&(n+="attrs:"+ro(t.attrs)+","),t.props&&(n+="domProps:"+ro(t.props)+","),t.events&&(n+=Fi(t.events,!1)+","),t.nativeEvents&&(n+=Fi(t.nativeEvents,!0)+","),t.slotTarget&&!t.slotScope&&(n+="slot:"+t.slotTarget+","),t.scopedSlots&&(n+=function(t,e,n){var r=t.for||Object.keys(e).some((function(t){var n=e[t];return n.slotTargetDynamic||n.if||n.for||Yi(n)})),a=!!t.if;if(!r)for(var s=t.parent;s;){if(s.slotScope&&s.slotScope!==fi||s.for){r=!0;break}s.if&&(a=!0),s=s.parent}var i=Object.keys(e).map((function(t){return Qi(e[t],n)})).join(",");return"scopedSlots:_u(["+i+"]"+(r?",null,true":"")+(!r&&a?",null,false,"+function(t){var e=5381,n=t.length;for(;n;)e=33*e^t.charCodeAt(--n);return e>>>0}(i):"")+")"}(t,t.scopedSlots,e)+","),t.model&&(n+="model:{value:"+t.model.value+",callback:"+t.model.callback+",expression:"+t.model.expression+"},"),t.inlineTemplate){var s=function(t,e){var n=t.children[0];0;if(n&&1===n.type){var r=qi(n,e.options);return"inlineTemplate:{render:function(){"+r.render+"},staticRenderFns:["+r.staticRenderFns.map((function(t){return"function(){"+t+"}"})).join(",")+"]}"}}(t,e);s&&(n+=s+",")}return n=n.replace(/,$/,"")+"}",t.dynamicAttrs&&(n="_b("+n+',"'+t.tag+'",'+ro(t.dynamicAttrs)+")"),t.wrapData&&(n=t.wrapData(n)),t.wrapListeners&&(n=t.wrapListeners(n)),n}function Yi(t){return 1===t.type&&("slot"===t.tag||t.children.some(Yi))}function Qi(t,e){var n=t.attrsMap["slot-scope"];if(t.if&&!t.ifProcessed&&!n)return Gi(t,e,Qi,"null");if(t.for&&!t.forProcessed)return Ki(t,e,Qi);var r=t.slotScope===fi?"":String(t.slotScope),a="function("+r+"){return "+("template"===t.tag?t.if&&n?"("+t.if+")?"+(to(t,e)||"undefined")+":undefined":to(t,e)||"undefined":Vi(t,e))+"}",s=r?"":",proxy:true";return"{key:"+(t.slotTarget||'"default"')+",fn:"+a+s+"}"}function to(t,e,n,r,a){var s=t.children;if(s.length){var i=s[0];if(1===s.length&&i.for&&"template"!==i.tag&&"slot"!==i.tag){var o=n?e.maybeComponent(i)?",1":",0":"";return""+(r||Vi)(i,e)+o}var c=n?function(t,e){for(var n=0,r=0;r<t.length;r++){var a=t[r];if(1===a.type){if(eo(a)||a.ifConditions&&a.ifConditions.some((function(t){return eo(t.block)}))){n=2;break}(e(a)||a.ifConditions&&a.ifConditions.some((function(t){return e(t.block)})))&&(n=1)}}return n}(s,e.maybeComponent):0,l=a||no;return"["+s.map((function(t){return l(t,e)})).join(",")+"]"+(c?","+c:"")}}function eo(t){return void 0!==t.for||"template"===t.tag||"slot"===t.tag}function no(t,e){return 1===t.type?Vi(t,e):3===t.type&&t.isComment?function(t){return"_e("+JSON.stringify(t.text)+")"}(t):"_v("+(2===(n=t).type?n.expression:ao(JSON.stringify(n.text)))+")";var n}function ro(t){for(var e="",n="",r=0;r<t.length;r++){var a=t[r],s=ao(a.value);a.dynamic?n+=a.name+","+s+",":e+='"'+a.name+'":'+s+","}return e="{"+e.slice(0,-1)+"}",n?"_d("+e+",["+n.slice(0,-1)+"])":e}function ao(t){return t.replace(/\u2028/g,"\\u2028").replace(/\u2029/g,"\\u2029")}new RegExp("\\b"+"do,if,for,let,new,try,var,case,else,with,await,break,catch,class,const,super,throw,while,yield,delete,export,import,return,switch,default,extends,finally,continue,debugger,function,arguments".split(",").join("\\b|\\b")+"\\b"),new RegExp("\\b"+"delete,typeof,void".split(",").join("\\s*\\([^\\)]*\\)|\\b")+"\\s*\\([^\\)]*\\)");function so(t,e){try{return new Function(t)}catch(n){return e.push({err:n,code:t}),T}}function io(t){var e=Object.create(null);return function(n,r,a){(r=O({},r)).warn;delete r.warn;var s=r.delimiters?String(r.delimiters)+n:n;if(e[s])return e[s];var i=t(n,r);var o={},c=[];return o.render=so(i.render,c),o.staticRenderFns=i.staticRenderFns.map((function(t){return so(t,c)})),e[s]=o}}var oo,co,lo=(oo=function(t,e){var n=vi(t.trim(),e);!1!==e.optimize&&Ei(n,e);var r=qi(n,e);return{ast:n,render:r.render,staticRenderFns:r.staticRenderFns}},function(t){function e(e,n){var r=Object.create(t),a=[],s=[];if(n)for(var i in n.modules&&(r.modules=(t.modules||[]).concat(n.modules)),n.directives&&(r.directives=O(Object.create(t.directives||null),n.directives)),n)"modules"!==i&&"directives"!==i&&(r[i]=n[i]);r.warn=function(t,e,n){(n?s:a).push(t)};var o=oo(e.trim(),r);return o.errors=a,o.tips=s,o}return{compile:e,compileToFunctions:io(e)}})(Ai),uo=(lo.compile,lo.compileToFunctions);function fo(t){return(co=co||document.createElement("div")).innerHTML=t?'<a href="\n"/>':'<div a="\n"/>',co.innerHTML.indexOf("&#10;")>0}var po=!!W&&fo(!1),vo=!!W&&fo(!0),ho=b((function(t){var e=ar(t);return e&&e.innerHTML})),mo=En.prototype.$mount;En.prototype.$mount=function(t,e){if((t=t&&ar(t))===document.body||t===document.documentElement)return this;var n=this.$options;if(!n.render){var r=n.template;if(r)if("string"==typeof r)"#"===r.charAt(0)&&(r=ho(r));else{if(!r.nodeType)return this;r=r.innerHTML}else t&&(r=function(t){if(t.outerHTML)return t.outerHTML;var e=document.createElement("div");return e.appendChild(t.cloneNode(!0)),e.innerHTML}(t));if(r){0;var a=uo(r,{outputSourceRange:!1,shouldDecodeNewlines:po,shouldDecodeNewlinesForHref:vo,delimiters:n.delimiters,comments:n.comments},this),s=a.render,i=a.staticRenderFns;n.render=s,n.staticRenderFns=i}}return mo.call(this,t,e)},En.compile=uo;const go=En;function _o(t,e){for(var n in e)t[n]=e[n];return t}var yo=/[!'()*]/g,bo=function(t){return"%"+t.charCodeAt(0).toString(16)},wo=/%2C/g,Co=function(t){return encodeURIComponent(t).replace(yo,bo).replace(wo,",")};function xo(t){try{return decodeURIComponent(t)}catch(t){0}return t}var $o=function(t){return null==t||"object"==typeof t?t:String(t)};function ko(t){var e={};return(t=t.trim().replace(/^(\?|#|&)/,""))?(t.split("&").forEach((function(t){var n=t.replace(/\+/g," ").split("="),r=xo(n.shift()),a=n.length>0?xo(n.join("=")):null;void 0===e[r]?e[r]=a:Array.isArray(e[r])?e[r].push(a):e[r]=[e[r],a]})),e):e}function So(t){var e=t?Object.keys(t).map((function(e){var n=t[e];if(void 0===n)return"";if(null===n)return Co(e);if(Array.isArray(n)){var r=[];return n.forEach((function(t){void 0!==t&&(null===t?r.push(Co(e)):r.push(Co(e)+"="+Co(t)))})),r.join("&")}return Co(e)+"="+Co(n)})).filter((function(t){return t.length>0})).join("&"):null;return e?"?"+e:""}var Ao=/\/?$/;function Oo(t,e,n,r){var a=r&&r.options.stringifyQuery,s=e.query||{};try{s=Eo(s)}catch(t){}var i={name:e.name||t&&t.name,meta:t&&t.meta||{},path:e.path||"/",hash:e.hash||"",query:s,params:e.params||{},fullPath:jo(e,a),matched:t?Po(t):[]};return n&&(i.redirectedFrom=jo(n,a)),Object.freeze(i)}function Eo(t){if(Array.isArray(t))return t.map(Eo);if(t&&"object"==typeof t){var e={};for(var n in t)e[n]=Eo(t[n]);return e}return t}var To=Oo(null,{path:"/"});function Po(t){for(var e=[];t;)e.unshift(t),t=t.parent;return e}function jo(t,e){var n=t.path,r=t.query;void 0===r&&(r={});var a=t.hash;return void 0===a&&(a=""),(n||"/")+(e||So)(r)+a}function No(t,e,n){return e===To?t===e:!!e&&(t.path&&e.path?t.path.replace(Ao,"")===e.path.replace(Ao,"")&&(n||t.hash===e.hash&&Bo(t.query,e.query)):!(!t.name||!e.name)&&(t.name===e.name&&(n||t.hash===e.hash&&Bo(t.query,e.query)&&Bo(t.params,e.params))))}function Bo(t,e){if(void 0===t&&(t={}),void 0===e&&(e={}),!t||!e)return t===e;var n=Object.keys(t).sort(),r=Object.keys(e).sort();return n.length===r.length&&n.every((function(n,a){var s=t[n];if(r[a]!==n)return!1;var i=e[n];return null==s||null==i?s===i:"object"==typeof s&&"object"==typeof i?Bo(s,i):String(s)===String(i)}))}function Do(t){for(var e=0;e<t.matched.length;e++){var n=t.matched[e];for(var r in n.instances){var a=n.instances[r],s=n.enteredCbs[r];if(a&&s){delete n.enteredCbs[r];for(var i=0;i<s.length;i++)a._isBeingDestroyed||s[i](a)}}}}var Mo={name:"RouterView",functional:!0,props:{name:{type:String,default:"default"}},render:function(t,e){var n=e.props,r=e.children,a=e.parent,s=e.data;s.routerView=!0;for(var i=a.$createElement,o=n.name,c=a.$route,l=a._routerViewCache||(a._routerViewCache={}),u=0,d=!1;a&&a._routerRoot!==a;){var f=a.$vnode?a.$vnode.data:{};f.routerView&&u++,f.keepAlive&&a._directInactive&&a._inactive&&(d=!0),a=a.$parent}if(s.routerViewDepth=u,d){var p=l[o],v=p&&p.component;return v?(p.configProps&&Ro(v,s,p.route,p.configProps),i(v,s,r)):i()}var h=c.matched[u],m=h&&h.components[o];if(!h||!m)return l[o]=null,i();l[o]={component:m},s.registerRouteInstance=function(t,e){var n=h.instances[o];(e&&n!==t||!e&&n===t)&&(h.instances[o]=e)},(s.hook||(s.hook={})).prepatch=function(t,e){h.instances[o]=e.componentInstance},s.hook.init=function(t){t.data.keepAlive&&t.componentInstance&&t.componentInstance!==h.instances[o]&&(h.instances[o]=t.componentInstance),Do(c)};var g=h.props&&h.props[o];return g&&(_o(l[o],{route:c,configProps:g}),Ro(m,s,c,g)),i(m,s,r)}};function Ro(t,e,n,r){var a=e.props=function(t,e){switch(typeof e){case"undefined":return;case"object":return e;case"function":return e(t);case"boolean":return e?t.params:void 0;default:0}}(n,r);if(a){a=e.props=_o({},a);var s=e.attrs=e.attrs||{};for(var i in a)t.props&&i in t.props||(s[i]=a[i],delete a[i])}}function Lo(t,e,n){var r=t.charAt(0);if("/"===r)return t;if("?"===r||"#"===r)return e+t;var a=e.split("/");n&&a[a.length-1]||a.pop();for(var s=t.replace(/^\//,"").split("/"),i=0;i<s.length;i++){var o=s[i];".."===o?a.pop():"."!==o&&a.push(o)}return""!==a[0]&&a.unshift(""),a.join("/")}function Fo(t){return t.replace(/\/\//g,"/")}var Uo=Array.isArray||function(t){return"[object Array]"==Object.prototype.toString.call(t)},Io=nc,Ho=zo,Zo=function(t,e){return Ko(zo(t,e),e)},qo=Ko,Vo=ec,Wo=new RegExp(["(\\\\.)","([\\/.])?(?:(?:\\:(\\w+)(?:\\(((?:\\\\.|[^\\\\()])+)\\))?|\\(((?:\\\\.|[^\\\\()])+)\\))([+*?])?|(\\*))"].join("|"),"g");function zo(t,e){for(var n,r=[],a=0,s=0,i="",o=e&&e.delimiter||"/";null!=(n=Wo.exec(t));){var c=n[0],l=n[1],u=n.index;if(i+=t.slice(s,u),s=u+c.length,l)i+=l[1];else{var d=t[s],f=n[2],p=n[3],v=n[4],h=n[5],m=n[6],g=n[7];i&&(r.push(i),i="");var _=null!=f&&null!=d&&d!==f,y="+"===m||"*"===m,b="?"===m||"*"===m,w=n[2]||o,C=v||h;r.push({name:p||a++,prefix:f||"",delimiter:w,optional:b,repeat:y,partial:_,asterisk:!!g,pattern:C?Yo(C):g?".*":"[^"+Xo(w)+"]+?"})}}return s<t.length&&(i+=t.substr(s)),i&&r.push(i),r}function Go(t){return encodeURI(t).replace(/[\/?#]/g,(function(t){return"%"+t.charCodeAt(0).toString(16).toUpperCase()}))}function Jo(t){return encodeURI(t).replace(/[?#]/g,(function(t){return"%"+t.charCodeAt(0).toString(16).toUpperCase()}))}function Ko(t,e){for(var n=new Array(t.length),r=0;r<t.length;r++)"object"==typeof t[r]&&(n[r]=new RegExp("^(?:"+t[r].pattern+")$",tc(e)));return function(e,r){for(var a="",s=e||{},i=(r||{}).pretty?Go:encodeURIComponent,o=0;o<t.length;o++){var c=t[o];if("string"!=typeof c){var l,u=s[c.name];if(null==u){if(c.optional){c.partial&&(a+=c.prefix);continue}throw new TypeError('Expected "'+c.name+'" to be defined')}if(Uo(u)){if(!c.repeat)throw new TypeError('Expected "'+c.name+'" to not repeat, but received `'+JSON.stringify(u)+"`");if(0===u.length){if(c.optional)continue;throw new TypeError('Expected "'+c.name+'" to not be empty')}for(var d=0;d<u.length;d++){if(l=i(u[d]),!n[o].test(l))throw new TypeError('Expected all "'+c.name+'" to match "'+c.pattern+'", but received `'+JSON.stringify(l)+"`");a+=(0===d?c.prefix:c.delimiter)+l}}else{if(l=c.asterisk?Jo(u):i(u),!n[o].test(l))throw new TypeError('Expected "'+c.name+'" to match "'+c.pattern+'", but received "'+l+'"');a+=c.prefix+l}}else a+=c}return a}}function Xo(t){return t.replace(/([.+*?=^!:${}()[\]|\/\\])/g,"\\$1")}function Yo(t){return t.replace(/([=!:$\/()])/g,"\\$1")}function Qo(t,e){return t.keys=e,t}function tc(t){return t&&t.sensitive?"":"i"}function ec(t,e,n){Uo(e)||(n=e||n,e=[]);for(var r=(n=n||{}).strict,a=!1!==n.end,s="",i=0;i<t.length;i++){var o=t[i];if("string"==typeof o)s+=Xo(o);else{var c=Xo(o.prefix),l="(?:"+o.pattern+")";e.push(o),o.repeat&&(l+="(?:"+c+l+")*"),s+=l=o.optional?o.partial?c+"("+l+")?":"(?:"+c+"("+l+"))?":c+"("+l+")"}}var u=Xo(n.delimiter||"/"),d=s.slice(-u.length)===u;return r||(s=(d?s.slice(0,-u.length):s)+"(?:"+u+"(?=$))?"),s+=a?"$":r&&d?"":"(?="+u+"|$)",Qo(new RegExp("^"+s,tc(n)),e)}function nc(t,e,n){return Uo(e)||(n=e||n,e=[]),n=n||{},t instanceof RegExp?function(t,e){var n=t.source.match(/\((?!\?)/g);if(n)for(var r=0;r<n.length;r++)e.push({name:r,prefix:null,delimiter:null,optional:!1,repeat:!1,partial:!1,asterisk:!1,pattern:null});return Qo(t,e)}(t,e):Uo(t)?function(t,e,n){for(var r=[],a=0;a<t.length;a++)r.push(nc(t[a],e,n).source);return Qo(new RegExp("(?:"+r.join("|")+")",tc(n)),e)}(t,e,n):function(t,e,n){return ec(zo(t,n),e,n)}(t,e,n)}Io.parse=Ho,Io.compile=Zo,Io.tokensToFunction=qo,Io.tokensToRegExp=Vo;var rc=Object.create(null);function ac(t,e,n){e=e||{};try{var r=rc[t]||(rc[t]=Io.compile(t));return"string"==typeof e.pathMatch&&(e[0]=e.pathMatch),r(e,{pretty:!0})}catch(t){return""}finally{delete e[0]}}function sc(t,e,n,r){var a="string"==typeof t?{path:t}:t;if(a._normalized)return a;if(a.name){var s=(a=_o({},t)).params;return s&&"object"==typeof s&&(a.params=_o({},s)),a}if(!a.path&&a.params&&e){(a=_o({},a))._normalized=!0;var i=_o(_o({},e.params),a.params);if(e.name)a.name=e.name,a.params=i;else if(e.matched.length){var o=e.matched[e.matched.length-1].path;a.path=ac(o,i,e.path)}else 0;return a}var c=function(t){var e="",n="",r=t.indexOf("#");r>=0&&(e=t.slice(r),t=t.slice(0,r));var a=t.indexOf("?");return a>=0&&(n=t.slice(a+1),t=t.slice(0,a)),{path:t,query:n,hash:e}}(a.path||""),l=e&&e.path||"/",u=c.path?Lo(c.path,l,n||a.append):l,d=function(t,e,n){void 0===e&&(e={});var r,a=n||ko;try{r=a(t||"")}catch(t){r={}}for(var s in e){var i=e[s];r[s]=Array.isArray(i)?i.map($o):$o(i)}return r}(c.query,a.query,r&&r.options.parseQuery),f=a.hash||c.hash;return f&&"#"!==f.charAt(0)&&(f="#"+f),{_normalized:!0,path:u,query:d,hash:f}}var ic,oc=function(){},cc={name:"RouterLink",props:{to:{type:[String,Object],required:!0},tag:{type:String,default:"a"},custom:Boolean,exact:Boolean,exactPath:Boolean,append:Boolean,replace:Boolean,activeClass:String,exactActiveClass:String,ariaCurrentValue:{type:String,default:"page"},event:{type:[String,Array],default:"click"}},render:function(t){var e=this,n=this.$router,r=this.$route,a=n.resolve(this.to,r,this.append),s=a.location,i=a.route,o=a.href,c={},l=n.options.linkActiveClass,u=n.options.linkExactActiveClass,d=null==l?"router-link-active":l,f=null==u?"router-link-exact-active":u,p=null==this.activeClass?d:this.activeClass,v=null==this.exactActiveClass?f:this.exactActiveClass,h=i.redirectedFrom?Oo(null,sc(i.redirectedFrom),null,n):i;c[v]=No(r,h,this.exactPath),c[p]=this.exact||this.exactPath?c[v]:function(t,e){return 0===t.path.replace(Ao,"/").indexOf(e.path.replace(Ao,"/"))&&(!e.hash||t.hash===e.hash)&&function(t,e){for(var n in e)if(!(n in t))return!1;return!0}(t.query,e.query)}(r,h);var m=c[v]?this.ariaCurrentValue:null,g=function(t){lc(t)&&(e.replace?n.replace(s,oc):n.push(s,oc))},_={click:lc};Array.isArray(this.event)?this.event.forEach((function(t){_[t]=g})):_[this.event]=g;var y={class:c},b=!this.$scopedSlots.$hasNormal&&this.$scopedSlots.default&&this.$scopedSlots.default({href:o,route:i,navigate:g,isActive:c[p],isExactActive:c[v]});if(b){if(1===b.length)return b[0];if(b.length>1||!b.length)return 0===b.length?t():t("span",{},b)}if("a"===this.tag)y.on=_,y.attrs={href:o,"aria-current":m};else{var w=uc(this.$slots.default);if(w){w.isStatic=!1;var C=w.data=_o({},w.data);for(var x in C.on=C.on||{},C.on){var $=C.on[x];x in _&&(C.on[x]=Array.isArray($)?$:[$])}for(var k in _)k in C.on?C.on[k].push(_[k]):C.on[k]=g;var S=w.data.attrs=_o({},w.data.attrs);S.href=o,S["aria-current"]=m}else y.on=_}return t(this.tag,y,this.$slots.default)}};function lc(t){if(!(t.metaKey||t.altKey||t.ctrlKey||t.shiftKey||t.defaultPrevented||void 0!==t.button&&0!==t.button)){if(t.currentTarget&&t.currentTarget.getAttribute){var e=t.currentTarget.getAttribute("target");if(/\b_blank\b/i.test(e))return}return t.preventDefault&&t.preventDefault(),!0}}function uc(t){if(t)for(var e,n=0;n<t.length;n++){if("a"===(e=t[n]).tag)return e;if(e.children&&(e=uc(e.children)))return e}}var dc="undefined"!=typeof window;function fc(t,e,n,r,a){var s=e||[],i=n||Object.create(null),o=r||Object.create(null);t.forEach((function(t){pc(s,i,o,t,a)}));for(var c=0,l=s.length;c<l;c++)"*"===s[c]&&(s.push(s.splice(c,1)[0]),l--,c--);return{pathList:s,pathMap:i,nameMap:o}}function pc(t,e,n,r,a,s){var i=r.path,o=r.name;var c=r.pathToRegexpOptions||{},l=function(t,e,n){n||(t=t.replace(/\/$/,""));if("/"===t[0])return t;if(null==e)return t;return Fo(e.path+"/"+t)}(i,a,c.strict);"boolean"==typeof r.caseSensitive&&(c.sensitive=r.caseSensitive);var u={path:l,regex:vc(l,c),components:r.components||{default:r.component},alias:r.alias?"string"==typeof r.alias?[r.alias]:r.alias:[],instances:{},enteredCbs:{},name:o,parent:a,matchAs:s,redirect:r.redirect,beforeEnter:r.beforeEnter,meta:r.meta||{},props:null==r.props?{}:r.components?r.props:{default:r.props}};if(r.children&&r.children.forEach((function(r){var a=s?Fo(s+"/"+r.path):void 0;pc(t,e,n,r,u,a)})),e[u.path]||(t.push(u.path),e[u.path]=u),void 0!==r.alias)for(var d=Array.isArray(r.alias)?r.alias:[r.alias],f=0;f<d.length;++f){0;var p={path:d[f],children:r.children};pc(t,e,n,p,a,u.path||"/")}o&&(n[o]||(n[o]=u))}function vc(t,e){return Io(t,[],e)}function hc(t,e){var n=fc(t),r=n.pathList,a=n.pathMap,s=n.nameMap;function i(t,n,i){var o=sc(t,n,!1,e),l=o.name;if(l){var u=s[l];if(!u)return c(null,o);var d=u.regex.keys.filter((function(t){return!t.optional})).map((function(t){return t.name}));if("object"!=typeof o.params&&(o.params={}),n&&"object"==typeof n.params)for(var f in n.params)!(f in o.params)&&d.indexOf(f)>-1&&(o.params[f]=n.params[f]);return o.path=ac(u.path,o.params),c(u,o,i)}if(o.path){o.params={};for(var p=0;p<r.length;p++){var v=r[p],h=a[v];if(mc(h.regex,o.path,o.params))return c(h,o,i)}}return c(null,o)}function o(t,n){var r=t.redirect,a="function"==typeof r?r(Oo(t,n,null,e)):r;if("string"==typeof a&&(a={path:a}),!a||"object"!=typeof a)return c(null,n);var o=a,l=o.name,u=o.path,d=n.query,f=n.hash,p=n.params;if(d=o.hasOwnProperty("query")?o.query:d,f=o.hasOwnProperty("hash")?o.hash:f,p=o.hasOwnProperty("params")?o.params:p,l){s[l];return i({_normalized:!0,name:l,query:d,hash:f,params:p},void 0,n)}if(u){var v=function(t,e){return Lo(t,e.parent?e.parent.path:"/",!0)}(u,t);return i({_normalized:!0,path:ac(v,p),query:d,hash:f},void 0,n)}return c(null,n)}function c(t,n,r){return t&&t.redirect?o(t,r||n):t&&t.matchAs?function(t,e,n){var r=i({_normalized:!0,path:ac(n,e.params)});if(r){var a=r.matched,s=a[a.length-1];return e.params=r.params,c(s,e)}return c(null,e)}(0,n,t.matchAs):Oo(t,n,r,e)}return{match:i,addRoute:function(t,e){var n="object"!=typeof t?s[t]:void 0;fc([e||t],r,a,s,n),n&&n.alias.length&&fc(n.alias.map((function(t){return{path:t,children:[e]}})),r,a,s,n)},getRoutes:function(){return r.map((function(t){return a[t]}))},addRoutes:function(t){fc(t,r,a,s)}}}function mc(t,e,n){var r=e.match(t);if(!r)return!1;if(!n)return!0;for(var a=1,s=r.length;a<s;++a){var i=t.keys[a-1];i&&(n[i.name||"pathMatch"]="string"==typeof r[a]?xo(r[a]):r[a])}return!0}var gc=dc&&window.performance&&window.performance.now?window.performance:Date;function _c(){return gc.now().toFixed(3)}var yc=_c();function bc(){return yc}function wc(t){return yc=t}var Cc=Object.create(null);function xc(){"scrollRestoration"in window.history&&(window.history.scrollRestoration="manual");var t=window.location.protocol+"//"+window.location.host,e=window.location.href.replace(t,""),n=_o({},window.history.state);return n.key=bc(),window.history.replaceState(n,"",e),window.addEventListener("popstate",Sc),function(){window.removeEventListener("popstate",Sc)}}function $c(t,e,n,r){if(t.app){var a=t.options.scrollBehavior;a&&t.app.$nextTick((function(){var s=function(){var t=bc();if(t)return Cc[t]}(),i=a.call(t,e,n,r?s:null);i&&("function"==typeof i.then?i.then((function(t){Pc(t,s)})).catch((function(t){0})):Pc(i,s))}))}}function kc(){var t=bc();t&&(Cc[t]={x:window.pageXOffset,y:window.pageYOffset})}function Sc(t){kc(),t.state&&t.state.key&&wc(t.state.key)}function Ac(t){return Ec(t.x)||Ec(t.y)}function Oc(t){return{x:Ec(t.x)?t.x:window.pageXOffset,y:Ec(t.y)?t.y:window.pageYOffset}}function Ec(t){return"number"==typeof t}var Tc=/^#\d/;function Pc(t,e){var n,r="object"==typeof t;if(r&&"string"==typeof t.selector){var a=Tc.test(t.selector)?document.getElementById(t.selector.slice(1)):document.querySelector(t.selector);if(a){var s=t.offset&&"object"==typeof t.offset?t.offset:{};e=function(t,e){var n=document.documentElement.getBoundingClientRect(),r=t.getBoundingClientRect();return{x:r.left-n.left-e.x,y:r.top-n.top-e.y}}(a,s={x:Ec((n=s).x)?n.x:0,y:Ec(n.y)?n.y:0})}else Ac(t)&&(e=Oc(t))}else r&&Ac(t)&&(e=Oc(t));e&&("scrollBehavior"in document.documentElement.style?window.scrollTo({left:e.x,top:e.y,behavior:t.behavior}):window.scrollTo(e.x,e.y))}var jc,Nc=dc&&((-1===(jc=window.navigator.userAgent).indexOf("Android 2.")&&-1===jc.indexOf("Android 4.0")||-1===jc.indexOf("Mobile Safari")||-1!==jc.indexOf("Chrome")||-1!==jc.indexOf("Windows Phone"))&&window.history&&"function"==typeof window.history.pushState);function Bc(t,e){kc();var n=window.history;try{if(e){var r=_o({},n.state);r.key=bc(),n.replaceState(r,"",t)}else n.pushState({key:wc(_c())},"",t)}catch(n){window.location[e?"replace":"assign"](t)}}function Dc(t){Bc(t,!0)}function Mc(t,e,n){var r=function(a){a>=t.length?n():t[a]?e(t[a],(function(){r(a+1)})):r(a+1)};r(0)}var Rc={redirected:2,aborted:4,cancelled:8,duplicated:16};function Lc(t,e){return Uc(t,e,Rc.redirected,'Redirected when going from "'+t.fullPath+'" to "'+function(t){if("string"==typeof t)return t;if("path"in t)return t.path;var e={};return Ic.forEach((function(n){n in t&&(e[n]=t[n])})),JSON.stringify(e,null,2)}(e)+'" via a navigation guard.')}function Fc(t,e){return Uc(t,e,Rc.cancelled,'Navigation cancelled from "'+t.fullPath+'" to "'+e.fullPath+'" with a new navigation.')}function Uc(t,e,n,r){var a=new Error(r);return a._isRouter=!0,a.from=t,a.to=e,a.type=n,a}var Ic=["params","query","hash"];function Hc(t){return Object.prototype.toString.call(t).indexOf("Error")>-1}function Zc(t,e){return Hc(t)&&t._isRouter&&(null==e||t.type===e)}function qc(t){return function(e,n,r){var a=!1,s=0,i=null;Vc(t,(function(t,e,n,o){if("function"==typeof t&&void 0===t.cid){a=!0,s++;var c,l=Gc((function(e){var a;((a=e).__esModule||zc&&"Module"===a[Symbol.toStringTag])&&(e=e.default),t.resolved="function"==typeof e?e:ic.extend(e),n.components[o]=e,--s<=0&&r()})),u=Gc((function(t){var e="Failed to resolve async component "+o+": "+t;i||(i=Hc(t)?t:new Error(e),r(i))}));try{c=t(l,u)}catch(t){u(t)}if(c)if("function"==typeof c.then)c.then(l,u);else{var d=c.component;d&&"function"==typeof d.then&&d.then(l,u)}}})),a||r()}}function Vc(t,e){return Wc(t.map((function(t){return Object.keys(t.components).map((function(n){return e(t.components[n],t.instances[n],t,n)}))})))}function Wc(t){return Array.prototype.concat.apply([],t)}var zc="function"==typeof Symbol&&"symbol"==typeof Symbol.toStringTag;function Gc(t){var e=!1;return function(){for(var n=[],r=arguments.length;r--;)n[r]=arguments[r];if(!e)return e=!0,t.apply(this,n)}}var Jc=function(t,e){this.router=t,this.base=function(t){if(!t)if(dc){var e=document.querySelector("base");t=(t=e&&e.getAttribute("href")||"/").replace(/^https?:\/\/[^\/]+/,"")}else t="/";"/"!==t.charAt(0)&&(t="/"+t);return t.replace(/\/$/,"")}(e),this.current=To,this.pending=null,this.ready=!1,this.readyCbs=[],this.readyErrorCbs=[],this.errorCbs=[],this.listeners=[]};function Kc(t,e,n,r){var a=Vc(t,(function(t,r,a,s){var i=function(t,e){"function"!=typeof t&&(t=ic.extend(t));return t.options[e]}(t,e);if(i)return Array.isArray(i)?i.map((function(t){return n(t,r,a,s)})):n(i,r,a,s)}));return Wc(r?a.reverse():a)}function Xc(t,e){if(e)return function(){return t.apply(e,arguments)}}Jc.prototype.listen=function(t){this.cb=t},Jc.prototype.onReady=function(t,e){this.ready?t():(this.readyCbs.push(t),e&&this.readyErrorCbs.push(e))},Jc.prototype.onError=function(t){this.errorCbs.push(t)},Jc.prototype.transitionTo=function(t,e,n){var r,a=this;try{r=this.router.match(t,this.current)}catch(t){throw this.errorCbs.forEach((function(e){e(t)})),t}var s=this.current;this.confirmTransition(r,(function(){a.updateRoute(r),e&&e(r),a.ensureURL(),a.router.afterHooks.forEach((function(t){t&&t(r,s)})),a.ready||(a.ready=!0,a.readyCbs.forEach((function(t){t(r)})))}),(function(t){n&&n(t),t&&!a.ready&&(Zc(t,Rc.redirected)&&s===To||(a.ready=!0,a.readyErrorCbs.forEach((function(e){e(t)}))))}))},Jc.prototype.confirmTransition=function(t,e,n){var r=this,a=this.current;this.pending=t;var s,i,o=function(t){!Zc(t)&&Hc(t)&&(r.errorCbs.length?r.errorCbs.forEach((function(e){e(t)})):console.error(t)),n&&n(t)},c=t.matched.length-1,l=a.matched.length-1;if(No(t,a)&&c===l&&t.matched[c]===a.matched[l])return this.ensureURL(),o(((i=Uc(s=a,t,Rc.duplicated,'Avoided redundant navigation to current location: "'+s.fullPath+'".')).name="NavigationDuplicated",i));var u=function(t,e){var n,r=Math.max(t.length,e.length);for(n=0;n<r&&t[n]===e[n];n++);return{updated:e.slice(0,n),activated:e.slice(n),deactivated:t.slice(n)}}(this.current.matched,t.matched),d=u.updated,f=u.deactivated,p=u.activated,v=[].concat(function(t){return Kc(t,"beforeRouteLeave",Xc,!0)}(f),this.router.beforeHooks,function(t){return Kc(t,"beforeRouteUpdate",Xc)}(d),p.map((function(t){return t.beforeEnter})),qc(p)),h=function(e,n){if(r.pending!==t)return o(Fc(a,t));try{e(t,a,(function(e){!1===e?(r.ensureURL(!0),o(function(t,e){return Uc(t,e,Rc.aborted,'Navigation aborted from "'+t.fullPath+'" to "'+e.fullPath+'" via a navigation guard.')}(a,t))):Hc(e)?(r.ensureURL(!0),o(e)):"string"==typeof e||"object"==typeof e&&("string"==typeof e.path||"string"==typeof e.name)?(o(Lc(a,t)),"object"==typeof e&&e.replace?r.replace(e):r.push(e)):n(e)}))}catch(t){o(t)}};Mc(v,h,(function(){Mc(function(t){return Kc(t,"beforeRouteEnter",(function(t,e,n,r){return function(t,e,n){return function(r,a,s){return t(r,a,(function(t){"function"==typeof t&&(e.enteredCbs[n]||(e.enteredCbs[n]=[]),e.enteredCbs[n].push(t)),s(t)}))}}(t,n,r)}))}(p).concat(r.router.resolveHooks),h,(function(){if(r.pending!==t)return o(Fc(a,t));r.pending=null,e(t),r.router.app&&r.router.app.$nextTick((function(){Do(t)}))}))}))},Jc.prototype.updateRoute=function(t){this.current=t,this.cb&&this.cb(t)},Jc.prototype.setupListeners=function(){},Jc.prototype.teardown=function(){this.listeners.forEach((function(t){t()})),this.listeners=[],this.current=To,this.pending=null};var Yc=function(t){function e(e,n){t.call(this,e,n),this._startLocation=Qc(this.base)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.setupListeners=function(){var t=this;if(!(this.listeners.length>0)){var e=this.router,n=e.options.scrollBehavior,r=Nc&&n;r&&this.listeners.push(xc());var a=function(){var n=t.current,a=Qc(t.base);t.current===To&&a===t._startLocation||t.transitionTo(a,(function(t){r&&$c(e,t,n,!0)}))};window.addEventListener("popstate",a),this.listeners.push((function(){window.removeEventListener("popstate",a)}))}},e.prototype.go=function(t){window.history.go(t)},e.prototype.push=function(t,e,n){var r=this,a=this.current;this.transitionTo(t,(function(t){Bc(Fo(r.base+t.fullPath)),$c(r.router,t,a,!1),e&&e(t)}),n)},e.prototype.replace=function(t,e,n){var r=this,a=this.current;this.transitionTo(t,(function(t){Dc(Fo(r.base+t.fullPath)),$c(r.router,t,a,!1),e&&e(t)}),n)},e.prototype.ensureURL=function(t){if(Qc(this.base)!==this.current.fullPath){var e=Fo(this.base+this.current.fullPath);t?Bc(e):Dc(e)}},e.prototype.getCurrentLocation=function(){return Qc(this.base)},e}(Jc);function Qc(t){var e=window.location.pathname,n=e.toLowerCase(),r=t.toLowerCase();return!t||n!==r&&0!==n.indexOf(Fo(r+"/"))||(e=e.slice(t.length)),(e||"/")+window.location.search+window.location.hash}var tl=function(t){function e(e,n,r){t.call(this,e,n),r&&function(t){var e=Qc(t);if(!/^\/#/.test(e))return window.location.replace(Fo(t+"/#"+e)),!0}(this.base)||el()}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.setupListeners=function(){var t=this;if(!(this.listeners.length>0)){var e=this.router.options.scrollBehavior,n=Nc&&e;n&&this.listeners.push(xc());var r=function(){var e=t.current;el()&&t.transitionTo(nl(),(function(r){n&&$c(t.router,r,e,!0),Nc||sl(r.fullPath)}))},a=Nc?"popstate":"hashchange";window.addEventListener(a,r),this.listeners.push((function(){window.removeEventListener(a,r)}))}},e.prototype.push=function(t,e,n){var r=this,a=this.current;this.transitionTo(t,(function(t){al(t.fullPath),$c(r.router,t,a,!1),e&&e(t)}),n)},e.prototype.replace=function(t,e,n){var r=this,a=this.current;this.transitionTo(t,(function(t){sl(t.fullPath),$c(r.router,t,a,!1),e&&e(t)}),n)},e.prototype.go=function(t){window.history.go(t)},e.prototype.ensureURL=function(t){var e=this.current.fullPath;nl()!==e&&(t?al(e):sl(e))},e.prototype.getCurrentLocation=function(){return nl()},e}(Jc);function el(){var t=nl();return"/"===t.charAt(0)||(sl("/"+t),!1)}function nl(){var t=window.location.href,e=t.indexOf("#");return e<0?"":t=t.slice(e+1)}function rl(t){var e=window.location.href,n=e.indexOf("#");return(n>=0?e.slice(0,n):e)+"#"+t}function al(t){Nc?Bc(rl(t)):window.location.hash=t}function sl(t){Nc?Dc(rl(t)):window.location.replace(rl(t))}var il=function(t){function e(e,n){t.call(this,e,n),this.stack=[],this.index=-1}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.push=function(t,e,n){var r=this;this.transitionTo(t,(function(t){r.stack=r.stack.slice(0,r.index+1).concat(t),r.index++,e&&e(t)}),n)},e.prototype.replace=function(t,e,n){var r=this;this.transitionTo(t,(function(t){r.stack=r.stack.slice(0,r.index).concat(t),e&&e(t)}),n)},e.prototype.go=function(t){var e=this,n=this.index+t;if(!(n<0||n>=this.stack.length)){var r=this.stack[n];this.confirmTransition(r,(function(){var t=e.current;e.index=n,e.updateRoute(r),e.router.afterHooks.forEach((function(e){e&&e(r,t)}))}),(function(t){Zc(t,Rc.duplicated)&&(e.index=n)}))}},e.prototype.getCurrentLocation=function(){var t=this.stack[this.stack.length-1];return t?t.fullPath:"/"},e.prototype.ensureURL=function(){},e}(Jc),ol=function(t){void 0===t&&(t={}),this.app=null,this.apps=[],this.options=t,this.beforeHooks=[],this.resolveHooks=[],this.afterHooks=[],this.matcher=hc(t.routes||[],this);var e=t.mode||"hash";switch(this.fallback="history"===e&&!Nc&&!1!==t.fallback,this.fallback&&(e="hash"),dc||(e="abstract"),this.mode=e,e){case"history":this.history=new Yc(this,t.base);break;case"hash":this.history=new tl(this,t.base,this.fallback);break;case"abstract":this.history=new il(this,t.base);break;default:0}},cl={currentRoute:{configurable:!0}};function ll(t,e){return t.push(e),function(){var n=t.indexOf(e);n>-1&&t.splice(n,1)}}ol.prototype.match=function(t,e,n){return this.matcher.match(t,e,n)},cl.currentRoute.get=function(){return this.history&&this.history.current},ol.prototype.init=function(t){var e=this;if(this.apps.push(t),t.$once("hook:destroyed",(function(){var n=e.apps.indexOf(t);n>-1&&e.apps.splice(n,1),e.app===t&&(e.app=e.apps[0]||null),e.app||e.history.teardown()})),!this.app){this.app=t;var n=this.history;if(n instanceof Yc||n instanceof tl){var r=function(t){n.setupListeners(),function(t){var r=n.current,a=e.options.scrollBehavior;Nc&&a&&"fullPath"in t&&$c(e,t,r,!1)}(t)};n.transitionTo(n.getCurrentLocation(),r,r)}n.listen((function(t){e.apps.forEach((function(e){e._route=t}))}))}},ol.prototype.beforeEach=function(t){return ll(this.beforeHooks,t)},ol.prototype.beforeResolve=function(t){return ll(this.resolveHooks,t)},ol.prototype.afterEach=function(t){return ll(this.afterHooks,t)},ol.prototype.onReady=function(t,e){this.history.onReady(t,e)},ol.prototype.onError=function(t){this.history.onError(t)},ol.prototype.push=function(t,e,n){var r=this;if(!e&&!n&&"undefined"!=typeof Promise)return new Promise((function(e,n){r.history.push(t,e,n)}));this.history.push(t,e,n)},ol.prototype.replace=function(t,e,n){var r=this;if(!e&&!n&&"undefined"!=typeof Promise)return new Promise((function(e,n){r.history.replace(t,e,n)}));this.history.replace(t,e,n)},ol.prototype.go=function(t){this.history.go(t)},ol.prototype.back=function(){this.go(-1)},ol.prototype.forward=function(){this.go(1)},ol.prototype.getMatchedComponents=function(t){var e=t?t.matched?t:this.resolve(t).route:this.currentRoute;return e?[].concat.apply([],e.matched.map((function(t){return Object.keys(t.components).map((function(e){return t.components[e]}))}))):[]},ol.prototype.resolve=function(t,e,n){var r=sc(t,e=e||this.history.current,n,this),a=this.match(r,e),s=a.redirectedFrom||a.fullPath;return{location:r,route:a,href:function(t,e,n){var r="hash"===n?"#"+e:e;return t?Fo(t+"/"+r):r}(this.history.base,s,this.mode),normalizedTo:r,resolved:a}},ol.prototype.getRoutes=function(){return this.matcher.getRoutes()},ol.prototype.addRoute=function(t,e){this.matcher.addRoute(t,e),this.history.current!==To&&this.history.transitionTo(this.history.getCurrentLocation())},ol.prototype.addRoutes=function(t){this.matcher.addRoutes(t),this.history.current!==To&&this.history.transitionTo(this.history.getCurrentLocation())},Object.defineProperties(ol.prototype,cl),ol.install=function t(e){if(!t.installed||ic!==e){t.installed=!0,ic=e;var n=function(t){return void 0!==t},r=function(t,e){var r=t.$options._parentVnode;n(r)&&n(r=r.data)&&n(r=r.registerRouteInstance)&&r(t,e)};e.mixin({beforeCreate:function(){n(this.$options.router)?(this._routerRoot=this,this._router=this.$options.router,this._router.init(this),e.util.defineReactive(this,"_route",this._router.history.current)):this._routerRoot=this.$parent&&this.$parent._routerRoot||this,r(this,this)},destroyed:function(){r(this)}}),Object.defineProperty(e.prototype,"$router",{get:function(){return this._routerRoot._router}}),Object.defineProperty(e.prototype,"$route",{get:function(){return this._routerRoot._route}}),e.component("RouterView",Mo),e.component("RouterLink",cc);var a=e.config.optionMergeStrategies;a.beforeRouteEnter=a.beforeRouteLeave=a.beforeRouteUpdate=a.created}},ol.version="3.5.2",ol.isNavigationFailure=Zc,ol.NavigationFailureType=Rc,ol.START_LOCATION=To,dc&&window.Vue&&window.Vue.use(ol);const ul=ol;var dl=n(7398),fl=n.n(dl),pl=n(3379),vl=n.n(pl),hl=n(6369),ml={insert:"head",singleton:!1};vl()(hl.Z,ml);hl.Z.locals;var gl=[{path:"/",name:"dashboard",component:n(7479).Z,meta:{title:"Dashboard Home"}},{path:"/websites",name:"websites",component:n(8629).Z,meta:{title:"Manage Websites"}},{path:"/websites/:id",name:"website",component:n(8959).Z,meta:{title:"Manage Website"}},{path:"/websites/:id/files",name:"filemanager",component:n(162).Z,meta:{title:"Manage Website Files"}},{path:"/deploy-website",name:"deploysite",component:n(1001).Z,meta:{title:"Create Website"}},{path:"/databases",name:"databases",component:n(3798).Z,meta:{title:"Manage Databases"}},{path:"/databases/:id",name:"database",component:n(9263).Z,meta:{title:"Manage Database"}},{path:"/create-database",name:"createdb",component:n(2858).Z,meta:{title:"Create Database"}},{path:"/users",name:"users",component:n(1220).Z,meta:{title:"Manage Users"}},{path:"/users/:id",name:"user",component:n(5002).Z,meta:{title:"Manage User"}},{path:"/create-user",name:"createuser",component:n(5650).Z,meta:{title:"Create User"}},{path:"/harware-info",name:"hardware",component:n(9062).Z,meta:{title:"Hardware Info"}}];var _l=("undefined"!=typeof window?window:void 0!==n.g?n.g:{}).__VUE_DEVTOOLS_GLOBAL_HOOK__;function yl(t,e){if(void 0===e&&(e=[]),null===t||"object"!=typeof t)return t;var n,r=(n=function(e){return e.original===t},e.filter(n)[0]);if(r)return r.copy;var a=Array.isArray(t)?[]:{};return e.push({original:t,copy:a}),Object.keys(t).forEach((function(n){a[n]=yl(t[n],e)})),a}function bl(t,e){Object.keys(t).forEach((function(n){return e(t[n],n)}))}function wl(t){return null!==t&&"object"==typeof t}var Cl=function(t,e){this.runtime=e,this._children=Object.create(null),this._rawModule=t;var n=t.state;this.state=("function"==typeof n?n():n)||{}},xl={namespaced:{configurable:!0}};xl.namespaced.get=function(){return!!this._rawModule.namespaced},Cl.prototype.addChild=function(t,e){this._children[t]=e},Cl.prototype.removeChild=function(t){delete this._children[t]},Cl.prototype.getChild=function(t){return this._children[t]},Cl.prototype.hasChild=function(t){return t in this._children},Cl.prototype.update=function(t){this._rawModule.namespaced=t.namespaced,t.actions&&(this._rawModule.actions=t.actions),t.mutations&&(this._rawModule.mutations=t.mutations),t.getters&&(this._rawModule.getters=t.getters)},Cl.prototype.forEachChild=function(t){bl(this._children,t)},Cl.prototype.forEachGetter=function(t){this._rawModule.getters&&bl(this._rawModule.getters,t)},Cl.prototype.forEachAction=function(t){this._rawModule.actions&&bl(this._rawModule.actions,t)},Cl.prototype.forEachMutation=function(t){this._rawModule.mutations&&bl(this._rawModule.mutations,t)},Object.defineProperties(Cl.prototype,xl);var $l=function(t){this.register([],t,!1)};function kl(t,e,n){if(e.update(n),n.modules)for(var r in n.modules){if(!e.getChild(r))return void 0;kl(t.concat(r),e.getChild(r),n.modules[r])}}$l.prototype.get=function(t){return t.reduce((function(t,e){return t.getChild(e)}),this.root)},$l.prototype.getNamespace=function(t){var e=this.root;return t.reduce((function(t,n){return t+((e=e.getChild(n)).namespaced?n+"/":"")}),"")},$l.prototype.update=function(t){kl([],this.root,t)},$l.prototype.register=function(t,e,n){var r=this;void 0===n&&(n=!0);var a=new Cl(e,n);0===t.length?this.root=a:this.get(t.slice(0,-1)).addChild(t[t.length-1],a);e.modules&&bl(e.modules,(function(e,a){r.register(t.concat(a),e,n)}))},$l.prototype.unregister=function(t){var e=this.get(t.slice(0,-1)),n=t[t.length-1],r=e.getChild(n);r&&r.runtime&&e.removeChild(n)},$l.prototype.isRegistered=function(t){var e=this.get(t.slice(0,-1)),n=t[t.length-1];return!!e&&e.hasChild(n)};var Sl;var Al=function(t){var e=this;void 0===t&&(t={}),!Sl&&"undefined"!=typeof window&&window.Vue&&Dl(window.Vue);var n=t.plugins;void 0===n&&(n=[]);var r=t.strict;void 0===r&&(r=!1),this._committing=!1,this._actions=Object.create(null),this._actionSubscribers=[],this._mutations=Object.create(null),this._wrappedGetters=Object.create(null),this._modules=new $l(t),this._modulesNamespaceMap=Object.create(null),this._subscribers=[],this._watcherVM=new Sl,this._makeLocalGettersCache=Object.create(null);var a=this,s=this.dispatch,i=this.commit;this.dispatch=function(t,e){return s.call(a,t,e)},this.commit=function(t,e,n){return i.call(a,t,e,n)},this.strict=r;var o=this._modules.root.state;jl(this,o,[],this._modules.root),Pl(this,o),n.forEach((function(t){return t(e)})),(void 0!==t.devtools?t.devtools:Sl.config.devtools)&&function(t){_l&&(t._devtoolHook=_l,_l.emit("vuex:init",t),_l.on("vuex:travel-to-state",(function(e){t.replaceState(e)})),t.subscribe((function(t,e){_l.emit("vuex:mutation",t,e)}),{prepend:!0}),t.subscribeAction((function(t,e){_l.emit("vuex:action",t,e)}),{prepend:!0}))}(this)},Ol={state:{configurable:!0}};function El(t,e,n){return e.indexOf(t)<0&&(n&&n.prepend?e.unshift(t):e.push(t)),function(){var n=e.indexOf(t);n>-1&&e.splice(n,1)}}function Tl(t,e){t._actions=Object.create(null),t._mutations=Object.create(null),t._wrappedGetters=Object.create(null),t._modulesNamespaceMap=Object.create(null);var n=t.state;jl(t,n,[],t._modules.root,!0),Pl(t,n,e)}function Pl(t,e,n){var r=t._vm;t.getters={},t._makeLocalGettersCache=Object.create(null);var a=t._wrappedGetters,s={};bl(a,(function(e,n){s[n]=function(t,e){return function(){return t(e)}}(e,t),Object.defineProperty(t.getters,n,{get:function(){return t._vm[n]},enumerable:!0})}));var i=Sl.config.silent;Sl.config.silent=!0,t._vm=new Sl({data:{$$state:e},computed:s}),Sl.config.silent=i,t.strict&&function(t){t._vm.$watch((function(){return this._data.$$state}),(function(){0}),{deep:!0,sync:!0})}(t),r&&(n&&t._withCommit((function(){r._data.$$state=null})),Sl.nextTick((function(){return r.$destroy()})))}function jl(t,e,n,r,a){var s=!n.length,i=t._modules.getNamespace(n);if(r.namespaced&&(t._modulesNamespaceMap[i],t._modulesNamespaceMap[i]=r),!s&&!a){var o=Nl(e,n.slice(0,-1)),c=n[n.length-1];t._withCommit((function(){Sl.set(o,c,r.state)}))}var l=r.context=function(t,e,n){var r=""===e,a={dispatch:r?t.dispatch:function(n,r,a){var s=Bl(n,r,a),i=s.payload,o=s.options,c=s.type;return o&&o.root||(c=e+c),t.dispatch(c,i)},commit:r?t.commit:function(n,r,a){var s=Bl(n,r,a),i=s.payload,o=s.options,c=s.type;o&&o.root||(c=e+c),t.commit(c,i,o)}};return Object.defineProperties(a,{getters:{get:r?function(){return t.getters}:function(){return function(t,e){if(!t._makeLocalGettersCache[e]){var n={},r=e.length;Object.keys(t.getters).forEach((function(a){if(a.slice(0,r)===e){var s=a.slice(r);Object.defineProperty(n,s,{get:function(){return t.getters[a]},enumerable:!0})}})),t._makeLocalGettersCache[e]=n}return t._makeLocalGettersCache[e]}(t,e)}},state:{get:function(){return Nl(t.state,n)}}}),a}(t,i,n);r.forEachMutation((function(e,n){!function(t,e,n,r){(t._mutations[e]||(t._mutations[e]=[])).push((function(e){n.call(t,r.state,e)}))}(t,i+n,e,l)})),r.forEachAction((function(e,n){var r=e.root?n:i+n,a=e.handler||e;!function(t,e,n,r){(t._actions[e]||(t._actions[e]=[])).push((function(e){var a,s=n.call(t,{dispatch:r.dispatch,commit:r.commit,getters:r.getters,state:r.state,rootGetters:t.getters,rootState:t.state},e);return(a=s)&&"function"==typeof a.then||(s=Promise.resolve(s)),t._devtoolHook?s.catch((function(e){throw t._devtoolHook.emit("vuex:error",e),e})):s}))}(t,r,a,l)})),r.forEachGetter((function(e,n){!function(t,e,n,r){if(t._wrappedGetters[e])return void 0;t._wrappedGetters[e]=function(t){return n(r.state,r.getters,t.state,t.getters)}}(t,i+n,e,l)})),r.forEachChild((function(r,s){jl(t,e,n.concat(s),r,a)}))}function Nl(t,e){return e.reduce((function(t,e){return t[e]}),t)}function Bl(t,e,n){return wl(t)&&t.type&&(n=e,e=t,t=t.type),{type:t,payload:e,options:n}}function Dl(t){Sl&&t===Sl||function(t){if(Number(t.version.split(".")[0])>=2)t.mixin({beforeCreate:n});else{var e=t.prototype._init;t.prototype._init=function(t){void 0===t&&(t={}),t.init=t.init?[n].concat(t.init):n,e.call(this,t)}}function n(){var t=this.$options;t.store?this.$store="function"==typeof t.store?t.store():t.store:t.parent&&t.parent.$store&&(this.$store=t.parent.$store)}}(Sl=t)}Ol.state.get=function(){return this._vm._data.$$state},Ol.state.set=function(t){0},Al.prototype.commit=function(t,e,n){var r=this,a=Bl(t,e,n),s=a.type,i=a.payload,o=(a.options,{type:s,payload:i}),c=this._mutations[s];c&&(this._withCommit((function(){c.forEach((function(t){t(i)}))})),this._subscribers.slice().forEach((function(t){return t(o,r.state)})))},Al.prototype.dispatch=function(t,e){var n=this,r=Bl(t,e),a=r.type,s=r.payload,i={type:a,payload:s},o=this._actions[a];if(o){try{this._actionSubscribers.slice().filter((function(t){return t.before})).forEach((function(t){return t.before(i,n.state)}))}catch(t){0}var c=o.length>1?Promise.all(o.map((function(t){return t(s)}))):o[0](s);return new Promise((function(t,e){c.then((function(e){try{n._actionSubscribers.filter((function(t){return t.after})).forEach((function(t){return t.after(i,n.state)}))}catch(t){0}t(e)}),(function(t){try{n._actionSubscribers.filter((function(t){return t.error})).forEach((function(e){return e.error(i,n.state,t)}))}catch(t){0}e(t)}))}))}},Al.prototype.subscribe=function(t,e){return El(t,this._subscribers,e)},Al.prototype.subscribeAction=function(t,e){return El("function"==typeof t?{before:t}:t,this._actionSubscribers,e)},Al.prototype.watch=function(t,e,n){var r=this;return this._watcherVM.$watch((function(){return t(r.state,r.getters)}),e,n)},Al.prototype.replaceState=function(t){var e=this;this._withCommit((function(){e._vm._data.$$state=t}))},Al.prototype.registerModule=function(t,e,n){void 0===n&&(n={}),"string"==typeof t&&(t=[t]),this._modules.register(t,e),jl(this,this.state,t,this._modules.get(t),n.preserveState),Pl(this,this.state)},Al.prototype.unregisterModule=function(t){var e=this;"string"==typeof t&&(t=[t]),this._modules.unregister(t),this._withCommit((function(){var n=Nl(e.state,t.slice(0,-1));Sl.delete(n,t[t.length-1])})),Tl(this)},Al.prototype.hasModule=function(t){return"string"==typeof t&&(t=[t]),this._modules.isRegistered(t)},Al.prototype.hotUpdate=function(t){this._modules.update(t),Tl(this,!0)},Al.prototype._withCommit=function(t){var e=this._committing;this._committing=!0,t(),this._committing=e},Object.defineProperties(Al.prototype,Ol);var Ml=Il((function(t,e){var n={};return Ul(e).forEach((function(e){var r=e.key,a=e.val;n[r]=function(){var e=this.$store.state,n=this.$store.getters;if(t){var r=Hl(this.$store,"mapState",t);if(!r)return;e=r.context.state,n=r.context.getters}return"function"==typeof a?a.call(this,e,n):e[a]},n[r].vuex=!0})),n})),Rl=Il((function(t,e){var n={};return Ul(e).forEach((function(e){var r=e.key,a=e.val;n[r]=function(){for(var e=[],n=arguments.length;n--;)e[n]=arguments[n];var r=this.$store.commit;if(t){var s=Hl(this.$store,"mapMutations",t);if(!s)return;r=s.context.commit}return"function"==typeof a?a.apply(this,[r].concat(e)):r.apply(this.$store,[a].concat(e))}})),n})),Ll=Il((function(t,e){var n={};return Ul(e).forEach((function(e){var r=e.key,a=e.val;a=t+a,n[r]=function(){if(!t||Hl(this.$store,"mapGetters",t))return this.$store.getters[a]},n[r].vuex=!0})),n})),Fl=Il((function(t,e){var n={};return Ul(e).forEach((function(e){var r=e.key,a=e.val;n[r]=function(){for(var e=[],n=arguments.length;n--;)e[n]=arguments[n];var r=this.$store.dispatch;if(t){var s=Hl(this.$store,"mapActions",t);if(!s)return;r=s.context.dispatch}return"function"==typeof a?a.apply(this,[r].concat(e)):r.apply(this.$store,[a].concat(e))}})),n}));function Ul(t){return function(t){return Array.isArray(t)||wl(t)}(t)?Array.isArray(t)?t.map((function(t){return{key:t,val:t}})):Object.keys(t).map((function(e){return{key:e,val:t[e]}})):[]}function Il(t){return function(e,n){return"string"!=typeof e?(n=e,e=""):"/"!==e.charAt(e.length-1)&&(e+="/"),t(e,n)}}function Hl(t,e,n){return t._modulesNamespaceMap[n]}function Zl(t,e,n){var r=n?t.groupCollapsed:t.group;try{r.call(t,e)}catch(n){t.log(e)}}function ql(t){try{t.groupEnd()}catch(e){t.log("—— log end ——")}}function Vl(){var t=new Date;return" @ "+Wl(t.getHours(),2)+":"+Wl(t.getMinutes(),2)+":"+Wl(t.getSeconds(),2)+"."+Wl(t.getMilliseconds(),3)}function Wl(t,e){return function(t,e){return new Array(e+1).join(t)}("0",e-t.toString().length)+t}const zl={Store:Al,install:Dl,version:"3.6.2",mapState:Ml,mapMutations:Rl,mapGetters:Ll,mapActions:Fl,createNamespacedHelpers:function(t){return{mapState:Ml.bind(null,t),mapGetters:Ll.bind(null,t),mapMutations:Rl.bind(null,t),mapActions:Fl.bind(null,t)}},createLogger:function(t){void 0===t&&(t={});var e=t.collapsed;void 0===e&&(e=!0);var n=t.filter;void 0===n&&(n=function(t,e,n){return!0});var r=t.transformer;void 0===r&&(r=function(t){return t});var a=t.mutationTransformer;void 0===a&&(a=function(t){return t});var s=t.actionFilter;void 0===s&&(s=function(t,e){return!0});var i=t.actionTransformer;void 0===i&&(i=function(t){return t});var o=t.logMutations;void 0===o&&(o=!0);var c=t.logActions;void 0===c&&(c=!0);var l=t.logger;return void 0===l&&(l=console),function(t){var u=yl(t.state);void 0!==l&&(o&&t.subscribe((function(t,s){var i=yl(s);if(n(t,u,i)){var o=Vl(),c=a(t),d="mutation "+t.type+o;Zl(l,d,e),l.log("%c prev state","color: #9E9E9E; font-weight: bold",r(u)),l.log("%c mutation","color: #03A9F4; font-weight: bold",c),l.log("%c next state","color: #4CAF50; font-weight: bold",r(i)),ql(l)}u=i})),c&&t.subscribeAction((function(t,n){if(s(t,n)){var r=Vl(),a=i(t),o="action "+t.type+r;Zl(l,o,e),l.log("%c action","color: #03A9F4; font-weight: bold",a),ql(l)}})))}}};n(9147),go.use(zl);var Gl=new zl.Store({state:{user:JSON.parse(localStorage.getItem("user")),busy:!1,path:localStorage.getItem("file_path")},mutations:{setPath:function(t,e){t.path=e,localStorage.setItem("file_path",e)},setBusy:function(t,e){t.busy=e},setUser:function(t){axios.defaults.baseURL="/api/",axios.get("/account/").then((function(e){localStorage.setItem("user",JSON.stringify(e.data)),t.user=e.data})).catch((function(t){}))},unsetUser:function(t){localStorage.removeItem("user"),t.user=null}}});n(9147),window.axios.defaults.baseURL="/api/";var Jl=new go;go.use(ul),go.component("loading",fl()),go.filter("floatformat",(function(t){return parseFloat(t).toFixed(2)})),go.component("v-select",VueSelect.VueSelect),go.component("usercreate-component",n(6116).Z),go.filter("prettyBytes",(function(t){if("number"!=typeof t||isNaN(t))throw new TypeError("Expected a number");var e,n=t<0,r=["B","kB","MB","GB","TB","PB","EB","ZB","YB"];return n&&(t=-t),t<1?(n?"-":"")+t+" B":(e=Math.min(Math.floor(Math.log(t)/Math.log(1024)),r.length-1),(n?"-":"")+(t=1*(t/Math.pow(1024,e)).toFixed(2))+" "+r[e])}));var Kl=new ul({mode:"history",routes:gl,base:"/dashboard/"});go.mixin({data:function(){return{EventBus:Jl,FM_ROOT,PMA_URL}},methods:{genRandPassword:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:15,e="0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz";return Array(t).fill(e).map((function(t){return t[Math.floor(Math.random()*t.length)]})).join("")}}});new go({el:"#wrapper",router:Kl,store:Gl,mounted:function(){this.$store.commit("setUser")},methods:{signOut:function(){var t=this;axios.defaults.baseURL="/dashboard/",axios.get("/sign-out/").then((function(e){t.$store.commit("unsetUser"),window.location=""})).catch((function(t){}))}}})})()})();
\ No newline at end of file
+(()=>{var t={9669:(t,e,n)=>{t.exports=n(1609)},5448:(t,e,n)=>{"use strict";var r=n(4867),a=n(6026),s=n(4372),i=n(5327),o=n(4097),c=n(4109),l=n(7985),u=n(5061);t.exports=function(t){return new Promise((function(e,n){var d=t.data,f=t.headers;r.isFormData(d)&&delete f["Content-Type"];var p=new XMLHttpRequest;if(t.auth){var v=t.auth.username||"",h=t.auth.password?unescape(encodeURIComponent(t.auth.password)):"";f.Authorization="Basic "+btoa(v+":"+h)}var m=o(t.baseURL,t.url);if(p.open(t.method.toUpperCase(),i(m,t.params,t.paramsSerializer),!0),p.timeout=t.timeout,p.onreadystatechange=function(){if(p&&4===p.readyState&&(0!==p.status||p.responseURL&&0===p.responseURL.indexOf("file:"))){var r="getAllResponseHeaders"in p?c(p.getAllResponseHeaders()):null,s={data:t.responseType&&"text"!==t.responseType?p.response:p.responseText,status:p.status,statusText:p.statusText,headers:r,config:t,request:p};a(e,n,s),p=null}},p.onabort=function(){p&&(n(u("Request aborted",t,"ECONNABORTED",p)),p=null)},p.onerror=function(){n(u("Network Error",t,null,p)),p=null},p.ontimeout=function(){var e="timeout of "+t.timeout+"ms exceeded";t.timeoutErrorMessage&&(e=t.timeoutErrorMessage),n(u(e,t,"ECONNABORTED",p)),p=null},r.isStandardBrowserEnv()){var g=(t.withCredentials||l(m))&&t.xsrfCookieName?s.read(t.xsrfCookieName):void 0;g&&(f[t.xsrfHeaderName]=g)}if("setRequestHeader"in p&&r.forEach(f,(function(t,e){void 0===d&&"content-type"===e.toLowerCase()?delete f[e]:p.setRequestHeader(e,t)})),r.isUndefined(t.withCredentials)||(p.withCredentials=!!t.withCredentials),t.responseType)try{p.responseType=t.responseType}catch(e){if("json"!==t.responseType)throw e}"function"==typeof t.onDownloadProgress&&p.addEventListener("progress",t.onDownloadProgress),"function"==typeof t.onUploadProgress&&p.upload&&p.upload.addEventListener("progress",t.onUploadProgress),t.cancelToken&&t.cancelToken.promise.then((function(t){p&&(p.abort(),n(t),p=null)})),d||(d=null),p.send(d)}))}},1609:(t,e,n)=>{"use strict";var r=n(4867),a=n(1849),s=n(321),i=n(7185);function o(t){var e=new s(t),n=a(s.prototype.request,e);return r.extend(n,s.prototype,e),r.extend(n,e),n}var c=o(n(5655));c.Axios=s,c.create=function(t){return o(i(c.defaults,t))},c.Cancel=n(5263),c.CancelToken=n(4972),c.isCancel=n(6502),c.all=function(t){return Promise.all(t)},c.spread=n(8713),c.isAxiosError=n(6268),t.exports=c,t.exports.default=c},5263:t=>{"use strict";function e(t){this.message=t}e.prototype.toString=function(){return"Cancel"+(this.message?": "+this.message:"")},e.prototype.__CANCEL__=!0,t.exports=e},4972:(t,e,n)=>{"use strict";var r=n(5263);function a(t){if("function"!=typeof t)throw new TypeError("executor must be a function.");var e;this.promise=new Promise((function(t){e=t}));var n=this;t((function(t){n.reason||(n.reason=new r(t),e(n.reason))}))}a.prototype.throwIfRequested=function(){if(this.reason)throw this.reason},a.source=function(){var t;return{token:new a((function(e){t=e})),cancel:t}},t.exports=a},6502:t=>{"use strict";t.exports=function(t){return!(!t||!t.__CANCEL__)}},321:(t,e,n)=>{"use strict";var r=n(4867),a=n(5327),s=n(782),i=n(3572),o=n(7185);function c(t){this.defaults=t,this.interceptors={request:new s,response:new s}}c.prototype.request=function(t){"string"==typeof t?(t=arguments[1]||{}).url=arguments[0]:t=t||{},(t=o(this.defaults,t)).method?t.method=t.method.toLowerCase():this.defaults.method?t.method=this.defaults.method.toLowerCase():t.method="get";var e=[i,void 0],n=Promise.resolve(t);for(this.interceptors.request.forEach((function(t){e.unshift(t.fulfilled,t.rejected)})),this.interceptors.response.forEach((function(t){e.push(t.fulfilled,t.rejected)}));e.length;)n=n.then(e.shift(),e.shift());return n},c.prototype.getUri=function(t){return t=o(this.defaults,t),a(t.url,t.params,t.paramsSerializer).replace(/^\?/,"")},r.forEach(["delete","get","head","options"],(function(t){c.prototype[t]=function(e,n){return this.request(o(n||{},{method:t,url:e,data:(n||{}).data}))}})),r.forEach(["post","put","patch"],(function(t){c.prototype[t]=function(e,n,r){return this.request(o(r||{},{method:t,url:e,data:n}))}})),t.exports=c},782:(t,e,n)=>{"use strict";var r=n(4867);function a(){this.handlers=[]}a.prototype.use=function(t,e){return this.handlers.push({fulfilled:t,rejected:e}),this.handlers.length-1},a.prototype.eject=function(t){this.handlers[t]&&(this.handlers[t]=null)},a.prototype.forEach=function(t){r.forEach(this.handlers,(function(e){null!==e&&t(e)}))},t.exports=a},4097:(t,e,n)=>{"use strict";var r=n(1793),a=n(7303);t.exports=function(t,e){return t&&!r(e)?a(t,e):e}},5061:(t,e,n)=>{"use strict";var r=n(481);t.exports=function(t,e,n,a,s){var i=new Error(t);return r(i,e,n,a,s)}},3572:(t,e,n)=>{"use strict";var r=n(4867),a=n(8527),s=n(6502),i=n(5655);function o(t){t.cancelToken&&t.cancelToken.throwIfRequested()}t.exports=function(t){return o(t),t.headers=t.headers||{},t.data=a(t.data,t.headers,t.transformRequest),t.headers=r.merge(t.headers.common||{},t.headers[t.method]||{},t.headers),r.forEach(["delete","get","head","post","put","patch","common"],(function(e){delete t.headers[e]})),(t.adapter||i.adapter)(t).then((function(e){return o(t),e.data=a(e.data,e.headers,t.transformResponse),e}),(function(e){return s(e)||(o(t),e&&e.response&&(e.response.data=a(e.response.data,e.response.headers,t.transformResponse))),Promise.reject(e)}))}},481:t=>{"use strict";t.exports=function(t,e,n,r,a){return t.config=e,n&&(t.code=n),t.request=r,t.response=a,t.isAxiosError=!0,t.toJSON=function(){return{message:this.message,name:this.name,description:this.description,number:this.number,fileName:this.fileName,lineNumber:this.lineNumber,columnNumber:this.columnNumber,stack:this.stack,config:this.config,code:this.code}},t}},7185:(t,e,n)=>{"use strict";var r=n(4867);t.exports=function(t,e){e=e||{};var n={},a=["url","method","data"],s=["headers","auth","proxy","params"],i=["baseURL","transformRequest","transformResponse","paramsSerializer","timeout","timeoutMessage","withCredentials","adapter","responseType","xsrfCookieName","xsrfHeaderName","onUploadProgress","onDownloadProgress","decompress","maxContentLength","maxBodyLength","maxRedirects","transport","httpAgent","httpsAgent","cancelToken","socketPath","responseEncoding"],o=["validateStatus"];function c(t,e){return r.isPlainObject(t)&&r.isPlainObject(e)?r.merge(t,e):r.isPlainObject(e)?r.merge({},e):r.isArray(e)?e.slice():e}function l(a){r.isUndefined(e[a])?r.isUndefined(t[a])||(n[a]=c(void 0,t[a])):n[a]=c(t[a],e[a])}r.forEach(a,(function(t){r.isUndefined(e[t])||(n[t]=c(void 0,e[t]))})),r.forEach(s,l),r.forEach(i,(function(a){r.isUndefined(e[a])?r.isUndefined(t[a])||(n[a]=c(void 0,t[a])):n[a]=c(void 0,e[a])})),r.forEach(o,(function(r){r in e?n[r]=c(t[r],e[r]):r in t&&(n[r]=c(void 0,t[r]))}));var u=a.concat(s).concat(i).concat(o),d=Object.keys(t).concat(Object.keys(e)).filter((function(t){return-1===u.indexOf(t)}));return r.forEach(d,l),n}},6026:(t,e,n)=>{"use strict";var r=n(5061);t.exports=function(t,e,n){var a=n.config.validateStatus;n.status&&a&&!a(n.status)?e(r("Request failed with status code "+n.status,n.config,null,n.request,n)):t(n)}},8527:(t,e,n)=>{"use strict";var r=n(4867);t.exports=function(t,e,n){return r.forEach(n,(function(n){t=n(t,e)})),t}},5655:(t,e,n)=>{"use strict";var r=n(4155),a=n(4867),s=n(6016),i={"Content-Type":"application/x-www-form-urlencoded"};function o(t,e){!a.isUndefined(t)&&a.isUndefined(t["Content-Type"])&&(t["Content-Type"]=e)}var c,l={adapter:(("undefined"!=typeof XMLHttpRequest||void 0!==r&&"[object process]"===Object.prototype.toString.call(r))&&(c=n(5448)),c),transformRequest:[function(t,e){return s(e,"Accept"),s(e,"Content-Type"),a.isFormData(t)||a.isArrayBuffer(t)||a.isBuffer(t)||a.isStream(t)||a.isFile(t)||a.isBlob(t)?t:a.isArrayBufferView(t)?t.buffer:a.isURLSearchParams(t)?(o(e,"application/x-www-form-urlencoded;charset=utf-8"),t.toString()):a.isObject(t)?(o(e,"application/json;charset=utf-8"),JSON.stringify(t)):t}],transformResponse:[function(t){if("string"==typeof t)try{t=JSON.parse(t)}catch(t){}return t}],timeout:0,xsrfCookieName:"XSRF-TOKEN",xsrfHeaderName:"X-XSRF-TOKEN",maxContentLength:-1,maxBodyLength:-1,validateStatus:function(t){return t>=200&&t<300}};l.headers={common:{Accept:"application/json, text/plain, */*"}},a.forEach(["delete","get","head"],(function(t){l.headers[t]={}})),a.forEach(["post","put","patch"],(function(t){l.headers[t]=a.merge(i)})),t.exports=l},1849:t=>{"use strict";t.exports=function(t,e){return function(){for(var n=new Array(arguments.length),r=0;r<n.length;r++)n[r]=arguments[r];return t.apply(e,n)}}},5327:(t,e,n)=>{"use strict";var r=n(4867);function a(t){return encodeURIComponent(t).replace(/%3A/gi,":").replace(/%24/g,"$").replace(/%2C/gi,",").replace(/%20/g,"+").replace(/%5B/gi,"[").replace(/%5D/gi,"]")}t.exports=function(t,e,n){if(!e)return t;var s;if(n)s=n(e);else if(r.isURLSearchParams(e))s=e.toString();else{var i=[];r.forEach(e,(function(t,e){null!=t&&(r.isArray(t)?e+="[]":t=[t],r.forEach(t,(function(t){r.isDate(t)?t=t.toISOString():r.isObject(t)&&(t=JSON.stringify(t)),i.push(a(e)+"="+a(t))})))})),s=i.join("&")}if(s){var o=t.indexOf("#");-1!==o&&(t=t.slice(0,o)),t+=(-1===t.indexOf("?")?"?":"&")+s}return t}},7303:t=>{"use strict";t.exports=function(t,e){return e?t.replace(/\/+$/,"")+"/"+e.replace(/^\/+/,""):t}},4372:(t,e,n)=>{"use strict";var r=n(4867);t.exports=r.isStandardBrowserEnv()?{write:function(t,e,n,a,s,i){var o=[];o.push(t+"="+encodeURIComponent(e)),r.isNumber(n)&&o.push("expires="+new Date(n).toGMTString()),r.isString(a)&&o.push("path="+a),r.isString(s)&&o.push("domain="+s),!0===i&&o.push("secure"),document.cookie=o.join("; ")},read:function(t){var e=document.cookie.match(new RegExp("(^|;\\s*)("+t+")=([^;]*)"));return e?decodeURIComponent(e[3]):null},remove:function(t){this.write(t,"",Date.now()-864e5)}}:{write:function(){},read:function(){return null},remove:function(){}}},1793:t=>{"use strict";t.exports=function(t){return/^([a-z][a-z\d\+\-\.]*:)?\/\//i.test(t)}},6268:t=>{"use strict";t.exports=function(t){return"object"==typeof t&&!0===t.isAxiosError}},7985:(t,e,n)=>{"use strict";var r=n(4867);t.exports=r.isStandardBrowserEnv()?function(){var t,e=/(msie|trident)/i.test(navigator.userAgent),n=document.createElement("a");function a(t){var r=t;return e&&(n.setAttribute("href",r),r=n.href),n.setAttribute("href",r),{href:n.href,protocol:n.protocol?n.protocol.replace(/:$/,""):"",host:n.host,search:n.search?n.search.replace(/^\?/,""):"",hash:n.hash?n.hash.replace(/^#/,""):"",hostname:n.hostname,port:n.port,pathname:"/"===n.pathname.charAt(0)?n.pathname:"/"+n.pathname}}return t=a(window.location.href),function(e){var n=r.isString(e)?a(e):e;return n.protocol===t.protocol&&n.host===t.host}}():function(){return!0}},6016:(t,e,n)=>{"use strict";var r=n(4867);t.exports=function(t,e){r.forEach(t,(function(n,r){r!==e&&r.toUpperCase()===e.toUpperCase()&&(t[e]=n,delete t[r])}))}},4109:(t,e,n)=>{"use strict";var r=n(4867),a=["age","authorization","content-length","content-type","etag","expires","from","host","if-modified-since","if-unmodified-since","last-modified","location","max-forwards","proxy-authorization","referer","retry-after","user-agent"];t.exports=function(t){var e,n,s,i={};return t?(r.forEach(t.split("\n"),(function(t){if(s=t.indexOf(":"),e=r.trim(t.substr(0,s)).toLowerCase(),n=r.trim(t.substr(s+1)),e){if(i[e]&&a.indexOf(e)>=0)return;i[e]="set-cookie"===e?(i[e]?i[e]:[]).concat([n]):i[e]?i[e]+", "+n:n}})),i):i}},8713:t=>{"use strict";t.exports=function(t){return function(e){return t.apply(null,e)}}},4867:(t,e,n)=>{"use strict";var r=n(1849),a=Object.prototype.toString;function s(t){return"[object Array]"===a.call(t)}function i(t){return void 0===t}function o(t){return null!==t&&"object"==typeof t}function c(t){if("[object Object]"!==a.call(t))return!1;var e=Object.getPrototypeOf(t);return null===e||e===Object.prototype}function l(t){return"[object Function]"===a.call(t)}function u(t,e){if(null!=t)if("object"!=typeof t&&(t=[t]),s(t))for(var n=0,r=t.length;n<r;n++)e.call(null,t[n],n,t);else for(var a in t)Object.prototype.hasOwnProperty.call(t,a)&&e.call(null,t[a],a,t)}t.exports={isArray:s,isArrayBuffer:function(t){return"[object ArrayBuffer]"===a.call(t)},isBuffer:function(t){return null!==t&&!i(t)&&null!==t.constructor&&!i(t.constructor)&&"function"==typeof t.constructor.isBuffer&&t.constructor.isBuffer(t)},isFormData:function(t){return"undefined"!=typeof FormData&&t instanceof FormData},isArrayBufferView:function(t){return"undefined"!=typeof ArrayBuffer&&ArrayBuffer.isView?ArrayBuffer.isView(t):t&&t.buffer&&t.buffer instanceof ArrayBuffer},isString:function(t){return"string"==typeof t},isNumber:function(t){return"number"==typeof t},isObject:o,isPlainObject:c,isUndefined:i,isDate:function(t){return"[object Date]"===a.call(t)},isFile:function(t){return"[object File]"===a.call(t)},isBlob:function(t){return"[object Blob]"===a.call(t)},isFunction:l,isStream:function(t){return o(t)&&l(t.pipe)},isURLSearchParams:function(t){return"undefined"!=typeof URLSearchParams&&t instanceof URLSearchParams},isStandardBrowserEnv:function(){return("undefined"==typeof navigator||"ReactNative"!==navigator.product&&"NativeScript"!==navigator.product&&"NS"!==navigator.product)&&("undefined"!=typeof window&&"undefined"!=typeof document)},forEach:u,merge:function t(){var e={};function n(n,r){c(e[r])&&c(n)?e[r]=t(e[r],n):c(n)?e[r]=t({},n):s(n)?e[r]=n.slice():e[r]=n}for(var r=0,a=arguments.length;r<a;r++)u(arguments[r],n);return e},extend:function(t,e,n){return u(e,(function(e,a){t[a]=n&&"function"==typeof e?r(e,n):e})),t},trim:function(t){return t.replace(/^\s*/,"").replace(/\s*$/,"")},stripBOM:function(t){return 65279===t.charCodeAt(0)&&(t=t.slice(1)),t}}},9147:(t,e,n)=>{window.axios=n(9669),window.axios.defaults.xsrfHeaderName="X-CSRFToken",window.axios.defaults.xsrfCookieName="csrftoken",window.axios.defaults.headers.common["X-Requested-With"]="XMLHttpRequest"},6369:(t,e,n)=>{"use strict";n.d(e,{Z:()=>s});var r=n(3645),a=n.n(r)()((function(t){return t[1]}));a.push([t.id,".vld-overlay,.vld-shown{overflow:hidden}.vld-overlay{align-items:center;bottom:0;display:none;justify-content:center;left:0;position:absolute;right:0;top:0;z-index:9999}.vld-overlay.is-active{display:flex}.vld-overlay.is-full-page{position:fixed;z-index:9999}.vld-overlay .vld-background{background:#fff;bottom:0;left:0;opacity:.5;position:absolute;right:0;top:0}.vld-overlay .vld-icon,.vld-parent{position:relative}",""]);const s=a},5476:(t,e,n)=>{"use strict";n.d(e,{Z:()=>s});var r=n(3645),a=n.n(r)()((function(t){return t[1]}));a.push([t.id,"tbody tr[data-v-67504978]{cursor:pointer}tbody tr[data-v-67504978]:hover{background-color:#ebfaff!important}.file-toolbar[data-v-67504978]{font-size:15px}.file-toolbar a[data-v-67504978]{text-decoration:none}select[data-v-67504978]{height:30px}",""]);const s=a},3645:t=>{"use strict";t.exports=function(t){var e=[];return e.toString=function(){return this.map((function(e){var n=t(e);return e[2]?"@media ".concat(e[2]," {").concat(n,"}"):n})).join("")},e.i=function(t,n,r){"string"==typeof t&&(t=[[null,t,""]]);var a={};if(r)for(var s=0;s<this.length;s++){var i=this[s][0];null!=i&&(a[i]=!0)}for(var o=0;o<t.length;o++){var c=[].concat(t[o]);r&&a[c[0]]||(n&&(c[2]?c[2]="".concat(n," and ").concat(c[2]):c[2]=n),e.push(c))}},e}},4155:t=>{var e,n,r=t.exports={};function a(){throw new Error("setTimeout has not been defined")}function s(){throw new Error("clearTimeout has not been defined")}function i(t){if(e===setTimeout)return setTimeout(t,0);if((e===a||!e)&&setTimeout)return e=setTimeout,setTimeout(t,0);try{return e(t,0)}catch(n){try{return e.call(null,t,0)}catch(n){return e.call(this,t,0)}}}!function(){try{e="function"==typeof setTimeout?setTimeout:a}catch(t){e=a}try{n="function"==typeof clearTimeout?clearTimeout:s}catch(t){n=s}}();var o,c=[],l=!1,u=-1;function d(){l&&o&&(l=!1,o.length?c=o.concat(c):u=-1,c.length&&f())}function f(){if(!l){var t=i(d);l=!0;for(var e=c.length;e;){for(o=c,c=[];++u<e;)o&&o[u].run();u=-1,e=c.length}o=null,l=!1,function(t){if(n===clearTimeout)return clearTimeout(t);if((n===s||!n)&&clearTimeout)return n=clearTimeout,clearTimeout(t);try{n(t)}catch(e){try{return n.call(null,t)}catch(e){return n.call(this,t)}}}(t)}}function p(t,e){this.fun=t,this.array=e}function v(){}r.nextTick=function(t){var e=new Array(arguments.length-1);if(arguments.length>1)for(var n=1;n<arguments.length;n++)e[n-1]=arguments[n];c.push(new p(t,e)),1!==c.length||l||i(f)},p.prototype.run=function(){this.fun.apply(null,this.array)},r.title="browser",r.browser=!0,r.env={},r.argv=[],r.version="",r.versions={},r.on=v,r.addListener=v,r.once=v,r.off=v,r.removeListener=v,r.removeAllListeners=v,r.emit=v,r.prependListener=v,r.prependOnceListener=v,r.listeners=function(t){return[]},r.binding=function(t){throw new Error("process.binding is not supported")},r.cwd=function(){return"/"},r.chdir=function(t){throw new Error("process.chdir is not supported")},r.umask=function(){return 0}},3379:(t,e,n)=>{"use strict";var r,a=function(){return void 0===r&&(r=Boolean(window&&document&&document.all&&!window.atob)),r},s=function(){var t={};return function(e){if(void 0===t[e]){var n=document.querySelector(e);if(window.HTMLIFrameElement&&n instanceof window.HTMLIFrameElement)try{n=n.contentDocument.head}catch(t){n=null}t[e]=n}return t[e]}}(),i=[];function o(t){for(var e=-1,n=0;n<i.length;n++)if(i[n].identifier===t){e=n;break}return e}function c(t,e){for(var n={},r=[],a=0;a<t.length;a++){var s=t[a],c=e.base?s[0]+e.base:s[0],l=n[c]||0,u="".concat(c," ").concat(l);n[c]=l+1;var d=o(u),f={css:s[1],media:s[2],sourceMap:s[3]};-1!==d?(i[d].references++,i[d].updater(f)):i.push({identifier:u,updater:m(f,e),references:1}),r.push(u)}return r}function l(t){var e=document.createElement("style"),r=t.attributes||{};if(void 0===r.nonce){var a=n.nc;a&&(r.nonce=a)}if(Object.keys(r).forEach((function(t){e.setAttribute(t,r[t])})),"function"==typeof t.insert)t.insert(e);else{var i=s(t.insert||"head");if(!i)throw new Error("Couldn't find a style target. This probably means that the value for the 'insert' parameter is invalid.");i.appendChild(e)}return e}var u,d=(u=[],function(t,e){return u[t]=e,u.filter(Boolean).join("\n")});function f(t,e,n,r){var a=n?"":r.media?"@media ".concat(r.media," {").concat(r.css,"}"):r.css;if(t.styleSheet)t.styleSheet.cssText=d(e,a);else{var s=document.createTextNode(a),i=t.childNodes;i[e]&&t.removeChild(i[e]),i.length?t.insertBefore(s,i[e]):t.appendChild(s)}}function p(t,e,n){var r=n.css,a=n.media,s=n.sourceMap;if(a?t.setAttribute("media",a):t.removeAttribute("media"),s&&"undefined"!=typeof btoa&&(r+="\n/*# sourceMappingURL=data:application/json;base64,".concat(btoa(unescape(encodeURIComponent(JSON.stringify(s))))," */")),t.styleSheet)t.styleSheet.cssText=r;else{for(;t.firstChild;)t.removeChild(t.firstChild);t.appendChild(document.createTextNode(r))}}var v=null,h=0;function m(t,e){var n,r,a;if(e.singleton){var s=h++;n=v||(v=l(e)),r=f.bind(null,n,s,!1),a=f.bind(null,n,s,!0)}else n=l(e),r=p.bind(null,n,e),a=function(){!function(t){if(null===t.parentNode)return!1;t.parentNode.removeChild(t)}(n)};return r(t),function(e){if(e){if(e.css===t.css&&e.media===t.media&&e.sourceMap===t.sourceMap)return;r(t=e)}else a()}}t.exports=function(t,e){(e=e||{}).singleton||"boolean"==typeof e.singleton||(e.singleton=a());var n=c(t=t||[],e);return function(t){if(t=t||[],"[object Array]"===Object.prototype.toString.call(t)){for(var r=0;r<n.length;r++){var a=o(n[r]);i[a].references--}for(var s=c(t,e),l=0;l<n.length;l++){var u=o(n[l]);0===i[u].references&&(i[u].updater(),i.splice(u,1))}n=s}}}},7479:(t,e,n)=>{"use strict";n.d(e,{Z:()=>i});var r=n(5203),a=n(3109);const s={components:{DatabasesTable:r.Z,WebsitesTable:a.Z},data:function(){return{resources:!1}},mounted:function(){this.$store.state.user&&this.$store.state.user.is_root&&this.getStats()},created:function(){this.EventBus.$on("doSearch",this.browseWebsites)},beforeDestroy:function(){this.EventBus.$off("doSearch",this.browseWebsites)},methods:{browseWebsites:function(){this.$router.push({name:"websites"})},getStats:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/stats/common/").then((function(e){t.$store.commit("setBusy",!1),t.resources=e.data})).catch((function(e){t.$store.commit("setBusy",!1)}))}}};const i=(0,n(1900).Z)(s,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[t.$store.state.user&&t.$store.state.user.is_root&&t.resources?n("div",{staticClass:"row"},[n("div",{staticClass:"col-xl-3 col-md-6 mb-4"},[n("router-link",{staticClass:"card text-decoration-none border-left-primary shadow h-100 py-2",attrs:{to:{name:"websites"}}},[n("div",{staticClass:"card-body"},[n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col mr-2"},[n("div",{staticClass:"text-xs font-weight-bold text-primary text-uppercase mb-1"},[t._v("\n                                    Websites\n                                ")]),t._v(" "),n("div",{staticClass:"h5 mb-0 font-weight-bold text-gray-800"},[t._v("\n                                    "+t._s(t.resources.stats.websites)+"\n                                ")])]),t._v(" "),n("div",{staticClass:"col-auto"},[n("i",{staticClass:"fas fa-globe fa-2x text-gray-300"})])])])])],1),t._v(" "),n("div",{staticClass:"col-xl-3 col-md-6 mb-4"},[n("router-link",{staticClass:"card text-decoration-none border-left-primary shadow h-100 py-2",attrs:{to:{name:"databases"}}},[n("div",{staticClass:"card-body"},[n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col mr-2"},[n("div",{staticClass:"text-xs font-weight-bold text-primary text-uppercase mb-1"},[t._v("\n                                    Databases\n                                ")]),t._v(" "),n("div",{staticClass:"h5 mb-0 font-weight-bold text-gray-800"},[t._v("\n                                    "+t._s(t.resources.stats.databases)+"\n                                ")])]),t._v(" "),n("div",{staticClass:"col-auto"},[n("i",{staticClass:"fas fa-database fa-2x text-gray-300"})])])])])],1),t._v(" "),n("div",{staticClass:"col-xl-3 col-md-6 mb-4"},[n("router-link",{staticClass:"card text-decoration-none border-left-primary shadow h-100 py-2",attrs:{to:{name:"hardware"}}},[n("div",{staticClass:"card-body"},[n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col mr-2"},[n("div",{staticClass:"text-xs font-weight-bold text-primary text-uppercase mb-1"},[t._v("\n                                    Storage ("+t._s(t._f("prettyBytes")(t.resources.disk.total))+")\n                                ")]),t._v(" "),n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col-auto"},[n("div",{staticClass:"h5 mb-0 mr-3 font-weight-bold text-gray-800"},[t._v("\n                                            "+t._s(t.resources.disk.percent)+"%\n                                        ")])]),t._v(" "),n("div",{staticClass:"col"},[n("div",{staticClass:"progress progress-sm mr-2"},[n("div",{staticClass:"progress-bar bg-primary",style:"width: "+t.resources.disk.percent+"%",attrs:{role:"progressbar","aria-valuenow":t.resources.disk.percent,"aria-valuemin":"0","aria-valuemax":"100"}})])])])]),t._v(" "),n("div",{staticClass:"col-auto"},[n("i",{staticClass:"fas fa-hdd fa-2x text-gray-300"})])])])])],1),t._v(" "),n("div",{staticClass:"col-xl-3 col-md-6 mb-4"},[n("router-link",{staticClass:"card text-decoration-none border-left-primary shadow h-100 py-2",attrs:{to:{name:"hardware"}}},[n("div",{staticClass:"card-body"},[n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col mr-2"},[n("div",{staticClass:"text-xs font-weight-bold text-primary text-uppercase mb-1"},[t._v("\n                                    RAM ("+t._s(t._f("prettyBytes")(t.resources.ram.memory.total))+")\n                                ")]),t._v(" "),n("div",{staticClass:"row no-gutters align-items-center"},[n("div",{staticClass:"col-auto"},[n("div",{staticClass:"h5 mb-0 mr-3 font-weight-bold text-gray-800"},[t._v("\n                                            "+t._s(t.resources.ram.memory.percent)+"%\n                                        ")])]),t._v(" "),n("div",{staticClass:"col"},[n("div",{staticClass:"progress progress-sm mr-2"},[n("div",{staticClass:"progress-bar bg-primary",style:"width: "+t.resources.ram.memory.percent+"%",attrs:{role:"progressbar","aria-valuenow":t.resources.ram.memory.percent,"aria-valuemin":"0","aria-valuemax":"100"}})])])])]),t._v(" "),n("div",{staticClass:"col-auto"},[n("i",{staticClass:"fas fa-memory fa-2x text-gray-300"})])])])])],1)]):t._e()]),t._v(" "),n("websites-table",{staticClass:"mb-3"}),t._v(" "),n("databases-table")],1)}),[],!1,null,null,null).exports},2858:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{name:"",username:"",password:"",errors:{},create:!1,ssh_user:"",users:[]}},created:function(){this.password=this.genRandPassword(),this.$store.state.user&&this.$store.state.user.is_root&&this.getUsers(),this.EventBus.$on("userCreated",this.handleUserCreated)},methods:{handleUserCreated:function(t){this.create=!1,this.ssh_user=t,this.getUsers()},getUsers:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"",e=arguments.length>1&&void 0!==arguments[1]&&arguments[1],n=this;n.errors={},e&&e(!0),n.$store.commit("setBusy",!0),axios.get("/ssh-users/?q=".concat(t)).then((function(t){n.$store.commit("setBusy",!1);for(var r=[],a=0;a<t.data.results.length;a++)r.push(t.data.results[a].username);e&&e(!1),n.users=r})).catch((function(t){n.$store.commit("setBusy",!1),toastr.error("SSH users list cannot be obtained."),e&&e(!1)}))},createDatabase:function(){var t=this;t.errors={},t.$store.commit("setBusy",!0);var e=new FormData;e.append("name",t.name),e.append("ssh_user",t.ssh_user),e.append("username",t.username),e.append("password",t.password),axios.post("/databases/",e).then((function(e){t.$store.commit("setBusy",!1),toastr.success("Databases has been created successfully."),t.$router.push({name:"databases"})})).catch((function(e){t.$store.commit("setBusy",!1),t.errors=e.response.data}))}},watch:{create:function(t,e){t&&(this.user_pass=this.genRandPassword(),this.ssh_user=""),this.errors={}}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"card"},[n("div",{staticClass:"card-header"},[n("h4",{staticClass:"float-left mt-1"},[t._v("Create Database")]),t._v(" "),n("router-link",{staticClass:"btn mt-1 btn-sm float-right btn-warning",attrs:{to:{name:"databases"}}},[n("i",{staticClass:"fas fa-times"}),t._v(" Cancel\n                ")])],1),t._v(" "),n("div",{staticClass:"card-body"},[n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-6"},[t.$store.state.user&&t.$store.state.user.is_root?n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"user"}},[t._v("\n                                SSH User\n                                "),n("small",[n("a",{staticClass:"text-primary text-decoration-none",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.create=!t.create}}},[t.create?n("span",[t._v("Cancel")]):n("span",[n("i",{staticClass:"fas fa-plus"}),t._v(" Create\n                                        ")])])])]),t._v(" "),t.create?n("usercreate-component"):n("v-select",{attrs:{options:t.users},on:{search:t.getUsers},model:{value:t.ssh_user,callback:function(e){t.ssh_user=e},expression:"ssh_user"}}),t._v(" "),!t.create&&t.errors.user?n("p",{staticClass:"text-danger"},[t._v(t._s(t.errors.user[0]))]):t._e()],1):t._e(),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"name"}},[t._v("Database Name")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.name,expression:"name"}],staticClass:"form-control",class:{"is-invalid":t.errors.name},attrs:{id:"name",type:"text",placeholder:"Database name..."},domProps:{value:t.name},on:{input:function(e){e.target.composing||(t.name=e.target.value)}}}),t._v(" "),t.errors.name?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.name[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"username"}},[t._v("Database Username")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.username,expression:"username"}],staticClass:"form-control",class:{"is-invalid":t.errors.username},attrs:{id:"username",type:"text",placeholder:"Database username..."},domProps:{value:t.username},on:{input:function(e){e.target.composing||(t.username=e.target.value)}}}),t._v(" "),t.errors.username?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.username[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"password"}},[t._v("User Password")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.password,expression:"password"}],staticClass:"form-control",class:{"is-invalid":t.errors.password},attrs:{id:"password",type:"text",placeholder:"Database user password..."},domProps:{value:t.password},on:{input:function(e){e.target.composing||(t.password=e.target.value)}}}),t._v(" "),t.errors.password?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.password[0]))]):t._e()])])])]),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-primary",on:{click:function(e){return t.createDatabase()}}},[t._v("Create")])])])])])}),[],!1,null,null,null).exports},9263:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{database:!1,del:!1,reset:!1,change_php:!1,php_versions:[],del_dom:!1,new_domain:"",new_password:!1,add_dom:!1,errors:{}}},created:function(){this.getDatabase()},methods:{resetPassword:function(){var t=this;t.new_password=!1,t.$store.commit("setBusy",!0),axios.post("/databases/".concat(t.$route.params.id,"/reset-password/")).then((function(e){t.$store.commit("setBusy",!1),toastr.success("The password has been updated."),t.new_password=e.data.new_password,t.reset=!1})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("MySQL password cannot be updated.")}))},getDatabase:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/databases/".concat(t.$route.params.id,"/")).then((function(e){t.$store.commit("setBusy",!1),t.database=e.data})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("Cannot fetch the database data.")}))},deleteDatabase:function(){var t=this;t.$store.commit("setBusy",!0),axios.delete("/databases/".concat(t.$route.params.id,"/")).then((function(e){toastr.error("The database and associated data has been deleted."),t.$router.push({name:"databases"})})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("An error occured while trying to delete this database.")}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",[t._v("Manage Database: #"+t._s(t.$route.params.id))])])]),t._v(" "),t.database?n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-8"},[n("div",{staticClass:"card mb-3"},[n("div",{staticClass:"card-header bg-primary text-light"},[t._v("\n                        General Details\n                        "),n("a",{staticClass:"btn btn-sm btn-outline-warning float-right",attrs:{href:t.PMA_URL,target:"_blank"}},[n("i",{staticClass:"fas fa-database"}),t._v(" phpMyAdmin\n                        ")])]),t._v(" "),n("div",{staticClass:"card-body"},[t.new_password?n("p",[t._v("New password is "),n("small",{staticClass:"text-info font-weight-bold"},[t._v(t._s(t.new_password))]),t._v(" and it is shown to you this single time. "),n("a",{staticClass:"text-danger text-decoration-none",staticStyle:{"font-size":"14px"},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.new_password=!1}}},[n("i",{staticClass:"fas fa-times-circle"}),t._v(" Hide")])]):t._e(),t._v(" "),n("div",{staticClass:"responsive-table"},[n("table",{staticClass:"table table-borderless table-striped"},[n("tbody",[n("tr",[n("td",[t._v("Database Name")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.database.name)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",{staticStyle:{width:"40%"}},[t._v("Database User")]),t._v(" "),n("td",[n("span",[t._v(t._s(t.database.username))]),t._v(" "),n("small",[t.reset?t._e():n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!0}}},[n("i",{staticClass:"fas fa-redo"}),t._v(" Reset\n                                                    Password\n                                                ")]),t._v(" "),t.reset?n("a",{staticClass:"text-success",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!1}}},[t._v("\n                                                    Cancel\n                                                ")]):t._e(),t._v(" "),t.reset?n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.resetPassword()}}},[t._v("\n                                                    Reset\n                                                ")]):t._e()])])])])])])])]),t._v(" "),n("div",{staticClass:"card mt-3"},[n("div",{staticClass:"card-header text-light",class:{"bg-secondary":!t.del,"bg-danger":t.del}},[t._v("\n                        Danger Zone\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[t._v("\n                        Do you want to delete this database? You can do so here. Beware\n                        that this action is irreversible and you cannot undo once the\n                        database is deleted. Entire data associated to this database\n                        will be permanently lost.\n                    ")]),t._v(" "),n("div",{staticClass:"card-footer"},[t.del?t._e():n("button",{staticClass:"btn btn-secondary",on:{click:function(e){t.del=!0}}},[t._v("\n                            Delete Database\n                        ")]),t._v(" "),t.del?n("button",{staticClass:"btn btn-success",on:{click:function(e){t.del=!1}}},[t._v("\n                            Don't Delete\n                        ")]):t._e(),t._v(" "),t.del?n("button",{staticClass:"btn btn-danger",on:{click:function(e){return t.deleteDatabase()}}},[t._v("\n                            Confirm Deletion\n                        ")]):t._e()])])])]):t._e()])])}),[],!1,null,null,null).exports},3798:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={components:{DatabasesTable:n(5203).Z}};const a=(0,n(1900).Z)(r,(function(){var t=this.$createElement,e=this._self._c||t;return e("div",{staticClass:"row"},[e("databases-table",{attrs:{pagination:!0}})],1)}),[],!1,null,null,null).exports},5203:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={props:["pagination"],data:function(){return{data:!1}},created:function(){this.getDatabases(),"databases"==this.$route.name&&this.EventBus.$on("doSearch",this.getDatabases)},beforeDestroy:function(){this.EventBus.$off("doSearch",this.getDatabases)},methods:{getDatabases:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;if(null!=t){var e=document.getElementById("search-input").value,n=this;n.$store.commit("setBusy",!0),axios.get("/databases/?page=".concat(t,"&q=").concat(e)).then((function(t){n.data=t.data,n.$store.commit("setBusy",!1)})).catch((function(t){n.$store.commit("setBusy",!1)}))}}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",{staticClass:"float-left"},[t._v("Databases "),t.data?n("span",[t._v("("+t._s(t.data.count)+")")]):t._e()]),t._v(" "),t.pagination?n("router-link",{staticClass:"btn mb-1 btn-sm float-right btn-secondary",attrs:{to:{name:"createdb"}}},[n("i",{staticClass:"fas fa-plus"}),t._v(" Add New\n            ")]):n("router-link",{staticClass:"btn mb-1 btn-sm float-right btn-outline-primary",attrs:{to:{name:"databases"}}},[n("i",{staticClass:"fas fa-list"}),t._v(" All Databases\n            ")])],1)]),t._v(" "),t.data&&t.data.count>0?n("div",{staticClass:"table-responsive"},[n("table",{staticClass:"table table-bordered"},[t._m(0),t._v(" "),n("tbody",t._l(t.data.results,(function(e){return n("tr",{key:e.id},[n("td",[t._v(t._s(e.name))]),t._v(" "),n("td",[t._v(t._s(e.username))]),t._v(" "),n("td",[t._v("\n                        "+t._s(e.created)+"\n                    ")]),t._v(" "),n("td",{staticClass:"text-right"},[n("router-link",{staticClass:"btn btn-sm btn-outline-primary",attrs:{to:{name:"database",params:{id:e.id}}}},[n("i",{staticClass:"fas fa-cog"}),t._v(" Manage\n                        ")])],1)])})),0)])]):n("div",{staticClass:"text-muted mb-3 border border-muted rounded p-5 text-center"},[t._v("\n        No databases found.\n    ")]),t._v(" "),t.pagination&&t.data&&t.data.count>0?n("nav",{attrs:{"aria-label":"Database Pagination"}},[n("ul",{staticClass:"pagination float-right"},[n("li",{staticClass:"page-item",class:{disabled:!t.data.links.previous},on:{click:function(e){return t.getDatabases(t.data.links.previous)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Previous")])]),t._v(" "),n("li",{staticClass:"page-item",class:{disabled:!t.data.links.next},on:{click:function(e){return t.getDatabases(t.data.links.next)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Next")])])])]):t._e()])}),[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("thead",{staticClass:"bg-primary text-white"},[n("tr",[n("th",{staticStyle:{width:"30%"}},[t._v("Name")]),t._v(" "),n("th",{staticStyle:{width:"20%"}},[t._v("\n                        Username\n                    ")]),t._v(" "),n("th",{attrs:{colspan:"2"}},[t._v("\n                        Created\n                    ")])])])}],!1,null,null,null).exports},162:(t,e,n)=>{"use strict";n.d(e,{Z:()=>l});function r(t,e,n){return e in t?Object.defineProperty(t,e,{value:n,enumerable:!0,configurable:!0,writable:!0}):t[e]=n,t}const a={data:function(){var t;return r(t={files:!1,edit:!1,edit_content:"",remote_upl:"",remote_url:"",bad_file:!1,saving:!1,rename:!1,selected:[],move_selected:[],errors:{},selall:!1,del:!1,create:!1,itemname:"",itemtype:"file",extract:!1,move:!1,copy:!1},"rename",!1),r(t,"new_name",""),r(t,"old_name",""),r(t,"edit_permissions",""),r(t,"new_permissions",""),r(t,"web_root",""),r(t,"website_name",""),t},created:function(){this.getWebsite(),this.EventBus.$on("doSearch",this.getFiles)},beforeDestroy:function(){this.EventBus.$off("doSearch",this.getFiles)},methods:{browseSegment:function(t){if(!(t<4)){for(var e="",n=0;n<this.files.segments.length;n++)n<=t&&(e+="/".concat(this.files.segments[n][1]));this.$store.commit("setPath",e),this.getFiles()}},getWebsite:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/websites/".concat(t.$route.params.id,"/")).then((function(e){t.$store.commit("setBusy",!1),e.data&&e.data.metadata.path?(t.web_root=e.data.metadata.path,t.website_name=e.data.label,t.$store.commit("setPath",e.data.metadata.path),t.getFiles()):toastr.error("Website root path cannot be obtained.")})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("Files listing cannot be obtained.")}))},chooseFiles:function(){document.getElementById("fileUpload").click()},clearSelected:function(){this.selected=[],this.selall=!1},editFile:function(t){var e=this;e.edit=t,e.bad_file=!1,axios.get("/file-manager/file-manipulation/?path=".concat(t.path)).then((function(t){e.edit_content=t.data.content})).catch((function(t){e.bad_file=!0,e.edit_content="File manager cannot edit this file."}))},saveFile:function(){var t=this,e=new FormData;e.append("content",t.edit_content),e.append("path",t.edit.path),axios.put("/file-manager/file-manipulation/",e).then((function(e){toastr.success("File content has been updated."),t.saving=!1})).catch((function(e){toastr.error("Error occured. File cannot be saved."),t.saving=!1}))},getFiles:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;if(null!=t){var e=document.getElementById("search-input").value,n=this;n.$store.commit("setBusy",!0);var r=n.$store.state.path;null!=r&&(r=encodeURIComponent(r),axios.get("/file-manager/files/?page=".concat(t,"&search=").concat(e,"&path=").concat(r)).then((function(t){n.files=t.data,n.$store.commit("setBusy",!1)})).catch((function(t){toastr.error("Directory listing cannot be retrieved."),n.$store.commit("setBusy",!1)})))}},browseFile:function(t){"directory"==t.file_type&&(this.$store.commit("setPath",t.path),this.getFiles())},compressFiles:function(){var t=this;if(0!=t.selected.length){t.$store.commit("setBusy",!0);var e=new FormData;e.append("path",t.$store.state.path),e.append("paths",t.selected),axios.post("/file-manager/generate-archive/",e).then((function(e){toastr.info("The archive for selected files has been generated."),t.$store.commit("setBusy",!1),t.getFiles(),t.clearSelected()})).catch((function(e){toastr.error("Something went wrong. Archive cannot be generated."),t.$store.commit("setBusy",!1)}))}},goBack:function(){var t=this,e=t.$store.state.path;if(t.selected=[],t.selall=!1,e!=t.web_root){var n=e.substring(0,e.lastIndexOf("/"));t.$store.commit("setPath",n),t.getFiles()}},goHome:function(){this.$store.state.path!=this.web_root&&(this.$store.commit("setPath",this.web_root),this.getFiles(),this.clearSelected())},selectItem:function(t){this.selected.includes(t)?this.selected.splice(this.selected.indexOf(t),1):this.selected.push(t)},createItem:function(){var t=this,e=new FormData;e.append("item_name",t.itemname),e.append("item_type",t.itemtype),e.append("path",t.$store.state.path),t.$store.commit("setBusy",!0),axios.post("/file-manager/file-manipulation/",e).then((function(e){t.$store.commit("setBusy",!1),toastr.success("Iteam has been created successfully."),t.create=!1,t.itemname="",t.itemtype="file",t.getFiles()})).catch((function(e){e.response&&e.response.data.error?toastr.error(e.response.data.error):toastr.error("Item with this name cannot be created."),t.$store.commit("setBusy",!1)}))},deleteItems:function(){var t=this;if(0!=t.selected.length){t.$store.commit("setBusy",!0);var e=new FormData;e.append("paths",t.selected),axios.post("/file-manager/delete-items/",e).then((function(e){toastr.info("The selected items have been deleted."),t.$store.commit("setBusy",!1),t.del=!1,t.getFiles(),t.clearSelected()})).catch((function(e){toastr.error("Something went wrong. Files cannot be deleted."),t.$store.commit("setBusy",!1)}))}else t.del=!1},isZip:function(t){if(!t)return!1;if(1!=t.length)return!1;var e=t[0],n=!1;if(this.files&&this.files.results.length)for(var r=0;r<this.files.results.length;r++)e==this.files.results[r].path&&(n=this.files.results[r]);return!(!n||"zip"!=n.name.slice(-3))||void 0},extractArchive:function(){var t=this,e=t.selected[0];if(e){t.$store.commit("setBusy",!0);var n=new FormData;n.append("path",e),n.append("root_path",t.$store.state.path),axios.post("/file-manager/extract-archive/",n).then((function(e){toastr.info("The archive contents have been extracted."),t.$store.commit("setBusy",!1),t.extract=!1,t.getFiles(),t.clearSelected()})).catch((function(e){toastr.error("Something went wrong. Archive cannot be extracted."),t.$store.commit("setBusy",!1)}))}},handleFileUpload:function(){var t=this,e=this.$refs.file.files[0],n=new FormData;n.append("file",e),n.append("path",t.$store.state.path),t.$store.commit("setBusy",!0),axios.post("/file-manager/upload-files/",n,{headers:{"Content-Type":"multipart/form-data"}}).then((function(e){t.$store.commit("setBusy",!1),toastr.success("Files have been uploaded successfully."),document.getElementById("fileUpload").value="",t.getFiles()})).catch((function(e){t.$store.commit("setBusy",!1),e.response&&e.response.data.error?toastr.error(e.response.data.error):toastr.error("File upload failed. Maybe it already exists.")}))},validDestination:function(){return!this.move_selected.includes(this.$store.state.path)},moveItems:function(){var t=this;t.$store.commit("setBusy",!0);var e=new FormData;if(t.copy)var n="copy";else n="move";e.append("path",t.$store.state.path),e.append("paths",t.move_selected),e.append("action",n),axios.post("/file-manager/move-items/",e).then((function(e){if(t.$store.commit("setBusy",!1),t.copy)var n="copied";else n="moved";toastr.success("Items have been ".concat(n," successfully.")),t.move=!1,t.copy=!1,t.selected=[],t.move_selected=[],t.getFiles()})).catch((function(e){toastr.error("An error occured while moving some items."),t.$store.commit("setBusy",!1)}))},prepareRename:function(){if(1==this.selected.length){this.rename=this.selected[0];for(var t=0;t<this.files.results.length;t++)this.files.results[t].path==this.rename&&(this.old_name=this.files.results[t].name,this.new_name=this.old_name)}},renameItem:function(){var t=this,e=new FormData;e.append("path",t.$store.state.path),e.append("new_name",t.new_name),e.append("old_name",t.old_name),t.$store.commit("setBusy",!0),axios.post("/file-manager/rename-item/",e).then((function(e){toastr.success("The item has been renamed successfully."),t.getFiles(),t.rename=!1,t.new_name="",t.old_name="",t.$store.commit("setBusy",!1)})).catch((function(e){toastr.error("Error occured, the item cannot be renamed."),t.$store.commit("setBusy",!1)}))},updatePermissions:function(){var t=this,e=new FormData;e.append("path",t.edit_permissions),e.append("permissions",t.new_permissions),axios.post("/file-manager/update-permissions/",e).then((function(e){t.new_permissions="",t.edit_permissions="",t.getFiles(),toastr.success("Permissions have been successfully updated.")})).catch((function(t){toastr.error("Permissions cannot be updated for this item.")}))},fetchRemote:function(){var t=this;t.errors={};var e=new FormData;e.append("path",t.$store.state.path),e.append("remote_url",t.remote_url),t.$store.commit("setBusy",!0),axios.post("/file-manager/remote-fetch/",e).then((function(e){t.$store.commit("setBusy",!1),t.remote_url="",t.remote_upl=!1,toastr.success("Remote file has been successfully downloaded."),t.getFiles()})).catch((function(e){toastr.error("Remote file cannot be downloaded."),e.response&&e.response.data&&(t.errors=e.response.data),t.$store.commit("setBusy",!1)}))}},watch:{selall:function(t,e){var n=[];if(1==t&&this.files&&this.files.results.length)for(var r=0;r<this.files.results.length;r++)n.push(this.files.results[r].path);this.selected=n}}};var s=n(3379),i=n.n(s),o=n(5476),c={insert:"head",singleton:!1};i()(o.Z,c);o.Z.locals;const l=(0,n(1900).Z)(a,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return t.files?n("div",{staticClass:"row"},[n("div",{staticClass:"col-12 mb-2"},[n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("h4",[t._v("File Manager ("+t._s(t.files.count)+")")]),t._v(" "),t.files.segments.length?n("p",{staticClass:"d-block"},[t._v("\n                    Browsing: ~/apps"),t._l(t.files.segments,(function(e){return e[0]>3?n("a",{key:e[0],staticClass:"text-info",attrs:{href:"javascript:void(0)"},on:{click:function(n){return t.browseSegment(e[0])}}},[t._v("/"+t._s(e[1]))]):t._e()}))],2):t._e(),t._v(" "),(t.move||t.copy)&&t.move_selected.length>0?n("p",{staticClass:"border p-1 file-toolbar rounded"},[t._v("\n                    You are going to "),t.move?n("span",[t._v("move")]):n("span",[t._v("copy")]),t._v(" "+t._s(t.move_selected.length)+" "),1==t.move_selected.length?n("span",[t._v("item")]):n("span",[t._v("items")]),t._v(". Now go to the destination directory and click confirm.\n                    "),n("button",{staticClass:"btn btn-sm btn-danger",attrs:{disabled:!t.validDestination()},on:{click:function(e){return t.moveItems()}}},[t._v("Confirm")]),t._v(" "),n("button",{staticClass:"btn btn-sm btn-primary",on:{click:function(e){t.move_selected=[],t.move=!1}}},[t._v("Cancel")])]):t._e(),t._v(" "),t.del?n("p",{staticClass:"border p-1 file-toolbar rounded"},[t._v("\n                    Do you really want to permanently delete the selected\n                    "+t._s(t.selected.length)+" "),1==t.selected.length?n("span",[t._v("item")]):n("span",[t._v("items")]),t._v("?\n                    "),n("a",{staticClass:"text-success mr-1",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.del=!1,t.confirm=!1}}},[t._v("Cancel")]),t._v(" "),n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.deleteItems()}}},[t._v("Confirm")])]):t.extract?n("p",{staticClass:"border p-1 file-toolbar rounded"},[t._v("\n                    Do you really want to extract the selected archive's content in\n                    the current directory?\n                    "),n("a",{staticClass:"text-success mr-1",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.del=!1,t.confirm=!1}}},[t._v("Cancel")]),t._v(" "),n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.extractArchive()}}},[t._v("Confirm")])]):t.create?n("p",[n("input",{directives:[{name:"model",rawName:"v-model",value:t.itemname,expression:"itemname"}],attrs:{type:"text",placeholder:"Name"},domProps:{value:t.itemname},on:{input:function(e){e.target.composing||(t.itemname=e.target.value)}}}),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model",value:t.itemtype,expression:"itemtype"}],on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,(function(t){return t.selected})).map((function(t){return"_value"in t?t._value:t.value}));t.itemtype=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:"file"}},[t._v("File")]),t._v(" "),n("option",{attrs:{value:"directory"}},[t._v("Directory")])]),t._v(" "),n("button",{staticClass:"btn btn-info btn-sm",attrs:{disabled:!t.itemname},on:{click:function(e){return t.createItem()}}},[t._v("\n                        Create\n                    ")]),t._v(" "),n("button",{staticClass:"btn btn-warning btn-sm",on:{click:function(e){t.create=!1}}},[t._v("\n                        Cancel\n                    ")])]):t.remote_upl?n("p",[n("input",{directives:[{name:"model",rawName:"v-model",value:t.remote_url,expression:"remote_url"}],staticStyle:{"max-width":"inherit",width:"80%"},attrs:{type:"url",placeholder:"Enter a remote public URL to download any file over your server network."},domProps:{value:t.remote_url},on:{input:function(e){e.target.composing||(t.remote_url=e.target.value)}}}),t._v(" "),n("button",{staticClass:"btn btn-info btn-sm",attrs:{disabled:!t.remote_url},on:{click:function(e){return t.fetchRemote()}}},[t._v("\n                        Fetch\n                    ")]),t._v(" "),n("button",{staticClass:"btn btn-warning btn-sm",on:{click:function(e){t.remote_upl=!1}}},[t._v("\n                        Cancel\n                    ")]),t._v(" "),t.errors.remote_url?n("small",{staticClass:"text-danger d-block mt-0"},[t._v(t._s(t.errors.remote_url[0]))]):t._e()]):t._e(),t._v(" "),n("p",{staticClass:"border mb-1 mt-1 file-toolbar rounded"},[n("nav",{staticClass:"navbar navbar-expand-lg navbar-light bg-light"},[t._m(0),t._v(" "),n("div",{staticClass:"collapse navbar-collapse",attrs:{id:"navbarNavAltMarkup"}},[n("div",{staticClass:"navbar-nav"},[n("a",{staticClass:"nav-item nav-link",class:{disabled:t.$store.state.path==t.web_root},attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.goHome()}}},[n("i",{staticClass:"fas fa-home"}),t._v(" Home\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:t.$store.state.path==t.web_root},attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.goBack()}}},[n("i",{staticClass:"fas fa-arrow-left"}),t._v(" Back\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.chooseFiles()}}},[n("i",{staticClass:"fas fa-upload"}),t._v(" Upload\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.remote_upl=!t.remote_upl}}},[n("i",{staticClass:"fas fa-globe"}),t._v(" Remote Fetch\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.create=!0}}},[n("i",{staticClass:"fas fa-plus"}),t._v(" Create\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.getFiles()}}},[n("i",{staticClass:"fas fa-redo"}),t._v(" Refresh\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:0==t.selected.length},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.selected.length>0&&(t.move=!0,t.copy=!1,t.move_selected=t.selected,t.selall=!1,t.selected=[])}}},[n("i",{staticClass:"fas fa-arrows-alt"}),t._v(" Move\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:0==t.selected.length},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.selected.length>0&&(t.copy=!0,t.move=!1,t.move_selected=t.selected,t.selall=!1,t.selected=[])}}},[n("i",{staticClass:"fas fa-copy"}),t._v(" Copy\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:1!=t.selected.length},attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.prepareRename()}}},[n("i",{staticClass:"fas fa-retweet"}),t._v(" Rename\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:0==t.selected.length},attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.compressFiles()}}},[n("i",{staticClass:"fas fa-archive"}),t._v(" Compress\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:!t.isZip(t.selected)},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.selected.length>0&&(t.extract=!0)}}},[n("i",{staticClass:"fas fa-file-archive"}),t._v(" Extract\n                                ")]),t._v(" "),n("a",{staticClass:"nav-item nav-link",class:{disabled:0==t.selected.length},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.selected.length>0&&(t.del=!0)}}},[n("i",{staticClass:"fas fa-trash"}),t._v(" Delete\n                                ")])])])])])])])]),t._v(" "),n("div",{staticClass:"col-12"},[n("div",{staticClass:"table-responsive"},[t.files.count>0?n("table",{staticClass:"table table-striped"},[n("thead",{staticClass:"bg-primary text-white"},[n("tr",[t.move_selected.length?t._e():n("th",{staticStyle:{width:"2%"}},[n("input",{directives:[{name:"model",rawName:"v-model",value:t.selall,expression:"selall"}],attrs:{type:"checkbox"},domProps:{checked:Array.isArray(t.selall)?t._i(t.selall,null)>-1:t.selall},on:{change:function(e){var n=t.selall,r=e.target,a=!!r.checked;if(Array.isArray(n)){var s=t._i(n,null);r.checked?s<0&&(t.selall=n.concat([null])):s>-1&&(t.selall=n.slice(0,s).concat(n.slice(s+1)))}else t.selall=a}}})]),t._v(" "),n("th",{staticStyle:{width:"25%"}},[t._v("File name")]),t._v(" "),n("th",{staticStyle:{width:"10%"}},[t._v("Size")]),t._v(" "),n("th",{staticStyle:{width:"25%"}},[t._v("Permissions")]),t._v(" "),n("th",{attrs:{colspan:"2"}},[t._v("Modified")])])]),t._v(" "),n("tbody",t._l(t.files.results,(function(e){return n("tr",{key:e.path},[t.move_selected.length?t._e():n("td",[n("input",{attrs:{type:"checkbox"},domProps:{checked:t.selected.includes(e.path)},on:{click:function(n){return t.selectItem(e.path)}}})]),t._v(" "),n("td",[t.rename==e.path?n("span",[n("input",{directives:[{name:"model",rawName:"v-model",value:t.new_name,expression:"new_name"}],attrs:{type:"text"},domProps:{value:t.new_name},on:{input:function(e){e.target.composing||(t.new_name=e.target.value)}}}),t._v(" "),n("button",{staticClass:"btn btn-warning btn-sm",on:{click:function(e){return t.renameItem()}}},[t._v("Save")]),t._v(" "),n("button",{staticClass:"btn btn-sm btn-primary",on:{click:function(e){t.rename=!1,t.selected=[]}}},[t._v("Cancel")])]):n("span",{on:{click:function(n){return t.browseFile(e)}}},["directory"==e.file_type?n("i",{staticClass:"fas text-warning fa-folder"}):n("i",{staticClass:"fas fa-file"}),t._v(" "+t._s(e.name)+"\n                            ")])]),t._v(" "),n("td",[t._v(t._s(t._f("prettyBytes")(e.size)))]),t._v(" "),n("td",[t.edit_permissions==e.path?n("span",[n("input",{directives:[{name:"model",rawName:"v-model",value:t.new_permissions,expression:"new_permissions"}],attrs:{type:"text"},domProps:{value:t.new_permissions},on:{input:function(e){e.target.composing||(t.new_permissions=e.target.value)}}}),t._v(" "),n("button",{staticClass:"btn btn-warning btn-sm",on:{click:function(e){return t.updatePermissions()}}},[t._v("Save")]),t._v(" "),n("button",{staticClass:"btn btn-sm btn-primary",on:{click:function(e){t.edit_permissions=!1,t.new_permissions=""}}},[t._v("Cancel")])]):n("span",{on:{click:function(n){t.edit_permissions=e.path,t.new_permissions=e.permissions}}},[t._v("\n                                "+t._s(e.permissions)+"\n                            ")])]),t._v(" "),n("td",[t._v(t._s(e.modified))]),t._v(" "),n("td",{staticClass:"text-right"},["file"==e.file_type?n("button",{staticClass:"btn btn-sm btn-outline-info",attrs:{"data-toggle":"modal","data-target":"#fileEditModal"},on:{click:function(n){return t.editFile(e)}}},[n("i",{staticClass:"fas fa-edit"})]):t._e(),t._v(" "),"file"==e.file_type?n("a",{staticClass:"btn btn-sm btn-outline-primary",attrs:{href:t.BASE_PATH+"dashboard/download-file/?path="+encodeURIComponent(e.path),target:"_blank",rel:"nofollow noopener"}},[n("i",{staticClass:"fas fa-download"})]):t._e()])])})),0)]):n("div",{staticClass:"text-muted mb-3 border border-muted rounded p-5 text-center"},[t._v("\n                No files or directories found here.\n                "),n("a",{staticClass:"text-decoration-none",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.create=!0}}},[t._v("\n                    Create \n                ")]),t._v(" a file or a directory or\n                "),n("a",{staticClass:"text-decoration-none",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.chooseFiles()}}},[t._v("\n                    upload \n                ")]),t._v(" files.\n            ")]),t._v(" "),t.files&&t.files.count>0?n("nav",{attrs:{"aria-label":"Pagination"}},[n("ul",{staticClass:"pagination float-right"},[n("li",{staticClass:"page-item",class:{disabled:!t.files.links.previous},on:{click:function(e){return t.getFiles(t.files.links.previous)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Previous")])]),t._v(" "),n("li",{staticClass:"page-item",class:{disabled:!t.files.links.next},on:{click:function(e){return t.getFiles(t.files.links.next)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Next")])])])]):t._e()])]),t._v(" "),n("div",{staticClass:"modal fade",attrs:{id:"fileEditModal",tabindex:"-1",role:"dialog","aria-labelledby":"fileEditModal","aria-hidden":"true"}},[n("div",{staticClass:"modal-dialog modal-lg",attrs:{role:"document"}},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[n("h5",{staticClass:"modal-title",attrs:{id:"editLabel"}},[t._v("Edit "+t._s(t.edit.name))]),t._v(" "),t._m(1)]),t._v(" "),n("div",{staticClass:"modal-body"},[n("textarea",{directives:[{name:"model",rawName:"v-model",value:t.edit_content,expression:"edit_content"}],staticClass:"form-control",attrs:{disabled:t.bad_file,rows:"20"},domProps:{value:t.edit_content},on:{input:function(e){e.target.composing||(t.edit_content=e.target.value)}}})]),t._v(" "),n("div",{staticClass:"modal-footer"},[n("button",{staticClass:"btn btn-secondary",attrs:{type:"button","data-dismiss":"modal"}},[t._v("\n                        Close\n                    ")]),t._v(" "),n("button",{staticClass:"btn btn-primary",attrs:{disabled:t.bad_file||t.saving,type:"button"},on:{click:function(e){return t.saveFile()}}},[t._v("\n                        Save Changes\n                    ")])])])])]),t._v(" "),n("input",{ref:"file",attrs:{id:"fileUpload",type:"file",hidden:""},on:{change:function(e){return t.handleFileUpload()}}})]):t._e()}),[function(){var t=this.$createElement,e=this._self._c||t;return e("button",{staticClass:"navbar-toggler",attrs:{type:"button","data-toggle":"collapse","data-target":"#navbarNavAltMarkup","aria-controls":"navbarNavAltMarkup","aria-expanded":"false","aria-label":"Toggle navigation"}},[e("span",{staticClass:"navbar-toggler-icon"})])},function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],!1,null,"67504978",null).exports},6116:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{ssh_user:"",user_pass:"",errors:{}}},created:function(){this.user_pass=this.genRandPassword()},methods:{createUser:function(){var t=this;t.$store.commit("setBusy",!0);var e=new FormData;e.append("username",t.ssh_user),e.append("password",t.user_pass),axios.post("/ssh-users/",e).then((function(e){toastr.success("SSH user has been created successfully."),t.$store.commit("setBusy",!1),t.EventBus.$emit("userCreated",e.data.username)})).catch((function(e){t.$store.commit("setBusy",!1),t.errors=e.response.data}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"input-group"},[n("input",{directives:[{name:"model",rawName:"v-model",value:t.ssh_user,expression:"ssh_user"}],staticClass:"form-control",class:{"is-invalid":t.errors.username},attrs:{placeholder:"Username",type:"text"},domProps:{value:t.ssh_user},on:{input:function(e){e.target.composing||(t.ssh_user=e.target.value)}}}),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.user_pass,expression:"user_pass"}],staticClass:"form-control",class:{"is-invalid":t.errors.password},attrs:{type:"text",placeholder:"Password"},domProps:{value:t.user_pass},on:{input:function(e){e.target.composing||(t.user_pass=e.target.value)}}}),t._v(" "),n("div",{staticClass:"input-group-append"},[n("button",{staticClass:"btn",class:{"btn-outline-secondary":!t.errors.username,"btn-outline-danger":t.errors.username},attrs:{type:"button"},on:{click:function(e){return t.createUser()}}},[t._v("\n            Creat User\n        ")])]),t._v(" "),t.errors.username?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.username[0]))]):t._e()])}),[],!1,null,null,null).exports},9062:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{info:!1}},mounted:function(){this.getHardwareInfo()},methods:{getHardwareInfo:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/stats/hardware/").then((function(e){t.$store.commit("setBusy",!1),t.info=e.data})).catch((function(e){t.$store.commit("setBusy",!1)}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return t.info?n("div",{staticClass:"row"},[t._m(0),t._v(" "),n("div",{staticClass:"col-md-12"},[n("div",{staticClass:"table-responsive"},[n("table",{staticClass:"table table-striped table-bordered"},[n("tbody",[n("tr",[n("td",{staticStyle:{width:"40%"}},[t._v("CPU Cores")]),t._v(" "),n("td",[t._v(t._s(t.info.cpu.physical)+" physical "),n("span",{staticClass:"badge badge-secondary"},[t._v(t._s(t.info.cpu.logical)+" virtual")])])]),t._v(" "),n("tr",[n("td",[t._v("CPU Load")]),t._v(" "),n("td",[t._v(t._s(t._f("floatformat")((t.info.cpu.load[0]+t.info.cpu.load[1]+t.info.cpu.load[2])/3/t.info.cpu.logical*100))+"%")])]),t._v(" "),n("tr",[n("td",[t._v("RAM")]),t._v(" "),n("td",[t._v(t._s(t._f("prettyBytes")(t.info.ram.memory.total))+" "),n("span",{staticClass:"badge badge-secondary"},[t._v(t._s(t._f("floatformat")(t.info.ram.memory.percent))+"% used")])])]),t._v(" "),n("tr",[n("td",[t._v("Swap")]),t._v(" "),n("td",[t._v(t._s(t._f("prettyBytes")(t.info.ram.swap.total))+" "),n("span",{staticClass:"badge badge-secondary"},[t._v(t._s(t._f("floatformat")(t.info.ram.swap.percent))+"% used")])])]),t._v(" "),n("tr",[n("td",[t._v("Storage")]),t._v(" "),n("td",[t._v(t._s(t._f("prettyBytes")(t.info.disk.total))+" "),n("span",{staticClass:"badge badge-secondary"},[t._v(t._s(t._f("floatformat")(t.info.disk.percent))+"% used")])])]),t._v(" "),n("tr",[n("td",[t._v("Uptime")]),t._v(" "),n("td",[n("span",{staticClass:"badge badge-secondary"},[t._v(t._s(t.info.uptime))])])])])])])])]):t._e()}),[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"col-12"},[n("h4",{staticClass:"float-left"},[t._v("Hardware Details")])])}],!1,null,null,null).exports},5650:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{errors:{},username:"",max_sites:10,max_dbs:10,max_storage:"",is_active:!0,password:""}},created:function(){this.password=this.genRandPassword()},methods:{createUser:function(){var t=this;t.$store.commit("setBusy",!0);var e=new FormData;e.append("username",t.username),e.append("password",t.password),e.append("max_sites",t.max_sites),e.append("max_dbs",t.max_dbs),e.append("max_storage",t.max_storage),e.append("is_active",t.is_active),axios.post("/ssh-users/",e).then((function(e){toastr.success("SSH user has been created successfully."),t.$store.commit("setBusy",!1),t.$router.push({name:"users"})})).catch((function(e){t.$store.commit("setBusy",!1),e.response&&(t.errors=e.response.data),toastr.error("SSH user cannot be created.")}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"card"},[n("div",{staticClass:"card-header"},[n("h4",{staticClass:"float-left mt-1"},[t._v("Create User")]),t._v(" "),n("router-link",{staticClass:"btn mt-1 btn-sm float-right btn-warning",attrs:{to:{name:"users"}}},[n("i",{staticClass:"fas fa-times"}),t._v(" Cancel\n                ")])],1),t._v(" "),n("div",{staticClass:"card-body"},[n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-6"},[n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"username"}},[t._v("Username")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.username,expression:"username"}],staticClass:"form-control",class:{"is-invalid":t.errors.username},attrs:{id:"username",type:"text",placeholder:"SSH username"},domProps:{value:t.username},on:{input:function(e){e.target.composing||(t.username=e.target.value)}}}),t._v(" "),t.errors.username?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.username[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"password"}},[t._v("Password")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.password,expression:"password"}],staticClass:"form-control",class:{"is-invalid":t.errors.password},attrs:{id:"password",type:"text",placeholder:"SSH & control panel login password"},domProps:{value:t.password},on:{input:function(e){e.target.composing||(t.password=e.target.value)}}}),t._v(" "),t.errors.password?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.password[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Websites")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.max_sites,expression:"max_sites"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_sites},attrs:{id:"max_sites",type:"text",placeholder:"Max. websites allowed..."},domProps:{value:t.max_sites},on:{input:function(e){e.target.composing||(t.max_sites=e.target.value)}}}),t._v(" "),t.errors.max_sites?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_sites[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Databases")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.max_dbs,expression:"max_dbs"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_dbs},attrs:{id:"max_dbs",type:"text",placeholder:"Max. websites allowed..."},domProps:{value:t.max_dbs},on:{input:function(e){e.target.composing||(t.max_dbs=e.target.value)}}}),t._v(" "),t.errors.max_dbs?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_dbs[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Storage")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.max_storage,expression:"max_storage"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_storage},attrs:{id:"max_dbs",type:"text",placeholder:"Max. storage allowed in bytes..."},domProps:{value:t.max_storage},on:{input:function(e){e.target.composing||(t.max_storage=e.target.value)}}}),t._v(" "),t.errors.max_storage?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_storage[0]))]):t._e()])])])]),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-primary",on:{click:function(e){return t.createUser()}}},[t._v("Create User")])])])])])}),[],!1,null,null,null).exports},5002:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{user:!1,del:!1,reset:!1,errors:{},new_password:!1}},created:function(){this.getUser()},methods:{saveSettings:function(){var t=this;t.$store.commit("setBusy",!0);var e=new FormData;e.append("is_active",t.user.is_active),e.append("max_dbs",t.user.max_dbs),e.append("max_sites",t.user.max_sites),e.append("max_storage",t.user.max_storage),axios.patch("/ssh-users/".concat(t.user.id,"/"),e).then((function(e){t.$store.commit("setBusy",!1),t.getUser(),toastr.success("User settings have been updated.")})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("User settings cannot be updated.")}))},resetPassword:function(){var t=this;t.$store.commit("setBusy",!0),t.new_password=!1,axios.post("/ssh-users/".concat(t.$route.params.id,"/reset-password/")).then((function(e){t.$store.commit("setBusy",!1),toastr.success("SSH/SFTP password has been updated."),t.new_password=e.data.new_password,t.reset=!1})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("SSH/SFTP password cannot be updated.")}))},getUser:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/ssh-users/".concat(t.$route.params.id,"/")).then((function(e){t.$store.commit("setBusy",!1),t.user=e.data})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("Cannot fetch the user data.")}))},deleteUser:function(){var t=this;t.$store.commit("setBusy",!0),axios.delete("/ssh-users/".concat(t.$route.params.id,"/")).then((function(e){toastr.error("The user and associated data has been deleted."),t.$router.push({name:"users"})})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("An error occured while trying to delete this user.")}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return t.user?n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",[t._v("Manage User: "+t._s(t.user.username))])])]),t._v(" "),t.user?n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-8"},[n("div",{staticClass:"card mb-3"},[n("div",{staticClass:"card-header bg-primary text-light"},[t._v("\n                        General Details\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[t.new_password?n("p",[t._v("New password is "),n("small",{staticClass:"text-info font-weight-bold"},[t._v(t._s(t.new_password))]),t._v(" and it is shown to you this single time. "),n("a",{staticClass:"text-danger text-decoration-none",staticStyle:{"font-size":"14px"},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.new_password=!1}}},[n("i",{staticClass:"fas fa-times-circle"}),t._v(" Hide")])]):t._e(),t._v(" "),n("div",{staticClass:"responsive-table"},[n("table",{staticClass:"table table-borderless table-striped"},[n("tbody",[n("tr",[n("td",{staticStyle:{width:"40%"}},[t._v("User Name")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.user.username)+"\n                                            "),n("small",[t.reset?t._e():n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!0}}},[n("i",{staticClass:"fas fa-redo"}),t._v(" Reset\n                                                    Password\n                                                ")]),t._v(" "),t.reset?n("a",{staticClass:"text-success",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!1}}},[t._v("\n                                                    Cancel\n                                                ")]):t._e(),t._v(" "),t.reset?n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.resetPassword()}}},[t._v("\n                                                    Reset\n                                                ")]):t._e()])])]),t._v(" "),n("tr",[n("td",[t._v("Unix ID")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.user.uid)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",[t._v("Status")]),t._v(" "),n("td",[t.user.is_active?n("span",{staticClass:"text-success"},[n("i",{staticClass:"fas fa-check-circle"}),t._v(" Active\n                                            ")]):n("span",{staticClass:"text-danger"},[n("i",{staticClass:"fas fa-times-circle"}),t._v(" Suspended\n                                            ")])])]),t._v(" "),n("tr",[n("td",[t._v("Websites")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.user.total_sites)+"/"+t._s(t.user.max_sites)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",[t._v("Databases")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.user.total_dbs)+"/"+t._s(t.user.max_dbs)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",[t._v("Storage Used")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t._f("prettyBytes")(t.user.storage_used))+"/"+t._s(t._f("prettyBytes")(t.user.max_storage))+"\n                                        ")])])])])])])]),t._v(" "),n("div",{staticClass:"card mt-3"},[n("div",{staticClass:"card-header bg-primary text-light"},[t._v("\n                        Updated User\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-6"},[n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("User Status")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model",value:t.user.is_active,expression:"user.is_active"}],staticClass:"form-control",class:{"is-invalid":t.errors.is_active},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,(function(t){return t.selected})).map((function(t){return"_value"in t?t._value:t.value}));t.$set(t.user,"is_active",e.target.multiple?n:n[0])}}},[n("option",{domProps:{value:!0}},[t._v("Active")]),t._v(" "),n("option",{domProps:{value:!1}},[t._v("Suspended")])]),t._v(" "),t.errors.is_active?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.is_active[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Websites")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.user.max_sites,expression:"user.max_sites"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_sites},attrs:{id:"max_sites",type:"text",placeholder:"Max. websites allowed..."},domProps:{value:t.user.max_sites},on:{input:function(e){e.target.composing||t.$set(t.user,"max_sites",e.target.value)}}}),t._v(" "),t.errors.max_sites?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_sites[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Databases")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.user.max_dbs,expression:"user.max_dbs"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_dbs},attrs:{id:"max_dbs",type:"text",placeholder:"Max. websites allowed..."},domProps:{value:t.user.max_dbs},on:{input:function(e){e.target.composing||t.$set(t.user,"max_dbs",e.target.value)}}}),t._v(" "),t.errors.max_dbs?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_dbs[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"max_sites"}},[t._v("Max. Storage")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.user.max_storage,expression:"user.max_storage"}],staticClass:"form-control",class:{"is-invalid":t.errors.max_storage},attrs:{id:"max_dbs",type:"text",placeholder:"Max. storage allowed in bytes..."},domProps:{value:t.user.max_storage},on:{input:function(e){e.target.composing||t.$set(t.user,"max_storage",e.target.value)}}}),t._v(" "),t.errors.max_storage?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.max_storage[0]))]):t._e()])])])]),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-primary",on:{click:function(e){return t.saveSettings()}}},[t._v("Save Settings")])])]),t._v(" "),n("div",{staticClass:"card mt-3"},[n("div",{staticClass:"card-header text-light",class:{"bg-secondary":!t.del,"bg-danger":t.del}},[t._v("\n                        Danger Zone\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[t._v("\n                        Do you want to delete this user? You can do so here. Beware\n                        that this action is irreversible and you cannot undo once the\n                        user is deleted. Entire data including websites and databases associated to this user\n                        will be permanently deleted.\n                    ")]),t._v(" "),n("div",{staticClass:"card-footer"},[t.del?t._e():n("button",{staticClass:"btn btn-secondary",on:{click:function(e){t.del=!0}}},[t._v("\n                            Delete User\n                        ")]),t._v(" "),t.del?n("button",{staticClass:"btn btn-success",on:{click:function(e){t.del=!1}}},[t._v("\n                            Don't Delete\n                        ")]):t._e(),t._v(" "),t.del?n("button",{staticClass:"btn btn-danger",on:{click:function(e){return t.deleteUser()}}},[t._v("\n                            Confirm Deletion\n                        ")]):t._e()])])])]):t._e()])]):t._e()}),[],!1,null,null,null).exports},1220:(t,e,n)=>{"use strict";n.d(e,{Z:()=>i});const r={props:["pagination"],data:function(){return{data:!1}},created:function(){this.getUsers(),"users"==this.$route.name&&this.EventBus.$on("doSearch",this.getUsers)},beforeDestroy:function(){this.EventBus.$off("doSearch",this.getUsers)},methods:{getUsers:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;if(null!=t){var e=document.getElementById("search-input").value,n=this;n.$store.commit("setBusy",!0),axios.get("/ssh-users/?page=".concat(t,"&q=").concat(e)).then((function(t){n.data=t.data,n.$store.commit("setBusy",!1)})).catch((function(t){n.$store.commit("setBusy",!1)}))}}}};var a=n(1900);const s={components:{UsersTable:(0,a.Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",{staticClass:"float-left"},[t._v("SSH/SFTP Users "),t.data?n("span",[t._v("("+t._s(t.data.count)+")")]):t._e()]),t._v(" "),t.pagination?n("router-link",{staticClass:"btn mb-1 btn-sm float-right btn-secondary",attrs:{to:{name:"createuser"}}},[n("i",{staticClass:"fas fa-plus"}),t._v(" Add New\n            ")]):t._e()],1)]),t._v(" "),t.data&&t.data.count>0?n("div",{staticClass:"table-responsive"},[n("table",{staticClass:"table table-bordered"},[t._m(0),t._v(" "),n("tbody",t._l(t.data.results,(function(e){return n("tr",{key:e.id},[n("td",[t._v(t._s(e.username)),e.uid?n("span",{staticClass:"font-weight-bold text-secondary"},[t._v(" ("+t._s(e.uid)+")")]):t._e()]),t._v(" "),n("td",[t._v(t._s(e.total_dbs)+"/"+t._s(e.max_dbs))]),t._v(" "),n("td",[t._v(t._s(e.total_sites)+"/"+t._s(e.max_sites))]),t._v(" "),n("td",[e.is_active?n("span",{staticClass:"text-success"},[n("i",{staticClass:"fas fa-check-circle"}),t._v(" Active\n                        ")]):n("span",{staticClass:"text-danger"},[n("i",{staticClass:"fas fa-times-circle"}),t._v(" Suspended\n                        ")])]),t._v(" "),n("td",[t._v("\n                        "+t._s(e.date_joined)+"\n                    ")]),t._v(" "),n("td",{staticClass:"text-right"},[n("router-link",{staticClass:"btn btn-sm btn-outline-primary",attrs:{to:{name:"user",params:{id:e.id}}}},[n("i",{staticClass:"fas fa-cog"}),t._v(" Settings\n                        ")])],1)])})),0)])]):n("div",{staticClass:"text-muted mb-3 border border-muted rounded p-5 text-center"},[t._v("\n        No users found.\n    ")]),t._v(" "),t.pagination&&t.data&&t.data.count>0?n("nav",{attrs:{"aria-label":"User Pagination"}},[n("ul",{staticClass:"pagination float-right"},[n("li",{staticClass:"page-item",class:{disabled:!t.data.links.previous},on:{click:function(e){return t.getUsers(t.data.links.previous)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Previous")])]),t._v(" "),n("li",{staticClass:"page-item",class:{disabled:!t.data.links.next},on:{click:function(e){return t.getUsers(t.data.links.next)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Next")])])])]):t._e()])}),[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("thead",{staticClass:"bg-primary text-white"},[n("tr",[n("th",{staticStyle:{width:"20%"}},[t._v("\n                        Username & ID\n                    ")]),t._v(" "),n("th",[t._v("\n                        Databases\n                    ")]),t._v(" "),n("th",[t._v("\n                        Websites\n                    ")]),t._v(" "),n("th",[t._v("\n                        Status\n                    ")]),t._v(" "),n("th",{attrs:{colspan:"2"}},[t._v("\n                        Created\n                    ")])])])}],!1,null,null,null).exports}};const i=(0,a.Z)(s,(function(){var t=this.$createElement,e=this._self._c||t;return e("div",{staticClass:"row"},[e("users-table",{attrs:{pagination:!0}})],1)}),[],!1,null,null,null).exports},1001:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{label:"",php:"",php_versions:[],domains:"",errors:{},users:[],ssh_user:"",create:!1,website_type:"blank",wpuser:"admin",email:"",password:""}},created:function(){this.getPhpVersions(),this.$store.state.user&&this.$store.state.user.is_root&&this.getUsers(),this.EventBus.$on("userCreated",this.handleUserCreated)},methods:{handleUserCreated:function(t){this.create=!1,this.ssh_user=t,this.getUsers()},getUsers:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"",e=arguments.length>1&&void 0!==arguments[1]&&arguments[1],n=this;n.errors={},e&&e(!0),n.$store.commit("setBusy",!0),axios.get("/ssh-users/?q=".concat(t)).then((function(t){n.$store.commit("setBusy",!1);for(var r=[],a=0;a<t.data.results.length;a++)r.push(t.data.results[a].username);e&&e(!1),n.users=r})).catch((function(t){n.$store.commit("setBusy",!1),toastr.error("SSH users list cannot be obtained."),e&&e(!1)}))},getPhpVersions:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/websites/php-versions/").then((function(e){t.php_versions=e.data.php_versions,t.php=t.php_versions[0],t.$store.commit("setBusy",!1)})).catch((function(e){toastr.error("Supported PHP versions list cannot be retrieved."),t.$store.commit("setBusy",!1)}))},createWebsite:function(){var t=this;t.errors={},t.$store.commit("setBusy",!0);var e=new FormData;e.append("label",t.label),e.append("php",t.php),e.append("ssh_user",t.ssh_user),e.append("domains",t.domains),e.append("website_type",t.website_type),axios.post("/websites/",e).then((function(e){t.$store.commit("setBusy",!1),toastr.success("Website has been added successfully."),t.$router.push({name:"websites"})})).catch((function(e){t.$store.commit("setBusy",!1),t.errors=e.response.data}))}},watch:{create:function(t,e){t&&(this.user_pass=this.genRandPassword(),this.ssh_user=""),this.errors={}}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"card"},[n("div",{staticClass:"card-header"},[n("h4",{staticClass:"float-left mt-1"},[t._v("Deploy Website")]),t._v(" "),n("router-link",{staticClass:"btn mt-1 btn-sm float-right btn-warning",attrs:{to:{name:"websites"}}},[n("i",{staticClass:"fas fa-times"}),t._v(" Cancel\n                ")])],1),t._v(" "),n("div",{staticClass:"card-body"},[n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-6"},[n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"label"}},[t._v("Website Label")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.label,expression:"label"}],staticClass:"form-control",class:{"is-invalid":t.errors.label},attrs:{id:"label",type:"text",placeholder:"A unique label to identify your website..."},domProps:{value:t.label},on:{input:function(e){e.target.composing||(t.label=e.target.value)}}}),t._v(" "),t.errors.label?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.label[0]))]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"php"}},[t._v("PHP Version")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model",value:t.php,expression:"php"}],staticClass:"form-control",class:{"is-invalid":t.errors.php},attrs:{id:"php"},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,(function(t){return t.selected})).map((function(t){return"_value"in t?t._value:t.value}));t.php=e.target.multiple?n:n[0]}}},t._l(t.php_versions,(function(e){return n("option",{key:e,domProps:{value:e}},[t._v("PHP "+t._s(e))])})),0),t._v(" "),t.errors.php?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.php[0]))]):t._e()]),t._v(" "),t.$store.state.user&&t.$store.state.user.is_root?n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"user"}},[t._v("\n                                SSH User\n                                "),n("small",[n("a",{staticClass:"text-primary text-decoration-none",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.create=!t.create}}},[t.create?n("span",[t._v("Cancel")]):n("span",[n("i",{staticClass:"fas fa-plus"}),t._v(" Create\n                                        ")])])])]),t._v(" "),t.create?n("usercreate-component"):n("v-select",{attrs:{options:t.users},on:{search:t.getUsers},model:{value:t.ssh_user,callback:function(e){t.ssh_user=e},expression:"ssh_user"}}),t._v(" "),!t.create&&t.errors.username?n("p",{staticClass:"text-danger"},[t._v(t._s(t.errors.username))]):t._e()],1):t._e(),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"website_type"}},[t._v("Website Type")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model",value:t.website_type,expression:"website_type"}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,(function(t){return t.selected})).map((function(t){return"_value"in t?t._value:t.value}));t.website_type=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:"blank"}},[t._v("Blank PHP Website")]),t._v(" "),n("option",{attrs:{value:"wordpress"}},[t._v("WordPress Website")])]),t._v(" "),"wordpress"==t.website_type?n("p",{staticClass:"text-info"},[t._v("\n                                Once the website is deployed, visit the primary domain to complete the WordPress installation.\n                            ")]):t._e()]),t._v(" "),n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"domains"}},[t._v("Domains")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.domains,expression:"domains"}],staticClass:"form-control",class:{"is-invalid":t.errors.domains},attrs:{id:"domains",type:"text",placeholder:"Comma-separated domains list, i.e. example.com, www.example.com"},domProps:{value:t.domains},on:{input:function(e){e.target.composing||(t.domains=e.target.value)}}}),t._v(" "),t.errors.domains?n("p",{staticClass:"invalid-feedback"},[t._v(t._s(t.errors.domains[0]))]):t._e()])])])]),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-primary",attrs:{disabled:t.create},on:{click:function(e){return t.createWebsite()}}},[t._v("Deploy Now")])])])])])}),[],!1,null,null,null).exports},8959:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={data:function(){return{website:!1,del:!1,reset:!1,change_php:!1,php_versions:[],del_dom:!1,new_domain:"",add_dom:!1,refresh_ssl:!1,new_password:!1,errors:{}}},created:function(){this.getWebsite(),this.getPhpVersions()},methods:{addDomain:function(){var t=this;t.$store.commit("setBusy",!0),t.errors={};var e=new FormData;e.append("domain",t.new_domain),axios.post("/websites/".concat(t.$route.params.id,"/add-domain/"),e).then((function(e){t.add_dom=!1,t.new_domain="",t.$store.commit("setbusy",!1),toastr.success("Domain has been added successfully."),t.getWebsite()})).catch((function(e){e.response&&e.response.data.errors?t.errors=e.response.data.errors:toastr.error("An error occurred and the domain cannot be added."),t.$store.commit("setBusy",!1)}))},getPhpVersions:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/websites/php-versions/").then((function(e){t.php_versions=e.data.php_versions,t.$store.commit("setBusy",!1)})).catch((function(e){toastr.error("Supported PHP versions list cannot be retrieved."),t.$store.commit("setBusy",!1)}))},changePhp:function(){var t=this;t.$store.commit("setBusy",!0);var e=new FormData;e.append("php",t.website.php),axios.post("/websites/".concat(t.$route.params.id,"/change-php/"),e).then((function(e){t.$store.commit("setBusy",!1),toastr.success("PHP version has been updated."),t.change_php=!1})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("PHP version cannot be updated.")}))},resetPassword:function(){var t=this;t.$store.commit("setBusy",!0),axios.post("/ssh-users/".concat(t.website.user,"/reset-password/")).then((function(e){t.$store.commit("setBusy",!1),toastr.success("SSH/SFTP password has been updated."),t.new_password=e.data.new_password,t.reset=!1})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("SSH/SFTP password cannot be updated.")}))},getWebsite:function(){var t=this;t.$store.commit("setBusy",!0),axios.get("/websites/".concat(t.$route.params.id,"/")).then((function(e){t.$store.commit("setBusy",!1),t.website=e.data})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("Cannot fetch the website data.")}))},deleteWebsite:function(){var t=this;t.$store.commit("setBusy",!0),axios.delete("/websites/".concat(t.$route.params.id,"/")).then((function(e){toastr.error("The website and associated data has been deleted."),t.$router.push({name:"websites"})})).catch((function(e){t.$store.commit("setBusy",!1),toastr.error("An error occured while trying to delete this website.")}))},deleteDomain:function(t){var e=this;e.$store.commit("setBusy",!0),axios.delete("/websites/".concat(e.$route.params.id,"/delete-domain/").concat(t,"/")).then((function(t){e.$store.commit("setBusy",!1),e.getWebsite(),toastr.success("Domain has been deleted.")})).catch((function(t){e.$store.commit("setBusy",!1),t.response&&t.response.data.message?toastr.error(t.response.data.message):toastr.error("Domain cannot be deleted.")}))},refreshSslCerts:function(){var t=this;t.$store.commit("setBusy",!0),axios.post("/websites/".concat(t.$route.params.id,"/refresh-ssl/")).then((function(e){t.$store.commit("setBusy",!1),t.getWebsite(),toastr.success(e.data.message),t.refresh_ssl=!1})).catch((function(e){t.$store.commit("setBusy",!1),e.response&&e.response.data.message?toastr.error(e.response.data.message):toastr.error("SSL certificates cannot be refreshed.")}))}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"row"},[n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",[t._v("Manage Website: #"+t._s(t.$route.params.id))])])]),t._v(" "),t.website?n("div",{staticClass:"row"},[n("div",{staticClass:"col-md-8"},[n("div",{staticClass:"card mb-3"},[n("div",{staticClass:"card-header bg-primary text-light"},[t._v("\n                        General Details\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[t.new_password?n("p",[t._v("New password is "),n("small",{staticClass:"text-info font-weight-bold"},[t._v(t._s(t.new_password))]),t._v(" and it is shown to you this single time. "),n("a",{staticClass:"text-danger text-decoration-none",staticStyle:{"font-size":"14px"},attrs:{href:"javascript:void(0)"},on:{click:function(e){t.new_password=!1}}},[n("i",{staticClass:"fas fa-times-circle"}),t._v(" Hide")])]):t._e(),t._v(" "),n("div",{staticClass:"responsive-table"},[n("table",{staticClass:"table table-borderless table-striped"},[n("tbody",[n("tr",[n("td",{staticStyle:{width:"40%"}},[t._v("SSH/SFTP User")]),t._v(" "),n("td",[n("span",[t._v(t._s(t.website.metadata.user))]),t._v(" "),n("small",[t.reset?t._e():n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!0}}},[n("i",{staticClass:"fas fa-redo"}),t._v(" Reset\n                                                    Password\n                                                ")]),t._v(" "),t.reset?n("a",{staticClass:"text-success",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.reset=!1}}},[t._v("\n                                                    Cancel\n                                                ")]):t._e(),t._v(" "),t.reset?n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){return t.resetPassword()}}},[t._v("\n                                                    Reset\n                                                ")]):t._e()])])]),t._v(" "),n("tr",[n("td",[t._v("SFTP/SSH Host")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.website.metadata.ip_addr)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",[t._v("Web Root")]),t._v(" "),n("td",[t._v("\n                                            "+t._s(t.website.metadata.path)+"\n                                        ")])]),t._v(" "),n("tr",[n("td",[t._v("PHP Version")]),t._v(" "),n("td",[t.change_php?n("select",{directives:[{name:"model",rawName:"v-model",value:t.website.php,expression:"website.php"}],on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,(function(t){return t.selected})).map((function(t){return"_value"in t?t._value:t.value}));t.$set(t.website,"php",e.target.multiple?n:n[0])}}},t._l(t.php_versions,(function(e){return n("option",{key:e,domProps:{value:e}},[t._v("PHP "+t._s(e))])})),0):n("a",[n("i",{staticClass:"fab fa-php"}),t._v(" PHP\n                                                "+t._s(t.website.php)+"\n                                                "),n("a",{staticClass:"text-danger",attrs:{href:"javascript:void(0)"},on:{click:function(e){t.change_php=!0}}},[t._v("Change")])]),t._v(" "),t.change_php?n("button",{staticClass:"btn btn-primary btn-sm",staticStyle:{"font-size":"12px"},on:{click:function(e){t.change_php=!1}}},[t._v("Cancel")]):t._e(),t._v(" "),t.change_php?n("button",{staticClass:"btn btn-danger btn-sm",staticStyle:{"font-size":"12px"},on:{click:function(e){return t.changePhp()}}},[t._v("Update")]):t._e()])])])])])])]),t._v(" "),t.add_dom?n("div",{staticClass:"card mb-3"},[n("div",{staticClass:"card-body"},[n("div",{staticClass:"form-group"},[n("label",{attrs:{for:"domain"}},[t._v("Domain Name")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model",value:t.new_domain,expression:"new_domain"}],staticClass:"form-control",class:{"is-invalid":t.errors.domain},attrs:{type:"text",placeholder:"Add a new domain to this website."},domProps:{value:t.new_domain},on:{input:function(e){e.target.composing||(t.new_domain=e.target.value)}}}),t._v(" "),t.errors.domain?n("small",{staticClass:"d-block invalid-feedback"},[t._v("\n                                "+t._s(t.errors.domain[0])+"\n                            ")]):t._e()])]),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-primary",on:{click:function(e){return t.addDomain()}}},[t._v("\n                            Add Domain\n                        ")]),t._v(" "),n("button",{staticClass:"btn btn-success",on:{click:function(e){t.add_dom=!1}}},[t._v("Cancel")])])]):t._e(),t._v(" "),t.refresh_ssl?n("div",{staticClass:"card mb-3"},[n("div",{staticClass:"card-header"},[t._v("\n                        Refresh SSL Certificates\n                    ")]),t._v(" "),t._m(0),t._v(" "),n("div",{staticClass:"card-footer"},[n("button",{staticClass:"btn btn-warning",on:{click:function(e){return t.refreshSslCerts()}}},[t._v("\n                            Refresh SSL\n                        ")]),t._v(" "),n("button",{staticClass:"btn btn-primary",on:{click:function(e){t.refresh_ssl=!1}}},[t._v("\n                            Cancel\n                        ")])])]):t._e(),t._v(" "),n("div",{staticClass:"card"},[n("div",{staticClass:"card-header text-light bg-primary"},[t._v("\n                        Domains ("+t._s(t.website.domains.length)+")\n                        "),n("button",{staticClass:"btn btn-primary float-right",on:{click:function(e){t.add_dom=!t.add_dom}}},[n("i",{staticClass:"fas fa-plus"}),t._v(" Add\n                        ")]),t._v(" "),n("button",{staticClass:"btn btn-primary float-right",on:{click:function(e){t.refresh_ssl=!t.refresh_ssl}}},[n("i",{staticClass:"fas fa-lock"}),t._v(" Refresh SSL\n                        ")])]),t._v(" "),n("div",{staticClass:"card-body"},[n("div",{staticClass:"table-responsive"},[n("table",{staticClass:"table table-borderless table-striped"},[n("tbody",t._l(t.website.domains,(function(e){return n("tr",{key:e.domain},[n("td",{staticStyle:{width:"40%"}},[t._v(t._s(e.domain))]),t._v(" "),n("td",{staticClass:"font-weight-bold",class:{"text-success":e.ssl,"text-muted":!e.ssl},staticStyle:{width:"20%"}},[e.ssl?n("span",[n("i",{staticClass:"fas fa-lock"}),t._v(" HTTPS\n                                            ")]):n("span",[n("i",{staticClass:"fas fa-unlock"}),t._v(" HTTP\n                                            ")])]),t._v(" "),n("td",{staticClass:"text-right"},[t.del_dom!=e.id?n("button",{staticClass:"btn btn-sm btn-warning",on:{click:function(n){t.del_dom=e.id}}},[t._v("\n                                                Delete\n                                            ")]):t._e(),t._v(" "),t.del_dom==e.id?n("button",{staticClass:"btn btn-sm btn-danger",on:{click:function(n){return t.deleteDomain(e.id)}}},[t._v("\n                                                Confirm\n                                            ")]):t._e(),t._v(" "),t.del_dom==e.id?n("button",{staticClass:"btn btn-sm btn-success",on:{click:function(e){t.del_dom=!1}}},[t._v("\n                                                Cancel\n                                            ")]):t._e()])])})),0)])])])]),t._v(" "),n("div",{staticClass:"card mt-3"},[n("div",{staticClass:"card-header text-light",class:{"bg-secondary":!t.del,"bg-danger":t.del}},[t._v("\n                        Danger Zone\n                    ")]),t._v(" "),n("div",{staticClass:"card-body"},[t._v("\n                        Do you want to delete this website? You can do so here. Beware\n                        that this action is irreversible and you cannot undo once the\n                        website is deleted. Entire data associated to this website\n                        will be permanently lost.\n                    ")]),t._v(" "),n("div",{staticClass:"card-footer"},[t.del?t._e():n("button",{staticClass:"btn btn-secondary",on:{click:function(e){t.del=!0}}},[t._v("\n                            Delete Website\n                        ")]),t._v(" "),t.del?n("button",{staticClass:"btn btn-success",on:{click:function(e){t.del=!1}}},[t._v("\n                            Don't Delete\n                        ")]):t._e(),t._v(" "),t.del?n("button",{staticClass:"btn btn-danger",on:{click:function(e){return t.deleteWebsite()}}},[t._v("\n                            Confirm Deletion\n                        ")]):t._e()])])])]):t._e()])])}),[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"card-body"},[n("p",[t._v("FastCP automatically obtains SSL certificates and automatically renews them for domains that point to this server. When a new domain is added, it may take up to 1 hour before an SSL certificate is obtained.")]),t._v(" "),n("p",[t._v("But if you are in a hurry and need to activate SSL certificates right away, you can request a refresh below. If SSL can't be activated, ensure that the domains are resolving to this server's IP.")])])}],!1,null,null,null).exports},8629:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={components:{WebsitesTable:n(3109).Z}};const a=(0,n(1900).Z)(r,(function(){var t=this.$createElement,e=this._self._c||t;return e("div",{staticClass:"row"},[e("websites-table",{attrs:{pagination:!0}})],1)}),[],!1,null,null,null).exports},3109:(t,e,n)=>{"use strict";n.d(e,{Z:()=>a});const r={props:["pagination"],data:function(){return{data:!1}},created:function(){this.getWebsites(),"websites"==this.$route.name&&this.EventBus.$on("doSearch",this.getWebsites)},beforeDestroy:function(){this.EventBus.$off("doSearch",this.getWebsites)},methods:{getWebsites:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;if(null!=t){var e=document.getElementById("search-input").value,n=this;n.$store.commit("setBusy",!0),axios.get("/websites/?page=".concat(t,"&q=").concat(e)).then((function(t){n.data=t.data,n.$store.commit("setBusy",!1)})).catch((function(t){n.$store.commit("setBusy",!1)}))}}}};const a=(0,n(1900).Z)(r,(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"col-12"},[n("div",{staticClass:"row mb-2"},[n("div",{staticClass:"col-12"},[n("h4",{staticClass:"float-left"},[t._v("Websites "),t.data?n("span",[t._v("("+t._s(t.data.count)+")")]):t._e()]),t._v(" "),t.pagination?n("router-link",{staticClass:"btn mb-1 btn-sm float-right btn-outline-primary",attrs:{to:{name:"deploysite"}}},[n("i",{staticClass:"fas fa-plus"}),t._v(" Deploy New\n            ")]):n("router-link",{staticClass:"btn mb-1 btn-sm float-right btn-outline-primary",attrs:{to:{name:"websites"}}},[n("i",{staticClass:"fas fa-list"}),t._v(" All Websites\n            ")])],1)]),t._v(" "),t.data&&t.data.count>0?n("div",{staticClass:"table-responsive"},[n("table",{staticClass:"table table-bordered"},[t._m(0),t._v(" "),n("tbody",t._l(t.data.results,(function(e){return n("tr",{key:e.id},[n("td",[t._v(t._s(e.label))]),t._v(" "),n("td",[n("i",{staticClass:"fab fa-php"}),t._v(" "+t._s(e.php))]),t._v(" "),n("td",[e.has_ssl?n("span",{staticClass:"text-success"},[n("i",{staticClass:"fas fa-lock"}),t._v(" HTTPS")]):n("span",{staticClass:"text-warning"},[n("i",{staticClass:"fas fa-unlock"}),t._v(" HTTP")])]),t._v(" "),n("td",{staticClass:"text-right"},[n("router-link",{staticClass:"btn btn-sm btn-warning",attrs:{to:{name:"filemanager",params:{id:e.id}}}},[n("i",{staticClass:"fas fa-folder"}),t._v(" Files\n                        ")]),t._v(" "),n("router-link",{staticClass:"btn btn-sm btn-primary",attrs:{to:{name:"website",params:{id:e.id}}}},[n("i",{staticClass:"fas fa-cog"}),t._v(" Settings\n                        ")])],1)])})),0)])]):n("div",{staticClass:"text-muted mb-3 border border-muted rounded p-5 text-center"},[t._v("\n        No websites found.\n    ")]),t._v(" "),t.pagination&&t.data&&t.data.count>0?n("nav",{attrs:{"aria-label":"Pagination"}},[n("ul",{staticClass:"pagination float-right"},[n("li",{staticClass:"page-item",class:{disabled:!t.data.links.previous},on:{click:function(e){return t.getWebsites(t.data.links.previous)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Previous")])]),t._v(" "),n("li",{staticClass:"page-item",class:{disabled:!t.data.links.next},on:{click:function(e){return t.getWebsites(t.data.links.next)}}},[n("a",{staticClass:"page-link",attrs:{href:"javascript:void(0)"}},[t._v("Next")])])])]):t._e()])}),[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("thead",{staticClass:"bg-primary text-white"},[n("tr",[n("th",{staticStyle:{width:"30%"}},[t._v("Label")]),t._v(" "),n("th",{staticStyle:{width:"20%"}},[t._v("\n                        PHP Version\n                    ")]),t._v(" "),n("th",{attrs:{colspan:"2"}},[t._v("\n                        SSL\n                    ")])])])}],!1,null,null,null).exports},1900:(t,e,n)=>{"use strict";function r(t,e,n,r,a,s,i,o){var c,l="function"==typeof t?t.options:t;if(e&&(l.render=e,l.staticRenderFns=n,l._compiled=!0),r&&(l.functional=!0),s&&(l._scopeId="data-v-"+s),i?(c=function(t){(t=t||this.$vnode&&this.$vnode.ssrContext||this.parent&&this.parent.$vnode&&this.parent.$vnode.ssrContext)||"undefined"==typeof __VUE_SSR_CONTEXT__||(t=__VUE_SSR_CONTEXT__),a&&a.call(this,t),t&&t._registeredComponents&&t._registeredComponents.add(i)},l._ssrRegister=c):a&&(c=o?function(){a.call(this,(l.functional?this.parent:this).$root.$options.shadowRoot)}:a),c)if(l.functional){l._injectStyles=c;var u=l.render;l.render=function(t,e){return c.call(e),u(t,e)}}else{var d=l.beforeCreate;l.beforeCreate=d?[].concat(d,c):[c]}return{exports:t,options:l}}n.d(e,{Z:()=>r})},7398:function(t){"undefined"!=typeof self&&self,t.exports=function(t){var e={};function n(r){if(e[r])return e[r].exports;var a=e[r]={i:r,l:!1,exports:{}};return t[r].call(a.exports,a,a.exports,n),a.l=!0,a.exports}return n.m=t,n.c=e,n.d=function(t,e,r){n.o(t,e)||Object.defineProperty(t,e,{enumerable:!0,get:r})},n.r=function(t){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(t,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(t,"__esModule",{value:!0})},n.t=function(t,e){if(1&e&&(t=n(t)),8&e)return t;if(4&e&&"object"==typeof t&&t&&t.__esModule)return t;var r=Object.create(null);if(n.r(r),Object.defineProperty(r,"default",{enumerable:!0,value:t}),2&e&&"string"!=typeof t)for(var a in t)n.d(r,a,function(e){return t[e]}.bind(null,a));return r},n.n=function(t){var e=t&&t.__esModule?function(){return t.default}:function(){return t};return n.d(e,"a",e),e},n.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},n.p="",n(n.s=1)}([function(t,e,n){},function(t,e,n){"use strict";n.r(e);var r="undefined"!=typeof window?window.HTMLElement:Object,a={mounted:function(){this.enforceFocus&&document.addEventListener("focusin",this.focusIn)},methods:{focusIn:function(t){if(this.isActive&&t.target!==this.$el&&!this.$el.contains(t.target)){var e=this.container?this.container:this.isFullPage?null:this.$el.parentElement;(this.isFullPage||e&&e.contains(t.target))&&(t.preventDefault(),this.$el.focus())}}},beforeDestroy:function(){document.removeEventListener("focusin",this.focusIn)}};function s(t,e,n,r,a,s,i,o){var c,l="function"==typeof t?t.options:t;if(e&&(l.render=e,l.staticRenderFns=n,l._compiled=!0),r&&(l.functional=!0),s&&(l._scopeId="data-v-"+s),i?(c=function(t){(t=t||this.$vnode&&this.$vnode.ssrContext||this.parent&&this.parent.$vnode&&this.parent.$vnode.ssrContext)||"undefined"==typeof __VUE_SSR_CONTEXT__||(t=__VUE_SSR_CONTEXT__),a&&a.call(this,t),t&&t._registeredComponents&&t._registeredComponents.add(i)},l._ssrRegister=c):a&&(c=o?function(){a.call(this,(l.functional?this.parent:this).$root.$options.shadowRoot)}:a),c)if(l.functional){l._injectStyles=c;var u=l.render;l.render=function(t,e){return c.call(e),u(t,e)}}else{var d=l.beforeCreate;l.beforeCreate=d?[].concat(d,c):[c]}return{exports:t,options:l}}var i=s({name:"spinner",props:{color:{type:String,default:"#000"},height:{type:Number,default:64},width:{type:Number,default:64}}},(function(){var t=this.$createElement,e=this._self._c||t;return e("svg",{attrs:{viewBox:"0 0 38 38",xmlns:"http://www.w3.org/2000/svg",width:this.width,height:this.height,stroke:this.color}},[e("g",{attrs:{fill:"none","fill-rule":"evenodd"}},[e("g",{attrs:{transform:"translate(1 1)","stroke-width":"2"}},[e("circle",{attrs:{"stroke-opacity":".25",cx:"18",cy:"18",r:"18"}}),e("path",{attrs:{d:"M36 18c0-9.94-8.06-18-18-18"}},[e("animateTransform",{attrs:{attributeName:"transform",type:"rotate",from:"0 18 18",to:"360 18 18",dur:"0.8s",repeatCount:"indefinite"}})],1)])])])}),[],!1,null,null,null).exports,o=s({name:"dots",props:{color:{type:String,default:"#000"},height:{type:Number,default:240},width:{type:Number,default:60}}},(function(){var t=this.$createElement,e=this._self._c||t;return e("svg",{attrs:{viewBox:"0 0 120 30",xmlns:"http://www.w3.org/2000/svg",fill:this.color,width:this.width,height:this.height}},[e("circle",{attrs:{cx:"15",cy:"15",r:"15"}},[e("animate",{attrs:{attributeName:"r",from:"15",to:"15",begin:"0s",dur:"0.8s",values:"15;9;15",calcMode:"linear",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"fill-opacity",from:"1",to:"1",begin:"0s",dur:"0.8s",values:"1;.5;1",calcMode:"linear",repeatCount:"indefinite"}})]),e("circle",{attrs:{cx:"60",cy:"15",r:"9","fill-opacity":"0.3"}},[e("animate",{attrs:{attributeName:"r",from:"9",to:"9",begin:"0s",dur:"0.8s",values:"9;15;9",calcMode:"linear",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"fill-opacity",from:"0.5",to:"0.5",begin:"0s",dur:"0.8s",values:".5;1;.5",calcMode:"linear",repeatCount:"indefinite"}})]),e("circle",{attrs:{cx:"105",cy:"15",r:"15"}},[e("animate",{attrs:{attributeName:"r",from:"15",to:"15",begin:"0s",dur:"0.8s",values:"15;9;15",calcMode:"linear",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"fill-opacity",from:"1",to:"1",begin:"0s",dur:"0.8s",values:"1;.5;1",calcMode:"linear",repeatCount:"indefinite"}})])])}),[],!1,null,null,null).exports,c=s({name:"bars",props:{color:{type:String,default:"#000"},height:{type:Number,default:40},width:{type:Number,default:40}}},(function(){var t=this.$createElement,e=this._self._c||t;return e("svg",{attrs:{xmlns:"http://www.w3.org/2000/svg",viewBox:"0 0 30 30",height:this.height,width:this.width,fill:this.color}},[e("rect",{attrs:{x:"0",y:"13",width:"4",height:"5"}},[e("animate",{attrs:{attributeName:"height",attributeType:"XML",values:"5;21;5",begin:"0s",dur:"0.6s",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"y",attributeType:"XML",values:"13; 5; 13",begin:"0s",dur:"0.6s",repeatCount:"indefinite"}})]),e("rect",{attrs:{x:"10",y:"13",width:"4",height:"5"}},[e("animate",{attrs:{attributeName:"height",attributeType:"XML",values:"5;21;5",begin:"0.15s",dur:"0.6s",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"y",attributeType:"XML",values:"13; 5; 13",begin:"0.15s",dur:"0.6s",repeatCount:"indefinite"}})]),e("rect",{attrs:{x:"20",y:"13",width:"4",height:"5"}},[e("animate",{attrs:{attributeName:"height",attributeType:"XML",values:"5;21;5",begin:"0.3s",dur:"0.6s",repeatCount:"indefinite"}}),e("animate",{attrs:{attributeName:"y",attributeType:"XML",values:"13; 5; 13",begin:"0.3s",dur:"0.6s",repeatCount:"indefinite"}})])])}),[],!1,null,null,null).exports,l=s({name:"vue-loading",mixins:[a],props:{active:Boolean,programmatic:Boolean,container:[Object,Function,r],isFullPage:{type:Boolean,default:!0},enforceFocus:{type:Boolean,default:!0},lockScroll:{type:Boolean,default:!1},transition:{type:String,default:"fade"},canCancel:Boolean,onCancel:{type:Function,default:function(){}},color:String,backgroundColor:String,blur:{type:String,default:"2px"},opacity:Number,width:Number,height:Number,zIndex:Number,loader:{type:String,default:"spinner"}},data:function(){return{isActive:this.active}},components:{Spinner:i,Dots:o,Bars:c},beforeMount:function(){this.programmatic&&(this.container?(this.isFullPage=!1,this.container.appendChild(this.$el)):document.body.appendChild(this.$el))},mounted:function(){this.programmatic&&(this.isActive=!0),document.addEventListener("keyup",this.keyPress)},methods:{cancel:function(){this.canCancel&&this.isActive&&(this.hide(),this.onCancel.apply(null,arguments))},hide:function(){var t=this;this.$emit("hide"),this.$emit("update:active",!1),this.programmatic&&(this.isActive=!1,setTimeout((function(){var e;t.$destroy(),void 0!==(e=t.$el).remove?e.remove():e.parentNode.removeChild(e)}),150))},disableScroll:function(){this.isFullPage&&this.lockScroll&&document.body.classList.add("vld-shown")},enableScroll:function(){this.isFullPage&&this.lockScroll&&document.body.classList.remove("vld-shown")},keyPress:function(t){27===t.keyCode&&this.cancel()}},watch:{active:function(t){this.isActive=t},isActive:function(t){t?this.disableScroll():this.enableScroll()}},computed:{bgStyle:function(){return{background:this.backgroundColor,opacity:this.opacity,backdropFilter:"blur(".concat(this.blur,")")}}},beforeDestroy:function(){document.removeEventListener("keyup",this.keyPress)}},(function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("transition",{attrs:{name:t.transition}},[n("div",{directives:[{name:"show",rawName:"v-show",value:t.isActive,expression:"isActive"}],staticClass:"vld-overlay is-active",class:{"is-full-page":t.isFullPage},style:{zIndex:t.zIndex},attrs:{tabindex:"0","aria-busy":t.isActive,"aria-label":"Loading"}},[n("div",{staticClass:"vld-background",style:t.bgStyle,on:{click:function(e){return e.preventDefault(),t.cancel(e)}}}),n("div",{staticClass:"vld-icon"},[t._t("before"),t._t("default",[n(t.loader,{tag:"component",attrs:{color:t.color,width:t.width,height:t.height}})]),t._t("after")],2)])])}),[],!1,null,null,null).exports,u=function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{};return{show:function(){var r=arguments.length>0&&void 0!==arguments[0]?arguments[0]:e,a=arguments.length>1&&void 0!==arguments[1]?arguments[1]:n,s={programmatic:!0},i=Object.assign({},e,r,s),o=new(t.extend(l))({el:document.createElement("div"),propsData:i}),c=Object.assign({},n,a);return Object.keys(c).map((function(t){o.$slots[t]=c[t]})),o}}};n(0),l.install=function(t){var e=u(t,arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},arguments.length>2&&void 0!==arguments[2]?arguments[2]:{});t.$loading=e,t.prototype.$loading=e},e.default=l}]).default}},e={};function n(r){var a=e[r];if(void 0!==a)return a.exports;var s=e[r]={id:r,exports:{}};return t[r].call(s.exports,s,s.exports,n),s.exports}n.n=t=>{var e=t&&t.__esModule?()=>t.default:()=>t;return n.d(e,{a:e}),e},n.d=(t,e)=>{for(var r in e)n.o(e,r)&&!n.o(t,r)&&Object.defineProperty(t,r,{enumerable:!0,get:e[r]})},n.g=function(){if("object"==typeof globalThis)return globalThis;try{return this||new Function("return this")()}catch(t){if("object"==typeof window)return window}}(),n.o=(t,e)=>Object.prototype.hasOwnProperty.call(t,e),(()=>{"use strict";var t=Object.freeze({});function e(t){return null==t}function r(t){return null!=t}function a(t){return!0===t}function s(t){return"string"==typeof t||"number"==typeof t||"symbol"==typeof t||"boolean"==typeof t}function i(t){return null!==t&&"object"==typeof t}var o=Object.prototype.toString;function c(t){return"[object Object]"===o.call(t)}function l(t){return"[object RegExp]"===o.call(t)}function u(t){var e=parseFloat(String(t));return e>=0&&Math.floor(e)===e&&isFinite(t)}function d(t){return r(t)&&"function"==typeof t.then&&"function"==typeof t.catch}function f(t){return null==t?"":Array.isArray(t)||c(t)&&t.toString===o?JSON.stringify(t,null,2):String(t)}function p(t){var e=parseFloat(t);return isNaN(e)?t:e}function v(t,e){for(var n=Object.create(null),r=t.split(","),a=0;a<r.length;a++)n[r[a]]=!0;return e?function(t){return n[t.toLowerCase()]}:function(t){return n[t]}}var h=v("slot,component",!0),m=v("key,ref,slot,slot-scope,is");function g(t,e){if(t.length){var n=t.indexOf(e);if(n>-1)return t.splice(n,1)}}var _=Object.prototype.hasOwnProperty;function y(t,e){return _.call(t,e)}function b(t){var e=Object.create(null);return function(n){return e[n]||(e[n]=t(n))}}var w=/-(\w)/g,C=b((function(t){return t.replace(w,(function(t,e){return e?e.toUpperCase():""}))})),x=b((function(t){return t.charAt(0).toUpperCase()+t.slice(1)})),$=/\B([A-Z])/g,k=b((function(t){return t.replace($,"-$1").toLowerCase()}));var S=Function.prototype.bind?function(t,e){return t.bind(e)}:function(t,e){function n(n){var r=arguments.length;return r?r>1?t.apply(e,arguments):t.call(e,n):t.call(e)}return n._length=t.length,n};function A(t,e){e=e||0;for(var n=t.length-e,r=new Array(n);n--;)r[n]=t[n+e];return r}function O(t,e){for(var n in e)t[n]=e[n];return t}function E(t){for(var e={},n=0;n<t.length;n++)t[n]&&O(e,t[n]);return e}function T(t,e,n){}var P=function(t,e,n){return!1},j=function(t){return t};function N(t,e){if(t===e)return!0;var n=i(t),r=i(e);if(!n||!r)return!n&&!r&&String(t)===String(e);try{var a=Array.isArray(t),s=Array.isArray(e);if(a&&s)return t.length===e.length&&t.every((function(t,n){return N(t,e[n])}));if(t instanceof Date&&e instanceof Date)return t.getTime()===e.getTime();if(a||s)return!1;var o=Object.keys(t),c=Object.keys(e);return o.length===c.length&&o.every((function(n){return N(t[n],e[n])}))}catch(t){return!1}}function B(t,e){for(var n=0;n<t.length;n++)if(N(t[n],e))return n;return-1}function D(t){var e=!1;return function(){e||(e=!0,t.apply(this,arguments))}}var M="data-server-rendered",R=["component","directive","filter"],L=["beforeCreate","created","beforeMount","mounted","beforeUpdate","updated","beforeDestroy","destroyed","activated","deactivated","errorCaptured","serverPrefetch"],F={optionMergeStrategies:Object.create(null),silent:!1,productionTip:!1,devtools:!1,performance:!1,errorHandler:null,warnHandler:null,ignoredElements:[],keyCodes:Object.create(null),isReservedTag:P,isReservedAttr:P,isUnknownElement:P,getTagNamespace:T,parsePlatformTagName:j,mustUseProp:P,async:!0,_lifecycleHooks:L},U=/a-zA-Z\u00B7\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u037D\u037F-\u1FFF\u200C-\u200D\u203F-\u2040\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD/;function I(t){var e=(t+"").charCodeAt(0);return 36===e||95===e}function H(t,e,n,r){Object.defineProperty(t,e,{value:n,enumerable:!!r,writable:!0,configurable:!0})}var Z=new RegExp("[^"+U.source+".$_\\d]");var q,V="__proto__"in{},W="undefined"!=typeof window,z="undefined"!=typeof WXEnvironment&&!!WXEnvironment.platform,G=z&&WXEnvironment.platform.toLowerCase(),J=W&&window.navigator.userAgent.toLowerCase(),K=J&&/msie|trident/.test(J),X=J&&J.indexOf("msie 9.0")>0,Y=J&&J.indexOf("edge/")>0,Q=(J&&J.indexOf("android"),J&&/iphone|ipad|ipod|ios/.test(J)||"ios"===G),tt=(J&&/chrome\/\d+/.test(J),J&&/phantomjs/.test(J),J&&J.match(/firefox\/(\d+)/)),et={}.watch,nt=!1;if(W)try{var rt={};Object.defineProperty(rt,"passive",{get:function(){nt=!0}}),window.addEventListener("test-passive",null,rt)}catch(t){}var at=function(){return void 0===q&&(q=!W&&!z&&void 0!==n.g&&(n.g.process&&"server"===n.g.process.env.VUE_ENV)),q},st=W&&window.__VUE_DEVTOOLS_GLOBAL_HOOK__;function it(t){return"function"==typeof t&&/native code/.test(t.toString())}var ot,ct="undefined"!=typeof Symbol&&it(Symbol)&&"undefined"!=typeof Reflect&&it(Reflect.ownKeys);ot="undefined"!=typeof Set&&it(Set)?Set:function(){function t(){this.set=Object.create(null)}return t.prototype.has=function(t){return!0===this.set[t]},t.prototype.add=function(t){this.set[t]=!0},t.prototype.clear=function(){this.set=Object.create(null)},t}();var lt=T,ut=0,dt=function(){this.id=ut++,this.subs=[]};dt.prototype.addSub=function(t){this.subs.push(t)},dt.prototype.removeSub=function(t){g(this.subs,t)},dt.prototype.depend=function(){dt.target&&dt.target.addDep(this)},dt.prototype.notify=function(){var t=this.subs.slice();for(var e=0,n=t.length;e<n;e++)t[e].update()},dt.target=null;var ft=[];function pt(t){ft.push(t),dt.target=t}function vt(){ft.pop(),dt.target=ft[ft.length-1]}var ht=function(t,e,n,r,a,s,i,o){this.tag=t,this.data=e,this.children=n,this.text=r,this.elm=a,this.ns=void 0,this.context=s,this.fnContext=void 0,this.fnOptions=void 0,this.fnScopeId=void 0,this.key=e&&e.key,this.componentOptions=i,this.componentInstance=void 0,this.parent=void 0,this.raw=!1,this.isStatic=!1,this.isRootInsert=!0,this.isComment=!1,this.isCloned=!1,this.isOnce=!1,this.asyncFactory=o,this.asyncMeta=void 0,this.isAsyncPlaceholder=!1},mt={child:{configurable:!0}};mt.child.get=function(){return this.componentInstance},Object.defineProperties(ht.prototype,mt);var gt=function(t){void 0===t&&(t="");var e=new ht;return e.text=t,e.isComment=!0,e};function _t(t){return new ht(void 0,void 0,void 0,String(t))}function yt(t){var e=new ht(t.tag,t.data,t.children&&t.children.slice(),t.text,t.elm,t.context,t.componentOptions,t.asyncFactory);return e.ns=t.ns,e.isStatic=t.isStatic,e.key=t.key,e.isComment=t.isComment,e.fnContext=t.fnContext,e.fnOptions=t.fnOptions,e.fnScopeId=t.fnScopeId,e.asyncMeta=t.asyncMeta,e.isCloned=!0,e}var bt=Array.prototype,wt=Object.create(bt);["push","pop","shift","unshift","splice","sort","reverse"].forEach((function(t){var e=bt[t];H(wt,t,(function(){for(var n=[],r=arguments.length;r--;)n[r]=arguments[r];var a,s=e.apply(this,n),i=this.__ob__;switch(t){case"push":case"unshift":a=n;break;case"splice":a=n.slice(2)}return a&&i.observeArray(a),i.dep.notify(),s}))}));var Ct=Object.getOwnPropertyNames(wt),xt=!0;function $t(t){xt=t}var kt=function(t){this.value=t,this.dep=new dt,this.vmCount=0,H(t,"__ob__",this),Array.isArray(t)?(V?function(t,e){t.__proto__=e}(t,wt):function(t,e,n){for(var r=0,a=n.length;r<a;r++){var s=n[r];H(t,s,e[s])}}(t,wt,Ct),this.observeArray(t)):this.walk(t)};function St(t,e){var n;if(i(t)&&!(t instanceof ht))return y(t,"__ob__")&&t.__ob__ instanceof kt?n=t.__ob__:xt&&!at()&&(Array.isArray(t)||c(t))&&Object.isExtensible(t)&&!t._isVue&&(n=new kt(t)),e&&n&&n.vmCount++,n}function At(t,e,n,r,a){var s=new dt,i=Object.getOwnPropertyDescriptor(t,e);if(!i||!1!==i.configurable){var o=i&&i.get,c=i&&i.set;o&&!c||2!==arguments.length||(n=t[e]);var l=!a&&St(n);Object.defineProperty(t,e,{enumerable:!0,configurable:!0,get:function(){var e=o?o.call(t):n;return dt.target&&(s.depend(),l&&(l.dep.depend(),Array.isArray(e)&&Tt(e))),e},set:function(e){var r=o?o.call(t):n;e===r||e!=e&&r!=r||o&&!c||(c?c.call(t,e):n=e,l=!a&&St(e),s.notify())}})}}function Ot(t,e,n){if(Array.isArray(t)&&u(e))return t.length=Math.max(t.length,e),t.splice(e,1,n),n;if(e in t&&!(e in Object.prototype))return t[e]=n,n;var r=t.__ob__;return t._isVue||r&&r.vmCount?n:r?(At(r.value,e,n),r.dep.notify(),n):(t[e]=n,n)}function Et(t,e){if(Array.isArray(t)&&u(e))t.splice(e,1);else{var n=t.__ob__;t._isVue||n&&n.vmCount||y(t,e)&&(delete t[e],n&&n.dep.notify())}}function Tt(t){for(var e=void 0,n=0,r=t.length;n<r;n++)(e=t[n])&&e.__ob__&&e.__ob__.dep.depend(),Array.isArray(e)&&Tt(e)}kt.prototype.walk=function(t){for(var e=Object.keys(t),n=0;n<e.length;n++)At(t,e[n])},kt.prototype.observeArray=function(t){for(var e=0,n=t.length;e<n;e++)St(t[e])};var Pt=F.optionMergeStrategies;function jt(t,e){if(!e)return t;for(var n,r,a,s=ct?Reflect.ownKeys(e):Object.keys(e),i=0;i<s.length;i++)"__ob__"!==(n=s[i])&&(r=t[n],a=e[n],y(t,n)?r!==a&&c(r)&&c(a)&&jt(r,a):Ot(t,n,a));return t}function Nt(t,e,n){return n?function(){var r="function"==typeof e?e.call(n,n):e,a="function"==typeof t?t.call(n,n):t;return r?jt(r,a):a}:e?t?function(){return jt("function"==typeof e?e.call(this,this):e,"function"==typeof t?t.call(this,this):t)}:e:t}function Bt(t,e){var n=e?t?t.concat(e):Array.isArray(e)?e:[e]:t;return n?function(t){for(var e=[],n=0;n<t.length;n++)-1===e.indexOf(t[n])&&e.push(t[n]);return e}(n):n}function Dt(t,e,n,r){var a=Object.create(t||null);return e?O(a,e):a}Pt.data=function(t,e,n){return n?Nt(t,e,n):e&&"function"!=typeof e?t:Nt(t,e)},L.forEach((function(t){Pt[t]=Bt})),R.forEach((function(t){Pt[t+"s"]=Dt})),Pt.watch=function(t,e,n,r){if(t===et&&(t=void 0),e===et&&(e=void 0),!e)return Object.create(t||null);if(!t)return e;var a={};for(var s in O(a,t),e){var i=a[s],o=e[s];i&&!Array.isArray(i)&&(i=[i]),a[s]=i?i.concat(o):Array.isArray(o)?o:[o]}return a},Pt.props=Pt.methods=Pt.inject=Pt.computed=function(t,e,n,r){if(!t)return e;var a=Object.create(null);return O(a,t),e&&O(a,e),a},Pt.provide=Nt;var Mt=function(t,e){return void 0===e?t:e};function Rt(t,e,n){if("function"==typeof e&&(e=e.options),function(t,e){var n=t.props;if(n){var r,a,s={};if(Array.isArray(n))for(r=n.length;r--;)"string"==typeof(a=n[r])&&(s[C(a)]={type:null});else if(c(n))for(var i in n)a=n[i],s[C(i)]=c(a)?a:{type:a};t.props=s}}(e),function(t,e){var n=t.inject;if(n){var r=t.inject={};if(Array.isArray(n))for(var a=0;a<n.length;a++)r[n[a]]={from:n[a]};else if(c(n))for(var s in n){var i=n[s];r[s]=c(i)?O({from:s},i):{from:i}}}}(e),function(t){var e=t.directives;if(e)for(var n in e){var r=e[n];"function"==typeof r&&(e[n]={bind:r,update:r})}}(e),!e._base&&(e.extends&&(t=Rt(t,e.extends,n)),e.mixins))for(var r=0,a=e.mixins.length;r<a;r++)t=Rt(t,e.mixins[r],n);var s,i={};for(s in t)o(s);for(s in e)y(t,s)||o(s);function o(r){var a=Pt[r]||Mt;i[r]=a(t[r],e[r],n,r)}return i}function Lt(t,e,n,r){if("string"==typeof n){var a=t[e];if(y(a,n))return a[n];var s=C(n);if(y(a,s))return a[s];var i=x(s);return y(a,i)?a[i]:a[n]||a[s]||a[i]}}function Ft(t,e,n,r){var a=e[t],s=!y(n,t),i=n[t],o=Zt(Boolean,a.type);if(o>-1)if(s&&!y(a,"default"))i=!1;else if(""===i||i===k(t)){var c=Zt(String,a.type);(c<0||o<c)&&(i=!0)}if(void 0===i){i=function(t,e,n){if(!y(e,"default"))return;var r=e.default;0;if(t&&t.$options.propsData&&void 0===t.$options.propsData[n]&&void 0!==t._props[n])return t._props[n];return"function"==typeof r&&"Function"!==It(e.type)?r.call(t):r}(r,a,t);var l=xt;$t(!0),St(i),$t(l)}return i}var Ut=/^\s*function (\w+)/;function It(t){var e=t&&t.toString().match(Ut);return e?e[1]:""}function Ht(t,e){return It(t)===It(e)}function Zt(t,e){if(!Array.isArray(e))return Ht(e,t)?0:-1;for(var n=0,r=e.length;n<r;n++)if(Ht(e[n],t))return n;return-1}function qt(t,e,n){pt();try{if(e)for(var r=e;r=r.$parent;){var a=r.$options.errorCaptured;if(a)for(var s=0;s<a.length;s++)try{if(!1===a[s].call(r,t,e,n))return}catch(t){Wt(t,r,"errorCaptured hook")}}Wt(t,e,n)}finally{vt()}}function Vt(t,e,n,r,a){var s;try{(s=n?t.apply(e,n):t.call(e))&&!s._isVue&&d(s)&&!s._handled&&(s.catch((function(t){return qt(t,r,a+" (Promise/async)")})),s._handled=!0)}catch(t){qt(t,r,a)}return s}function Wt(t,e,n){if(F.errorHandler)try{return F.errorHandler.call(null,t,e,n)}catch(e){e!==t&&zt(e,null,"config.errorHandler")}zt(t,e,n)}function zt(t,e,n){if(!W&&!z||"undefined"==typeof console)throw t;console.error(t)}var Gt,Jt=!1,Kt=[],Xt=!1;function Yt(){Xt=!1;var t=Kt.slice(0);Kt.length=0;for(var e=0;e<t.length;e++)t[e]()}if("undefined"!=typeof Promise&&it(Promise)){var Qt=Promise.resolve();Gt=function(){Qt.then(Yt),Q&&setTimeout(T)},Jt=!0}else if(K||"undefined"==typeof MutationObserver||!it(MutationObserver)&&"[object MutationObserverConstructor]"!==MutationObserver.toString())Gt="undefined"!=typeof setImmediate&&it(setImmediate)?function(){setImmediate(Yt)}:function(){setTimeout(Yt,0)};else{var te=1,ee=new MutationObserver(Yt),ne=document.createTextNode(String(te));ee.observe(ne,{characterData:!0}),Gt=function(){te=(te+1)%2,ne.data=String(te)},Jt=!0}function re(t,e){var n;if(Kt.push((function(){if(t)try{t.call(e)}catch(t){qt(t,e,"nextTick")}else n&&n(e)})),Xt||(Xt=!0,Gt()),!t&&"undefined"!=typeof Promise)return new Promise((function(t){n=t}))}var ae=new ot;function se(t){ie(t,ae),ae.clear()}function ie(t,e){var n,r,a=Array.isArray(t);if(!(!a&&!i(t)||Object.isFrozen(t)||t instanceof ht)){if(t.__ob__){var s=t.__ob__.dep.id;if(e.has(s))return;e.add(s)}if(a)for(n=t.length;n--;)ie(t[n],e);else for(n=(r=Object.keys(t)).length;n--;)ie(t[r[n]],e)}}var oe=b((function(t){var e="&"===t.charAt(0),n="~"===(t=e?t.slice(1):t).charAt(0),r="!"===(t=n?t.slice(1):t).charAt(0);return{name:t=r?t.slice(1):t,once:n,capture:r,passive:e}}));function ce(t,e){function n(){var t=arguments,r=n.fns;if(!Array.isArray(r))return Vt(r,null,arguments,e,"v-on handler");for(var a=r.slice(),s=0;s<a.length;s++)Vt(a[s],null,t,e,"v-on handler")}return n.fns=t,n}function le(t,n,r,s,i,o){var c,l,u,d;for(c in t)l=t[c],u=n[c],d=oe(c),e(l)||(e(u)?(e(l.fns)&&(l=t[c]=ce(l,o)),a(d.once)&&(l=t[c]=i(d.name,l,d.capture)),r(d.name,l,d.capture,d.passive,d.params)):l!==u&&(u.fns=l,t[c]=u));for(c in n)e(t[c])&&s((d=oe(c)).name,n[c],d.capture)}function ue(t,n,s){var i;t instanceof ht&&(t=t.data.hook||(t.data.hook={}));var o=t[n];function c(){s.apply(this,arguments),g(i.fns,c)}e(o)?i=ce([c]):r(o.fns)&&a(o.merged)?(i=o).fns.push(c):i=ce([o,c]),i.merged=!0,t[n]=i}function de(t,e,n,a,s){if(r(e)){if(y(e,n))return t[n]=e[n],s||delete e[n],!0;if(y(e,a))return t[n]=e[a],s||delete e[a],!0}return!1}function fe(t){return s(t)?[_t(t)]:Array.isArray(t)?ve(t):void 0}function pe(t){return r(t)&&r(t.text)&&!1===t.isComment}function ve(t,n){var i,o,c,l,u=[];for(i=0;i<t.length;i++)e(o=t[i])||"boolean"==typeof o||(l=u[c=u.length-1],Array.isArray(o)?o.length>0&&(pe((o=ve(o,(n||"")+"_"+i))[0])&&pe(l)&&(u[c]=_t(l.text+o[0].text),o.shift()),u.push.apply(u,o)):s(o)?pe(l)?u[c]=_t(l.text+o):""!==o&&u.push(_t(o)):pe(o)&&pe(l)?u[c]=_t(l.text+o.text):(a(t._isVList)&&r(o.tag)&&e(o.key)&&r(n)&&(o.key="__vlist"+n+"_"+i+"__"),u.push(o)));return u}function he(t,e){if(t){for(var n=Object.create(null),r=ct?Reflect.ownKeys(t):Object.keys(t),a=0;a<r.length;a++){var s=r[a];if("__ob__"!==s){for(var i=t[s].from,o=e;o;){if(o._provided&&y(o._provided,i)){n[s]=o._provided[i];break}o=o.$parent}if(!o)if("default"in t[s]){var c=t[s].default;n[s]="function"==typeof c?c.call(e):c}else 0}}return n}}function me(t,e){if(!t||!t.length)return{};for(var n={},r=0,a=t.length;r<a;r++){var s=t[r],i=s.data;if(i&&i.attrs&&i.attrs.slot&&delete i.attrs.slot,s.context!==e&&s.fnContext!==e||!i||null==i.slot)(n.default||(n.default=[])).push(s);else{var o=i.slot,c=n[o]||(n[o]=[]);"template"===s.tag?c.push.apply(c,s.children||[]):c.push(s)}}for(var l in n)n[l].every(ge)&&delete n[l];return n}function ge(t){return t.isComment&&!t.asyncFactory||" "===t.text}function _e(t){return t.isComment&&t.asyncFactory}function ye(e,n,r){var a,s=Object.keys(n).length>0,i=e?!!e.$stable:!s,o=e&&e.$key;if(e){if(e._normalized)return e._normalized;if(i&&r&&r!==t&&o===r.$key&&!s&&!r.$hasNormal)return r;for(var c in a={},e)e[c]&&"$"!==c[0]&&(a[c]=be(n,c,e[c]))}else a={};for(var l in n)l in a||(a[l]=we(n,l));return e&&Object.isExtensible(e)&&(e._normalized=a),H(a,"$stable",i),H(a,"$key",o),H(a,"$hasNormal",s),a}function be(t,e,n){var r=function(){var t=arguments.length?n.apply(null,arguments):n({}),e=(t=t&&"object"==typeof t&&!Array.isArray(t)?[t]:fe(t))&&t[0];return t&&(!e||1===t.length&&e.isComment&&!_e(e))?void 0:t};return n.proxy&&Object.defineProperty(t,e,{get:r,enumerable:!0,configurable:!0}),r}function we(t,e){return function(){return t[e]}}function Ce(t,e){var n,a,s,o,c;if(Array.isArray(t)||"string"==typeof t)for(n=new Array(t.length),a=0,s=t.length;a<s;a++)n[a]=e(t[a],a);else if("number"==typeof t)for(n=new Array(t),a=0;a<t;a++)n[a]=e(a+1,a);else if(i(t))if(ct&&t[Symbol.iterator]){n=[];for(var l=t[Symbol.iterator](),u=l.next();!u.done;)n.push(e(u.value,n.length)),u=l.next()}else for(o=Object.keys(t),n=new Array(o.length),a=0,s=o.length;a<s;a++)c=o[a],n[a]=e(t[c],c,a);return r(n)||(n=[]),n._isVList=!0,n}function xe(t,e,n,r){var a,s=this.$scopedSlots[t];s?(n=n||{},r&&(n=O(O({},r),n)),a=s(n)||("function"==typeof e?e():e)):a=this.$slots[t]||("function"==typeof e?e():e);var i=n&&n.slot;return i?this.$createElement("template",{slot:i},a):a}function $e(t){return Lt(this.$options,"filters",t)||j}function ke(t,e){return Array.isArray(t)?-1===t.indexOf(e):t!==e}function Se(t,e,n,r,a){var s=F.keyCodes[e]||n;return a&&r&&!F.keyCodes[e]?ke(a,r):s?ke(s,t):r?k(r)!==e:void 0===t}function Ae(t,e,n,r,a){if(n)if(i(n)){var s;Array.isArray(n)&&(n=E(n));var o=function(i){if("class"===i||"style"===i||m(i))s=t;else{var o=t.attrs&&t.attrs.type;s=r||F.mustUseProp(e,o,i)?t.domProps||(t.domProps={}):t.attrs||(t.attrs={})}var c=C(i),l=k(i);c in s||l in s||(s[i]=n[i],a&&((t.on||(t.on={}))["update:"+i]=function(t){n[i]=t}))};for(var c in n)o(c)}else;return t}function Oe(t,e){var n=this._staticTrees||(this._staticTrees=[]),r=n[t];return r&&!e||Te(r=n[t]=this.$options.staticRenderFns[t].call(this._renderProxy,null,this),"__static__"+t,!1),r}function Ee(t,e,n){return Te(t,"__once__"+e+(n?"_"+n:""),!0),t}function Te(t,e,n){if(Array.isArray(t))for(var r=0;r<t.length;r++)t[r]&&"string"!=typeof t[r]&&Pe(t[r],e+"_"+r,n);else Pe(t,e,n)}function Pe(t,e,n){t.isStatic=!0,t.key=e,t.isOnce=n}function je(t,e){if(e)if(c(e)){var n=t.on=t.on?O({},t.on):{};for(var r in e){var a=n[r],s=e[r];n[r]=a?[].concat(a,s):s}}else;return t}function Ne(t,e,n,r){e=e||{$stable:!n};for(var a=0;a<t.length;a++){var s=t[a];Array.isArray(s)?Ne(s,e,n):s&&(s.proxy&&(s.fn.proxy=!0),e[s.key]=s.fn)}return r&&(e.$key=r),e}function Be(t,e){for(var n=0;n<e.length;n+=2){var r=e[n];"string"==typeof r&&r&&(t[e[n]]=e[n+1])}return t}function De(t,e){return"string"==typeof t?e+t:t}function Me(t){t._o=Ee,t._n=p,t._s=f,t._l=Ce,t._t=xe,t._q=N,t._i=B,t._m=Oe,t._f=$e,t._k=Se,t._b=Ae,t._v=_t,t._e=gt,t._u=Ne,t._g=je,t._d=Be,t._p=De}function Re(e,n,r,s,i){var o,c=this,l=i.options;y(s,"_uid")?(o=Object.create(s))._original=s:(o=s,s=s._original);var u=a(l._compiled),d=!u;this.data=e,this.props=n,this.children=r,this.parent=s,this.listeners=e.on||t,this.injections=he(l.inject,s),this.slots=function(){return c.$slots||ye(e.scopedSlots,c.$slots=me(r,s)),c.$slots},Object.defineProperty(this,"scopedSlots",{enumerable:!0,get:function(){return ye(e.scopedSlots,this.slots())}}),u&&(this.$options=l,this.$slots=this.slots(),this.$scopedSlots=ye(e.scopedSlots,this.$slots)),l._scopeId?this._c=function(t,e,n,r){var a=qe(o,t,e,n,r,d);return a&&!Array.isArray(a)&&(a.fnScopeId=l._scopeId,a.fnContext=s),a}:this._c=function(t,e,n,r){return qe(o,t,e,n,r,d)}}function Le(t,e,n,r,a){var s=yt(t);return s.fnContext=n,s.fnOptions=r,e.slot&&((s.data||(s.data={})).slot=e.slot),s}function Fe(t,e){for(var n in e)t[C(n)]=e[n]}Me(Re.prototype);var Ue={init:function(t,e){if(t.componentInstance&&!t.componentInstance._isDestroyed&&t.data.keepAlive){var n=t;Ue.prepatch(n,n)}else{(t.componentInstance=function(t,e){var n={_isComponent:!0,_parentVnode:t,parent:e},a=t.data.inlineTemplate;r(a)&&(n.render=a.render,n.staticRenderFns=a.staticRenderFns);return new t.componentOptions.Ctor(n)}(t,tn)).$mount(e?t.elm:void 0,e)}},prepatch:function(e,n){var r=n.componentOptions;!function(e,n,r,a,s){0;var i=a.data.scopedSlots,o=e.$scopedSlots,c=!!(i&&!i.$stable||o!==t&&!o.$stable||i&&e.$scopedSlots.$key!==i.$key||!i&&e.$scopedSlots.$key),l=!!(s||e.$options._renderChildren||c);e.$options._parentVnode=a,e.$vnode=a,e._vnode&&(e._vnode.parent=a);if(e.$options._renderChildren=s,e.$attrs=a.data.attrs||t,e.$listeners=r||t,n&&e.$options.props){$t(!1);for(var u=e._props,d=e.$options._propKeys||[],f=0;f<d.length;f++){var p=d[f],v=e.$options.props;u[p]=Ft(p,v,n,e)}$t(!0),e.$options.propsData=n}r=r||t;var h=e.$options._parentListeners;e.$options._parentListeners=r,Qe(e,r,h),l&&(e.$slots=me(s,a.context),e.$forceUpdate());0}(n.componentInstance=e.componentInstance,r.propsData,r.listeners,n,r.children)},insert:function(t){var e,n=t.context,r=t.componentInstance;r._isMounted||(r._isMounted=!0,sn(r,"mounted")),t.data.keepAlive&&(n._isMounted?((e=r)._inactive=!1,cn.push(e)):rn(r,!0))},destroy:function(t){var e=t.componentInstance;e._isDestroyed||(t.data.keepAlive?an(e,!0):e.$destroy())}},Ie=Object.keys(Ue);function He(n,s,o,c,l){if(!e(n)){var u=o.$options._base;if(i(n)&&(n=u.extend(n)),"function"==typeof n){var f;if(e(n.cid)&&void 0===(n=function(t,n){if(a(t.error)&&r(t.errorComp))return t.errorComp;if(r(t.resolved))return t.resolved;var s=ze;s&&r(t.owners)&&-1===t.owners.indexOf(s)&&t.owners.push(s);if(a(t.loading)&&r(t.loadingComp))return t.loadingComp;if(s&&!r(t.owners)){var o=t.owners=[s],c=!0,l=null,u=null;s.$on("hook:destroyed",(function(){return g(o,s)}));var f=function(t){for(var e=0,n=o.length;e<n;e++)o[e].$forceUpdate();t&&(o.length=0,null!==l&&(clearTimeout(l),l=null),null!==u&&(clearTimeout(u),u=null))},p=D((function(e){t.resolved=Ge(e,n),c?o.length=0:f(!0)})),v=D((function(e){r(t.errorComp)&&(t.error=!0,f(!0))})),h=t(p,v);return i(h)&&(d(h)?e(t.resolved)&&h.then(p,v):d(h.component)&&(h.component.then(p,v),r(h.error)&&(t.errorComp=Ge(h.error,n)),r(h.loading)&&(t.loadingComp=Ge(h.loading,n),0===h.delay?t.loading=!0:l=setTimeout((function(){l=null,e(t.resolved)&&e(t.error)&&(t.loading=!0,f(!1))}),h.delay||200)),r(h.timeout)&&(u=setTimeout((function(){u=null,e(t.resolved)&&v(null)}),h.timeout)))),c=!1,t.loading?t.loadingComp:t.resolved}}(f=n,u)))return function(t,e,n,r,a){var s=gt();return s.asyncFactory=t,s.asyncMeta={data:e,context:n,children:r,tag:a},s}(f,s,o,c,l);s=s||{},On(n),r(s.model)&&function(t,e){var n=t.model&&t.model.prop||"value",a=t.model&&t.model.event||"input";(e.attrs||(e.attrs={}))[n]=e.model.value;var s=e.on||(e.on={}),i=s[a],o=e.model.callback;r(i)?(Array.isArray(i)?-1===i.indexOf(o):i!==o)&&(s[a]=[o].concat(i)):s[a]=o}(n.options,s);var p=function(t,n,a){var s=n.options.props;if(!e(s)){var i={},o=t.attrs,c=t.props;if(r(o)||r(c))for(var l in s){var u=k(l);de(i,c,l,u,!0)||de(i,o,l,u,!1)}return i}}(s,n);if(a(n.options.functional))return function(e,n,a,s,i){var o=e.options,c={},l=o.props;if(r(l))for(var u in l)c[u]=Ft(u,l,n||t);else r(a.attrs)&&Fe(c,a.attrs),r(a.props)&&Fe(c,a.props);var d=new Re(a,c,i,s,e),f=o.render.call(null,d._c,d);if(f instanceof ht)return Le(f,a,d.parent,o);if(Array.isArray(f)){for(var p=fe(f)||[],v=new Array(p.length),h=0;h<p.length;h++)v[h]=Le(p[h],a,d.parent,o);return v}}(n,p,s,o,c);var v=s.on;if(s.on=s.nativeOn,a(n.options.abstract)){var h=s.slot;s={},h&&(s.slot=h)}!function(t){for(var e=t.hook||(t.hook={}),n=0;n<Ie.length;n++){var r=Ie[n],a=e[r],s=Ue[r];a===s||a&&a._merged||(e[r]=a?Ze(s,a):s)}}(s);var m=n.options.name||l;return new ht("vue-component-"+n.cid+(m?"-"+m:""),s,void 0,void 0,void 0,o,{Ctor:n,propsData:p,listeners:v,tag:l,children:c},f)}}}function Ze(t,e){var n=function(n,r){t(n,r),e(n,r)};return n._merged=!0,n}function qe(t,e,n,o,c,l){return(Array.isArray(n)||s(n))&&(c=o,o=n,n=void 0),a(l)&&(c=2),function(t,e,n,a,s){if(r(n)&&r(n.__ob__))return gt();r(n)&&r(n.is)&&(e=n.is);if(!e)return gt();0;Array.isArray(a)&&"function"==typeof a[0]&&((n=n||{}).scopedSlots={default:a[0]},a.length=0);2===s?a=fe(a):1===s&&(a=function(t){for(var e=0;e<t.length;e++)if(Array.isArray(t[e]))return Array.prototype.concat.apply([],t);return t}(a));var o,c;if("string"==typeof e){var l;c=t.$vnode&&t.$vnode.ns||F.getTagNamespace(e),o=F.isReservedTag(e)?new ht(F.parsePlatformTagName(e),n,a,void 0,void 0,t):n&&n.pre||!r(l=Lt(t.$options,"components",e))?new ht(e,n,a,void 0,void 0,t):He(l,n,t,a,e)}else o=He(e,n,t,a);return Array.isArray(o)?o:r(o)?(r(c)&&Ve(o,c),r(n)&&function(t){i(t.style)&&se(t.style);i(t.class)&&se(t.class)}(n),o):gt()}(t,e,n,o,c)}function Ve(t,n,s){if(t.ns=n,"foreignObject"===t.tag&&(n=void 0,s=!0),r(t.children))for(var i=0,o=t.children.length;i<o;i++){var c=t.children[i];r(c.tag)&&(e(c.ns)||a(s)&&"svg"!==c.tag)&&Ve(c,n,s)}}var We,ze=null;function Ge(t,e){return(t.__esModule||ct&&"Module"===t[Symbol.toStringTag])&&(t=t.default),i(t)?e.extend(t):t}function Je(t){if(Array.isArray(t))for(var e=0;e<t.length;e++){var n=t[e];if(r(n)&&(r(n.componentOptions)||_e(n)))return n}}function Ke(t,e){We.$on(t,e)}function Xe(t,e){We.$off(t,e)}function Ye(t,e){var n=We;return function r(){var a=e.apply(null,arguments);null!==a&&n.$off(t,r)}}function Qe(t,e,n){We=t,le(e,n||{},Ke,Xe,Ye,t),We=void 0}var tn=null;function en(t){var e=tn;return tn=t,function(){tn=e}}function nn(t){for(;t&&(t=t.$parent);)if(t._inactive)return!0;return!1}function rn(t,e){if(e){if(t._directInactive=!1,nn(t))return}else if(t._directInactive)return;if(t._inactive||null===t._inactive){t._inactive=!1;for(var n=0;n<t.$children.length;n++)rn(t.$children[n]);sn(t,"activated")}}function an(t,e){if(!(e&&(t._directInactive=!0,nn(t))||t._inactive)){t._inactive=!0;for(var n=0;n<t.$children.length;n++)an(t.$children[n]);sn(t,"deactivated")}}function sn(t,e){pt();var n=t.$options[e],r=e+" hook";if(n)for(var a=0,s=n.length;a<s;a++)Vt(n[a],t,null,t,r);t._hasHookEvent&&t.$emit("hook:"+e),vt()}var on=[],cn=[],ln={},un=!1,dn=!1,fn=0;var pn=0,vn=Date.now;if(W&&!K){var hn=window.performance;hn&&"function"==typeof hn.now&&vn()>document.createEvent("Event").timeStamp&&(vn=function(){return hn.now()})}function mn(){var t,e;for(pn=vn(),dn=!0,on.sort((function(t,e){return t.id-e.id})),fn=0;fn<on.length;fn++)(t=on[fn]).before&&t.before(),e=t.id,ln[e]=null,t.run();var n=cn.slice(),r=on.slice();fn=on.length=cn.length=0,ln={},un=dn=!1,function(t){for(var e=0;e<t.length;e++)t[e]._inactive=!0,rn(t[e],!0)}(n),function(t){var e=t.length;for(;e--;){var n=t[e],r=n.vm;r._watcher===n&&r._isMounted&&!r._isDestroyed&&sn(r,"updated")}}(r),st&&F.devtools&&st.emit("flush")}var gn=0,_n=function(t,e,n,r,a){this.vm=t,a&&(t._watcher=this),t._watchers.push(this),r?(this.deep=!!r.deep,this.user=!!r.user,this.lazy=!!r.lazy,this.sync=!!r.sync,this.before=r.before):this.deep=this.user=this.lazy=this.sync=!1,this.cb=n,this.id=++gn,this.active=!0,this.dirty=this.lazy,this.deps=[],this.newDeps=[],this.depIds=new ot,this.newDepIds=new ot,this.expression="","function"==typeof e?this.getter=e:(this.getter=function(t){if(!Z.test(t)){var e=t.split(".");return function(t){for(var n=0;n<e.length;n++){if(!t)return;t=t[e[n]]}return t}}}(e),this.getter||(this.getter=T)),this.value=this.lazy?void 0:this.get()};_n.prototype.get=function(){var t;pt(this);var e=this.vm;try{t=this.getter.call(e,e)}catch(t){if(!this.user)throw t;qt(t,e,'getter for watcher "'+this.expression+'"')}finally{this.deep&&se(t),vt(),this.cleanupDeps()}return t},_n.prototype.addDep=function(t){var e=t.id;this.newDepIds.has(e)||(this.newDepIds.add(e),this.newDeps.push(t),this.depIds.has(e)||t.addSub(this))},_n.prototype.cleanupDeps=function(){for(var t=this.deps.length;t--;){var e=this.deps[t];this.newDepIds.has(e.id)||e.removeSub(this)}var n=this.depIds;this.depIds=this.newDepIds,this.newDepIds=n,this.newDepIds.clear(),n=this.deps,this.deps=this.newDeps,this.newDeps=n,this.newDeps.length=0},_n.prototype.update=function(){this.lazy?this.dirty=!0:this.sync?this.run():function(t){var e=t.id;if(null==ln[e]){if(ln[e]=!0,dn){for(var n=on.length-1;n>fn&&on[n].id>t.id;)n--;on.splice(n+1,0,t)}else on.push(t);un||(un=!0,re(mn))}}(this)},_n.prototype.run=function(){if(this.active){var t=this.get();if(t!==this.value||i(t)||this.deep){var e=this.value;if(this.value=t,this.user){var n='callback for watcher "'+this.expression+'"';Vt(this.cb,this.vm,[t,e],this.vm,n)}else this.cb.call(this.vm,t,e)}}},_n.prototype.evaluate=function(){this.value=this.get(),this.dirty=!1},_n.prototype.depend=function(){for(var t=this.deps.length;t--;)this.deps[t].depend()},_n.prototype.teardown=function(){if(this.active){this.vm._isBeingDestroyed||g(this.vm._watchers,this);for(var t=this.deps.length;t--;)this.deps[t].removeSub(this);this.active=!1}};var yn={enumerable:!0,configurable:!0,get:T,set:T};function bn(t,e,n){yn.get=function(){return this[e][n]},yn.set=function(t){this[e][n]=t},Object.defineProperty(t,n,yn)}function wn(t){t._watchers=[];var e=t.$options;e.props&&function(t,e){var n=t.$options.propsData||{},r=t._props={},a=t.$options._propKeys=[];t.$parent&&$t(!1);var s=function(s){a.push(s);var i=Ft(s,e,n,t);At(r,s,i),s in t||bn(t,"_props",s)};for(var i in e)s(i);$t(!0)}(t,e.props),e.methods&&function(t,e){t.$options.props;for(var n in e)t[n]="function"!=typeof e[n]?T:S(e[n],t)}(t,e.methods),e.data?function(t){var e=t.$options.data;c(e=t._data="function"==typeof e?function(t,e){pt();try{return t.call(e,e)}catch(t){return qt(t,e,"data()"),{}}finally{vt()}}(e,t):e||{})||(e={});var n=Object.keys(e),r=t.$options.props,a=(t.$options.methods,n.length);for(;a--;){var s=n[a];0,r&&y(r,s)||I(s)||bn(t,"_data",s)}St(e,!0)}(t):St(t._data={},!0),e.computed&&function(t,e){var n=t._computedWatchers=Object.create(null),r=at();for(var a in e){var s=e[a],i="function"==typeof s?s:s.get;0,r||(n[a]=new _n(t,i||T,T,Cn)),a in t||xn(t,a,s)}}(t,e.computed),e.watch&&e.watch!==et&&function(t,e){for(var n in e){var r=e[n];if(Array.isArray(r))for(var a=0;a<r.length;a++)Sn(t,n,r[a]);else Sn(t,n,r)}}(t,e.watch)}var Cn={lazy:!0};function xn(t,e,n){var r=!at();"function"==typeof n?(yn.get=r?$n(e):kn(n),yn.set=T):(yn.get=n.get?r&&!1!==n.cache?$n(e):kn(n.get):T,yn.set=n.set||T),Object.defineProperty(t,e,yn)}function $n(t){return function(){var e=this._computedWatchers&&this._computedWatchers[t];if(e)return e.dirty&&e.evaluate(),dt.target&&e.depend(),e.value}}function kn(t){return function(){return t.call(this,this)}}function Sn(t,e,n,r){return c(n)&&(r=n,n=n.handler),"string"==typeof n&&(n=t[n]),t.$watch(e,n,r)}var An=0;function On(t){var e=t.options;if(t.super){var n=On(t.super);if(n!==t.superOptions){t.superOptions=n;var r=function(t){var e,n=t.options,r=t.sealedOptions;for(var a in n)n[a]!==r[a]&&(e||(e={}),e[a]=n[a]);return e}(t);r&&O(t.extendOptions,r),(e=t.options=Rt(n,t.extendOptions)).name&&(e.components[e.name]=t)}}return e}function En(t){this._init(t)}function Tn(t){t.cid=0;var e=1;t.extend=function(t){t=t||{};var n=this,r=n.cid,a=t._Ctor||(t._Ctor={});if(a[r])return a[r];var s=t.name||n.options.name;var i=function(t){this._init(t)};return(i.prototype=Object.create(n.prototype)).constructor=i,i.cid=e++,i.options=Rt(n.options,t),i.super=n,i.options.props&&function(t){var e=t.options.props;for(var n in e)bn(t.prototype,"_props",n)}(i),i.options.computed&&function(t){var e=t.options.computed;for(var n in e)xn(t.prototype,n,e[n])}(i),i.extend=n.extend,i.mixin=n.mixin,i.use=n.use,R.forEach((function(t){i[t]=n[t]})),s&&(i.options.components[s]=i),i.superOptions=n.options,i.extendOptions=t,i.sealedOptions=O({},i.options),a[r]=i,i}}function Pn(t){return t&&(t.Ctor.options.name||t.tag)}function jn(t,e){return Array.isArray(t)?t.indexOf(e)>-1:"string"==typeof t?t.split(",").indexOf(e)>-1:!!l(t)&&t.test(e)}function Nn(t,e){var n=t.cache,r=t.keys,a=t._vnode;for(var s in n){var i=n[s];if(i){var o=i.name;o&&!e(o)&&Bn(n,s,r,a)}}}function Bn(t,e,n,r){var a=t[e];!a||r&&a.tag===r.tag||a.componentInstance.$destroy(),t[e]=null,g(n,e)}!function(e){e.prototype._init=function(e){var n=this;n._uid=An++,n._isVue=!0,e&&e._isComponent?function(t,e){var n=t.$options=Object.create(t.constructor.options),r=e._parentVnode;n.parent=e.parent,n._parentVnode=r;var a=r.componentOptions;n.propsData=a.propsData,n._parentListeners=a.listeners,n._renderChildren=a.children,n._componentTag=a.tag,e.render&&(n.render=e.render,n.staticRenderFns=e.staticRenderFns)}(n,e):n.$options=Rt(On(n.constructor),e||{},n),n._renderProxy=n,n._self=n,function(t){var e=t.$options,n=e.parent;if(n&&!e.abstract){for(;n.$options.abstract&&n.$parent;)n=n.$parent;n.$children.push(t)}t.$parent=n,t.$root=n?n.$root:t,t.$children=[],t.$refs={},t._watcher=null,t._inactive=null,t._directInactive=!1,t._isMounted=!1,t._isDestroyed=!1,t._isBeingDestroyed=!1}(n),function(t){t._events=Object.create(null),t._hasHookEvent=!1;var e=t.$options._parentListeners;e&&Qe(t,e)}(n),function(e){e._vnode=null,e._staticTrees=null;var n=e.$options,r=e.$vnode=n._parentVnode,a=r&&r.context;e.$slots=me(n._renderChildren,a),e.$scopedSlots=t,e._c=function(t,n,r,a){return qe(e,t,n,r,a,!1)},e.$createElement=function(t,n,r,a){return qe(e,t,n,r,a,!0)};var s=r&&r.data;At(e,"$attrs",s&&s.attrs||t,null,!0),At(e,"$listeners",n._parentListeners||t,null,!0)}(n),sn(n,"beforeCreate"),function(t){var e=he(t.$options.inject,t);e&&($t(!1),Object.keys(e).forEach((function(n){At(t,n,e[n])})),$t(!0))}(n),wn(n),function(t){var e=t.$options.provide;e&&(t._provided="function"==typeof e?e.call(t):e)}(n),sn(n,"created"),n.$options.el&&n.$mount(n.$options.el)}}(En),function(t){var e={get:function(){return this._data}},n={get:function(){return this._props}};Object.defineProperty(t.prototype,"$data",e),Object.defineProperty(t.prototype,"$props",n),t.prototype.$set=Ot,t.prototype.$delete=Et,t.prototype.$watch=function(t,e,n){var r=this;if(c(e))return Sn(r,t,e,n);(n=n||{}).user=!0;var a=new _n(r,t,e,n);if(n.immediate){var s='callback for immediate watcher "'+a.expression+'"';pt(),Vt(e,r,[a.value],r,s),vt()}return function(){a.teardown()}}}(En),function(t){var e=/^hook:/;t.prototype.$on=function(t,n){var r=this;if(Array.isArray(t))for(var a=0,s=t.length;a<s;a++)r.$on(t[a],n);else(r._events[t]||(r._events[t]=[])).push(n),e.test(t)&&(r._hasHookEvent=!0);return r},t.prototype.$once=function(t,e){var n=this;function r(){n.$off(t,r),e.apply(n,arguments)}return r.fn=e,n.$on(t,r),n},t.prototype.$off=function(t,e){var n=this;if(!arguments.length)return n._events=Object.create(null),n;if(Array.isArray(t)){for(var r=0,a=t.length;r<a;r++)n.$off(t[r],e);return n}var s,i=n._events[t];if(!i)return n;if(!e)return n._events[t]=null,n;for(var o=i.length;o--;)if((s=i[o])===e||s.fn===e){i.splice(o,1);break}return n},t.prototype.$emit=function(t){var e=this,n=e._events[t];if(n){n=n.length>1?A(n):n;for(var r=A(arguments,1),a='event handler for "'+t+'"',s=0,i=n.length;s<i;s++)Vt(n[s],e,r,e,a)}return e}}(En),function(t){t.prototype._update=function(t,e){var n=this,r=n.$el,a=n._vnode,s=en(n);n._vnode=t,n.$el=a?n.__patch__(a,t):n.__patch__(n.$el,t,e,!1),s(),r&&(r.__vue__=null),n.$el&&(n.$el.__vue__=n),n.$vnode&&n.$parent&&n.$vnode===n.$parent._vnode&&(n.$parent.$el=n.$el)},t.prototype.$forceUpdate=function(){this._watcher&&this._watcher.update()},t.prototype.$destroy=function(){var t=this;if(!t._isBeingDestroyed){sn(t,"beforeDestroy"),t._isBeingDestroyed=!0;var e=t.$parent;!e||e._isBeingDestroyed||t.$options.abstract||g(e.$children,t),t._watcher&&t._watcher.teardown();for(var n=t._watchers.length;n--;)t._watchers[n].teardown();t._data.__ob__&&t._data.__ob__.vmCount--,t._isDestroyed=!0,t.__patch__(t._vnode,null),sn(t,"destroyed"),t.$off(),t.$el&&(t.$el.__vue__=null),t.$vnode&&(t.$vnode.parent=null)}}}(En),function(t){Me(t.prototype),t.prototype.$nextTick=function(t){return re(t,this)},t.prototype._render=function(){var t,e=this,n=e.$options,r=n.render,a=n._parentVnode;a&&(e.$scopedSlots=ye(a.data.scopedSlots,e.$slots,e.$scopedSlots)),e.$vnode=a;try{ze=e,t=r.call(e._renderProxy,e.$createElement)}catch(n){qt(n,e,"render"),t=e._vnode}finally{ze=null}return Array.isArray(t)&&1===t.length&&(t=t[0]),t instanceof ht||(t=gt()),t.parent=a,t}}(En);var Dn=[String,RegExp,Array],Mn={KeepAlive:{name:"keep-alive",abstract:!0,props:{include:Dn,exclude:Dn,max:[String,Number]},methods:{cacheVNode:function(){var t=this,e=t.cache,n=t.keys,r=t.vnodeToCache,a=t.keyToCache;if(r){var s=r.tag,i=r.componentInstance,o=r.componentOptions;e[a]={name:Pn(o),tag:s,componentInstance:i},n.push(a),this.max&&n.length>parseInt(this.max)&&Bn(e,n[0],n,this._vnode),this.vnodeToCache=null}}},created:function(){this.cache=Object.create(null),this.keys=[]},destroyed:function(){for(var t in this.cache)Bn(this.cache,t,this.keys)},mounted:function(){var t=this;this.cacheVNode(),this.$watch("include",(function(e){Nn(t,(function(t){return jn(e,t)}))})),this.$watch("exclude",(function(e){Nn(t,(function(t){return!jn(e,t)}))}))},updated:function(){this.cacheVNode()},render:function(){var t=this.$slots.default,e=Je(t),n=e&&e.componentOptions;if(n){var r=Pn(n),a=this.include,s=this.exclude;if(a&&(!r||!jn(a,r))||s&&r&&jn(s,r))return e;var i=this.cache,o=this.keys,c=null==e.key?n.Ctor.cid+(n.tag?"::"+n.tag:""):e.key;i[c]?(e.componentInstance=i[c].componentInstance,g(o,c),o.push(c)):(this.vnodeToCache=e,this.keyToCache=c),e.data.keepAlive=!0}return e||t&&t[0]}}};!function(t){var e={get:function(){return F}};Object.defineProperty(t,"config",e),t.util={warn:lt,extend:O,mergeOptions:Rt,defineReactive:At},t.set=Ot,t.delete=Et,t.nextTick=re,t.observable=function(t){return St(t),t},t.options=Object.create(null),R.forEach((function(e){t.options[e+"s"]=Object.create(null)})),t.options._base=t,O(t.options.components,Mn),function(t){t.use=function(t){var e=this._installedPlugins||(this._installedPlugins=[]);if(e.indexOf(t)>-1)return this;var n=A(arguments,1);return n.unshift(this),"function"==typeof t.install?t.install.apply(t,n):"function"==typeof t&&t.apply(null,n),e.push(t),this}}(t),function(t){t.mixin=function(t){return this.options=Rt(this.options,t),this}}(t),Tn(t),function(t){R.forEach((function(e){t[e]=function(t,n){return n?("component"===e&&c(n)&&(n.name=n.name||t,n=this.options._base.extend(n)),"directive"===e&&"function"==typeof n&&(n={bind:n,update:n}),this.options[e+"s"][t]=n,n):this.options[e+"s"][t]}}))}(t)}(En),Object.defineProperty(En.prototype,"$isServer",{get:at}),Object.defineProperty(En.prototype,"$ssrContext",{get:function(){return this.$vnode&&this.$vnode.ssrContext}}),Object.defineProperty(En,"FunctionalRenderContext",{value:Re}),En.version="2.6.14";var Rn=v("style,class"),Ln=v("input,textarea,option,select,progress"),Fn=function(t,e,n){return"value"===n&&Ln(t)&&"button"!==e||"selected"===n&&"option"===t||"checked"===n&&"input"===t||"muted"===n&&"video"===t},Un=v("contenteditable,draggable,spellcheck"),In=v("events,caret,typing,plaintext-only"),Hn=v("allowfullscreen,async,autofocus,autoplay,checked,compact,controls,declare,default,defaultchecked,defaultmuted,defaultselected,defer,disabled,enabled,formnovalidate,hidden,indeterminate,inert,ismap,itemscope,loop,multiple,muted,nohref,noresize,noshade,novalidate,nowrap,open,pauseonexit,readonly,required,reversed,scoped,seamless,selected,sortable,truespeed,typemustmatch,visible"),Zn="http://www.w3.org/1999/xlink",qn=function(t){return":"===t.charAt(5)&&"xlink"===t.slice(0,5)},Vn=function(t){return qn(t)?t.slice(6,t.length):""},Wn=function(t){return null==t||!1===t};function zn(t){for(var e=t.data,n=t,a=t;r(a.componentInstance);)(a=a.componentInstance._vnode)&&a.data&&(e=Gn(a.data,e));for(;r(n=n.parent);)n&&n.data&&(e=Gn(e,n.data));return function(t,e){if(r(t)||r(e))return Jn(t,Kn(e));return""}(e.staticClass,e.class)}function Gn(t,e){return{staticClass:Jn(t.staticClass,e.staticClass),class:r(t.class)?[t.class,e.class]:e.class}}function Jn(t,e){return t?e?t+" "+e:t:e||""}function Kn(t){return Array.isArray(t)?function(t){for(var e,n="",a=0,s=t.length;a<s;a++)r(e=Kn(t[a]))&&""!==e&&(n&&(n+=" "),n+=e);return n}(t):i(t)?function(t){var e="";for(var n in t)t[n]&&(e&&(e+=" "),e+=n);return e}(t):"string"==typeof t?t:""}var Xn={svg:"http://www.w3.org/2000/svg",math:"http://www.w3.org/1998/Math/MathML"},Yn=v("html,body,base,head,link,meta,style,title,address,article,aside,footer,header,h1,h2,h3,h4,h5,h6,hgroup,nav,section,div,dd,dl,dt,figcaption,figure,picture,hr,img,li,main,ol,p,pre,ul,a,b,abbr,bdi,bdo,br,cite,code,data,dfn,em,i,kbd,mark,q,rp,rt,rtc,ruby,s,samp,small,span,strong,sub,sup,time,u,var,wbr,area,audio,map,track,video,embed,object,param,source,canvas,script,noscript,del,ins,caption,col,colgroup,table,thead,tbody,td,th,tr,button,datalist,fieldset,form,input,label,legend,meter,optgroup,option,output,progress,select,textarea,details,dialog,menu,menuitem,summary,content,element,shadow,template,blockquote,iframe,tfoot"),Qn=v("svg,animate,circle,clippath,cursor,defs,desc,ellipse,filter,font-face,foreignobject,g,glyph,image,line,marker,mask,missing-glyph,path,pattern,polygon,polyline,rect,switch,symbol,text,textpath,tspan,use,view",!0),tr=function(t){return Yn(t)||Qn(t)};function er(t){return Qn(t)?"svg":"math"===t?"math":void 0}var nr=Object.create(null);var rr=v("text,number,password,search,email,tel,url");function ar(t){if("string"==typeof t){var e=document.querySelector(t);return e||document.createElement("div")}return t}var sr=Object.freeze({createElement:function(t,e){var n=document.createElement(t);return"select"!==t||e.data&&e.data.attrs&&void 0!==e.data.attrs.multiple&&n.setAttribute("multiple","multiple"),n},createElementNS:function(t,e){return document.createElementNS(Xn[t],e)},createTextNode:function(t){return document.createTextNode(t)},createComment:function(t){return document.createComment(t)},insertBefore:function(t,e,n){t.insertBefore(e,n)},removeChild:function(t,e){t.removeChild(e)},appendChild:function(t,e){t.appendChild(e)},parentNode:function(t){return t.parentNode},nextSibling:function(t){return t.nextSibling},tagName:function(t){return t.tagName},setTextContent:function(t,e){t.textContent=e},setStyleScope:function(t,e){t.setAttribute(e,"")}}),ir={create:function(t,e){or(e)},update:function(t,e){t.data.ref!==e.data.ref&&(or(t,!0),or(e))},destroy:function(t){or(t,!0)}};function or(t,e){var n=t.data.ref;if(r(n)){var a=t.context,s=t.componentInstance||t.elm,i=a.$refs;e?Array.isArray(i[n])?g(i[n],s):i[n]===s&&(i[n]=void 0):t.data.refInFor?Array.isArray(i[n])?i[n].indexOf(s)<0&&i[n].push(s):i[n]=[s]:i[n]=s}}var cr=new ht("",{},[]),lr=["create","activate","update","remove","destroy"];function ur(t,n){return t.key===n.key&&t.asyncFactory===n.asyncFactory&&(t.tag===n.tag&&t.isComment===n.isComment&&r(t.data)===r(n.data)&&function(t,e){if("input"!==t.tag)return!0;var n,a=r(n=t.data)&&r(n=n.attrs)&&n.type,s=r(n=e.data)&&r(n=n.attrs)&&n.type;return a===s||rr(a)&&rr(s)}(t,n)||a(t.isAsyncPlaceholder)&&e(n.asyncFactory.error))}function dr(t,e,n){var a,s,i={};for(a=e;a<=n;++a)r(s=t[a].key)&&(i[s]=a);return i}var fr={create:pr,update:pr,destroy:function(t){pr(t,cr)}};function pr(t,e){(t.data.directives||e.data.directives)&&function(t,e){var n,r,a,s=t===cr,i=e===cr,o=hr(t.data.directives,t.context),c=hr(e.data.directives,e.context),l=[],u=[];for(n in c)r=o[n],a=c[n],r?(a.oldValue=r.value,a.oldArg=r.arg,gr(a,"update",e,t),a.def&&a.def.componentUpdated&&u.push(a)):(gr(a,"bind",e,t),a.def&&a.def.inserted&&l.push(a));if(l.length){var d=function(){for(var n=0;n<l.length;n++)gr(l[n],"inserted",e,t)};s?ue(e,"insert",d):d()}u.length&&ue(e,"postpatch",(function(){for(var n=0;n<u.length;n++)gr(u[n],"componentUpdated",e,t)}));if(!s)for(n in o)c[n]||gr(o[n],"unbind",t,t,i)}(t,e)}var vr=Object.create(null);function hr(t,e){var n,r,a=Object.create(null);if(!t)return a;for(n=0;n<t.length;n++)(r=t[n]).modifiers||(r.modifiers=vr),a[mr(r)]=r,r.def=Lt(e.$options,"directives",r.name);return a}function mr(t){return t.rawName||t.name+"."+Object.keys(t.modifiers||{}).join(".")}function gr(t,e,n,r,a){var s=t.def&&t.def[e];if(s)try{s(n.elm,t,n,r,a)}catch(r){qt(r,n.context,"directive "+t.name+" "+e+" hook")}}var _r=[ir,fr];function yr(t,n){var a=n.componentOptions;if(!(r(a)&&!1===a.Ctor.options.inheritAttrs||e(t.data.attrs)&&e(n.data.attrs))){var s,i,o=n.elm,c=t.data.attrs||{},l=n.data.attrs||{};for(s in r(l.__ob__)&&(l=n.data.attrs=O({},l)),l)i=l[s],c[s]!==i&&br(o,s,i,n.data.pre);for(s in(K||Y)&&l.value!==c.value&&br(o,"value",l.value),c)e(l[s])&&(qn(s)?o.removeAttributeNS(Zn,Vn(s)):Un(s)||o.removeAttribute(s))}}function br(t,e,n,r){r||t.tagName.indexOf("-")>-1?wr(t,e,n):Hn(e)?Wn(n)?t.removeAttribute(e):(n="allowfullscreen"===e&&"EMBED"===t.tagName?"true":e,t.setAttribute(e,n)):Un(e)?t.setAttribute(e,function(t,e){return Wn(e)||"false"===e?"false":"contenteditable"===t&&In(e)?e:"true"}(e,n)):qn(e)?Wn(n)?t.removeAttributeNS(Zn,Vn(e)):t.setAttributeNS(Zn,e,n):wr(t,e,n)}function wr(t,e,n){if(Wn(n))t.removeAttribute(e);else{if(K&&!X&&"TEXTAREA"===t.tagName&&"placeholder"===e&&""!==n&&!t.__ieph){var r=function(e){e.stopImmediatePropagation(),t.removeEventListener("input",r)};t.addEventListener("input",r),t.__ieph=!0}t.setAttribute(e,n)}}var Cr={create:yr,update:yr};function xr(t,n){var a=n.elm,s=n.data,i=t.data;if(!(e(s.staticClass)&&e(s.class)&&(e(i)||e(i.staticClass)&&e(i.class)))){var o=zn(n),c=a._transitionClasses;r(c)&&(o=Jn(o,Kn(c))),o!==a._prevClass&&(a.setAttribute("class",o),a._prevClass=o)}}var $r,kr,Sr,Ar,Or,Er,Tr={create:xr,update:xr},Pr=/[\w).+\-_$\]]/;function jr(t){var e,n,r,a,s,i=!1,o=!1,c=!1,l=!1,u=0,d=0,f=0,p=0;for(r=0;r<t.length;r++)if(n=e,e=t.charCodeAt(r),i)39===e&&92!==n&&(i=!1);else if(o)34===e&&92!==n&&(o=!1);else if(c)96===e&&92!==n&&(c=!1);else if(l)47===e&&92!==n&&(l=!1);else if(124!==e||124===t.charCodeAt(r+1)||124===t.charCodeAt(r-1)||u||d||f){switch(e){case 34:o=!0;break;case 39:i=!0;break;case 96:c=!0;break;case 40:f++;break;case 41:f--;break;case 91:d++;break;case 93:d--;break;case 123:u++;break;case 125:u--}if(47===e){for(var v=r-1,h=void 0;v>=0&&" "===(h=t.charAt(v));v--);h&&Pr.test(h)||(l=!0)}}else void 0===a?(p=r+1,a=t.slice(0,r).trim()):m();function m(){(s||(s=[])).push(t.slice(p,r).trim()),p=r+1}if(void 0===a?a=t.slice(0,r).trim():0!==p&&m(),s)for(r=0;r<s.length;r++)a=Nr(a,s[r]);return a}function Nr(t,e){var n=e.indexOf("(");if(n<0)return'_f("'+e+'")('+t+")";var r=e.slice(0,n),a=e.slice(n+1);return'_f("'+r+'")('+t+(")"!==a?","+a:a)}function Br(t,e){console.error("[Vue compiler]: "+t)}function Dr(t,e){return t?t.map((function(t){return t[e]})).filter((function(t){return t})):[]}function Mr(t,e,n,r,a){(t.props||(t.props=[])).push(Vr({name:e,value:n,dynamic:a},r)),t.plain=!1}function Rr(t,e,n,r,a){(a?t.dynamicAttrs||(t.dynamicAttrs=[]):t.attrs||(t.attrs=[])).push(Vr({name:e,value:n,dynamic:a},r)),t.plain=!1}function Lr(t,e,n,r){t.attrsMap[e]=n,t.attrsList.push(Vr({name:e,value:n},r))}function Fr(t,e,n,r,a,s,i,o){(t.directives||(t.directives=[])).push(Vr({name:e,rawName:n,value:r,arg:a,isDynamicArg:s,modifiers:i},o)),t.plain=!1}function Ur(t,e,n){return n?"_p("+e+',"'+t+'")':t+e}function Ir(e,n,r,a,s,i,o,c){var l;(a=a||t).right?c?n="("+n+")==='click'?'contextmenu':("+n+")":"click"===n&&(n="contextmenu",delete a.right):a.middle&&(c?n="("+n+")==='click'?'mouseup':("+n+")":"click"===n&&(n="mouseup")),a.capture&&(delete a.capture,n=Ur("!",n,c)),a.once&&(delete a.once,n=Ur("~",n,c)),a.passive&&(delete a.passive,n=Ur("&",n,c)),a.native?(delete a.native,l=e.nativeEvents||(e.nativeEvents={})):l=e.events||(e.events={});var u=Vr({value:r.trim(),dynamic:c},o);a!==t&&(u.modifiers=a);var d=l[n];Array.isArray(d)?s?d.unshift(u):d.push(u):l[n]=d?s?[u,d]:[d,u]:u,e.plain=!1}function Hr(t,e,n){var r=Zr(t,":"+e)||Zr(t,"v-bind:"+e);if(null!=r)return jr(r);if(!1!==n){var a=Zr(t,e);if(null!=a)return JSON.stringify(a)}}function Zr(t,e,n){var r;if(null!=(r=t.attrsMap[e]))for(var a=t.attrsList,s=0,i=a.length;s<i;s++)if(a[s].name===e){a.splice(s,1);break}return n&&delete t.attrsMap[e],r}function qr(t,e){for(var n=t.attrsList,r=0,a=n.length;r<a;r++){var s=n[r];if(e.test(s.name))return n.splice(r,1),s}}function Vr(t,e){return e&&(null!=e.start&&(t.start=e.start),null!=e.end&&(t.end=e.end)),t}function Wr(t,e,n){var r=n||{},a=r.number,s="$$v",i=s;r.trim&&(i="(typeof $$v === 'string'? $$v.trim(): $$v)"),a&&(i="_n("+i+")");var o=zr(e,i);t.model={value:"("+e+")",expression:JSON.stringify(e),callback:"function ($$v) {"+o+"}"}}function zr(t,e){var n=function(t){if(t=t.trim(),$r=t.length,t.indexOf("[")<0||t.lastIndexOf("]")<$r-1)return(Ar=t.lastIndexOf("."))>-1?{exp:t.slice(0,Ar),key:'"'+t.slice(Ar+1)+'"'}:{exp:t,key:null};kr=t,Ar=Or=Er=0;for(;!Jr();)Kr(Sr=Gr())?Yr(Sr):91===Sr&&Xr(Sr);return{exp:t.slice(0,Or),key:t.slice(Or+1,Er)}}(t);return null===n.key?t+"="+e:"$set("+n.exp+", "+n.key+", "+e+")"}function Gr(){return kr.charCodeAt(++Ar)}function Jr(){return Ar>=$r}function Kr(t){return 34===t||39===t}function Xr(t){var e=1;for(Or=Ar;!Jr();)if(Kr(t=Gr()))Yr(t);else if(91===t&&e++,93===t&&e--,0===e){Er=Ar;break}}function Yr(t){for(var e=t;!Jr()&&(t=Gr())!==e;);}var Qr,ta="__r";function ea(t,e,n){var r=Qr;return function a(){var s=e.apply(null,arguments);null!==s&&aa(t,a,n,r)}}var na=Jt&&!(tt&&Number(tt[1])<=53);function ra(t,e,n,r){if(na){var a=pn,s=e;e=s._wrapper=function(t){if(t.target===t.currentTarget||t.timeStamp>=a||t.timeStamp<=0||t.target.ownerDocument!==document)return s.apply(this,arguments)}}Qr.addEventListener(t,e,nt?{capture:n,passive:r}:n)}function aa(t,e,n,r){(r||Qr).removeEventListener(t,e._wrapper||e,n)}function sa(t,n){if(!e(t.data.on)||!e(n.data.on)){var a=n.data.on||{},s=t.data.on||{};Qr=n.elm,function(t){if(r(t.__r)){var e=K?"change":"input";t[e]=[].concat(t.__r,t[e]||[]),delete t.__r}r(t.__c)&&(t.change=[].concat(t.__c,t.change||[]),delete t.__c)}(a),le(a,s,ra,aa,ea,n.context),Qr=void 0}}var ia,oa={create:sa,update:sa};function ca(t,n){if(!e(t.data.domProps)||!e(n.data.domProps)){var a,s,i=n.elm,o=t.data.domProps||{},c=n.data.domProps||{};for(a in r(c.__ob__)&&(c=n.data.domProps=O({},c)),o)a in c||(i[a]="");for(a in c){if(s=c[a],"textContent"===a||"innerHTML"===a){if(n.children&&(n.children.length=0),s===o[a])continue;1===i.childNodes.length&&i.removeChild(i.childNodes[0])}if("value"===a&&"PROGRESS"!==i.tagName){i._value=s;var l=e(s)?"":String(s);la(i,l)&&(i.value=l)}else if("innerHTML"===a&&Qn(i.tagName)&&e(i.innerHTML)){(ia=ia||document.createElement("div")).innerHTML="<svg>"+s+"</svg>";for(var u=ia.firstChild;i.firstChild;)i.removeChild(i.firstChild);for(;u.firstChild;)i.appendChild(u.firstChild)}else if(s!==o[a])try{i[a]=s}catch(t){}}}}function la(t,e){return!t.composing&&("OPTION"===t.tagName||function(t,e){var n=!0;try{n=document.activeElement!==t}catch(t){}return n&&t.value!==e}(t,e)||function(t,e){var n=t.value,a=t._vModifiers;if(r(a)){if(a.number)return p(n)!==p(e);if(a.trim)return n.trim()!==e.trim()}return n!==e}(t,e))}var ua={create:ca,update:ca},da=b((function(t){var e={},n=/:(.+)/;return t.split(/;(?![^(]*\))/g).forEach((function(t){if(t){var r=t.split(n);r.length>1&&(e[r[0].trim()]=r[1].trim())}})),e}));function fa(t){var e=pa(t.style);return t.staticStyle?O(t.staticStyle,e):e}function pa(t){return Array.isArray(t)?E(t):"string"==typeof t?da(t):t}var va,ha=/^--/,ma=/\s*!important$/,ga=function(t,e,n){if(ha.test(e))t.style.setProperty(e,n);else if(ma.test(n))t.style.setProperty(k(e),n.replace(ma,""),"important");else{var r=ya(e);if(Array.isArray(n))for(var a=0,s=n.length;a<s;a++)t.style[r]=n[a];else t.style[r]=n}},_a=["Webkit","Moz","ms"],ya=b((function(t){if(va=va||document.createElement("div").style,"filter"!==(t=C(t))&&t in va)return t;for(var e=t.charAt(0).toUpperCase()+t.slice(1),n=0;n<_a.length;n++){var r=_a[n]+e;if(r in va)return r}}));function ba(t,n){var a=n.data,s=t.data;if(!(e(a.staticStyle)&&e(a.style)&&e(s.staticStyle)&&e(s.style))){var i,o,c=n.elm,l=s.staticStyle,u=s.normalizedStyle||s.style||{},d=l||u,f=pa(n.data.style)||{};n.data.normalizedStyle=r(f.__ob__)?O({},f):f;var p=function(t,e){var n,r={};if(e)for(var a=t;a.componentInstance;)(a=a.componentInstance._vnode)&&a.data&&(n=fa(a.data))&&O(r,n);(n=fa(t.data))&&O(r,n);for(var s=t;s=s.parent;)s.data&&(n=fa(s.data))&&O(r,n);return r}(n,!0);for(o in d)e(p[o])&&ga(c,o,"");for(o in p)(i=p[o])!==d[o]&&ga(c,o,null==i?"":i)}}var wa={create:ba,update:ba},Ca=/\s+/;function xa(t,e){if(e&&(e=e.trim()))if(t.classList)e.indexOf(" ")>-1?e.split(Ca).forEach((function(e){return t.classList.add(e)})):t.classList.add(e);else{var n=" "+(t.getAttribute("class")||"")+" ";n.indexOf(" "+e+" ")<0&&t.setAttribute("class",(n+e).trim())}}function $a(t,e){if(e&&(e=e.trim()))if(t.classList)e.indexOf(" ")>-1?e.split(Ca).forEach((function(e){return t.classList.remove(e)})):t.classList.remove(e),t.classList.length||t.removeAttribute("class");else{for(var n=" "+(t.getAttribute("class")||"")+" ",r=" "+e+" ";n.indexOf(r)>=0;)n=n.replace(r," ");(n=n.trim())?t.setAttribute("class",n):t.removeAttribute("class")}}function ka(t){if(t){if("object"==typeof t){var e={};return!1!==t.css&&O(e,Sa(t.name||"v")),O(e,t),e}return"string"==typeof t?Sa(t):void 0}}var Sa=b((function(t){return{enterClass:t+"-enter",enterToClass:t+"-enter-to",enterActiveClass:t+"-enter-active",leaveClass:t+"-leave",leaveToClass:t+"-leave-to",leaveActiveClass:t+"-leave-active"}})),Aa=W&&!X,Oa="transition",Ea="animation",Ta="transition",Pa="transitionend",ja="animation",Na="animationend";Aa&&(void 0===window.ontransitionend&&void 0!==window.onwebkittransitionend&&(Ta="WebkitTransition",Pa="webkitTransitionEnd"),void 0===window.onanimationend&&void 0!==window.onwebkitanimationend&&(ja="WebkitAnimation",Na="webkitAnimationEnd"));var Ba=W?window.requestAnimationFrame?window.requestAnimationFrame.bind(window):setTimeout:function(t){return t()};function Da(t){Ba((function(){Ba(t)}))}function Ma(t,e){var n=t._transitionClasses||(t._transitionClasses=[]);n.indexOf(e)<0&&(n.push(e),xa(t,e))}function Ra(t,e){t._transitionClasses&&g(t._transitionClasses,e),$a(t,e)}function La(t,e,n){var r=Ua(t,e),a=r.type,s=r.timeout,i=r.propCount;if(!a)return n();var o=a===Oa?Pa:Na,c=0,l=function(){t.removeEventListener(o,u),n()},u=function(e){e.target===t&&++c>=i&&l()};setTimeout((function(){c<i&&l()}),s+1),t.addEventListener(o,u)}var Fa=/\b(transform|all)(,|$)/;function Ua(t,e){var n,r=window.getComputedStyle(t),a=(r[Ta+"Delay"]||"").split(", "),s=(r[Ta+"Duration"]||"").split(", "),i=Ia(a,s),o=(r[ja+"Delay"]||"").split(", "),c=(r[ja+"Duration"]||"").split(", "),l=Ia(o,c),u=0,d=0;return e===Oa?i>0&&(n=Oa,u=i,d=s.length):e===Ea?l>0&&(n=Ea,u=l,d=c.length):d=(n=(u=Math.max(i,l))>0?i>l?Oa:Ea:null)?n===Oa?s.length:c.length:0,{type:n,timeout:u,propCount:d,hasTransform:n===Oa&&Fa.test(r[Ta+"Property"])}}function Ia(t,e){for(;t.length<e.length;)t=t.concat(t);return Math.max.apply(null,e.map((function(e,n){return Ha(e)+Ha(t[n])})))}function Ha(t){return 1e3*Number(t.slice(0,-1).replace(",","."))}function Za(t,n){var a=t.elm;r(a._leaveCb)&&(a._leaveCb.cancelled=!0,a._leaveCb());var s=ka(t.data.transition);if(!e(s)&&!r(a._enterCb)&&1===a.nodeType){for(var o=s.css,c=s.type,l=s.enterClass,u=s.enterToClass,d=s.enterActiveClass,f=s.appearClass,v=s.appearToClass,h=s.appearActiveClass,m=s.beforeEnter,g=s.enter,_=s.afterEnter,y=s.enterCancelled,b=s.beforeAppear,w=s.appear,C=s.afterAppear,x=s.appearCancelled,$=s.duration,k=tn,S=tn.$vnode;S&&S.parent;)k=S.context,S=S.parent;var A=!k._isMounted||!t.isRootInsert;if(!A||w||""===w){var O=A&&f?f:l,E=A&&h?h:d,T=A&&v?v:u,P=A&&b||m,j=A&&"function"==typeof w?w:g,N=A&&C||_,B=A&&x||y,M=p(i($)?$.enter:$);0;var R=!1!==o&&!X,L=Wa(j),F=a._enterCb=D((function(){R&&(Ra(a,T),Ra(a,E)),F.cancelled?(R&&Ra(a,O),B&&B(a)):N&&N(a),a._enterCb=null}));t.data.show||ue(t,"insert",(function(){var e=a.parentNode,n=e&&e._pending&&e._pending[t.key];n&&n.tag===t.tag&&n.elm._leaveCb&&n.elm._leaveCb(),j&&j(a,F)})),P&&P(a),R&&(Ma(a,O),Ma(a,E),Da((function(){Ra(a,O),F.cancelled||(Ma(a,T),L||(Va(M)?setTimeout(F,M):La(a,c,F)))}))),t.data.show&&(n&&n(),j&&j(a,F)),R||L||F()}}}function qa(t,n){var a=t.elm;r(a._enterCb)&&(a._enterCb.cancelled=!0,a._enterCb());var s=ka(t.data.transition);if(e(s)||1!==a.nodeType)return n();if(!r(a._leaveCb)){var o=s.css,c=s.type,l=s.leaveClass,u=s.leaveToClass,d=s.leaveActiveClass,f=s.beforeLeave,v=s.leave,h=s.afterLeave,m=s.leaveCancelled,g=s.delayLeave,_=s.duration,y=!1!==o&&!X,b=Wa(v),w=p(i(_)?_.leave:_);0;var C=a._leaveCb=D((function(){a.parentNode&&a.parentNode._pending&&(a.parentNode._pending[t.key]=null),y&&(Ra(a,u),Ra(a,d)),C.cancelled?(y&&Ra(a,l),m&&m(a)):(n(),h&&h(a)),a._leaveCb=null}));g?g(x):x()}function x(){C.cancelled||(!t.data.show&&a.parentNode&&((a.parentNode._pending||(a.parentNode._pending={}))[t.key]=t),f&&f(a),y&&(Ma(a,l),Ma(a,d),Da((function(){Ra(a,l),C.cancelled||(Ma(a,u),b||(Va(w)?setTimeout(C,w):La(a,c,C)))}))),v&&v(a,C),y||b||C())}}function Va(t){return"number"==typeof t&&!isNaN(t)}function Wa(t){if(e(t))return!1;var n=t.fns;return r(n)?Wa(Array.isArray(n)?n[0]:n):(t._length||t.length)>1}function za(t,e){!0!==e.data.show&&Za(e)}var Ga=function(t){var n,i,o={},c=t.modules,l=t.nodeOps;for(n=0;n<lr.length;++n)for(o[lr[n]]=[],i=0;i<c.length;++i)r(c[i][lr[n]])&&o[lr[n]].push(c[i][lr[n]]);function u(t){var e=l.parentNode(t);r(e)&&l.removeChild(e,t)}function d(t,e,n,s,i,c,u){if(r(t.elm)&&r(c)&&(t=c[u]=yt(t)),t.isRootInsert=!i,!function(t,e,n,s){var i=t.data;if(r(i)){var c=r(t.componentInstance)&&i.keepAlive;if(r(i=i.hook)&&r(i=i.init)&&i(t,!1),r(t.componentInstance))return f(t,e),p(n,t.elm,s),a(c)&&function(t,e,n,a){var s,i=t;for(;i.componentInstance;)if(r(s=(i=i.componentInstance._vnode).data)&&r(s=s.transition)){for(s=0;s<o.activate.length;++s)o.activate[s](cr,i);e.push(i);break}p(n,t.elm,a)}(t,e,n,s),!0}}(t,e,n,s)){var d=t.data,v=t.children,m=t.tag;r(m)?(t.elm=t.ns?l.createElementNS(t.ns,m):l.createElement(m,t),_(t),h(t,v,e),r(d)&&g(t,e),p(n,t.elm,s)):a(t.isComment)?(t.elm=l.createComment(t.text),p(n,t.elm,s)):(t.elm=l.createTextNode(t.text),p(n,t.elm,s))}}function f(t,e){r(t.data.pendingInsert)&&(e.push.apply(e,t.data.pendingInsert),t.data.pendingInsert=null),t.elm=t.componentInstance.$el,m(t)?(g(t,e),_(t)):(or(t),e.push(t))}function p(t,e,n){r(t)&&(r(n)?l.parentNode(n)===t&&l.insertBefore(t,e,n):l.appendChild(t,e))}function h(t,e,n){if(Array.isArray(e)){0;for(var r=0;r<e.length;++r)d(e[r],n,t.elm,null,!0,e,r)}else s(t.text)&&l.appendChild(t.elm,l.createTextNode(String(t.text)))}function m(t){for(;t.componentInstance;)t=t.componentInstance._vnode;return r(t.tag)}function g(t,e){for(var a=0;a<o.create.length;++a)o.create[a](cr,t);r(n=t.data.hook)&&(r(n.create)&&n.create(cr,t),r(n.insert)&&e.push(t))}function _(t){var e;if(r(e=t.fnScopeId))l.setStyleScope(t.elm,e);else for(var n=t;n;)r(e=n.context)&&r(e=e.$options._scopeId)&&l.setStyleScope(t.elm,e),n=n.parent;r(e=tn)&&e!==t.context&&e!==t.fnContext&&r(e=e.$options._scopeId)&&l.setStyleScope(t.elm,e)}function y(t,e,n,r,a,s){for(;r<=a;++r)d(n[r],s,t,e,!1,n,r)}function b(t){var e,n,a=t.data;if(r(a))for(r(e=a.hook)&&r(e=e.destroy)&&e(t),e=0;e<o.destroy.length;++e)o.destroy[e](t);if(r(e=t.children))for(n=0;n<t.children.length;++n)b(t.children[n])}function w(t,e,n){for(;e<=n;++e){var a=t[e];r(a)&&(r(a.tag)?(C(a),b(a)):u(a.elm))}}function C(t,e){if(r(e)||r(t.data)){var n,a=o.remove.length+1;for(r(e)?e.listeners+=a:e=function(t,e){function n(){0==--n.listeners&&u(t)}return n.listeners=e,n}(t.elm,a),r(n=t.componentInstance)&&r(n=n._vnode)&&r(n.data)&&C(n,e),n=0;n<o.remove.length;++n)o.remove[n](t,e);r(n=t.data.hook)&&r(n=n.remove)?n(t,e):e()}else u(t.elm)}function x(t,e,n,a){for(var s=n;s<a;s++){var i=e[s];if(r(i)&&ur(t,i))return s}}function $(t,n,s,i,c,u){if(t!==n){r(n.elm)&&r(i)&&(n=i[c]=yt(n));var f=n.elm=t.elm;if(a(t.isAsyncPlaceholder))r(n.asyncFactory.resolved)?A(t.elm,n,s):n.isAsyncPlaceholder=!0;else if(a(n.isStatic)&&a(t.isStatic)&&n.key===t.key&&(a(n.isCloned)||a(n.isOnce)))n.componentInstance=t.componentInstance;else{var p,v=n.data;r(v)&&r(p=v.hook)&&r(p=p.prepatch)&&p(t,n);var h=t.children,g=n.children;if(r(v)&&m(n)){for(p=0;p<o.update.length;++p)o.update[p](t,n);r(p=v.hook)&&r(p=p.update)&&p(t,n)}e(n.text)?r(h)&&r(g)?h!==g&&function(t,n,a,s,i){var o,c,u,f=0,p=0,v=n.length-1,h=n[0],m=n[v],g=a.length-1,_=a[0],b=a[g],C=!i;for(;f<=v&&p<=g;)e(h)?h=n[++f]:e(m)?m=n[--v]:ur(h,_)?($(h,_,s,a,p),h=n[++f],_=a[++p]):ur(m,b)?($(m,b,s,a,g),m=n[--v],b=a[--g]):ur(h,b)?($(h,b,s,a,g),C&&l.insertBefore(t,h.elm,l.nextSibling(m.elm)),h=n[++f],b=a[--g]):ur(m,_)?($(m,_,s,a,p),C&&l.insertBefore(t,m.elm,h.elm),m=n[--v],_=a[++p]):(e(o)&&(o=dr(n,f,v)),e(c=r(_.key)?o[_.key]:x(_,n,f,v))?d(_,s,t,h.elm,!1,a,p):ur(u=n[c],_)?($(u,_,s,a,p),n[c]=void 0,C&&l.insertBefore(t,u.elm,h.elm)):d(_,s,t,h.elm,!1,a,p),_=a[++p]);f>v?y(t,e(a[g+1])?null:a[g+1].elm,a,p,g,s):p>g&&w(n,f,v)}(f,h,g,s,u):r(g)?(r(t.text)&&l.setTextContent(f,""),y(f,null,g,0,g.length-1,s)):r(h)?w(h,0,h.length-1):r(t.text)&&l.setTextContent(f,""):t.text!==n.text&&l.setTextContent(f,n.text),r(v)&&r(p=v.hook)&&r(p=p.postpatch)&&p(t,n)}}}function k(t,e,n){if(a(n)&&r(t.parent))t.parent.data.pendingInsert=e;else for(var s=0;s<e.length;++s)e[s].data.hook.insert(e[s])}var S=v("attrs,class,staticClass,staticStyle,key");function A(t,e,n,s){var i,o=e.tag,c=e.data,l=e.children;if(s=s||c&&c.pre,e.elm=t,a(e.isComment)&&r(e.asyncFactory))return e.isAsyncPlaceholder=!0,!0;if(r(c)&&(r(i=c.hook)&&r(i=i.init)&&i(e,!0),r(i=e.componentInstance)))return f(e,n),!0;if(r(o)){if(r(l))if(t.hasChildNodes())if(r(i=c)&&r(i=i.domProps)&&r(i=i.innerHTML)){if(i!==t.innerHTML)return!1}else{for(var u=!0,d=t.firstChild,p=0;p<l.length;p++){if(!d||!A(d,l[p],n,s)){u=!1;break}d=d.nextSibling}if(!u||d)return!1}else h(e,l,n);if(r(c)){var v=!1;for(var m in c)if(!S(m)){v=!0,g(e,n);break}!v&&c.class&&se(c.class)}}else t.data!==e.text&&(t.data=e.text);return!0}return function(t,n,s,i){if(!e(n)){var c,u=!1,f=[];if(e(t))u=!0,d(n,f);else{var p=r(t.nodeType);if(!p&&ur(t,n))$(t,n,f,null,null,i);else{if(p){if(1===t.nodeType&&t.hasAttribute(M)&&(t.removeAttribute(M),s=!0),a(s)&&A(t,n,f))return k(n,f,!0),t;c=t,t=new ht(l.tagName(c).toLowerCase(),{},[],void 0,c)}var v=t.elm,h=l.parentNode(v);if(d(n,f,v._leaveCb?null:h,l.nextSibling(v)),r(n.parent))for(var g=n.parent,_=m(n);g;){for(var y=0;y<o.destroy.length;++y)o.destroy[y](g);if(g.elm=n.elm,_){for(var C=0;C<o.create.length;++C)o.create[C](cr,g);var x=g.data.hook.insert;if(x.merged)for(var S=1;S<x.fns.length;S++)x.fns[S]()}else or(g);g=g.parent}r(h)?w([t],0,0):r(t.tag)&&b(t)}}return k(n,f,u),n.elm}r(t)&&b(t)}}({nodeOps:sr,modules:[Cr,Tr,oa,ua,wa,W?{create:za,activate:za,remove:function(t,e){!0!==t.data.show?qa(t,e):e()}}:{}].concat(_r)});X&&document.addEventListener("selectionchange",(function(){var t=document.activeElement;t&&t.vmodel&&ns(t,"input")}));var Ja={inserted:function(t,e,n,r){"select"===n.tag?(r.elm&&!r.elm._vOptions?ue(n,"postpatch",(function(){Ja.componentUpdated(t,e,n)})):Ka(t,e,n.context),t._vOptions=[].map.call(t.options,Qa)):("textarea"===n.tag||rr(t.type))&&(t._vModifiers=e.modifiers,e.modifiers.lazy||(t.addEventListener("compositionstart",ts),t.addEventListener("compositionend",es),t.addEventListener("change",es),X&&(t.vmodel=!0)))},componentUpdated:function(t,e,n){if("select"===n.tag){Ka(t,e,n.context);var r=t._vOptions,a=t._vOptions=[].map.call(t.options,Qa);if(a.some((function(t,e){return!N(t,r[e])})))(t.multiple?e.value.some((function(t){return Ya(t,a)})):e.value!==e.oldValue&&Ya(e.value,a))&&ns(t,"change")}}};function Ka(t,e,n){Xa(t,e,n),(K||Y)&&setTimeout((function(){Xa(t,e,n)}),0)}function Xa(t,e,n){var r=e.value,a=t.multiple;if(!a||Array.isArray(r)){for(var s,i,o=0,c=t.options.length;o<c;o++)if(i=t.options[o],a)s=B(r,Qa(i))>-1,i.selected!==s&&(i.selected=s);else if(N(Qa(i),r))return void(t.selectedIndex!==o&&(t.selectedIndex=o));a||(t.selectedIndex=-1)}}function Ya(t,e){return e.every((function(e){return!N(e,t)}))}function Qa(t){return"_value"in t?t._value:t.value}function ts(t){t.target.composing=!0}function es(t){t.target.composing&&(t.target.composing=!1,ns(t.target,"input"))}function ns(t,e){var n=document.createEvent("HTMLEvents");n.initEvent(e,!0,!0),t.dispatchEvent(n)}function rs(t){return!t.componentInstance||t.data&&t.data.transition?t:rs(t.componentInstance._vnode)}var as={model:Ja,show:{bind:function(t,e,n){var r=e.value,a=(n=rs(n)).data&&n.data.transition,s=t.__vOriginalDisplay="none"===t.style.display?"":t.style.display;r&&a?(n.data.show=!0,Za(n,(function(){t.style.display=s}))):t.style.display=r?s:"none"},update:function(t,e,n){var r=e.value;!r!=!e.oldValue&&((n=rs(n)).data&&n.data.transition?(n.data.show=!0,r?Za(n,(function(){t.style.display=t.__vOriginalDisplay})):qa(n,(function(){t.style.display="none"}))):t.style.display=r?t.__vOriginalDisplay:"none")},unbind:function(t,e,n,r,a){a||(t.style.display=t.__vOriginalDisplay)}}},ss={name:String,appear:Boolean,css:Boolean,mode:String,type:String,enterClass:String,leaveClass:String,enterToClass:String,leaveToClass:String,enterActiveClass:String,leaveActiveClass:String,appearClass:String,appearActiveClass:String,appearToClass:String,duration:[Number,String,Object]};function is(t){var e=t&&t.componentOptions;return e&&e.Ctor.options.abstract?is(Je(e.children)):t}function os(t){var e={},n=t.$options;for(var r in n.propsData)e[r]=t[r];var a=n._parentListeners;for(var s in a)e[C(s)]=a[s];return e}function cs(t,e){if(/\d-keep-alive$/.test(e.tag))return t("keep-alive",{props:e.componentOptions.propsData})}var ls=function(t){return t.tag||_e(t)},us=function(t){return"show"===t.name},ds={name:"transition",props:ss,abstract:!0,render:function(t){var e=this,n=this.$slots.default;if(n&&(n=n.filter(ls)).length){0;var r=this.mode;0;var a=n[0];if(function(t){for(;t=t.parent;)if(t.data.transition)return!0}(this.$vnode))return a;var i=is(a);if(!i)return a;if(this._leaving)return cs(t,a);var o="__transition-"+this._uid+"-";i.key=null==i.key?i.isComment?o+"comment":o+i.tag:s(i.key)?0===String(i.key).indexOf(o)?i.key:o+i.key:i.key;var c=(i.data||(i.data={})).transition=os(this),l=this._vnode,u=is(l);if(i.data.directives&&i.data.directives.some(us)&&(i.data.show=!0),u&&u.data&&!function(t,e){return e.key===t.key&&e.tag===t.tag}(i,u)&&!_e(u)&&(!u.componentInstance||!u.componentInstance._vnode.isComment)){var d=u.data.transition=O({},c);if("out-in"===r)return this._leaving=!0,ue(d,"afterLeave",(function(){e._leaving=!1,e.$forceUpdate()})),cs(t,a);if("in-out"===r){if(_e(i))return l;var f,p=function(){f()};ue(c,"afterEnter",p),ue(c,"enterCancelled",p),ue(d,"delayLeave",(function(t){f=t}))}}return a}}},fs=O({tag:String,moveClass:String},ss);function ps(t){t.elm._moveCb&&t.elm._moveCb(),t.elm._enterCb&&t.elm._enterCb()}function vs(t){t.data.newPos=t.elm.getBoundingClientRect()}function hs(t){var e=t.data.pos,n=t.data.newPos,r=e.left-n.left,a=e.top-n.top;if(r||a){t.data.moved=!0;var s=t.elm.style;s.transform=s.WebkitTransform="translate("+r+"px,"+a+"px)",s.transitionDuration="0s"}}delete fs.mode;var ms={Transition:ds,TransitionGroup:{props:fs,beforeMount:function(){var t=this,e=this._update;this._update=function(n,r){var a=en(t);t.__patch__(t._vnode,t.kept,!1,!0),t._vnode=t.kept,a(),e.call(t,n,r)}},render:function(t){for(var e=this.tag||this.$vnode.data.tag||"span",n=Object.create(null),r=this.prevChildren=this.children,a=this.$slots.default||[],s=this.children=[],i=os(this),o=0;o<a.length;o++){var c=a[o];if(c.tag)if(null!=c.key&&0!==String(c.key).indexOf("__vlist"))s.push(c),n[c.key]=c,(c.data||(c.data={})).transition=i;else;}if(r){for(var l=[],u=[],d=0;d<r.length;d++){var f=r[d];f.data.transition=i,f.data.pos=f.elm.getBoundingClientRect(),n[f.key]?l.push(f):u.push(f)}this.kept=t(e,null,l),this.removed=u}return t(e,null,s)},updated:function(){var t=this.prevChildren,e=this.moveClass||(this.name||"v")+"-move";t.length&&this.hasMove(t[0].elm,e)&&(t.forEach(ps),t.forEach(vs),t.forEach(hs),this._reflow=document.body.offsetHeight,t.forEach((function(t){if(t.data.moved){var n=t.elm,r=n.style;Ma(n,e),r.transform=r.WebkitTransform=r.transitionDuration="",n.addEventListener(Pa,n._moveCb=function t(r){r&&r.target!==n||r&&!/transform$/.test(r.propertyName)||(n.removeEventListener(Pa,t),n._moveCb=null,Ra(n,e))})}})))},methods:{hasMove:function(t,e){if(!Aa)return!1;if(this._hasMove)return this._hasMove;var n=t.cloneNode();t._transitionClasses&&t._transitionClasses.forEach((function(t){$a(n,t)})),xa(n,e),n.style.display="none",this.$el.appendChild(n);var r=Ua(n);return this.$el.removeChild(n),this._hasMove=r.hasTransform}}}};En.config.mustUseProp=Fn,En.config.isReservedTag=tr,En.config.isReservedAttr=Rn,En.config.getTagNamespace=er,En.config.isUnknownElement=function(t){if(!W)return!0;if(tr(t))return!1;if(t=t.toLowerCase(),null!=nr[t])return nr[t];var e=document.createElement(t);return t.indexOf("-")>-1?nr[t]=e.constructor===window.HTMLUnknownElement||e.constructor===window.HTMLElement:nr[t]=/HTMLUnknownElement/.test(e.toString())},O(En.options.directives,as),O(En.options.components,ms),En.prototype.__patch__=W?Ga:T,En.prototype.$mount=function(t,e){return function(t,e,n){var r;return t.$el=e,t.$options.render||(t.$options.render=gt),sn(t,"beforeMount"),r=function(){t._update(t._render(),n)},new _n(t,r,T,{before:function(){t._isMounted&&!t._isDestroyed&&sn(t,"beforeUpdate")}},!0),n=!1,null==t.$vnode&&(t._isMounted=!0,sn(t,"mounted")),t}(this,t=t&&W?ar(t):void 0,e)},W&&setTimeout((function(){F.devtools&&st&&st.emit("init",En)}),0);var gs=/\{\{((?:.|\r?\n)+?)\}\}/g,_s=/[-.*+?^${}()|[\]\/\\]/g,ys=b((function(t){var e=t[0].replace(_s,"\\$&"),n=t[1].replace(_s,"\\$&");return new RegExp(e+"((?:.|\\n)+?)"+n,"g")}));var bs={staticKeys:["staticClass"],transformNode:function(t,e){e.warn;var n=Zr(t,"class");n&&(t.staticClass=JSON.stringify(n));var r=Hr(t,"class",!1);r&&(t.classBinding=r)},genData:function(t){var e="";return t.staticClass&&(e+="staticClass:"+t.staticClass+","),t.classBinding&&(e+="class:"+t.classBinding+","),e}};var ws,Cs={staticKeys:["staticStyle"],transformNode:function(t,e){e.warn;var n=Zr(t,"style");n&&(t.staticStyle=JSON.stringify(da(n)));var r=Hr(t,"style",!1);r&&(t.styleBinding=r)},genData:function(t){var e="";return t.staticStyle&&(e+="staticStyle:"+t.staticStyle+","),t.styleBinding&&(e+="style:("+t.styleBinding+"),"),e}},xs=function(t){return(ws=ws||document.createElement("div")).innerHTML=t,ws.textContent},$s=v("area,base,br,col,embed,frame,hr,img,input,isindex,keygen,link,meta,param,source,track,wbr"),ks=v("colgroup,dd,dt,li,options,p,td,tfoot,th,thead,tr,source"),Ss=v("address,article,aside,base,blockquote,body,caption,col,colgroup,dd,details,dialog,div,dl,dt,fieldset,figcaption,figure,footer,form,h1,h2,h3,h4,h5,h6,head,header,hgroup,hr,html,legend,li,menuitem,meta,optgroup,option,param,rp,rt,source,style,summary,tbody,td,tfoot,th,thead,title,tr,track"),As=/^\s*([^\s"'<>\/=]+)(?:\s*(=)\s*(?:"([^"]*)"+|'([^']*)'+|([^\s"'=<>`]+)))?/,Os=/^\s*((?:v-[\w-]+:|@|:|#)\[[^=]+?\][^\s"'<>\/=]*)(?:\s*(=)\s*(?:"([^"]*)"+|'([^']*)'+|([^\s"'=<>`]+)))?/,Es="[a-zA-Z_][\\-\\.0-9_a-zA-Z"+U.source+"]*",Ts="((?:"+Es+"\\:)?"+Es+")",Ps=new RegExp("^<"+Ts),js=/^\s*(\/?)>/,Ns=new RegExp("^<\\/"+Ts+"[^>]*>"),Bs=/^<!DOCTYPE [^>]+>/i,Ds=/^<!\--/,Ms=/^<!\[/,Rs=v("script,style,textarea",!0),Ls={},Fs={"&lt;":"<","&gt;":">","&quot;":'"',"&amp;":"&","&#10;":"\n","&#9;":"\t","&#39;":"'"},Us=/&(?:lt|gt|quot|amp|#39);/g,Is=/&(?:lt|gt|quot|amp|#39|#10|#9);/g,Hs=v("pre,textarea",!0),Zs=function(t,e){return t&&Hs(t)&&"\n"===e[0]};function qs(t,e){var n=e?Is:Us;return t.replace(n,(function(t){return Fs[t]}))}var Vs,Ws,zs,Gs,Js,Ks,Xs,Ys,Qs=/^@|^v-on:/,ti=/^v-|^@|^:|^#/,ei=/([\s\S]*?)\s+(?:in|of)\s+([\s\S]*)/,ni=/,([^,\}\]]*)(?:,([^,\}\]]*))?$/,ri=/^\(|\)$/g,ai=/^\[.*\]$/,si=/:(.*)$/,ii=/^:|^\.|^v-bind:/,oi=/\.[^.\]]+(?=[^\]]*$)/g,ci=/^v-slot(:|$)|^#/,li=/[\r\n]/,ui=/[ \f\t\r\n]+/g,di=b(xs),fi="_empty_";function pi(t,e,n){return{type:1,tag:t,attrsList:e,attrsMap:bi(e),rawAttrsMap:{},parent:n,children:[]}}function vi(t,e){Vs=e.warn||Br,Ks=e.isPreTag||P,Xs=e.mustUseProp||P,Ys=e.getTagNamespace||P;var n=e.isReservedTag||P;(function(t){return!(!(t.component||t.attrsMap[":is"]||t.attrsMap["v-bind:is"])&&(t.attrsMap.is?n(t.attrsMap.is):n(t.tag)))}),zs=Dr(e.modules,"transformNode"),Gs=Dr(e.modules,"preTransformNode"),Js=Dr(e.modules,"postTransformNode"),Ws=e.delimiters;var r,a,s=[],i=!1!==e.preserveWhitespace,o=e.whitespace,c=!1,l=!1;function u(t){if(d(t),c||t.processed||(t=hi(t,e)),s.length||t===r||r.if&&(t.elseif||t.else)&&gi(r,{exp:t.elseif,block:t}),a&&!t.forbidden)if(t.elseif||t.else)i=t,(o=function(t){for(var e=t.length;e--;){if(1===t[e].type)return t[e];t.pop()}}(a.children))&&o.if&&gi(o,{exp:i.elseif,block:i});else{if(t.slotScope){var n=t.slotTarget||'"default"';(a.scopedSlots||(a.scopedSlots={}))[n]=t}a.children.push(t),t.parent=a}var i,o;t.children=t.children.filter((function(t){return!t.slotScope})),d(t),t.pre&&(c=!1),Ks(t.tag)&&(l=!1);for(var u=0;u<Js.length;u++)Js[u](t,e)}function d(t){if(!l)for(var e;(e=t.children[t.children.length-1])&&3===e.type&&" "===e.text;)t.children.pop()}return function(t,e){for(var n,r,a=[],s=e.expectHTML,i=e.isUnaryTag||P,o=e.canBeLeftOpenTag||P,c=0;t;){if(n=t,r&&Rs(r)){var l=0,u=r.toLowerCase(),d=Ls[u]||(Ls[u]=new RegExp("([\\s\\S]*?)(</"+u+"[^>]*>)","i")),f=t.replace(d,(function(t,n,r){return l=r.length,Rs(u)||"noscript"===u||(n=n.replace(/<!\--([\s\S]*?)-->/g,"$1").replace(/<!\[CDATA\[([\s\S]*?)]]>/g,"$1")),Zs(u,n)&&(n=n.slice(1)),e.chars&&e.chars(n),""}));c+=t.length-f.length,t=f,S(u,c-l,c)}else{var p=t.indexOf("<");if(0===p){if(Ds.test(t)){var v=t.indexOf("--\x3e");if(v>=0){e.shouldKeepComment&&e.comment(t.substring(4,v),c,c+v+3),x(v+3);continue}}if(Ms.test(t)){var h=t.indexOf("]>");if(h>=0){x(h+2);continue}}var m=t.match(Bs);if(m){x(m[0].length);continue}var g=t.match(Ns);if(g){var _=c;x(g[0].length),S(g[1],_,c);continue}var y=$();if(y){k(y),Zs(y.tagName,t)&&x(1);continue}}var b=void 0,w=void 0,C=void 0;if(p>=0){for(w=t.slice(p);!(Ns.test(w)||Ps.test(w)||Ds.test(w)||Ms.test(w)||(C=w.indexOf("<",1))<0);)p+=C,w=t.slice(p);b=t.substring(0,p)}p<0&&(b=t),b&&x(b.length),e.chars&&b&&e.chars(b,c-b.length,c)}if(t===n){e.chars&&e.chars(t);break}}function x(e){c+=e,t=t.substring(e)}function $(){var e=t.match(Ps);if(e){var n,r,a={tagName:e[1],attrs:[],start:c};for(x(e[0].length);!(n=t.match(js))&&(r=t.match(Os)||t.match(As));)r.start=c,x(r[0].length),r.end=c,a.attrs.push(r);if(n)return a.unarySlash=n[1],x(n[0].length),a.end=c,a}}function k(t){var n=t.tagName,c=t.unarySlash;s&&("p"===r&&Ss(n)&&S(r),o(n)&&r===n&&S(n));for(var l=i(n)||!!c,u=t.attrs.length,d=new Array(u),f=0;f<u;f++){var p=t.attrs[f],v=p[3]||p[4]||p[5]||"",h="a"===n&&"href"===p[1]?e.shouldDecodeNewlinesForHref:e.shouldDecodeNewlines;d[f]={name:p[1],value:qs(v,h)}}l||(a.push({tag:n,lowerCasedTag:n.toLowerCase(),attrs:d,start:t.start,end:t.end}),r=n),e.start&&e.start(n,d,l,t.start,t.end)}function S(t,n,s){var i,o;if(null==n&&(n=c),null==s&&(s=c),t)for(o=t.toLowerCase(),i=a.length-1;i>=0&&a[i].lowerCasedTag!==o;i--);else i=0;if(i>=0){for(var l=a.length-1;l>=i;l--)e.end&&e.end(a[l].tag,n,s);a.length=i,r=i&&a[i-1].tag}else"br"===o?e.start&&e.start(t,[],!0,n,s):"p"===o&&(e.start&&e.start(t,[],!1,n,s),e.end&&e.end(t,n,s))}S()}(t,{warn:Vs,expectHTML:e.expectHTML,isUnaryTag:e.isUnaryTag,canBeLeftOpenTag:e.canBeLeftOpenTag,shouldDecodeNewlines:e.shouldDecodeNewlines,shouldDecodeNewlinesForHref:e.shouldDecodeNewlinesForHref,shouldKeepComment:e.comments,outputSourceRange:e.outputSourceRange,start:function(t,n,i,o,d){var f=a&&a.ns||Ys(t);K&&"svg"===f&&(n=function(t){for(var e=[],n=0;n<t.length;n++){var r=t[n];wi.test(r.name)||(r.name=r.name.replace(Ci,""),e.push(r))}return e}(n));var p,v=pi(t,n,a);f&&(v.ns=f),"style"!==(p=v).tag&&("script"!==p.tag||p.attrsMap.type&&"text/javascript"!==p.attrsMap.type)||at()||(v.forbidden=!0);for(var h=0;h<Gs.length;h++)v=Gs[h](v,e)||v;c||(!function(t){null!=Zr(t,"v-pre")&&(t.pre=!0)}(v),v.pre&&(c=!0)),Ks(v.tag)&&(l=!0),c?function(t){var e=t.attrsList,n=e.length;if(n)for(var r=t.attrs=new Array(n),a=0;a<n;a++)r[a]={name:e[a].name,value:JSON.stringify(e[a].value)},null!=e[a].start&&(r[a].start=e[a].start,r[a].end=e[a].end);else t.pre||(t.plain=!0)}(v):v.processed||(mi(v),function(t){var e=Zr(t,"v-if");if(e)t.if=e,gi(t,{exp:e,block:t});else{null!=Zr(t,"v-else")&&(t.else=!0);var n=Zr(t,"v-else-if");n&&(t.elseif=n)}}(v),function(t){null!=Zr(t,"v-once")&&(t.once=!0)}(v)),r||(r=v),i?u(v):(a=v,s.push(v))},end:function(t,e,n){var r=s[s.length-1];s.length-=1,a=s[s.length-1],u(r)},chars:function(t,e,n){if(a&&(!K||"textarea"!==a.tag||a.attrsMap.placeholder!==t)){var r,s,u,d=a.children;if(t=l||t.trim()?"script"===(r=a).tag||"style"===r.tag?t:di(t):d.length?o?"condense"===o&&li.test(t)?"":" ":i?" ":"":"")l||"condense"!==o||(t=t.replace(ui," ")),!c&&" "!==t&&(s=function(t,e){var n=e?ys(e):gs;if(n.test(t)){for(var r,a,s,i=[],o=[],c=n.lastIndex=0;r=n.exec(t);){(a=r.index)>c&&(o.push(s=t.slice(c,a)),i.push(JSON.stringify(s)));var l=jr(r[1].trim());i.push("_s("+l+")"),o.push({"@binding":l}),c=a+r[0].length}return c<t.length&&(o.push(s=t.slice(c)),i.push(JSON.stringify(s))),{expression:i.join("+"),tokens:o}}}(t,Ws))?u={type:2,expression:s.expression,tokens:s.tokens,text:t}:" "===t&&d.length&&" "===d[d.length-1].text||(u={type:3,text:t}),u&&d.push(u)}},comment:function(t,e,n){if(a){var r={type:3,text:t,isComment:!0};0,a.children.push(r)}}}),r}function hi(t,e){var n;!function(t){var e=Hr(t,"key");if(e){t.key=e}}(t),t.plain=!t.key&&!t.scopedSlots&&!t.attrsList.length,function(t){var e=Hr(t,"ref");e&&(t.ref=e,t.refInFor=function(t){var e=t;for(;e;){if(void 0!==e.for)return!0;e=e.parent}return!1}(t))}(t),function(t){var e;"template"===t.tag?(e=Zr(t,"scope"),t.slotScope=e||Zr(t,"slot-scope")):(e=Zr(t,"slot-scope"))&&(t.slotScope=e);var n=Hr(t,"slot");n&&(t.slotTarget='""'===n?'"default"':n,t.slotTargetDynamic=!(!t.attrsMap[":slot"]&&!t.attrsMap["v-bind:slot"]),"template"===t.tag||t.slotScope||Rr(t,"slot",n,function(t,e){return t.rawAttrsMap[":"+e]||t.rawAttrsMap["v-bind:"+e]||t.rawAttrsMap[e]}(t,"slot")));if("template"===t.tag){var r=qr(t,ci);if(r){0;var a=_i(r),s=a.name,i=a.dynamic;t.slotTarget=s,t.slotTargetDynamic=i,t.slotScope=r.value||fi}}else{var o=qr(t,ci);if(o){0;var c=t.scopedSlots||(t.scopedSlots={}),l=_i(o),u=l.name,d=l.dynamic,f=c[u]=pi("template",[],t);f.slotTarget=u,f.slotTargetDynamic=d,f.children=t.children.filter((function(t){if(!t.slotScope)return t.parent=f,!0})),f.slotScope=o.value||fi,t.children=[],t.plain=!1}}}(t),"slot"===(n=t).tag&&(n.slotName=Hr(n,"name")),function(t){var e;(e=Hr(t,"is"))&&(t.component=e);null!=Zr(t,"inline-template")&&(t.inlineTemplate=!0)}(t);for(var r=0;r<zs.length;r++)t=zs[r](t,e)||t;return function(t){var e,n,r,a,s,i,o,c,l=t.attrsList;for(e=0,n=l.length;e<n;e++){if(r=a=l[e].name,s=l[e].value,ti.test(r))if(t.hasBindings=!0,(i=yi(r.replace(ti,"")))&&(r=r.replace(oi,"")),ii.test(r))r=r.replace(ii,""),s=jr(s),(c=ai.test(r))&&(r=r.slice(1,-1)),i&&(i.prop&&!c&&"innerHtml"===(r=C(r))&&(r="innerHTML"),i.camel&&!c&&(r=C(r)),i.sync&&(o=zr(s,"$event"),c?Ir(t,'"update:"+('+r+")",o,null,!1,0,l[e],!0):(Ir(t,"update:"+C(r),o,null,!1,0,l[e]),k(r)!==C(r)&&Ir(t,"update:"+k(r),o,null,!1,0,l[e])))),i&&i.prop||!t.component&&Xs(t.tag,t.attrsMap.type,r)?Mr(t,r,s,l[e],c):Rr(t,r,s,l[e],c);else if(Qs.test(r))r=r.replace(Qs,""),(c=ai.test(r))&&(r=r.slice(1,-1)),Ir(t,r,s,i,!1,0,l[e],c);else{var u=(r=r.replace(ti,"")).match(si),d=u&&u[1];c=!1,d&&(r=r.slice(0,-(d.length+1)),ai.test(d)&&(d=d.slice(1,-1),c=!0)),Fr(t,r,a,s,d,c,i,l[e])}else Rr(t,r,JSON.stringify(s),l[e]),!t.component&&"muted"===r&&Xs(t.tag,t.attrsMap.type,r)&&Mr(t,r,"true",l[e])}}(t),t}function mi(t){var e;if(e=Zr(t,"v-for")){var n=function(t){var e=t.match(ei);if(!e)return;var n={};n.for=e[2].trim();var r=e[1].trim().replace(ri,""),a=r.match(ni);a?(n.alias=r.replace(ni,"").trim(),n.iterator1=a[1].trim(),a[2]&&(n.iterator2=a[2].trim())):n.alias=r;return n}(e);n&&O(t,n)}}function gi(t,e){t.ifConditions||(t.ifConditions=[]),t.ifConditions.push(e)}function _i(t){var e=t.name.replace(ci,"");return e||"#"!==t.name[0]&&(e="default"),ai.test(e)?{name:e.slice(1,-1),dynamic:!0}:{name:'"'+e+'"',dynamic:!1}}function yi(t){var e=t.match(oi);if(e){var n={};return e.forEach((function(t){n[t.slice(1)]=!0})),n}}function bi(t){for(var e={},n=0,r=t.length;n<r;n++)e[t[n].name]=t[n].value;return e}var wi=/^xmlns:NS\d+/,Ci=/^NS\d+:/;function xi(t){return pi(t.tag,t.attrsList.slice(),t.parent)}var $i=[bs,Cs,{preTransformNode:function(t,e){if("input"===t.tag){var n,r=t.attrsMap;if(!r["v-model"])return;if((r[":type"]||r["v-bind:type"])&&(n=Hr(t,"type")),r.type||n||!r["v-bind"]||(n="("+r["v-bind"]+").type"),n){var a=Zr(t,"v-if",!0),s=a?"&&("+a+")":"",i=null!=Zr(t,"v-else",!0),o=Zr(t,"v-else-if",!0),c=xi(t);mi(c),Lr(c,"type","checkbox"),hi(c,e),c.processed=!0,c.if="("+n+")==='checkbox'"+s,gi(c,{exp:c.if,block:c});var l=xi(t);Zr(l,"v-for",!0),Lr(l,"type","radio"),hi(l,e),gi(c,{exp:"("+n+")==='radio'"+s,block:l});var u=xi(t);return Zr(u,"v-for",!0),Lr(u,":type",n),hi(u,e),gi(c,{exp:a,block:u}),i?c.else=!0:o&&(c.elseif=o),c}}}}];var ki,Si,Ai={expectHTML:!0,modules:$i,directives:{model:function(t,e,n){n;var r=e.value,a=e.modifiers,s=t.tag,i=t.attrsMap.type;if(t.component)return Wr(t,r,a),!1;if("select"===s)!function(t,e,n){var r='var $$selectedVal = Array.prototype.filter.call($event.target.options,function(o){return o.selected}).map(function(o){var val = "_value" in o ? o._value : o.value;return '+(n&&n.number?"_n(val)":"val")+"});";r=r+" "+zr(e,"$event.target.multiple ? $$selectedVal : $$selectedVal[0]"),Ir(t,"change",r,null,!0)}(t,r,a);else if("input"===s&&"checkbox"===i)!function(t,e,n){var r=n&&n.number,a=Hr(t,"value")||"null",s=Hr(t,"true-value")||"true",i=Hr(t,"false-value")||"false";Mr(t,"checked","Array.isArray("+e+")?_i("+e+","+a+")>-1"+("true"===s?":("+e+")":":_q("+e+","+s+")")),Ir(t,"change","var $$a="+e+",$$el=$event.target,$$c=$$el.checked?("+s+"):("+i+");if(Array.isArray($$a)){var $$v="+(r?"_n("+a+")":a)+",$$i=_i($$a,$$v);if($$el.checked){$$i<0&&("+zr(e,"$$a.concat([$$v])")+")}else{$$i>-1&&("+zr(e,"$$a.slice(0,$$i).concat($$a.slice($$i+1))")+")}}else{"+zr(e,"$$c")+"}",null,!0)}(t,r,a);else if("input"===s&&"radio"===i)!function(t,e,n){var r=n&&n.number,a=Hr(t,"value")||"null";Mr(t,"checked","_q("+e+","+(a=r?"_n("+a+")":a)+")"),Ir(t,"change",zr(e,a),null,!0)}(t,r,a);else if("input"===s||"textarea"===s)!function(t,e,n){var r=t.attrsMap.type;0;var a=n||{},s=a.lazy,i=a.number,o=a.trim,c=!s&&"range"!==r,l=s?"change":"range"===r?ta:"input",u="$event.target.value";o&&(u="$event.target.value.trim()");i&&(u="_n("+u+")");var d=zr(e,u);c&&(d="if($event.target.composing)return;"+d);Mr(t,"value","("+e+")"),Ir(t,l,d,null,!0),(o||i)&&Ir(t,"blur","$forceUpdate()")}(t,r,a);else{if(!F.isReservedTag(s))return Wr(t,r,a),!1}return!0},text:function(t,e){e.value&&Mr(t,"textContent","_s("+e.value+")",e)},html:function(t,e){e.value&&Mr(t,"innerHTML","_s("+e.value+")",e)}},isPreTag:function(t){return"pre"===t},isUnaryTag:$s,mustUseProp:Fn,canBeLeftOpenTag:ks,isReservedTag:tr,getTagNamespace:er,staticKeys:function(t){return t.reduce((function(t,e){return t.concat(e.staticKeys||[])}),[]).join(",")}($i)},Oi=b((function(t){return v("type,tag,attrsList,attrsMap,plain,parent,children,attrs,start,end,rawAttrsMap"+(t?","+t:""))}));function Ei(t,e){t&&(ki=Oi(e.staticKeys||""),Si=e.isReservedTag||P,Ti(t),Pi(t,!1))}function Ti(t){if(t.static=function(t){if(2===t.type)return!1;if(3===t.type)return!0;return!(!t.pre&&(t.hasBindings||t.if||t.for||h(t.tag)||!Si(t.tag)||function(t){for(;t.parent;){if("template"!==(t=t.parent).tag)return!1;if(t.for)return!0}return!1}(t)||!Object.keys(t).every(ki)))}(t),1===t.type){if(!Si(t.tag)&&"slot"!==t.tag&&null==t.attrsMap["inline-template"])return;for(var e=0,n=t.children.length;e<n;e++){var r=t.children[e];Ti(r),r.static||(t.static=!1)}if(t.ifConditions)for(var a=1,s=t.ifConditions.length;a<s;a++){var i=t.ifConditions[a].block;Ti(i),i.static||(t.static=!1)}}}function Pi(t,e){if(1===t.type){if((t.static||t.once)&&(t.staticInFor=e),t.static&&t.children.length&&(1!==t.children.length||3!==t.children[0].type))return void(t.staticRoot=!0);if(t.staticRoot=!1,t.children)for(var n=0,r=t.children.length;n<r;n++)Pi(t.children[n],e||!!t.for);if(t.ifConditions)for(var a=1,s=t.ifConditions.length;a<s;a++)Pi(t.ifConditions[a].block,e)}}var ji=/^([\w$_]+|\([^)]*?\))\s*=>|^function(?:\s+[\w$]+)?\s*\(/,Ni=/\([^)]*?\);*$/,Bi=/^[A-Za-z_$][\w$]*(?:\.[A-Za-z_$][\w$]*|\['[^']*?']|\["[^"]*?"]|\[\d+]|\[[A-Za-z_$][\w$]*])*$/,Di={esc:27,tab:9,enter:13,space:32,up:38,left:37,right:39,down:40,delete:[8,46]},Mi={esc:["Esc","Escape"],tab:"Tab",enter:"Enter",space:[" ","Spacebar"],up:["Up","ArrowUp"],left:["Left","ArrowLeft"],right:["Right","ArrowRight"],down:["Down","ArrowDown"],delete:["Backspace","Delete","Del"]},Ri=function(t){return"if("+t+")return null;"},Li={stop:"$event.stopPropagation();",prevent:"$event.preventDefault();",self:Ri("$event.target !== $event.currentTarget"),ctrl:Ri("!$event.ctrlKey"),shift:Ri("!$event.shiftKey"),alt:Ri("!$event.altKey"),meta:Ri("!$event.metaKey"),left:Ri("'button' in $event && $event.button !== 0"),middle:Ri("'button' in $event && $event.button !== 1"),right:Ri("'button' in $event && $event.button !== 2")};function Fi(t,e){var n=e?"nativeOn:":"on:",r="",a="";for(var s in t){var i=Ui(t[s]);t[s]&&t[s].dynamic?a+=s+","+i+",":r+='"'+s+'":'+i+","}return r="{"+r.slice(0,-1)+"}",a?n+"_d("+r+",["+a.slice(0,-1)+"])":n+r}function Ui(t){if(!t)return"function(){}";if(Array.isArray(t))return"["+t.map((function(t){return Ui(t)})).join(",")+"]";var e=Bi.test(t.value),n=ji.test(t.value),r=Bi.test(t.value.replace(Ni,""));if(t.modifiers){var a="",s="",i=[];for(var o in t.modifiers)if(Li[o])s+=Li[o],Di[o]&&i.push(o);else if("exact"===o){var c=t.modifiers;s+=Ri(["ctrl","shift","alt","meta"].filter((function(t){return!c[t]})).map((function(t){return"$event."+t+"Key"})).join("||"))}else i.push(o);return i.length&&(a+=function(t){return"if(!$event.type.indexOf('key')&&"+t.map(Ii).join("&&")+")return null;"}(i)),s&&(a+=s),"function($event){"+a+(e?"return "+t.value+".apply(null, arguments)":n?"return ("+t.value+").apply(null, arguments)":r?"return "+t.value:t.value)+"}"}return e||n?t.value:"function($event){"+(r?"return "+t.value:t.value)+"}"}function Ii(t){var e=parseInt(t,10);if(e)return"$event.keyCode!=="+e;var n=Di[t],r=Mi[t];return"_k($event.keyCode,"+JSON.stringify(t)+","+JSON.stringify(n)+",$event.key,"+JSON.stringify(r)+")"}var Hi={on:function(t,e){t.wrapListeners=function(t){return"_g("+t+","+e.value+")"}},bind:function(t,e){t.wrapData=function(n){return"_b("+n+",'"+t.tag+"',"+e.value+","+(e.modifiers&&e.modifiers.prop?"true":"false")+(e.modifiers&&e.modifiers.sync?",true":"")+")"}},cloak:T},Zi=function(t){this.options=t,this.warn=t.warn||Br,this.transforms=Dr(t.modules,"transformCode"),this.dataGenFns=Dr(t.modules,"genData"),this.directives=O(O({},Hi),t.directives);var e=t.isReservedTag||P;this.maybeComponent=function(t){return!!t.component||!e(t.tag)},this.onceId=0,this.staticRenderFns=[],this.pre=!1};function qi(t,e){var n=new Zi(e);return{render:"with(this){return "+(t?"script"===t.tag?"null":Vi(t,n):'_c("div")')+"}",staticRenderFns:n.staticRenderFns}}function Vi(t,e){if(t.parent&&(t.pre=t.pre||t.parent.pre),t.staticRoot&&!t.staticProcessed)return Wi(t,e);if(t.once&&!t.onceProcessed)return zi(t,e);if(t.for&&!t.forProcessed)return Ki(t,e);if(t.if&&!t.ifProcessed)return Gi(t,e);if("template"!==t.tag||t.slotTarget||e.pre){if("slot"===t.tag)return function(t,e){var n=t.slotName||'"default"',r=to(t,e),a="_t("+n+(r?",function(){return "+r+"}":""),s=t.attrs||t.dynamicAttrs?ro((t.attrs||[]).concat(t.dynamicAttrs||[]).map((function(t){return{name:C(t.name),value:t.value,dynamic:t.dynamic}}))):null,i=t.attrsMap["v-bind"];!s&&!i||r||(a+=",null");s&&(a+=","+s);i&&(a+=(s?"":",null")+","+i);return a+")"}(t,e);var n;if(t.component)n=function(t,e,n){var r=e.inlineTemplate?null:to(e,n,!0);return"_c("+t+","+Xi(e,n)+(r?","+r:"")+")"}(t.component,t,e);else{var r;(!t.plain||t.pre&&e.maybeComponent(t))&&(r=Xi(t,e));var a=t.inlineTemplate?null:to(t,e,!0);n="_c('"+t.tag+"'"+(r?","+r:"")+(a?","+a:"")+")"}for(var s=0;s<e.transforms.length;s++)n=e.transforms[s](t,n);return n}return to(t,e)||"void 0"}function Wi(t,e){t.staticProcessed=!0;var n=e.pre;return t.pre&&(e.pre=t.pre),e.staticRenderFns.push("with(this){return "+Vi(t,e)+"}"),e.pre=n,"_m("+(e.staticRenderFns.length-1)+(t.staticInFor?",true":"")+")"}function zi(t,e){if(t.onceProcessed=!0,t.if&&!t.ifProcessed)return Gi(t,e);if(t.staticInFor){for(var n="",r=t.parent;r;){if(r.for){n=r.key;break}r=r.parent}return n?"_o("+Vi(t,e)+","+e.onceId+++","+n+")":Vi(t,e)}return Wi(t,e)}function Gi(t,e,n,r){return t.ifProcessed=!0,Ji(t.ifConditions.slice(),e,n,r)}function Ji(t,e,n,r){if(!t.length)return r||"_e()";var a=t.shift();return a.exp?"("+a.exp+")?"+s(a.block)+":"+Ji(t,e,n,r):""+s(a.block);function s(t){return n?n(t,e):t.once?zi(t,e):Vi(t,e)}}function Ki(t,e,n,r){var a=t.for,s=t.alias,i=t.iterator1?","+t.iterator1:"",o=t.iterator2?","+t.iterator2:"";return t.forProcessed=!0,(r||"_l")+"(("+a+"),function("+s+i+o+"){return "+(n||Vi)(t,e)+"})"}function Xi(t,e){var n="{",r=function(t,e){var n=t.directives;if(!n)return;var r,a,s,i,o="directives:[",c=!1;for(r=0,a=n.length;r<a;r++){s=n[r],i=!0;var l=e.directives[s.name];l&&(i=!!l(t,s,e.warn)),i&&(c=!0,o+='{name:"'+s.name+'",rawName:"'+s.rawName+'"'+(s.value?",value:("+s.value+"),expression:"+JSON.stringify(s.value):"")+(s.arg?",arg:"+(s.isDynamicArg?s.arg:'"'+s.arg+'"'):"")+(s.modifiers?",modifiers:"+JSON.stringify(s.modifiers):"")+"},")}if(c)return o.slice(0,-1)+"]"}(t,e);r&&(n+=r+","),t.key&&(n+="key:"+t.key+","),t.ref&&(n+="ref:"+t.ref+","),t.refInFor&&(n+="refInFor:true,"),t.pre&&(n+="pre:true,"),t.component&&(n+='tag:"'+t.tag+'",');for(var a=0;a<e.dataGenFns.length;a++)n+=e.dataGenFns[a](t);if(t.attrs&&(n+="attrs:"+ro(t.attrs)+","),t.props&&(n+="domProps:"+ro(t.props)+","),t.events&&(n+=Fi(t.events,!1)+","),t.nativeEvents&&(n+=Fi(t.nativeEvents,!0)+","),t.slotTarget&&!t.slotScope&&(n+="slot:"+t.slotTarget+","),t.scopedSlots&&(n+=function(t,e,n){var r=t.for||Object.keys(e).some((function(t){var n=e[t];return n.slotTargetDynamic||n.if||n.for||Yi(n)})),a=!!t.if;if(!r)for(var s=t.parent;s;){if(s.slotScope&&s.slotScope!==fi||s.for){r=!0;break}s.if&&(a=!0),s=s.parent}var i=Object.keys(e).map((function(t){return Qi(e[t],n)})).join(",");return"scopedSlots:_u(["+i+"]"+(r?",null,true":"")+(!r&&a?",null,false,"+function(t){var e=5381,n=t.length;for(;n;)e=33*e^t.charCodeAt(--n);return e>>>0}(i):"")+")"}(t,t.scopedSlots,e)+","),t.model&&(n+="model:{value:"+t.model.value+",callback:"+t.model.callback+",expression:"+t.model.expression+"},"),t.inlineTemplate){var s=function(t,e){var n=t.children[0];0;if(n&&1===n.type){var r=qi(n,e.options);return"inlineTemplate:{render:function(){"+r.render+"},staticRenderFns:["+r.staticRenderFns.map((function(t){return"function(){"+t+"}"})).join(",")+"]}"}}(t,e);s&&(n+=s+",")}return n=n.replace(/,$/,"")+"}",t.dynamicAttrs&&(n="_b("+n+',"'+t.tag+'",'+ro(t.dynamicAttrs)+")"),t.wrapData&&(n=t.wrapData(n)),t.wrapListeners&&(n=t.wrapListeners(n)),n}function Yi(t){return 1===t.type&&("slot"===t.tag||t.children.some(Yi))}function Qi(t,e){var n=t.attrsMap["slot-scope"];if(t.if&&!t.ifProcessed&&!n)return Gi(t,e,Qi,"null");if(t.for&&!t.forProcessed)return Ki(t,e,Qi);var r=t.slotScope===fi?"":String(t.slotScope),a="function("+r+"){return "+("template"===t.tag?t.if&&n?"("+t.if+")?"+(to(t,e)||"undefined")+":undefined":to(t,e)||"undefined":Vi(t,e))+"}",s=r?"":",proxy:true";return"{key:"+(t.slotTarget||'"default"')+",fn:"+a+s+"}"}function to(t,e,n,r,a){var s=t.children;if(s.length){var i=s[0];if(1===s.length&&i.for&&"template"!==i.tag&&"slot"!==i.tag){var o=n?e.maybeComponent(i)?",1":",0":"";return""+(r||Vi)(i,e)+o}var c=n?function(t,e){for(var n=0,r=0;r<t.length;r++){var a=t[r];if(1===a.type){if(eo(a)||a.ifConditions&&a.ifConditions.some((function(t){return eo(t.block)}))){n=2;break}(e(a)||a.ifConditions&&a.ifConditions.some((function(t){return e(t.block)})))&&(n=1)}}return n}(s,e.maybeComponent):0,l=a||no;return"["+s.map((function(t){return l(t,e)})).join(",")+"]"+(c?","+c:"")}}function eo(t){return void 0!==t.for||"template"===t.tag||"slot"===t.tag}function no(t,e){return 1===t.type?Vi(t,e):3===t.type&&t.isComment?function(t){return"_e("+JSON.stringify(t.text)+")"}(t):"_v("+(2===(n=t).type?n.expression:ao(JSON.stringify(n.text)))+")";var n}function ro(t){for(var e="",n="",r=0;r<t.length;r++){var a=t[r],s=ao(a.value);a.dynamic?n+=a.name+","+s+",":e+='"'+a.name+'":'+s+","}return e="{"+e.slice(0,-1)+"}",n?"_d("+e+",["+n.slice(0,-1)+"])":e}function ao(t){return t.replace(/\u2028/g,"\\u2028").replace(/\u2029/g,"\\u2029")}new RegExp("\\b"+"do,if,for,let,new,try,var,case,else,with,await,break,catch,class,const,super,throw,while,yield,delete,export,import,return,switch,default,extends,finally,continue,debugger,function,arguments".split(",").join("\\b|\\b")+"\\b"),new RegExp("\\b"+"delete,typeof,void".split(",").join("\\s*\\([^\\)]*\\)|\\b")+"\\s*\\([^\\)]*\\)");function so(t,e){try{return new Function(t)}catch(n){return e.push({err:n,code:t}),T}}function io(t){var e=Object.create(null);return function(n,r,a){(r=O({},r)).warn;delete r.warn;var s=r.delimiters?String(r.delimiters)+n:n;if(e[s])return e[s];var i=t(n,r);var o={},c=[];return o.render=so(i.render,c),o.staticRenderFns=i.staticRenderFns.map((function(t){return so(t,c)})),e[s]=o}}var oo,co,lo=(oo=function(t,e){var n=vi(t.trim(),e);!1!==e.optimize&&Ei(n,e);var r=qi(n,e);return{ast:n,render:r.render,staticRenderFns:r.staticRenderFns}},function(t){function e(e,n){var r=Object.create(t),a=[],s=[];if(n)for(var i in n.modules&&(r.modules=(t.modules||[]).concat(n.modules)),n.directives&&(r.directives=O(Object.create(t.directives||null),n.directives)),n)"modules"!==i&&"directives"!==i&&(r[i]=n[i]);r.warn=function(t,e,n){(n?s:a).push(t)};var o=oo(e.trim(),r);return o.errors=a,o.tips=s,o}return{compile:e,compileToFunctions:io(e)}})(Ai),uo=(lo.compile,lo.compileToFunctions);function fo(t){return(co=co||document.createElement("div")).innerHTML=t?'<a href="\n"/>':'<div a="\n"/>',co.innerHTML.indexOf("&#10;")>0}var po=!!W&&fo(!1),vo=!!W&&fo(!0),ho=b((function(t){var e=ar(t);return e&&e.innerHTML})),mo=En.prototype.$mount;En.prototype.$mount=function(t,e){if((t=t&&ar(t))===document.body||t===document.documentElement)return this;var n=this.$options;if(!n.render){var r=n.template;if(r)if("string"==typeof r)"#"===r.charAt(0)&&(r=ho(r));else{if(!r.nodeType)return this;r=r.innerHTML}else t&&(r=function(t){if(t.outerHTML)return t.outerHTML;var e=document.createElement("div");return e.appendChild(t.cloneNode(!0)),e.innerHTML}(t));if(r){0;var a=uo(r,{outputSourceRange:!1,shouldDecodeNewlines:po,shouldDecodeNewlinesForHref:vo,delimiters:n.delimiters,comments:n.comments},this),s=a.render,i=a.staticRenderFns;n.render=s,n.staticRenderFns=i}}return mo.call(this,t,e)},En.compile=uo;const go=En;function _o(t,e){for(var n in e)t[n]=e[n];return t}var yo=/[!'()*]/g,bo=function(t){return"%"+t.charCodeAt(0).toString(16)},wo=/%2C/g,Co=function(t){return encodeURIComponent(t).replace(yo,bo).replace(wo,",")};function xo(t){try{return decodeURIComponent(t)}catch(t){0}return t}var $o=function(t){return null==t||"object"==typeof t?t:String(t)};function ko(t){var e={};return(t=t.trim().replace(/^(\?|#|&)/,""))?(t.split("&").forEach((function(t){var n=t.replace(/\+/g," ").split("="),r=xo(n.shift()),a=n.length>0?xo(n.join("=")):null;void 0===e[r]?e[r]=a:Array.isArray(e[r])?e[r].push(a):e[r]=[e[r],a]})),e):e}function So(t){var e=t?Object.keys(t).map((function(e){var n=t[e];if(void 0===n)return"";if(null===n)return Co(e);if(Array.isArray(n)){var r=[];return n.forEach((function(t){void 0!==t&&(null===t?r.push(Co(e)):r.push(Co(e)+"="+Co(t)))})),r.join("&")}return Co(e)+"="+Co(n)})).filter((function(t){return t.length>0})).join("&"):null;return e?"?"+e:""}var Ao=/\/?$/;function Oo(t,e,n,r){var a=r&&r.options.stringifyQuery,s=e.query||{};try{s=Eo(s)}catch(t){}var i={name:e.name||t&&t.name,meta:t&&t.meta||{},path:e.path||"/",hash:e.hash||"",query:s,params:e.params||{},fullPath:jo(e,a),matched:t?Po(t):[]};return n&&(i.redirectedFrom=jo(n,a)),Object.freeze(i)}function Eo(t){if(Array.isArray(t))return t.map(Eo);if(t&&"object"==typeof t){var e={};for(var n in t)e[n]=Eo(t[n]);return e}return t}var To=Oo(null,{path:"/"});function Po(t){for(var e=[];t;)e.unshift(t),t=t.parent;return e}function jo(t,e){var n=t.path,r=t.query;void 0===r&&(r={});var a=t.hash;return void 0===a&&(a=""),(n||"/")+(e||So)(r)+a}function No(t,e,n){return e===To?t===e:!!e&&(t.path&&e.path?t.path.replace(Ao,"")===e.path.replace(Ao,"")&&(n||t.hash===e.hash&&Bo(t.query,e.query)):!(!t.name||!e.name)&&(t.name===e.name&&(n||t.hash===e.hash&&Bo(t.query,e.query)&&Bo(t.params,e.params))))}function Bo(t,e){if(void 0===t&&(t={}),void 0===e&&(e={}),!t||!e)return t===e;var n=Object.keys(t).sort(),r=Object.keys(e).sort();return n.length===r.length&&n.every((function(n,a){var s=t[n];if(r[a]!==n)return!1;var i=e[n];return null==s||null==i?s===i:"object"==typeof s&&"object"==typeof i?Bo(s,i):String(s)===String(i)}))}function Do(t){for(var e=0;e<t.matched.length;e++){var n=t.matched[e];for(var r in n.instances){var a=n.instances[r],s=n.enteredCbs[r];if(a&&s){delete n.enteredCbs[r];for(var i=0;i<s.length;i++)a._isBeingDestroyed||s[i](a)}}}}var Mo={name:"RouterView",functional:!0,props:{name:{type:String,default:"default"}},render:function(t,e){var n=e.props,r=e.children,a=e.parent,s=e.data;s.routerView=!0;for(var i=a.$createElement,o=n.name,c=a.$route,l=a._routerViewCache||(a._routerViewCache={}),u=0,d=!1;a&&a._routerRoot!==a;){var f=a.$vnode?a.$vnode.data:{};f.routerView&&u++,f.keepAlive&&a._directInactive&&a._inactive&&(d=!0),a=a.$parent}if(s.routerViewDepth=u,d){var p=l[o],v=p&&p.component;return v?(p.configProps&&Ro(v,s,p.route,p.configProps),i(v,s,r)):i()}var h=c.matched[u],m=h&&h.components[o];if(!h||!m)return l[o]=null,i();l[o]={component:m},s.registerRouteInstance=function(t,e){var n=h.instances[o];(e&&n!==t||!e&&n===t)&&(h.instances[o]=e)},(s.hook||(s.hook={})).prepatch=function(t,e){h.instances[o]=e.componentInstance},s.hook.init=function(t){t.data.keepAlive&&t.componentInstance&&t.componentInstance!==h.instances[o]&&(h.instances[o]=t.componentInstance),Do(c)};var g=h.props&&h.props[o];return g&&(_o(l[o],{route:c,configProps:g}),Ro(m,s,c,g)),i(m,s,r)}};function Ro(t,e,n,r){var a=e.props=function(t,e){switch(typeof e){case"undefined":return;case"object":return e;case"function":return e(t);case"boolean":return e?t.params:void 0;default:0}}(n,r);if(a){a=e.props=_o({},a);var s=e.attrs=e.attrs||{};for(var i in a)t.props&&i in t.props||(s[i]=a[i],delete a[i])}}function Lo(t,e,n){var r=t.charAt(0);if("/"===r)return t;if("?"===r||"#"===r)return e+t;var a=e.split("/");n&&a[a.length-1]||a.pop();for(var s=t.replace(/^\//,"").split("/"),i=0;i<s.length;i++){var o=s[i];".."===o?a.pop():"."!==o&&a.push(o)}return""!==a[0]&&a.unshift(""),a.join("/")}function Fo(t){return t.replace(/\/\//g,"/")}var Uo=Array.isArray||function(t){return"[object Array]"==Object.prototype.toString.call(t)},Io=nc,Ho=zo,Zo=function(t,e){return Ko(zo(t,e),e)},qo=Ko,Vo=ec,Wo=new RegExp(["(\\\\.)","([\\/.])?(?:(?:\\:(\\w+)(?:\\(((?:\\\\.|[^\\\\()])+)\\))?|\\(((?:\\\\.|[^\\\\()])+)\\))([+*?])?|(\\*))"].join("|"),"g");function zo(t,e){for(var n,r=[],a=0,s=0,i="",o=e&&e.delimiter||"/";null!=(n=Wo.exec(t));){var c=n[0],l=n[1],u=n.index;if(i+=t.slice(s,u),s=u+c.length,l)i+=l[1];else{var d=t[s],f=n[2],p=n[3],v=n[4],h=n[5],m=n[6],g=n[7];i&&(r.push(i),i="");var _=null!=f&&null!=d&&d!==f,y="+"===m||"*"===m,b="?"===m||"*"===m,w=n[2]||o,C=v||h;r.push({name:p||a++,prefix:f||"",delimiter:w,optional:b,repeat:y,partial:_,asterisk:!!g,pattern:C?Yo(C):g?".*":"[^"+Xo(w)+"]+?"})}}return s<t.length&&(i+=t.substr(s)),i&&r.push(i),r}function Go(t){return encodeURI(t).replace(/[\/?#]/g,(function(t){return"%"+t.charCodeAt(0).toString(16).toUpperCase()}))}function Jo(t){return encodeURI(t).replace(/[?#]/g,(function(t){return"%"+t.charCodeAt(0).toString(16).toUpperCase()}))}function Ko(t,e){for(var n=new Array(t.length),r=0;r<t.length;r++)"object"==typeof t[r]&&(n[r]=new RegExp("^(?:"+t[r].pattern+")$",tc(e)));return function(e,r){for(var a="",s=e||{},i=(r||{}).pretty?Go:encodeURIComponent,o=0;o<t.length;o++){var c=t[o];if("string"!=typeof c){var l,u=s[c.name];if(null==u){if(c.optional){c.partial&&(a+=c.prefix);continue}throw new TypeError('Expected "'+c.name+'" to be defined')}if(Uo(u)){if(!c.repeat)throw new TypeError('Expected "'+c.name+'" to not repeat, but received `'+JSON.stringify(u)+"`");if(0===u.length){if(c.optional)continue;throw new TypeError('Expected "'+c.name+'" to not be empty')}for(var d=0;d<u.length;d++){if(l=i(u[d]),!n[o].test(l))throw new TypeError('Expected all "'+c.name+'" to match "'+c.pattern+'", but received `'+JSON.stringify(l)+"`");a+=(0===d?c.prefix:c.delimiter)+l}}else{if(l=c.asterisk?Jo(u):i(u),!n[o].test(l))throw new TypeError('Expected "'+c.name+'" to match "'+c.pattern+'", but received "'+l+'"');a+=c.prefix+l}}else a+=c}return a}}function Xo(t){return t.replace(/([.+*?=^!:${}()[\]|\/\\])/g,"\\$1")}function Yo(t){return t.replace(/([=!:$\/()])/g,"\\$1")}function Qo(t,e){return t.keys=e,t}function tc(t){return t&&t.sensitive?"":"i"}function ec(t,e,n){Uo(e)||(n=e||n,e=[]);for(var r=(n=n||{}).strict,a=!1!==n.end,s="",i=0;i<t.length;i++){var o=t[i];if("string"==typeof o)s+=Xo(o);else{var c=Xo(o.prefix),l="(?:"+o.pattern+")";e.push(o),o.repeat&&(l+="(?:"+c+l+")*"),s+=l=o.optional?o.partial?c+"("+l+")?":"(?:"+c+"("+l+"))?":c+"("+l+")"}}var u=Xo(n.delimiter||"/"),d=s.slice(-u.length)===u;return r||(s=(d?s.slice(0,-u.length):s)+"(?:"+u+"(?=$))?"),s+=a?"$":r&&d?"":"(?="+u+"|$)",Qo(new RegExp("^"+s,tc(n)),e)}function nc(t,e,n){return Uo(e)||(n=e||n,e=[]),n=n||{},t instanceof RegExp?function(t,e){var n=t.source.match(/\((?!\?)/g);if(n)for(var r=0;r<n.length;r++)e.push({name:r,prefix:null,delimiter:null,optional:!1,repeat:!1,partial:!1,asterisk:!1,pattern:null});return Qo(t,e)}(t,e):Uo(t)?function(t,e,n){for(var r=[],a=0;a<t.length;a++)r.push(nc(t[a],e,n).source);return Qo(new RegExp("(?:"+r.join("|")+")",tc(n)),e)}(t,e,n):function(t,e,n){return ec(zo(t,n),e,n)}(t,e,n)}Io.parse=Ho,Io.compile=Zo,Io.tokensToFunction=qo,Io.tokensToRegExp=Vo;var rc=Object.create(null);function ac(t,e,n){e=e||{};try{var r=rc[t]||(rc[t]=Io.compile(t));return"string"==typeof e.pathMatch&&(e[0]=e.pathMatch),r(e,{pretty:!0})}catch(t){return""}finally{delete e[0]}}function sc(t,e,n,r){var a="string"==typeof t?{path:t}:t;if(a._normalized)return a;if(a.name){var s=(a=_o({},t)).params;return s&&"object"==typeof s&&(a.params=_o({},s)),a}if(!a.path&&a.params&&e){(a=_o({},a))._normalized=!0;var i=_o(_o({},e.params),a.params);if(e.name)a.name=e.name,a.params=i;else if(e.matched.length){var o=e.matched[e.matched.length-1].path;a.path=ac(o,i,e.path)}else 0;return a}var c=function(t){var e="",n="",r=t.indexOf("#");r>=0&&(e=t.slice(r),t=t.slice(0,r));var a=t.indexOf("?");return a>=0&&(n=t.slice(a+1),t=t.slice(0,a)),{path:t,query:n,hash:e}}(a.path||""),l=e&&e.path||"/",u=c.path?Lo(c.path,l,n||a.append):l,d=function(t,e,n){void 0===e&&(e={});var r,a=n||ko;try{r=a(t||"")}catch(t){r={}}for(var s in e){var i=e[s];r[s]=Array.isArray(i)?i.map($o):$o(i)}return r}(c.query,a.query,r&&r.options.parseQuery),f=a.hash||c.hash;return f&&"#"!==f.charAt(0)&&(f="#"+f),{_normalized:!0,path:u,query:d,hash:f}}var ic,oc=function(){},cc={name:"RouterLink",props:{to:{type:[String,Object],required:!0},tag:{type:String,default:"a"},custom:Boolean,exact:Boolean,exactPath:Boolean,append:Boolean,replace:Boolean,activeClass:String,exactActiveClass:String,ariaCurrentValue:{type:String,default:"page"},event:{type:[String,Array],default:"click"}},render:function(t){var e=this,n=this.$router,r=this.$route,a=n.resolve(this.to,r,this.append),s=a.location,i=a.route,o=a.href,c={},l=n.options.linkActiveClass,u=n.options.linkExactActiveClass,d=null==l?"router-link-active":l,f=null==u?"router-link-exact-active":u,p=null==this.activeClass?d:this.activeClass,v=null==this.exactActiveClass?f:this.exactActiveClass,h=i.redirectedFrom?Oo(null,sc(i.redirectedFrom),null,n):i;c[v]=No(r,h,this.exactPath),c[p]=this.exact||this.exactPath?c[v]:function(t,e){return 0===t.path.replace(Ao,"/").indexOf(e.path.replace(Ao,"/"))&&(!e.hash||t.hash===e.hash)&&function(t,e){for(var n in e)if(!(n in t))return!1;return!0}(t.query,e.query)}(r,h);var m=c[v]?this.ariaCurrentValue:null,g=function(t){lc(t)&&(e.replace?n.replace(s,oc):n.push(s,oc))},_={click:lc};Array.isArray(this.event)?this.event.forEach((function(t){_[t]=g})):_[this.event]=g;var y={class:c},b=!this.$scopedSlots.$hasNormal&&this.$scopedSlots.default&&this.$scopedSlots.default({href:o,route:i,navigate:g,isActive:c[p],isExactActive:c[v]});if(b){if(1===b.length)return b[0];if(b.length>1||!b.length)return 0===b.length?t():t("span",{},b)}if("a"===this.tag)y.on=_,y.attrs={href:o,"aria-current":m};else{var w=uc(this.$slots.default);if(w){w.isStatic=!1;var C=w.data=_o({},w.data);for(var x in C.on=C.on||{},C.on){var $=C.on[x];x in _&&(C.on[x]=Array.isArray($)?$:[$])}for(var k in _)k in C.on?C.on[k].push(_[k]):C.on[k]=g;var S=w.data.attrs=_o({},w.data.attrs);S.href=o,S["aria-current"]=m}else y.on=_}return t(this.tag,y,this.$slots.default)}};function lc(t){if(!(t.metaKey||t.altKey||t.ctrlKey||t.shiftKey||t.defaultPrevented||void 0!==t.button&&0!==t.button)){if(t.currentTarget&&t.currentTarget.getAttribute){var e=t.currentTarget.getAttribute("target");if(/\b_blank\b/i.test(e))return}return t.preventDefault&&t.preventDefault(),!0}}function uc(t){if(t)for(var e,n=0;n<t.length;n++){if("a"===(e=t[n]).tag)return e;if(e.children&&(e=uc(e.children)))return e}}var dc="undefined"!=typeof window;function fc(t,e,n,r,a){var s=e||[],i=n||Object.create(null),o=r||Object.create(null);t.forEach((function(t){pc(s,i,o,t,a)}));for(var c=0,l=s.length;c<l;c++)"*"===s[c]&&(s.push(s.splice(c,1)[0]),l--,c--);return{pathList:s,pathMap:i,nameMap:o}}function pc(t,e,n,r,a,s){var i=r.path,o=r.name;var c=r.pathToRegexpOptions||{},l=function(t,e,n){n||(t=t.replace(/\/$/,""));if("/"===t[0])return t;if(null==e)return t;return Fo(e.path+"/"+t)}(i,a,c.strict);"boolean"==typeof r.caseSensitive&&(c.sensitive=r.caseSensitive);var u={path:l,regex:vc(l,c),components:r.components||{default:r.component},alias:r.alias?"string"==typeof r.alias?[r.alias]:r.alias:[],instances:{},enteredCbs:{},name:o,parent:a,matchAs:s,redirect:r.redirect,beforeEnter:r.beforeEnter,meta:r.meta||{},props:null==r.props?{}:r.components?r.props:{default:r.props}};if(r.children&&r.children.forEach((function(r){var a=s?Fo(s+"/"+r.path):void 0;pc(t,e,n,r,u,a)})),e[u.path]||(t.push(u.path),e[u.path]=u),void 0!==r.alias)for(var d=Array.isArray(r.alias)?r.alias:[r.alias],f=0;f<d.length;++f){0;var p={path:d[f],children:r.children};pc(t,e,n,p,a,u.path||"/")}o&&(n[o]||(n[o]=u))}function vc(t,e){return Io(t,[],e)}function hc(t,e){var n=fc(t),r=n.pathList,a=n.pathMap,s=n.nameMap;function i(t,n,i){var o=sc(t,n,!1,e),l=o.name;if(l){var u=s[l];if(!u)return c(null,o);var d=u.regex.keys.filter((function(t){return!t.optional})).map((function(t){return t.name}));if("object"!=typeof o.params&&(o.params={}),n&&"object"==typeof n.params)for(var f in n.params)!(f in o.params)&&d.indexOf(f)>-1&&(o.params[f]=n.params[f]);return o.path=ac(u.path,o.params),c(u,o,i)}if(o.path){o.params={};for(var p=0;p<r.length;p++){var v=r[p],h=a[v];if(mc(h.regex,o.path,o.params))return c(h,o,i)}}return c(null,o)}function o(t,n){var r=t.redirect,a="function"==typeof r?r(Oo(t,n,null,e)):r;if("string"==typeof a&&(a={path:a}),!a||"object"!=typeof a)return c(null,n);var o=a,l=o.name,u=o.path,d=n.query,f=n.hash,p=n.params;if(d=o.hasOwnProperty("query")?o.query:d,f=o.hasOwnProperty("hash")?o.hash:f,p=o.hasOwnProperty("params")?o.params:p,l){s[l];return i({_normalized:!0,name:l,query:d,hash:f,params:p},void 0,n)}if(u){var v=function(t,e){return Lo(t,e.parent?e.parent.path:"/",!0)}(u,t);return i({_normalized:!0,path:ac(v,p),query:d,hash:f},void 0,n)}return c(null,n)}function c(t,n,r){return t&&t.redirect?o(t,r||n):t&&t.matchAs?function(t,e,n){var r=i({_normalized:!0,path:ac(n,e.params)});if(r){var a=r.matched,s=a[a.length-1];return e.params=r.params,c(s,e)}return c(null,e)}(0,n,t.matchAs):Oo(t,n,r,e)}return{match:i,addRoute:function(t,e){var n="object"!=typeof t?s[t]:void 0;fc([e||t],r,a,s,n),n&&n.alias.length&&fc(n.alias.map((function(t){return{path:t,children:[e]}})),r,a,s,n)},getRoutes:function(){return r.map((function(t){return a[t]}))},addRoutes:function(t){fc(t,r,a,s)}}}function mc(t,e,n){var r=e.match(t);if(!r)return!1;if(!n)return!0;for(var a=1,s=r.length;a<s;++a){var i=t.keys[a-1];i&&(n[i.name||"pathMatch"]="string"==typeof r[a]?xo(r[a]):r[a])}return!0}var gc=dc&&window.performance&&window.performance.now?window.performance:Date;function _c(){return gc.now().toFixed(3)}var yc=_c();function bc(){return yc}function wc(t){return yc=t}var Cc=Object.create(null);function xc(){"scrollRestoration"in window.history&&(window.history.scrollRestoration="manual");var t=window.location.protocol+"//"+window.location.host,e=window.location.href.replace(t,""),n=_o({},window.history.state);return n.key=bc(),window.history.replaceState(n,"",e),window.addEventListener("popstate",Sc),function(){window.removeEventListener("popstate",Sc)}}function $c(t,e,n,r){if(t.app){var a=t.options.scrollBehavior;a&&t.app.$nextTick((function(){var s=function(){var t=bc();if(t)return Cc[t]}(),i=a.call(t,e,n,r?s:null);i&&("function"==typeof i.then?i.then((function(t){Pc(t,s)})).catch((function(t){0})):Pc(i,s))}))}}function kc(){var t=bc();t&&(Cc[t]={x:window.pageXOffset,y:window.pageYOffset})}function Sc(t){kc(),t.state&&t.state.key&&wc(t.state.key)}function Ac(t){return Ec(t.x)||Ec(t.y)}function Oc(t){return{x:Ec(t.x)?t.x:window.pageXOffset,y:Ec(t.y)?t.y:window.pageYOffset}}function Ec(t){return"number"==typeof t}var Tc=/^#\d/;function Pc(t,e){var n,r="object"==typeof t;if(r&&"string"==typeof t.selector){var a=Tc.test(t.selector)?document.getElementById(t.selector.slice(1)):document.querySelector(t.selector);if(a){var s=t.offset&&"object"==typeof t.offset?t.offset:{};e=function(t,e){var n=document.documentElement.getBoundingClientRect(),r=t.getBoundingClientRect();return{x:r.left-n.left-e.x,y:r.top-n.top-e.y}}(a,s={x:Ec((n=s).x)?n.x:0,y:Ec(n.y)?n.y:0})}else Ac(t)&&(e=Oc(t))}else r&&Ac(t)&&(e=Oc(t));e&&("scrollBehavior"in document.documentElement.style?window.scrollTo({left:e.x,top:e.y,behavior:t.behavior}):window.scrollTo(e.x,e.y))}var jc,Nc=dc&&((-1===(jc=window.navigator.userAgent).indexOf("Android 2.")&&-1===jc.indexOf("Android 4.0")||-1===jc.indexOf("Mobile Safari")||-1!==jc.indexOf("Chrome")||-1!==jc.indexOf("Windows Phone"))&&window.history&&"function"==typeof window.history.pushState);function Bc(t,e){kc();var n=window.history;try{if(e){var r=_o({},n.state);r.key=bc(),n.replaceState(r,"",t)}else n.pushState({key:wc(_c())},"",t)}catch(n){window.location[e?"replace":"assign"](t)}}function Dc(t){Bc(t,!0)}function Mc(t,e,n){var r=function(a){a>=t.length?n():t[a]?e(t[a],(function(){r(a+1)})):r(a+1)};r(0)}var Rc={redirected:2,aborted:4,cancelled:8,duplicated:16};function Lc(t,e){return Uc(t,e,Rc.redirected,'Redirected when going from "'+t.fullPath+'" to "'+function(t){if("string"==typeof t)return t;if("path"in t)return t.path;var e={};return Ic.forEach((function(n){n in t&&(e[n]=t[n])})),JSON.stringify(e,null,2)}(e)+'" via a navigation guard.')}function Fc(t,e){return Uc(t,e,Rc.cancelled,'Navigation cancelled from "'+t.fullPath+'" to "'+e.fullPath+'" with a new navigation.')}function Uc(t,e,n,r){var a=new Error(r);return a._isRouter=!0,a.from=t,a.to=e,a.type=n,a}var Ic=["params","query","hash"];function Hc(t){return Object.prototype.toString.call(t).indexOf("Error")>-1}function Zc(t,e){return Hc(t)&&t._isRouter&&(null==e||t.type===e)}function qc(t){return function(e,n,r){var a=!1,s=0,i=null;Vc(t,(function(t,e,n,o){if("function"==typeof t&&void 0===t.cid){a=!0,s++;var c,l=Gc((function(e){var a;((a=e).__esModule||zc&&"Module"===a[Symbol.toStringTag])&&(e=e.default),t.resolved="function"==typeof e?e:ic.extend(e),n.components[o]=e,--s<=0&&r()})),u=Gc((function(t){var e="Failed to resolve async component "+o+": "+t;i||(i=Hc(t)?t:new Error(e),r(i))}));try{c=t(l,u)}catch(t){u(t)}if(c)if("function"==typeof c.then)c.then(l,u);else{var d=c.component;d&&"function"==typeof d.then&&d.then(l,u)}}})),a||r()}}function Vc(t,e){return Wc(t.map((function(t){return Object.keys(t.components).map((function(n){return e(t.components[n],t.instances[n],t,n)}))})))}function Wc(t){return Array.prototype.concat.apply([],t)}var zc="function"==typeof Symbol&&"symbol"==typeof Symbol.toStringTag;function Gc(t){var e=!1;return function(){for(var n=[],r=arguments.length;r--;)n[r]=arguments[r];if(!e)return e=!0,t.apply(this,n)}}var Jc=function(t,e){this.router=t,this.base=function(t){if(!t)if(dc){var e=document.querySelector("base");t=(t=e&&e.getAttribute("href")||"/").replace(/^https?:\/\/[^\/]+/,"")}else t="/";"/"!==t.charAt(0)&&(t="/"+t);return t.replace(/\/$/,"")}(e),this.current=To,this.pending=null,this.ready=!1,this.readyCbs=[],this.readyErrorCbs=[],this.errorCbs=[],this.listeners=[]};function Kc(t,e,n,r){var a=Vc(t,(function(t,r,a,s){var i=function(t,e){"function"!=typeof t&&(t=ic.extend(t));return t.options[e]}(t,e);if(i)return Array.isArray(i)?i.map((function(t){return n(t,r,a,s)})):n(i,r,a,s)}));return Wc(r?a.reverse():a)}function Xc(t,e){if(e)return function(){return t.apply(e,arguments)}}Jc.prototype.listen=function(t){this.cb=t},Jc.prototype.onReady=function(t,e){this.ready?t():(this.readyCbs.push(t),e&&this.readyErrorCbs.push(e))},Jc.prototype.onError=function(t){this.errorCbs.push(t)},Jc.prototype.transitionTo=function(t,e,n){var r,a=this;try{r=this.router.match(t,this.current)}catch(t){throw this.errorCbs.forEach((function(e){e(t)})),t}var s=this.current;this.confirmTransition(r,(function(){a.updateRoute(r),e&&e(r),a.ensureURL(),a.router.afterHooks.forEach((function(t){t&&t(r,s)})),a.ready||(a.ready=!0,a.readyCbs.forEach((function(t){t(r)})))}),(function(t){n&&n(t),t&&!a.ready&&(Zc(t,Rc.redirected)&&s===To||(a.ready=!0,a.readyErrorCbs.forEach((function(e){e(t)}))))}))},Jc.prototype.confirmTransition=function(t,e,n){var r=this,a=this.current;this.pending=t;var s,i,o=function(t){!Zc(t)&&Hc(t)&&(r.errorCbs.length?r.errorCbs.forEach((function(e){e(t)})):console.error(t)),n&&n(t)},c=t.matched.length-1,l=a.matched.length-1;if(No(t,a)&&c===l&&t.matched[c]===a.matched[l])return this.ensureURL(),o(((i=Uc(s=a,t,Rc.duplicated,'Avoided redundant navigation to current location: "'+s.fullPath+'".')).name="NavigationDuplicated",i));var u=function(t,e){var n,r=Math.max(t.length,e.length);for(n=0;n<r&&t[n]===e[n];n++);return{updated:e.slice(0,n),activated:e.slice(n),deactivated:t.slice(n)}}(this.current.matched,t.matched),d=u.updated,f=u.deactivated,p=u.activated,v=[].concat(function(t){return Kc(t,"beforeRouteLeave",Xc,!0)}(f),this.router.beforeHooks,function(t){return Kc(t,"beforeRouteUpdate",Xc)}(d),p.map((function(t){return t.beforeEnter})),qc(p)),h=function(e,n){if(r.pending!==t)return o(Fc(a,t));try{e(t,a,(function(e){!1===e?(r.ensureURL(!0),o(function(t,e){return Uc(t,e,Rc.aborted,'Navigation aborted from "'+t.fullPath+'" to "'+e.fullPath+'" via a navigation guard.')}(a,t))):Hc(e)?(r.ensureURL(!0),o(e)):"string"==typeof e||"object"==typeof e&&("string"==typeof e.path||"string"==typeof e.name)?(o(Lc(a,t)),"object"==typeof e&&e.replace?r.replace(e):r.push(e)):n(e)}))}catch(t){o(t)}};Mc(v,h,(function(){Mc(function(t){return Kc(t,"beforeRouteEnter",(function(t,e,n,r){return function(t,e,n){return function(r,a,s){return t(r,a,(function(t){"function"==typeof t&&(e.enteredCbs[n]||(e.enteredCbs[n]=[]),e.enteredCbs[n].push(t)),s(t)}))}}(t,n,r)}))}(p).concat(r.router.resolveHooks),h,(function(){if(r.pending!==t)return o(Fc(a,t));r.pending=null,e(t),r.router.app&&r.router.app.$nextTick((function(){Do(t)}))}))}))},Jc.prototype.updateRoute=function(t){this.current=t,this.cb&&this.cb(t)},Jc.prototype.setupListeners=function(){},Jc.prototype.teardown=function(){this.listeners.forEach((function(t){t()})),this.listeners=[],this.current=To,this.pending=null};var Yc=function(t){function e(e,n){t.call(this,e,n),this._startLocation=Qc(this.base)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.setupListeners=function(){var t=this;if(!(this.listeners.length>0)){var e=this.router,n=e.options.scrollBehavior,r=Nc&&n;r&&this.listeners.push(xc());var a=function(){var n=t.current,a=Qc(t.base);t.current===To&&a===t._startLocation||t.transitionTo(a,(function(t){r&&$c(e,t,n,!0)}))};window.addEventListener("popstate",a),this.listeners.push((function(){window.removeEventListener("popstate",a)}))}},e.prototype.go=function(t){window.history.go(t)},e.prototype.push=function(t,e,n){var r=this,a=this.current;this.transitionTo(t,(function(t){Bc(Fo(r.base+t.fullPath)),$c(r.router,t,a,!1),e&&e(t)}),n)},e.prototype.replace=function(t,e,n){var r=this,a=this.current;this.transitionTo(t,(function(t){Dc(Fo(r.base+t.fullPath)),$c(r.router,t,a,!1),e&&e(t)}),n)},e.prototype.ensureURL=function(t){if(Qc(this.base)!==this.current.fullPath){var e=Fo(this.base+this.current.fullPath);t?Bc(e):Dc(e)}},e.prototype.getCurrentLocation=function(){return Qc(this.base)},e}(Jc);function Qc(t){var e=window.location.pathname,n=e.toLowerCase(),r=t.toLowerCase();return!t||n!==r&&0!==n.indexOf(Fo(r+"/"))||(e=e.slice(t.length)),(e||"/")+window.location.search+window.location.hash}var tl=function(t){function e(e,n,r){t.call(this,e,n),r&&function(t){var e=Qc(t);if(!/^\/#/.test(e))return window.location.replace(Fo(t+"/#"+e)),!0}(this.base)||el()}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.setupListeners=function(){var t=this;if(!(this.listeners.length>0)){var e=this.router.options.scrollBehavior,n=Nc&&e;n&&this.listeners.push(xc());var r=function(){var e=t.current;el()&&t.transitionTo(nl(),(function(r){n&&$c(t.router,r,e,!0),Nc||sl(r.fullPath)}))},a=Nc?"popstate":"hashchange";window.addEventListener(a,r),this.listeners.push((function(){window.removeEventListener(a,r)}))}},e.prototype.push=function(t,e,n){var r=this,a=this.current;this.transitionTo(t,(function(t){al(t.fullPath),$c(r.router,t,a,!1),e&&e(t)}),n)},e.prototype.replace=function(t,e,n){var r=this,a=this.current;this.transitionTo(t,(function(t){sl(t.fullPath),$c(r.router,t,a,!1),e&&e(t)}),n)},e.prototype.go=function(t){window.history.go(t)},e.prototype.ensureURL=function(t){var e=this.current.fullPath;nl()!==e&&(t?al(e):sl(e))},e.prototype.getCurrentLocation=function(){return nl()},e}(Jc);function el(){var t=nl();return"/"===t.charAt(0)||(sl("/"+t),!1)}function nl(){var t=window.location.href,e=t.indexOf("#");return e<0?"":t=t.slice(e+1)}function rl(t){var e=window.location.href,n=e.indexOf("#");return(n>=0?e.slice(0,n):e)+"#"+t}function al(t){Nc?Bc(rl(t)):window.location.hash=t}function sl(t){Nc?Dc(rl(t)):window.location.replace(rl(t))}var il=function(t){function e(e,n){t.call(this,e,n),this.stack=[],this.index=-1}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.push=function(t,e,n){var r=this;this.transitionTo(t,(function(t){r.stack=r.stack.slice(0,r.index+1).concat(t),r.index++,e&&e(t)}),n)},e.prototype.replace=function(t,e,n){var r=this;this.transitionTo(t,(function(t){r.stack=r.stack.slice(0,r.index).concat(t),e&&e(t)}),n)},e.prototype.go=function(t){var e=this,n=this.index+t;if(!(n<0||n>=this.stack.length)){var r=this.stack[n];this.confirmTransition(r,(function(){var t=e.current;e.index=n,e.updateRoute(r),e.router.afterHooks.forEach((function(e){e&&e(r,t)}))}),(function(t){Zc(t,Rc.duplicated)&&(e.index=n)}))}},e.prototype.getCurrentLocation=function(){var t=this.stack[this.stack.length-1];return t?t.fullPath:"/"},e.prototype.ensureURL=function(){},e}(Jc),ol=function(t){void 0===t&&(t={}),this.app=null,this.apps=[],this.options=t,this.beforeHooks=[],this.resolveHooks=[],this.afterHooks=[],this.matcher=hc(t.routes||[],this);var e=t.mode||"hash";switch(this.fallback="history"===e&&!Nc&&!1!==t.fallback,this.fallback&&(e="hash"),dc||(e="abstract"),this.mode=e,e){case"history":this.history=new Yc(this,t.base);break;case"hash":this.history=new tl(this,t.base,this.fallback);break;case"abstract":this.history=new il(this,t.base);break;default:0}},cl={currentRoute:{configurable:!0}};function ll(t,e){return t.push(e),function(){var n=t.indexOf(e);n>-1&&t.splice(n,1)}}ol.prototype.match=function(t,e,n){return this.matcher.match(t,e,n)},cl.currentRoute.get=function(){return this.history&&this.history.current},ol.prototype.init=function(t){var e=this;if(this.apps.push(t),t.$once("hook:destroyed",(function(){var n=e.apps.indexOf(t);n>-1&&e.apps.splice(n,1),e.app===t&&(e.app=e.apps[0]||null),e.app||e.history.teardown()})),!this.app){this.app=t;var n=this.history;if(n instanceof Yc||n instanceof tl){var r=function(t){n.setupListeners(),function(t){var r=n.current,a=e.options.scrollBehavior;Nc&&a&&"fullPath"in t&&$c(e,t,r,!1)}(t)};n.transitionTo(n.getCurrentLocation(),r,r)}n.listen((function(t){e.apps.forEach((function(e){e._route=t}))}))}},ol.prototype.beforeEach=function(t){return ll(this.beforeHooks,t)},ol.prototype.beforeResolve=function(t){return ll(this.resolveHooks,t)},ol.prototype.afterEach=function(t){return ll(this.afterHooks,t)},ol.prototype.onReady=function(t,e){this.history.onReady(t,e)},ol.prototype.onError=function(t){this.history.onError(t)},ol.prototype.push=function(t,e,n){var r=this;if(!e&&!n&&"undefined"!=typeof Promise)return new Promise((function(e,n){r.history.push(t,e,n)}));this.history.push(t,e,n)},ol.prototype.replace=function(t,e,n){var r=this;if(!e&&!n&&"undefined"!=typeof Promise)return new Promise((function(e,n){r.history.replace(t,e,n)}));this.history.replace(t,e,n)},ol.prototype.go=function(t){this.history.go(t)},ol.prototype.back=function(){this.go(-1)},ol.prototype.forward=function(){this.go(1)},ol.prototype.getMatchedComponents=function(t){var e=t?t.matched?t:this.resolve(t).route:this.currentRoute;return e?[].concat.apply([],e.matched.map((function(t){return Object.keys(t.components).map((function(e){return t.components[e]}))}))):[]},ol.prototype.resolve=function(t,e,n){var r=sc(t,e=e||this.history.current,n,this),a=this.match(r,e),s=a.redirectedFrom||a.fullPath;return{location:r,route:a,href:function(t,e,n){var r="hash"===n?"#"+e:e;return t?Fo(t+"/"+r):r}(this.history.base,s,this.mode),normalizedTo:r,resolved:a}},ol.prototype.getRoutes=function(){return this.matcher.getRoutes()},ol.prototype.addRoute=function(t,e){this.matcher.addRoute(t,e),this.history.current!==To&&this.history.transitionTo(this.history.getCurrentLocation())},ol.prototype.addRoutes=function(t){this.matcher.addRoutes(t),this.history.current!==To&&this.history.transitionTo(this.history.getCurrentLocation())},Object.defineProperties(ol.prototype,cl),ol.install=function t(e){if(!t.installed||ic!==e){t.installed=!0,ic=e;var n=function(t){return void 0!==t},r=function(t,e){var r=t.$options._parentVnode;n(r)&&n(r=r.data)&&n(r=r.registerRouteInstance)&&r(t,e)};e.mixin({beforeCreate:function(){n(this.$options.router)?(this._routerRoot=this,this._router=this.$options.router,this._router.init(this),e.util.defineReactive(this,"_route",this._router.history.current)):this._routerRoot=this.$parent&&this.$parent._routerRoot||this,r(this,this)},destroyed:function(){r(this)}}),Object.defineProperty(e.prototype,"$router",{get:function(){return this._routerRoot._router}}),Object.defineProperty(e.prototype,"$route",{get:function(){return this._routerRoot._route}}),e.component("RouterView",Mo),e.component("RouterLink",cc);var a=e.config.optionMergeStrategies;a.beforeRouteEnter=a.beforeRouteLeave=a.beforeRouteUpdate=a.created}},ol.version="3.5.2",ol.isNavigationFailure=Zc,ol.NavigationFailureType=Rc,ol.START_LOCATION=To,dc&&window.Vue&&window.Vue.use(ol);const ul=ol;var dl=n(7398),fl=n.n(dl),pl=n(3379),vl=n.n(pl),hl=n(6369),ml={insert:"head",singleton:!1};vl()(hl.Z,ml);hl.Z.locals;var gl=[{path:"/",name:"dashboard",component:n(7479).Z,meta:{title:"Dashboard Home"}},{path:"/websites",name:"websites",component:n(8629).Z,meta:{title:"Manage Websites"}},{path:"/websites/:id",name:"website",component:n(8959).Z,meta:{title:"Manage Website"}},{path:"/websites/:id/files",name:"filemanager",component:n(162).Z,meta:{title:"Manage Website Files"}},{path:"/deploy-website",name:"deploysite",component:n(1001).Z,meta:{title:"Create Website"}},{path:"/databases",name:"databases",component:n(3798).Z,meta:{title:"Manage Databases"}},{path:"/databases/:id",name:"database",component:n(9263).Z,meta:{title:"Manage Database"}},{path:"/create-database",name:"createdb",component:n(2858).Z,meta:{title:"Create Database"}},{path:"/users",name:"users",component:n(1220).Z,meta:{title:"Manage Users"}},{path:"/users/:id",name:"user",component:n(5002).Z,meta:{title:"Manage User"}},{path:"/create-user",name:"createuser",component:n(5650).Z,meta:{title:"Create User"}},{path:"/harware-info",name:"hardware",component:n(9062).Z,meta:{title:"Hardware Info"}}];var _l=("undefined"!=typeof window?window:void 0!==n.g?n.g:{}).__VUE_DEVTOOLS_GLOBAL_HOOK__;function yl(t,e){if(void 0===e&&(e=[]),null===t||"object"!=typeof t)return t;var n,r=(n=function(e){return e.original===t},e.filter(n)[0]);if(r)return r.copy;var a=Array.isArray(t)?[]:{};return e.push({original:t,copy:a}),Object.keys(t).forEach((function(n){a[n]=yl(t[n],e)})),a}function bl(t,e){Object.keys(t).forEach((function(n){return e(t[n],n)}))}function wl(t){return null!==t&&"object"==typeof t}var Cl=function(t,e){this.runtime=e,this._children=Object.create(null),this._rawModule=t;var n=t.state;this.state=("function"==typeof n?n():n)||{}},xl={namespaced:{configurable:!0}};xl.namespaced.get=function(){return!!this._rawModule.namespaced},Cl.prototype.addChild=function(t,e){this._children[t]=e},Cl.prototype.removeChild=function(t){delete this._children[t]},Cl.prototype.getChild=function(t){return this._children[t]},Cl.prototype.hasChild=function(t){return t in this._children},Cl.prototype.update=function(t){this._rawModule.namespaced=t.namespaced,t.actions&&(this._rawModule.actions=t.actions),t.mutations&&(this._rawModule.mutations=t.mutations),t.getters&&(this._rawModule.getters=t.getters)},Cl.prototype.forEachChild=function(t){bl(this._children,t)},Cl.prototype.forEachGetter=function(t){this._rawModule.getters&&bl(this._rawModule.getters,t)},Cl.prototype.forEachAction=function(t){this._rawModule.actions&&bl(this._rawModule.actions,t)},Cl.prototype.forEachMutation=function(t){this._rawModule.mutations&&bl(this._rawModule.mutations,t)},Object.defineProperties(Cl.prototype,xl);var $l=function(t){this.register([],t,!1)};function kl(t,e,n){if(e.update(n),n.modules)for(var r in n.modules){if(!e.getChild(r))return void 0;kl(t.concat(r),e.getChild(r),n.modules[r])}}$l.prototype.get=function(t){return t.reduce((function(t,e){return t.getChild(e)}),this.root)},$l.prototype.getNamespace=function(t){var e=this.root;return t.reduce((function(t,n){return t+((e=e.getChild(n)).namespaced?n+"/":"")}),"")},$l.prototype.update=function(t){kl([],this.root,t)},$l.prototype.register=function(t,e,n){var r=this;void 0===n&&(n=!0);var a=new Cl(e,n);0===t.length?this.root=a:this.get(t.slice(0,-1)).addChild(t[t.length-1],a);e.modules&&bl(e.modules,(function(e,a){r.register(t.concat(a),e,n)}))},$l.prototype.unregister=function(t){var e=this.get(t.slice(0,-1)),n=t[t.length-1],r=e.getChild(n);r&&r.runtime&&e.removeChild(n)},$l.prototype.isRegistered=function(t){var e=this.get(t.slice(0,-1)),n=t[t.length-1];return!!e&&e.hasChild(n)};var Sl;var Al=function(t){var e=this;void 0===t&&(t={}),!Sl&&"undefined"!=typeof window&&window.Vue&&Dl(window.Vue);var n=t.plugins;void 0===n&&(n=[]);var r=t.strict;void 0===r&&(r=!1),this._committing=!1,this._actions=Object.create(null),this._actionSubscribers=[],this._mutations=Object.create(null),this._wrappedGetters=Object.create(null),this._modules=new $l(t),this._modulesNamespaceMap=Object.create(null),this._subscribers=[],this._watcherVM=new Sl,this._makeLocalGettersCache=Object.create(null);var a=this,s=this.dispatch,i=this.commit;this.dispatch=function(t,e){return s.call(a,t,e)},this.commit=function(t,e,n){return i.call(a,t,e,n)},this.strict=r;var o=this._modules.root.state;jl(this,o,[],this._modules.root),Pl(this,o),n.forEach((function(t){return t(e)})),(void 0!==t.devtools?t.devtools:Sl.config.devtools)&&function(t){_l&&(t._devtoolHook=_l,_l.emit("vuex:init",t),_l.on("vuex:travel-to-state",(function(e){t.replaceState(e)})),t.subscribe((function(t,e){_l.emit("vuex:mutation",t,e)}),{prepend:!0}),t.subscribeAction((function(t,e){_l.emit("vuex:action",t,e)}),{prepend:!0}))}(this)},Ol={state:{configurable:!0}};function El(t,e,n){return e.indexOf(t)<0&&(n&&n.prepend?e.unshift(t):e.push(t)),function(){var n=e.indexOf(t);n>-1&&e.splice(n,1)}}function Tl(t,e){t._actions=Object.create(null),t._mutations=Object.create(null),t._wrappedGetters=Object.create(null),t._modulesNamespaceMap=Object.create(null);var n=t.state;jl(t,n,[],t._modules.root,!0),Pl(t,n,e)}function Pl(t,e,n){var r=t._vm;t.getters={},t._makeLocalGettersCache=Object.create(null);var a=t._wrappedGetters,s={};bl(a,(function(e,n){s[n]=function(t,e){return function(){return t(e)}}(e,t),Object.defineProperty(t.getters,n,{get:function(){return t._vm[n]},enumerable:!0})}));var i=Sl.config.silent;Sl.config.silent=!0,t._vm=new Sl({data:{$$state:e},computed:s}),Sl.config.silent=i,t.strict&&function(t){t._vm.$watch((function(){return this._data.$$state}),(function(){0}),{deep:!0,sync:!0})}(t),r&&(n&&t._withCommit((function(){r._data.$$state=null})),Sl.nextTick((function(){return r.$destroy()})))}function jl(t,e,n,r,a){var s=!n.length,i=t._modules.getNamespace(n);if(r.namespaced&&(t._modulesNamespaceMap[i],t._modulesNamespaceMap[i]=r),!s&&!a){var o=Nl(e,n.slice(0,-1)),c=n[n.length-1];t._withCommit((function(){Sl.set(o,c,r.state)}))}var l=r.context=function(t,e,n){var r=""===e,a={dispatch:r?t.dispatch:function(n,r,a){var s=Bl(n,r,a),i=s.payload,o=s.options,c=s.type;return o&&o.root||(c=e+c),t.dispatch(c,i)},commit:r?t.commit:function(n,r,a){var s=Bl(n,r,a),i=s.payload,o=s.options,c=s.type;o&&o.root||(c=e+c),t.commit(c,i,o)}};return Object.defineProperties(a,{getters:{get:r?function(){return t.getters}:function(){return function(t,e){if(!t._makeLocalGettersCache[e]){var n={},r=e.length;Object.keys(t.getters).forEach((function(a){if(a.slice(0,r)===e){var s=a.slice(r);Object.defineProperty(n,s,{get:function(){return t.getters[a]},enumerable:!0})}})),t._makeLocalGettersCache[e]=n}return t._makeLocalGettersCache[e]}(t,e)}},state:{get:function(){return Nl(t.state,n)}}}),a}(t,i,n);r.forEachMutation((function(e,n){!function(t,e,n,r){(t._mutations[e]||(t._mutations[e]=[])).push((function(e){n.call(t,r.state,e)}))}(t,i+n,e,l)})),r.forEachAction((function(e,n){var r=e.root?n:i+n,a=e.handler||e;!function(t,e,n,r){(t._actions[e]||(t._actions[e]=[])).push((function(e){var a,s=n.call(t,{dispatch:r.dispatch,commit:r.commit,getters:r.getters,state:r.state,rootGetters:t.getters,rootState:t.state},e);return(a=s)&&"function"==typeof a.then||(s=Promise.resolve(s)),t._devtoolHook?s.catch((function(e){throw t._devtoolHook.emit("vuex:error",e),e})):s}))}(t,r,a,l)})),r.forEachGetter((function(e,n){!function(t,e,n,r){if(t._wrappedGetters[e])return void 0;t._wrappedGetters[e]=function(t){return n(r.state,r.getters,t.state,t.getters)}}(t,i+n,e,l)})),r.forEachChild((function(r,s){jl(t,e,n.concat(s),r,a)}))}function Nl(t,e){return e.reduce((function(t,e){return t[e]}),t)}function Bl(t,e,n){return wl(t)&&t.type&&(n=e,e=t,t=t.type),{type:t,payload:e,options:n}}function Dl(t){Sl&&t===Sl||function(t){if(Number(t.version.split(".")[0])>=2)t.mixin({beforeCreate:n});else{var e=t.prototype._init;t.prototype._init=function(t){void 0===t&&(t={}),t.init=t.init?[n].concat(t.init):n,e.call(this,t)}}function n(){var t=this.$options;t.store?this.$store="function"==typeof t.store?t.store():t.store:t.parent&&t.parent.$store&&(this.$store=t.parent.$store)}}(Sl=t)}Ol.state.get=function(){return this._vm._data.$$state},Ol.state.set=function(t){0},Al.prototype.commit=function(t,e,n){var r=this,a=Bl(t,e,n),s=a.type,i=a.payload,o=(a.options,{type:s,payload:i}),c=this._mutations[s];c&&(this._withCommit((function(){c.forEach((function(t){t(i)}))})),this._subscribers.slice().forEach((function(t){return t(o,r.state)})))},Al.prototype.dispatch=function(t,e){var n=this,r=Bl(t,e),a=r.type,s=r.payload,i={type:a,payload:s},o=this._actions[a];if(o){try{this._actionSubscribers.slice().filter((function(t){return t.before})).forEach((function(t){return t.before(i,n.state)}))}catch(t){0}var c=o.length>1?Promise.all(o.map((function(t){return t(s)}))):o[0](s);return new Promise((function(t,e){c.then((function(e){try{n._actionSubscribers.filter((function(t){return t.after})).forEach((function(t){return t.after(i,n.state)}))}catch(t){0}t(e)}),(function(t){try{n._actionSubscribers.filter((function(t){return t.error})).forEach((function(e){return e.error(i,n.state,t)}))}catch(t){0}e(t)}))}))}},Al.prototype.subscribe=function(t,e){return El(t,this._subscribers,e)},Al.prototype.subscribeAction=function(t,e){return El("function"==typeof t?{before:t}:t,this._actionSubscribers,e)},Al.prototype.watch=function(t,e,n){var r=this;return this._watcherVM.$watch((function(){return t(r.state,r.getters)}),e,n)},Al.prototype.replaceState=function(t){var e=this;this._withCommit((function(){e._vm._data.$$state=t}))},Al.prototype.registerModule=function(t,e,n){void 0===n&&(n={}),"string"==typeof t&&(t=[t]),this._modules.register(t,e),jl(this,this.state,t,this._modules.get(t),n.preserveState),Pl(this,this.state)},Al.prototype.unregisterModule=function(t){var e=this;"string"==typeof t&&(t=[t]),this._modules.unregister(t),this._withCommit((function(){var n=Nl(e.state,t.slice(0,-1));Sl.delete(n,t[t.length-1])})),Tl(this)},Al.prototype.hasModule=function(t){return"string"==typeof t&&(t=[t]),this._modules.isRegistered(t)},Al.prototype.hotUpdate=function(t){this._modules.update(t),Tl(this,!0)},Al.prototype._withCommit=function(t){var e=this._committing;this._committing=!0,t(),this._committing=e},Object.defineProperties(Al.prototype,Ol);var Ml=Il((function(t,e){var n={};return Ul(e).forEach((function(e){var r=e.key,a=e.val;n[r]=function(){var e=this.$store.state,n=this.$store.getters;if(t){var r=Hl(this.$store,"mapState",t);if(!r)return;e=r.context.state,n=r.context.getters}return"function"==typeof a?a.call(this,e,n):e[a]},n[r].vuex=!0})),n})),Rl=Il((function(t,e){var n={};return Ul(e).forEach((function(e){var r=e.key,a=e.val;n[r]=function(){for(var e=[],n=arguments.length;n--;)e[n]=arguments[n];var r=this.$store.commit;if(t){var s=Hl(this.$store,"mapMutations",t);if(!s)return;r=s.context.commit}return"function"==typeof a?a.apply(this,[r].concat(e)):r.apply(this.$store,[a].concat(e))}})),n})),Ll=Il((function(t,e){var n={};return Ul(e).forEach((function(e){var r=e.key,a=e.val;a=t+a,n[r]=function(){if(!t||Hl(this.$store,"mapGetters",t))return this.$store.getters[a]},n[r].vuex=!0})),n})),Fl=Il((function(t,e){var n={};return Ul(e).forEach((function(e){var r=e.key,a=e.val;n[r]=function(){for(var e=[],n=arguments.length;n--;)e[n]=arguments[n];var r=this.$store.dispatch;if(t){var s=Hl(this.$store,"mapActions",t);if(!s)return;r=s.context.dispatch}return"function"==typeof a?a.apply(this,[r].concat(e)):r.apply(this.$store,[a].concat(e))}})),n}));function Ul(t){return function(t){return Array.isArray(t)||wl(t)}(t)?Array.isArray(t)?t.map((function(t){return{key:t,val:t}})):Object.keys(t).map((function(e){return{key:e,val:t[e]}})):[]}function Il(t){return function(e,n){return"string"!=typeof e?(n=e,e=""):"/"!==e.charAt(e.length-1)&&(e+="/"),t(e,n)}}function Hl(t,e,n){return t._modulesNamespaceMap[n]}function Zl(t,e,n){var r=n?t.groupCollapsed:t.group;try{r.call(t,e)}catch(n){t.log(e)}}function ql(t){try{t.groupEnd()}catch(e){t.log("—— log end ——")}}function Vl(){var t=new Date;return" @ "+Wl(t.getHours(),2)+":"+Wl(t.getMinutes(),2)+":"+Wl(t.getSeconds(),2)+"."+Wl(t.getMilliseconds(),3)}function Wl(t,e){return function(t,e){return new Array(e+1).join(t)}("0",e-t.toString().length)+t}const zl={Store:Al,install:Dl,version:"3.6.2",mapState:Ml,mapMutations:Rl,mapGetters:Ll,mapActions:Fl,createNamespacedHelpers:function(t){return{mapState:Ml.bind(null,t),mapGetters:Ll.bind(null,t),mapMutations:Rl.bind(null,t),mapActions:Fl.bind(null,t)}},createLogger:function(t){void 0===t&&(t={});var e=t.collapsed;void 0===e&&(e=!0);var n=t.filter;void 0===n&&(n=function(t,e,n){return!0});var r=t.transformer;void 0===r&&(r=function(t){return t});var a=t.mutationTransformer;void 0===a&&(a=function(t){return t});var s=t.actionFilter;void 0===s&&(s=function(t,e){return!0});var i=t.actionTransformer;void 0===i&&(i=function(t){return t});var o=t.logMutations;void 0===o&&(o=!0);var c=t.logActions;void 0===c&&(c=!0);var l=t.logger;return void 0===l&&(l=console),function(t){var u=yl(t.state);void 0!==l&&(o&&t.subscribe((function(t,s){var i=yl(s);if(n(t,u,i)){var o=Vl(),c=a(t),d="mutation "+t.type+o;Zl(l,d,e),l.log("%c prev state","color: #9E9E9E; font-weight: bold",r(u)),l.log("%c mutation","color: #03A9F4; font-weight: bold",c),l.log("%c next state","color: #4CAF50; font-weight: bold",r(i)),ql(l)}u=i})),c&&t.subscribeAction((function(t,n){if(s(t,n)){var r=Vl(),a=i(t),o="action "+t.type+r;Zl(l,o,e),l.log("%c action","color: #03A9F4; font-weight: bold",a),ql(l)}})))}}};n(9147),go.use(zl);var Gl=new zl.Store({state:{user:JSON.parse(localStorage.getItem("user")),busy:!1,path:localStorage.getItem("file_path")},mutations:{setPath:function(t,e){t.path=e,localStorage.setItem("file_path",e)},setBusy:function(t,e){t.busy=e},setUser:function(t){axios.defaults.baseURL=BASE_PATH+"api/",axios.get("/account/").then((function(e){localStorage.setItem("user",JSON.stringify(e.data)),t.user=e.data})).catch((function(t){}))},unsetUser:function(t){localStorage.removeItem("user"),t.user=null}}});n(9147),window.axios.defaults.baseURL=BASE_PATH+"api/";var Jl=new go;go.use(ul),go.component("loading",fl()),go.filter("floatformat",(function(t){return parseFloat(t).toFixed(2)})),go.component("v-select",VueSelect.VueSelect),go.component("usercreate-component",n(6116).Z),go.filter("prettyBytes",(function(t){if("number"!=typeof t||isNaN(t))throw new TypeError("Expected a number");var e,n=t<0,r=["B","kB","MB","GB","TB","PB","EB","ZB","YB"];return n&&(t=-t),t<1?(n?"-":"")+t+" B":(e=Math.min(Math.floor(Math.log(t)/Math.log(1024)),r.length-1),(n?"-":"")+(t=1*(t/Math.pow(1024,e)).toFixed(2))+" "+r[e])}));var Kl=new ul({mode:"history",routes:gl,base:BASE_PATH+"dashboard/"});go.mixin({data:function(){return{EventBus:Jl,FM_ROOT,PMA_URL,BASE_PATH}},methods:{genRandPassword:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:15,e="0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz";return Array(t).fill(e).map((function(t){return t[Math.floor(Math.random()*t.length)]})).join("")}}});new go({el:"#wrapper",router:Kl,store:Gl,mounted:function(){this.$store.commit("setUser")},methods:{signOut:function(){var t=this;axios.defaults.baseURL=BASE_PATH+"dashboard/",axios.get("/sign-out/").then((function(e){t.$store.commit("unsetUser"),window.location=""})).catch((function(t){}))}}})})()})();
\ No newline at end of file
@@ -1,5 +1,15 @@
+import os
+from django.conf import settings
+from django.contrib.staticfiles.storage import HashedFilesMixin
 from whitenoise.storage import CompressedManifestStaticFilesStorage
 
 
 class WhiteNoiseStaticFilesStorage(CompressedManifestStaticFilesStorage):
-    manifest_strict = False
\ No newline at end of file
+    manifest_strict = False
+
+    def url(self, name, force=False):
+        """The files replaced in FASTCP_UI_DIR are served under their own names, the hashed names belong to
+        the bundled files."""
+        if settings.FASTCP_UI_DIR and name and os.path.isfile(os.path.join(settings.FASTCP_UI_DIR, name.split('?')[0])):
+            return super(HashedFilesMixin, self).url(name)
+        return super().url(name, force)
@@ -1,6 +1,7 @@
 from django.test import TestCase
 from .models import Website, User
 from .utils.system import setup_wordpress
+from .utils import templates, snippets, timers
 
 # Create your tests here.
 class TestWordPressDeploy(TestCase):
@@ -19,3 +20,53 @@ class TestWordPressDeploy(TestCase):
     def test_wp_deploy(self):
         w = Website.objects.first()
         setup_wordpress(w)
+
+
+class TestSystemTemplates(TestCase):
+    
+    def test_templates_render(self):
+        for name in templates.SYSTEM_TEMPLATES:
+            self.assertNotIn('error', templates.render_preview(name), name)
+    
+    def test_sysctl_output(self):
+        output = templates.render_preview('system/sysctl.txt').get('output')
+        self.assertEqual(output, '# Managed by FastCP. Changes to this file will be overwritten.\nvm.swappiness = 10\n\n')
+
+
+class TestSnippetValidation(TestCase):
+
+    def test_user_directives(self):
+        self.assertIsNone(snippets.validate_content('location /old { return 301 /new; }'))
+        self.assertIsNone(snippets.validate_content('add_header X-Note "a; b }";'))
+        self.assertIsNone(snippets.validate_content('# include { \nexpires 1d;'))
+
+    def test_admin_directives(self):
+        for content in ['include /etc/shadow;', '"include" /etc/shadow;', "'alias' /srv;", 'proxy_store on;',
+                        'location / { fastcgi_param PHP_VALUE x; }', 'types { text/html html; }']:
+            self.assertIsNotNone(snippets.validate_content(content), content)
+        self.assertIsNone(snippets.validate_content('include /etc/nginx/mime.types;', admin=True))
+
+    def test_proxy_targets(self):
+        for content in ['proxy_pass http://unix:/run/php/php8.1-fpm.sock:;', 'proxy_pass http://127.0.0.1:8000;',
+                        'proxy_pass http://10.0.0.5;', 'proxy_pass http://$arg_to;', 'proxy_pass https://example.com;',
+                        'proxy_pass http://[::1]:8000;']:
+            self.assertIsNotNone(snippets.validate_content(content), content)
+        self.assertIsNone(snippets.validate_content('proxy_pass https://93.184.216.34;'))
+
+    def test_syntax(self):
+        for content in ['', 'location / {', '}', 'expires 1d', 'add_header X "open;', '; expires 1d;']:
+            self.assertIsNotNone(snippets.validate_content(content, admin=True), content)
+
+
+class TestCommandValidation(TestCase):
+
+    def test_valid_command(self):
+        self.assertTrue(timers.valid_command('node server.js --port 3000'))
+        for command in ['', 'node server.js\rExecStart=/bin/sh -c id\rUser=root\r#', 'a\nb', 'a\0b', 'a\tb', 'a\x7fb']:
+            self.assertFalse(timers.valid_command(command), repr(command))
+
+    def test_systemd_quote(self):
+        self.assertEqual(timers.systemd_quote('echo "$HOME" 100%'), '"echo \\"$$HOME\\" 100%%"')
+        for command in ['a\rUser=root', 'a\nUser=root', 'a\0User=root']:
+            with self.assertRaises(ValueError):
+                timers.systemd_quote(command)
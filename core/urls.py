@@ -6,5 +6,5 @@ app_name = 'sites'
 urlpatterns = [
     path('sign-in/', views.sign_in, name='login'),
     path('sign-out/', views.sign_out, name='logout'),
-    path('download-file/', views.download_file, name='download')
+    path('debug-error/<slug:slug>/', views.debug_error, name='debug_error')
 ]
\ No newline at end of file
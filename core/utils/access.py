@@ -0,0 +1,102 @@
+import os, re, glob, ipaddress
+from subprocess import run, DEVNULL, STDOUT, TimeoutExpired
+from django.conf import settings
+from django.template.loader import render_to_string
+
+
+# The config that loads the GeoIP2 module of NGINX, added by libnginx-mod-http-geoip2
+MODULE_CONF_GLOB = '/etc/nginx/modules-enabled/*geoip2*.conf'
+PACKAGES = ['libnginx-mod-http-geoip2', 'geoipupdate']
+
+COUNTRY_RE = re.compile(r'^[A-Z]{2}$')
+
+
+def split_rules(value: str) -> list:
+    """Returns the values of a comma or newline separated list."""
+    return [v.strip() for v in re.split(r'[,\s]+', value or '') if v.strip()]
+
+
+def normalize_ips(value: str) -> tuple:
+    """Returns the IPs and the CIDR networks of a list in their canonical form, along with the invalid ones."""
+    ips, invalid = [], []
+    for ip in split_rules(value):
+        try:
+            network = ipaddress.ip_network(ip, strict=False)
+        except ValueError:
+            invalid.append(ip)
+            continue
+        ips.append(str(network.network_address) if network.num_addresses == 1 else str(network))
+    return list(dict.fromkeys(ips)), invalid
+
+
+def normalize_countries(value: str) -> tuple:
+    """Returns the ISO country codes of a list in upper case, along with the invalid ones."""
+    countries = [c.upper() for c in split_rules(value)]
+    return list(dict.fromkeys(c for c in countries if COUNTRY_RE.match(c))), [c for c in countries if not COUNTRY_RE.match(c)]
+
+
+def is_geoip_available() -> bool:
+    """Returns True if the GeoIP2 module of NGINX and the MaxMind country database are installed."""
+    return bool(glob.glob(MODULE_CONF_GLOB)) and os.path.exists(settings.FASTCP_GEOIP_DB)
+
+
+def install_job(job: object, params: dict) -> dict:
+    """Installs the GeoIP2 module of NGINX and geoipupdate, run as a background job. geoipupdate needs the
+    MaxMind account in /etc/GeoIP.conf to download the country database."""
+    env = dict(os.environ, DEBIAN_FRONTEND='noninteractive')
+    try:
+        res = run(['/usr/bin/apt-get', 'install', '-y'] + PACKAGES, stdout=DEVNULL, stderr=STDOUT, env=env, timeout=900)
+    except TimeoutExpired:
+        raise ValueError('The installation of the GeoIP2 module timed out.')
+    if res.returncode != 0:
+        raise ValueError('The GeoIP2 module cannot be installed with apt-get.')
+    if not os.path.exists(settings.FASTCP_GEOIP_DB):
+        try:
+            run(['/usr/bin/geoipupdate'], stdout=DEVNULL, stderr=STDOUT, timeout=300)
+        except (FileNotFoundError, TimeoutExpired):
+            pass
+    return {'available': write_geoip_conf()}
+
+
+def write_geoip_conf() -> bool:
+    """Writes the NGINX config that looks up the country of the visitors, or removes it if the GeoIP2 module
+    or the database is missing, as NGINX wouldn't start with it. Returns True if the countries can be
+    blocked."""
+    if not is_geoip_available():
+        if os.path.exists(settings.FASTCP_GEOIP_CONF):
+            os.remove(settings.FASTCP_GEOIP_CONF)
+        return False
+    with open(settings.FASTCP_GEOIP_CONF, 'w') as f:
+        f.write(render_to_string('system/nginx-geoip2.txt', {'db_path': settings.FASTCP_GEOIP_DB}))
+    return True
+
+
+def access_rules(website: object) -> dict:
+    """Returns the access rules of a website."""
+    return {
+        'allowed_ips': split_rules(website.allowed_ips),
+        'denied_ips': split_rules(website.denied_ips),
+        'blocked_countries': split_rules(website.blocked_countries),
+    }
+
+
+def nginx_access(website: object) -> dict:
+    """NGINX access rules.
+
+    Returns the access rules of a website for its NGINX vhost. The most specific network of the IPs
+    decides, so a denied IP within an allowed network is refused. If IPs are allowed, the rest are
+    refused. The countries are refused unless the IP is allowed explicitly, and only if the GeoIP2
+    module is available.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        dict: The rules and the name of the NGINX variables, or None if the website has no rules.
+    """
+    rules = access_rules(website)
+    if not is_geoip_available():
+        rules['blocked_countries'] = []
+    if not any(rules.values()):
+        return None
+    return {**rules, 'var': f'$fastcp_access_{website.id}'}
@@ -0,0 +1,85 @@
+import os
+from subprocess import run, PIPE, DEVNULL
+from core.utils import filesystem, system
+
+
+def profile_entries(website: object) -> list:
+    """Returns the named ACL entries the profile of a website grants, in the getfacl format.
+
+    The strict profile grants none, the files are only accessible as the permission bits of the owner allow.
+    """
+    if website.acl_profile == 'shared-group' and website.acl_group:
+        return [f'group:{website.acl_group}:rwx', f'default:group:{website.acl_group}:rwx']
+    if website.acl_profile == 'www-data':
+        return ['user:www-data:r-x', 'default:user:www-data:r-x']
+    return []
+
+
+def apply_profile(website: object) -> bool:
+    """Apply ACL profile.
+
+    Removes the extended ACLs of the website files and applies the ACLs of the profile of the website
+    recursively, including the default ACLs so new files inherit them.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    base_path = filesystem.get_website_paths(website).get('base_path')
+    if not system.run_cmd(f'/usr/bin/setfacl -R -b {base_path}'):
+        return False
+
+    entries = profile_entries(website)
+    if not entries:
+        return True
+    # X grants execute on the directories only
+    spec = ','.join(e.replace('r-x', 'rX').replace('rwx', 'rwX') for e in entries)
+    return system.run_cmd(f'/usr/bin/setfacl -R -m {spec} {base_path}')
+
+
+def named_entries(path: str) -> list:
+    """Returns the ACL entries of a path that name a user or a group, i.e. group:devs:rwx."""
+    try:
+        result = run(['/usr/bin/getfacl', '--omit-header', '--absolute-names', path], stdout=PIPE, stderr=DEVNULL, timeout=30)
+    except FileNotFoundError:
+        return []
+    entries = []
+    for line in result.stdout.decode().splitlines():
+        line = line.split('#')[0].strip()
+        parts = line.replace('default:', '', 1).split(':')
+        if len(parts) == 3 and parts[1] and parts[0] in ['user', 'group']:
+            entries.append(line)
+    return sorted(entries)
+
+
+def acl_drift(website: object) -> dict:
+    """ACL drift.
+
+    Compares the ACLs of the website directory and its public directory with the profile of the website,
+    and reports the entries that are missing or that the profile doesn't grant, i.e. added by hand.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        dict: The profile, either the ACLs drifted and the differences per path.
+    """
+    paths = filesystem.get_website_paths(website)
+    expected = sorted(profile_entries(website))
+    differences = {}
+    for path in [paths.get('base_path'), paths.get('web_root')]:
+        if not os.path.isdir(path):
+            continue
+        actual = named_entries(path)
+        missing = [e for e in expected if e not in actual]
+        unexpected = [e for e in actual if e not in expected]
+        if missing or unexpected:
+            differences[path] = {'missing': missing, 'unexpected': unexpected}
+    return {
+        'profile': website.acl_profile,
+        'group': website.acl_group,
+        'drift': bool(differences),
+        'differences': differences
+    }
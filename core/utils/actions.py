@@ -0,0 +1,127 @@
+import re, inspect
+from functools import lru_cache
+from django.urls import get_resolver, URLPattern, URLResolver
+from rest_framework import permissions
+from rest_framework.routers import APIRootView
+
+
+SAFE_METHODS = ['GET', 'HEAD', 'OPTIONS']
+
+# The request params a view reads, i.e. request.data.get('label')
+PARAM_RE = re.compile(r'request\.(?:POST|GET|data|query_params|FILES)\.get\(\s*[\'"](\w+)[\'"]')
+# The URL params, <int:id> in the routes and (?P<pk>[^/.]+) in the router patterns
+ROUTE_PARAM_RE = re.compile(r'<(?:\w+:)?(\w+)>|\(\?P<(\w+)>[^)]*\)')
+
+VIEWSET_ACTIONS = {
+    'list': 'Lists the {name} objects.',
+    'create': 'Creates a {name}.',
+    'retrieve': 'Returns a {name}.',
+    'update': 'Updates a {name}.',
+    'partial_update': 'Updates some fields of a {name}.',
+    'destroy': 'Deletes a {name}.',
+}
+
+
+def _route(pattern: object) -> str:
+    route = str(pattern).lstrip('^').rstrip('$')
+    return ROUTE_PARAM_RE.sub(lambda m: '{' + (m.group(1) or m.group(2)) + '}', route)
+
+
+def _walk(resolver: URLResolver, prefix: str = '', namespace: str = None):
+    """Yields the URL patterns along with their full paths and their namespaced names."""
+    for pattern in resolver.url_patterns:
+        path = prefix + _route(pattern.pattern)
+        if isinstance(pattern, URLResolver):
+            ns = pattern.namespace
+            if ns and namespace:
+                ns = f'{namespace}:{ns}'
+            yield from _walk(pattern, path, ns or namespace)
+        elif isinstance(pattern, URLPattern):
+            yield pattern, path, f'{namespace}:{pattern.name}' if namespace else pattern.name
+
+
+def _description(obj: object) -> str:
+    """Returns the first paragraph of a docstring after its title."""
+    paragraphs = [' '.join(p.split()) for p in (inspect.getdoc(obj) or '').split('\n\n') if p.strip()]
+    if len(paragraphs) > 1 and len(paragraphs[0].split()) <= 4:
+        return paragraphs[1]
+    return paragraphs[0] if paragraphs else None
+
+
+def _params(handler: object, view_class: object, action: str) -> list:
+    """Returns the params an action accepts, read from the source of its handler. The model viewsets
+    and the views with a serializer take its writable fields instead."""
+    if action in ['create', 'update', 'partial_update', 'post', 'put', 'patch'] and getattr(view_class, 'serializer_class', None):
+        fields = view_class.serializer_class().fields
+        return [
+            {'name': name, 'required': field.required and action not in ['partial_update', 'patch']}
+            for name, field in fields.items() if not field.read_only
+        ]
+    try:
+        source = inspect.getsource(handler)
+    except (OSError, TypeError):
+        return []
+    names = list(dict.fromkeys(PARAM_RE.findall(source)))
+    return [{'name': name, 'required': False} for name in names]
+
+
+def _admin_only(view_class: object) -> bool:
+    return any(
+        perm is permissions.IsAdminUser or (inspect.isclass(perm) and issubclass(perm, permissions.IsAdminUser))
+        for perm in getattr(view_class, 'permission_classes', [])
+    )
+
+
+@lru_cache(maxsize=1)
+def catalog() -> tuple:
+    """Actions catalog.
+
+    Builds the list of the API actions from the URL patterns, one for each path and method, along with the
+    params they accept and if they are limited to the admins. The URLs don't change while the panel runs,
+    so the catalog is built once.
+
+    Returns:
+        tuple: The actions.
+    """
+    actions = []
+    for pattern, path, name in _walk(get_resolver()):
+        view_class = getattr(pattern.callback, 'cls', None)
+        if not name or not name.startswith('api:') or not view_class or view_class is APIRootView:
+            continue
+        # The format suffix patterns of the routers duplicate the plain ones
+        if '{format}' in path:
+            continue
+        name = name[len('api:'):]
+        url_params = [m.group(1) or m.group(2) for m in ROUTE_PARAM_RE.finditer(str(pattern.pattern))]
+        admin_only = _admin_only(view_class)
+
+        # The viewsets map the methods to their actions, the API views handle them directly
+        methods = getattr(pattern.callback, 'actions', None)
+        if methods is None:
+            allowed = [m for m in getattr(view_class, 'http_method_names', []) if hasattr(view_class, m)]
+            methods = {m: m for m in allowed if m not in ['head', 'options']}
+
+        for method, action in methods.items():
+            handler = getattr(view_class, action, None)
+            if not handler:
+                continue
+            description = _description(handler)
+            if not description and action in VIEWSET_ACTIONS:
+                model = getattr(getattr(view_class, 'queryset', None), 'model', None)
+                description = VIEWSET_ACTIONS.get(action).format(name=model._meta.verbose_name if model else 'record')
+            actions.append({
+                'name': f'{name}:{action}',
+                'method': method.upper(),
+                'path': f'/{path}',
+                'url_params': url_params,
+                'params': _params(handler, view_class, action),
+                'changes': method.upper() not in SAFE_METHODS,
+                'admin_only': admin_only,
+                'description': description or _description(view_class),
+            })
+    return tuple(actions)
+
+
+def available_actions(user: object) -> list:
+    """Returns the actions of the catalog the user is allowed to run."""
+    return [action for action in catalog() if user.is_superuser or not action.get('admin_only')]
@@ -0,0 +1,121 @@
+import re, csv, uuid, logging, threading
+from django.utils.dateparse import parse_datetime, parse_date
+from core.models import AuditEntry
+
+
+# The request IDs set by a proxy in front of the panel are kept if they look sane
+REQUEST_ID_RE = re.compile(r'^[\w.:-]{1,64}$')
+
+EXPORT_FIELDS = ['id', 'created', 'username', 'ip', 'source', 'method', 'path', 'action', 'target', 'status', 'result', 'request_id']
+
+# The ID of the request the current thread is serving, the jobs started by the request are tagged with it
+_local = threading.local()
+
+logger = logging.getLogger('fastcp.audit')
+
+
+def request_id_for(request: object) -> str:
+    """Returns the X-Request-ID of a request, or a new ID if it has none. ProxyHeadersMiddleware drops the
+    header unless a trusted proxy sent it."""
+    request_id = request.META.get('HTTP_X_REQUEST_ID', '')
+    return request_id if REQUEST_ID_RE.match(request_id) else uuid.uuid4().hex
+
+
+def set_request_id(request_id: str) -> None:
+    _local.request_id = request_id
+
+
+def current_request_id() -> str:
+    return getattr(_local, 'request_id', None)
+
+
+def record(**fields) -> object:
+    """Saves an audit entry. A failing write is logged and doesn't fail the request or the job."""
+    try:
+        return AuditEntry.objects.create(**fields)
+    except Exception as e:
+        logger.error(f'Audit entry of {fields.get("action")} by {fields.get("username")} cannot be saved: {e}')
+        return None
+
+
+def record_request(request: object, response: object, match: object = None) -> object:
+    """Records an API call that changed something, along with the response status."""
+    match = match or getattr(request, 'resolver_match', None)
+    user = request.user
+    return record(
+        user=user,
+        username=user.username,
+        ip=request.META.get('REMOTE_ADDR') or None,
+        source='api',
+        method=request.method,
+        path=request.path[:255],
+        action=(match.view_name if match else request.path)[:150],
+        target=', '.join(f'{k}={v}' for k, v in match.kwargs.items())[:255] if match and match.kwargs else None,
+        status=response.status_code,
+        result='success' if response.status_code < 400 else 'failure',
+        request_id=getattr(request, 'request_id', None) or current_request_id() or uuid.uuid4().hex
+    )
+
+
+def record_job(job: object, request_id: str = None) -> object:
+    """Records a finished background job, tagged with the ID of the request that started it."""
+    return record(
+        user=job.user,
+        username=job.user.username if job.user else '-',
+        source='job',
+        action=f'job:{job.kind}',
+        target=job.target,
+        result='success' if job.state == 'done' else 'failure',
+        request_id=request_id or uuid.uuid4().hex
+    )
+
+
+def filter_entries(params: dict) -> object:
+    """Returns the audit entries matching the query params, newest first.
+
+    Args:
+        params (dict): The filters, any of user, source, action, result, request_id, since and until.
+            The action matches partly, since and until are datetimes or dates.
+
+    Returns:
+        object: The entries queryset.
+    """
+    entries = AuditEntry.objects.all()
+    if params.get('user'):
+        entries = entries.filter(username=params.get('user'))
+    if params.get('source'):
+        entries = entries.filter(source=params.get('source'))
+    if params.get('action'):
+        entries = entries.filter(action__icontains=params.get('action'))
+    if params.get('result'):
+        entries = entries.filter(result=params.get('result'))
+    if params.get('request_id'):
+        entries = entries.filter(request_id=params.get('request_id'))
+    for key, lookup in [('since', 'created__gte'), ('until', 'created__lte')]:
+        value = params.get(key)
+        if not value:
+            continue
+        moment = parse_datetime(value)
+        if moment:
+            entries = entries.filter(**{lookup: moment})
+        elif parse_date(value):
+            entries = entries.filter(**{lookup.replace('created', 'created__date'): parse_date(value)})
+    return entries.order_by('-id')
+
+
+def serialize_entry(entry: object) -> dict:
+    return {field: getattr(entry, field) for field in EXPORT_FIELDS}
+
+
+class _Echo:
+    """Hands the rows the csv writer writes back, so a CSV can be streamed."""
+    def write(self, value):
+        return value
+
+
+def csv_rows(entries: object):
+    """Yields the lines of the CSV export of the audit entries."""
+    writer = csv.writer(_Echo())
+    yield writer.writerow(EXPORT_FIELDS)
+    for entry in entries.iterator():
+        yield writer.writerow([getattr(entry, field) for field in EXPORT_FIELDS])
@@ -0,0 +1,97 @@
+import os, re, glob, shutil, hashlib
+import bcrypt
+from django.conf import settings
+
+
+PATH_RE = re.compile(r'^/[A-Za-z0-9._~/-]*$')
+USERNAME_RE = re.compile(r'^[A-Za-z0-9._@-]{1,50}$')
+REALM = 'Restricted'
+
+
+def hash_password(password: str) -> str:
+    """Returns the bcrypt hash of a password, which NGINX checks with crypt(3)."""
+    return bcrypt.hashpw(password.encode(), bcrypt.gensalt(rounds=10)).decode()
+
+
+def normalize_path(path: str) -> str:
+    """Returns a protected path without the trailing slash, None if it's not a valid URL path."""
+    path = (path or '').strip()
+    if not PATH_RE.match(path) or '//' in path or '/../' in f'{path}/':
+        return None
+    return path.rstrip('/') or '/'
+
+
+def users_file(website: object, path: str) -> str:
+    """Returns the users file of a protected path of a website."""
+    digest = hashlib.sha1(path.encode()).hexdigest()[:12]
+    return os.path.join(settings.FASTCP_HTPASSWD_DIR, f'{website.slug}_{digest}.htpasswd')
+
+
+def protected_paths(website: object) -> dict:
+    """Returns the credentials of the protected paths of a website keyed by the path."""
+    paths = {}
+    for protected in website.protected_paths.order_by('path', 'username'):
+        paths.setdefault(protected.path, []).append(protected)
+    return paths
+
+
+def write_users_files(website: object) -> None:
+    """Writes the users files of the protected paths of a website and removes the ones of the paths that
+    aren't protected anymore. The vhosts should be written again afterwards."""
+    os.makedirs(settings.FASTCP_HTPASSWD_DIR, mode=0o755, exist_ok=True)
+    current = []
+    for path, credentials in protected_paths(website).items():
+        file_path = users_file(website, path)
+        with open(file_path, 'w') as f:
+            f.writelines(f'{c.username}:{c.password_hash}\n' for c in credentials)
+        # The workers of NGINX read the users file on each request
+        os.chmod(file_path, 0o640)
+        try:
+            shutil.chown(file_path, 'root', 'www-data')
+        except (LookupError, PermissionError):
+            pass
+        current.append(file_path)
+    for file_path in website_users_files(website):
+        if file_path not in current:
+            os.remove(file_path)
+
+
+def website_users_files(website: object) -> list:
+    """Returns the users files of a website. The glob is narrowed to the exact name users_file() builds
+    so that the files of a website whose slug starts with this one are left alone."""
+    name = re.compile(rf'^{re.escape(website.slug)}_[0-9a-f]{{12}}\.htpasswd$')
+    return [
+        file_path for file_path in glob.glob(os.path.join(settings.FASTCP_HTPASSWD_DIR, f'{glob.escape(website.slug)}_*.htpasswd'))
+        if name.match(os.path.basename(file_path))
+    ]
+
+
+def delete_users_files(website: object) -> None:
+    for file_path in website_users_files(website):
+        os.remove(file_path)
+
+
+def nginx_basic_auth(website: object) -> dict:
+    """NGINX basic auth.
+
+    Returns the protected paths of a website for its NGINX vhost. The realm and the users file are picked
+    by the request URI with a map, so the locations of the backend stay the same. The longest path is
+    matched first and the ACME challenges are never protected.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        dict: The name of the NGINX variables and the paths, or None if no path is protected.
+    """
+    paths = sorted(protected_paths(website), key=len, reverse=True)
+    if not paths:
+        return None
+    return {
+        'var': f'$fastcp_auth_{website.id}',
+        'realm': REALM,
+        'paths': [{
+            'regex': '^' + re.escape(path.rstrip('/')) + '(/|$)',
+            'users_file': users_file(website, path)
+        } for path in paths]
+    }
@@ -0,0 +1,82 @@
+import os, requests
+from urllib.parse import urlsplit
+from django.conf import settings
+from django.core.mail import send_mail
+from django.utils import timezone
+
+
+def _message(username: str, password: str) -> tuple:
+    title = f'{settings.FASTCP_SITE_NAME} admin credentials'
+    details = f'Username: {username}\nPassword: {password}\nServer: {settings.SERVER_IP_ADDR}'
+    return title, details
+
+
+def send_email(address: str, username: str, password: str) -> bool:
+    title, details = _message(username, password)
+    try:
+        send_mail(title, details, None, [address])
+    except Exception:
+        return False
+    return True
+
+
+def post_webhook(url: str, username: str, password: str) -> bool:
+    """Posts the credentials to a webhook. Only HTTPS webhooks are used, and redirects aren't followed, so
+    the password isn't sent in plain text."""
+    if urlsplit(url).scheme != 'https':
+        return False
+    title, details = _message(username, password)
+    try:
+        res = requests.post(url, json={
+            'site': settings.FASTCP_SITE_NAME,
+            'event': 'credentials',
+            'title': title,
+            'username': username,
+            'password': password,
+            'server': settings.SERVER_IP_ADDR,
+            'time': timezone.now().isoformat()
+        }, timeout=10, allow_redirects=False)
+    except requests.RequestException:
+        return False
+    return res.status_code < 300
+
+
+def save_file(username: str, password: str) -> str:
+    """Saves the credentials to the root only credentials file and returns its path."""
+    path = settings.FASTCP_CREDENTIALS_FILE
+    title, details = _message(username, password)
+    fd = os.open(path, os.O_WRONLY | os.O_CREAT | os.O_TRUNC, 0o600)
+    with os.fdopen(fd, 'w') as f:
+        f.write(f'{title}\n{details}\n')
+    os.chmod(path, 0o600)
+    return path
+
+
+def deliver(username: str, password: str, email: str = None, webhook: str = None) -> dict:
+    """Deliver credentials.
+
+    Sends the credentials to an email address and posts them to a webhook, so they aren't lost in the
+    output of a service. The credentials file keeps them if no channel is set or all of them fail, and
+    it's removed once they are delivered.
+
+    Args:
+        username (str): The username.
+        password (str): The plain text password.
+        email (str): The email address, FASTCP_CREDENTIALS_EMAIL if None.
+        webhook (str): The webhook URL, FASTCP_CREDENTIALS_WEBHOOK if None.
+
+    Returns:
+        dict: Either each channel delivered the credentials, and the credentials file if it was written.
+    """
+    email = email or settings.FASTCP_CREDENTIALS_EMAIL
+    webhook = webhook or settings.FASTCP_CREDENTIALS_WEBHOOK
+    result = {}
+    if email:
+        result['email'] = send_email(email, username, password)
+    if webhook:
+        result['webhook'] = post_webhook(webhook, username, password)
+    if not any(result.values()):
+        result['file'] = save_file(username, password)
+    elif os.path.exists(settings.FASTCP_CREDENTIALS_FILE):
+        os.remove(settings.FASTCP_CREDENTIALS_FILE)
+    return result
@@ -0,0 +1,45 @@
+import re, secrets
+from django.conf import settings
+from django.utils import timezone
+
+
+# The posted fields that are never echoed back
+SECRET_FIELDS = re.compile(r'password|secret|token|key', re.I)
+
+MESSAGES = {
+    'POST': 'The changes have been saved.',
+    'PUT': 'The changes have been saved.',
+    'PATCH': 'The changes have been saved.',
+    'DELETE': 'The item has been deleted.',
+}
+
+
+def check_password(password: str) -> bool:
+    """Returns True if the demo mode is on and the password is the demo password."""
+    return settings.FASTCP_DEMO_MODE and secrets.compare_digest(password, settings.FASTCP_DEMO_PASSWORD)
+
+
+def simulated_result(request: object, kwargs: dict) -> dict:
+    """Simulated result.
+
+    Returns what a successful API request would, without running it: the usual success message along with
+    the posted fields, the IDs from the URL and a new ID for created items, so the UI can render the
+    result as if the change was made.
+
+    Args:
+        request (object): The API request.
+        kwargs (dict): The keyword arguments the view was resolved with.
+
+    Returns:
+        dict: The response data.
+    """
+    data = {key: value for key, value in request.POST.items() if not SECRET_FIELDS.search(key)}
+    data.update(kwargs)
+    if request.method == 'POST' and 'id' not in kwargs:
+        data['id'] = secrets.randbelow(90000) + 10000
+    data['created'] = timezone.now()
+    return {
+        'message': MESSAGES.get(request.method),
+        'demo': True,
+        'data': data
+    }
@@ -0,0 +1,209 @@
+import os, json, shutil
+from subprocess import run, PIPE, TimeoutExpired
+from datetime import timedelta
+from django.conf import settings
+from django.db.models import Q
+from django.utils import timezone
+from core.models import Website, User
+from core.utils import jobs
+from core.utils.filesystem import get_website_paths
+from core.utils.notifications import notify_users
+
+
+# The lockfiles audited and the package manager of each
+LOCKFILES = {
+    'composer.lock': 'composer',
+    'package-lock.json': 'npm',
+    'npm-shrinkwrap.json': 'npm',
+}
+
+# The installed packages and the VCS data are never searched for lockfiles
+SKIPPED_DIRS = ['vendor', 'node_modules', '.git']
+MAX_DEPTH = 3
+
+# Most severe first, npm's moderate is medium and its info is low
+SEVERITIES = ['critical', 'high', 'medium', 'low', 'unknown']
+SEVERITY_ALIASES = {'moderate': 'medium', 'info': 'low'}
+
+
+def report_path(website: object) -> str:
+    return os.path.join(settings.FASTCP_DEPENDENCIES_DIR, f'{website.id}.json')
+
+
+def find_lockfiles(website: object) -> list:
+    """Returns the paths of the Composer and NPM lockfiles of a website, up to a few levels deep in the
+    website directory. Symlinked directories are not followed."""
+    base_path = get_website_paths(website).get('base_path')
+    found = []
+    for root, dirs, files in os.walk(base_path):
+        depth = os.path.relpath(root, base_path).count(os.sep) + (root != base_path)
+        dirs[:] = [] if depth >= MAX_DEPTH else sorted(d for d in dirs if d not in SKIPPED_DIRS)
+        for name in sorted(files):
+            path = os.path.join(root, name)
+            if name in LOCKFILES and not os.path.islink(path):
+                found.append(path)
+    return found
+
+
+def _run_as(website: object, cmd: list, cwd: str) -> object:
+    """Runs an audit as the SSH user of the website, so the package managers never run as root."""
+    home = get_website_paths(website).get('base_path')
+    env = {'PATH': '/usr/local/bin:/usr/bin:/bin', 'HOME': home, 'COMPOSER_HOME': os.path.join(home, '.composer')}
+    return run(['/usr/sbin/runuser', '-u', website.user.username, '--'] + cmd, stdout=PIPE, stderr=PIPE, timeout=300, cwd=cwd, env=env)
+
+
+def severity(value: str) -> str:
+    value = (value or '').lower()
+    value = SEVERITY_ALIASES.get(value, value)
+    return value if value in SEVERITIES else 'unknown'
+
+
+def composer_vulnerabilities(output: dict) -> list:
+    """Returns the vulnerabilities in the output of composer audit --format=json."""
+    found = []
+    for package, advisories in (output.get('advisories') or {}).items():
+        # Composer returns a list, or an object keyed by index when some advisories were filtered
+        for advisory in (advisories.values() if isinstance(advisories, dict) else advisories):
+            found.append({
+                'package': package,
+                'affected': advisory.get('affectedVersions'),
+                'severity': severity(advisory.get('severity')),
+                'title': advisory.get('title'),
+                'advisory': advisory.get('cve') or advisory.get('advisoryId'),
+                'url': advisory.get('link'),
+            })
+    return found
+
+
+def npm_vulnerabilities(output: dict) -> list:
+    """Returns the vulnerabilities in the output of npm audit --json. The packages that are only
+    vulnerable through another package are reported once, by the package with the advisory."""
+    found = []
+    for package, vulnerability in (output.get('vulnerabilities') or {}).items():
+        for via in vulnerability.get('via') or []:
+            if not isinstance(via, dict):
+                continue
+            found.append({
+                'package': package,
+                'affected': via.get('range') or vulnerability.get('range'),
+                'severity': severity(via.get('severity') or vulnerability.get('severity')),
+                'title': via.get('title'),
+                'advisory': str(via.get('source')) if via.get('source') else None,
+                'url': via.get('url'),
+            })
+    return found
+
+
+def audit_lockfile(website: object, path: str) -> dict:
+    """Audit lockfile.
+
+    Audits the packages locked in a lockfile against the advisory database of its package manager. Only
+    the lockfile is read, the packages don't need to be installed.
+
+    Args:
+        website (object): Website model object.
+        path (str): The lockfile path.
+
+    Returns:
+        dict: The vulnerable packages, or the error if the audit couldn't run.
+    """
+    manager = LOCKFILES.get(os.path.basename(path))
+    result = {
+        'path': os.path.relpath(path, get_website_paths(website).get('base_path')),
+        'manager': manager,
+        'vulnerabilities': [],
+        'error': None
+    }
+    binary = shutil.which(manager, path='/usr/local/bin:/usr/bin:/bin')
+    if not binary:
+        result['error'] = f'{manager} is not installed.'
+        return result
+
+    if manager == 'composer':
+        cmd = [binary, 'audit', '--locked', '--format=json', '--no-interaction']
+    else:
+        cmd = [binary, 'audit', '--json', '--package-lock-only']
+    try:
+        res = _run_as(website, cmd, os.path.dirname(path))
+        output = json.loads(res.stdout.decode(errors='replace') or '{}')
+    except TimeoutExpired:
+        result['error'] = 'The audit timed out.'
+        return result
+    except (OSError, ValueError):
+        result['error'] = 'The audit failed.'
+        return result
+
+    if manager == 'composer':
+        result['vulnerabilities'] = composer_vulnerabilities(output)
+    else:
+        if output.get('error'):
+            result['error'] = (output.get('error') or {}).get('summary') or 'The audit failed.'
+        result['vulnerabilities'] = npm_vulnerabilities(output)
+    return result
+
+
+def audit_website(website: object) -> dict:
+    """Audits all lockfiles of a website and saves the result as the last report."""
+    lockfiles = [audit_lockfile(website, path) for path in find_lockfiles(website)]
+    counts = {level: 0 for level in SEVERITIES}
+    for lockfile in lockfiles:
+        for vulnerability in lockfile.get('vulnerabilities'):
+            counts[vulnerability.get('severity')] += 1
+    report = {
+        'checked': timezone.now().isoformat(),
+        'counts': counts,
+        'lockfiles': lockfiles
+    }
+    os.makedirs(settings.FASTCP_DEPENDENCIES_DIR, mode=0o700, exist_ok=True)
+    with open(report_path(website), 'w') as f:
+        json.dump(report, f)
+    return report
+
+
+def last_report(website: object) -> dict:
+    try:
+        with open(report_path(website)) as f:
+            return json.load(f)
+    except (OSError, ValueError):
+        return None
+
+
+def delete_report(website: object) -> None:
+    if os.path.exists(report_path(website)):
+        os.remove(report_path(website))
+
+
+def audit_job(job: object, params: dict) -> dict:
+    """Audits the dependencies of the websites of a job, reporting the counts as it goes."""
+    websites = Website.objects.filter(id__in=params.get('website_ids', [])).select_related('user').order_by('label')
+    jobs.report_progress(job, 0, websites.count())
+    results = {}
+    for i, website in enumerate(websites):
+        results[website.label] = audit_website(website).get('counts')
+        jobs.report_progress(job, i + 1, results=results)
+    return results
+
+
+def audit_websites() -> int:
+    """Audits the dependencies of all websites and alerts the owners and the admins about the critical and
+    high severity vulnerabilities, once a week per website while they are not fixed. Returns the number of
+    websites with vulnerable dependencies."""
+    vulnerable = 0
+    for website in Website.objects.select_related('user'):
+        if not find_lockfiles(website):
+            delete_report(website)
+            continue
+        counts = audit_website(website).get('counts')
+        if not sum(counts.values()):
+            continue
+        vulnerable += 1
+        serious = counts.get('critical') + counts.get('high')
+        if not serious:
+            continue
+        users = User.objects.filter(Q(is_superuser=True) | Q(id=website.user_id))
+        notify_users(
+            users, f'{serious} serious vulnerabilities in the dependencies of {website}',
+            details=', '.join(f'{level}: {counts.get(level)}' for level in SEVERITIES if counts.get(level)),
+            once_every=timedelta(days=7), event='dependencies'
+        )
+    return vulnerable
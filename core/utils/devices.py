@@ -0,0 +1,48 @@
+import hashlib, secrets
+from core.models import LoginDevice
+from core.utils.notifications import notify_users
+
+
+# A long lived cookie that identifies the browser across sessions
+DEVICE_COOKIE = 'fastcp_device'
+
+
+def _hash(token: str) -> str:
+    """Returns the hash of a device token, only the hashes are stored."""
+    return hashlib.sha256(token.encode()).hexdigest()
+
+
+def register_login(request, user: object) -> tuple:
+    """Register login.
+
+    Finds the device the user signed in from using the device cookie, or records a new device if the
+    cookie is missing or belongs to a revoked device or to another user.
+
+    Args:
+        request: Django HTTP request object.
+        user (object): User model object.
+
+    Returns:
+        tuple: The device model object, the device token to set as the cookie and either the device is new.
+    """
+    token = request.COOKIES.get(DEVICE_COOKIE)
+    device = None
+    if token:
+        device = LoginDevice.objects.filter(user=user, token_hash=_hash(token), revoked=False).first()
+
+    is_new = device is None
+    if is_new:
+        token = secrets.token_urlsafe(32)
+        device = LoginDevice(user=user, token_hash=_hash(token))
+
+    device.user_agent = request.META.get('HTTP_USER_AGENT', '')[:255] or None
+    device.ip_addr = request.META.get('REMOTE_ADDR')
+    device.save()
+    return device, token, is_new
+
+
+def alert_new_device(user: object, device: object) -> None:
+    """Lets the user know about a sign in from a new device."""
+    title = 'New sign in to your account'
+    details = f'Your account was signed in from a new device ({device.user_agent}) with the IP {device.ip_addr}. If this wasn\'t you, revoke the device and change your password.'
+    notify_users([user], title, details=details, event='new_login')
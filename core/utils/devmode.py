@@ -0,0 +1,100 @@
+import os
+from datetime import timedelta
+from subprocess import run, DEVNULL, STDOUT
+from django.conf import settings
+from django.utils import timezone
+from core.models import Website
+from core.utils import filesystem
+from core.utils.notifications import notify_users
+
+
+# Keeps the extensions idle for all pools but the ones in developer mode
+DEV_INI = """; Managed by FastCP. Developer mode enables these per pool.
+xdebug.mode = off
+pcov.enabled = 0
+"""
+
+
+def ensure_extension(version: str, extension: str) -> bool:
+    """Ensure an extension is installed.
+
+    Installs Xdebug or PCOV for a PHP version if missing, and turns it off for all pools so only the
+    pools in developer mode pay for it.
+
+    Args:
+        version (str): The PHP version.
+        extension (str): Either xdebug or pcov.
+
+    Returns:
+        bool: True if the extension is available and False otherwise.
+    """
+    php_root = os.path.join(settings.PHP_INSTALL_PATH, version)
+    if not os.path.exists(os.path.join(php_root, 'mods-available', f'{extension}.ini')):
+        env = dict(os.environ, DEBIAN_FRONTEND='noninteractive')
+        res = run(['/usr/bin/apt-get', 'install', '-y', f'php{version}-{extension}'], stdout=DEVNULL, stderr=STDOUT, env=env, timeout=600)
+        if res.returncode != 0:
+            return False
+
+    conf_d = os.path.join(php_root, 'fpm', 'conf.d')
+    os.makedirs(conf_d, exist_ok=True)
+    with open(os.path.join(conf_d, '99-fastcp-devmode.ini'), 'w') as f:
+        f.write(DEV_INI)
+    return True
+
+
+def dev_mode_active(website: object) -> bool:
+    """Returns True if the website is in developer mode."""
+    return website.dev_mode_until is not None and website.dev_mode_until > timezone.now()
+
+
+def enable_dev_mode(website: object, extension: str, hours: int) -> bool:
+    """Enable developer mode.
+
+    Enables Xdebug or PCOV in the FPM pool of the website for the given number of hours.
+
+    Args:
+        website (object): Website model object.
+        extension (str): Either xdebug or pcov.
+        hours (int): Hours to keep developer mode on for.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    if not ensure_extension(website.php, extension):
+        return False
+    previous = (website.dev_extension, website.dev_mode_until)
+    website.dev_extension = extension
+    website.dev_mode_until = timezone.now() + timedelta(hours=hours)
+    if not filesystem.generate_fpm_conf(website):
+        website.dev_extension, website.dev_mode_until = previous
+        return False
+    website.save()
+    return True
+
+
+def enable_job(job: object, params: dict) -> dict:
+    """Runs enable_dev_mode as a background job, as installing the extension with apt-get takes a while."""
+    website = Website.objects.get(id=params.get('website_id'))
+    extension = params.get('extension')
+    if not enable_dev_mode(website, extension, params.get('hours')):
+        raise ValueError(f'{extension} cannot be enabled for PHP {website.php}.')
+    return {'extension': extension, 'until': website.dev_mode_until.isoformat()}
+
+
+def disable_dev_mode(website: object) -> bool:
+    """Disables developer mode for a website, it stays on if the pool without the extension is rejected."""
+    until = website.dev_mode_until
+    website.dev_mode_until = None
+    if not filesystem.generate_fpm_conf(website):
+        website.dev_mode_until = until
+        return False
+    website.save()
+    return True
+
+
+def expire_dev_modes() -> None:
+    """Disables the developer mode of the websites whose time is up and lets their owners know."""
+    for website in Website.objects.filter(dev_mode_until__lte=timezone.now()):
+        if not disable_dev_mode(website):
+            continue
+        notify_users([website.user], f'Developer mode of {website} has been disabled', details=f'The {website.get_dev_extension_display()} time window has ended.', event='dev_mode')
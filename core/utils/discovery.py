@@ -0,0 +1,213 @@
+import os, re, shutil, glob
+import psutil, validators
+from subprocess import run, DEVNULL, STDOUT, TimeoutExpired
+from django.conf import settings
+from django.db import transaction
+from core import signals
+from core.models import Website, Domain
+from core.utils import filesystem, system
+
+
+# Where the web servers keep the vhosts that were not created by FastCP
+VHOST_GLOBS = {
+    'nginx': ['/etc/nginx/sites-enabled/*', '/etc/nginx/conf.d/*.conf'],
+    'apache': ['/etc/apache2/sites-enabled/*.conf'],
+    'caddy': ['/etc/caddy/Caddyfile'],
+}
+
+# Suffix of the vhosts disabled after their websites have been imported
+DISABLED_SUFFIX = '.fastcp-disabled'
+
+# The services FastCP can take the HTTP ports over from
+FOREIGN_SERVICES = {'caddy': 'caddy', 'httpd': 'apache2', 'apache2': 'apache2', 'lighttpd': 'lighttpd', 'traefik': 'traefik'}
+
+
+def _strip_comments(data: str) -> str:
+    return '\n'.join(line.split('#', 1)[0] for line in data.splitlines())
+
+
+def parse_nginx(data: str) -> list:
+    """Returns the server blocks of an NGINX config as dicts of domains, root and ports."""
+    sites = []
+    for block in re.split(r'\bserver\s*\{', _strip_comments(data))[1:]:
+        names = re.findall(r'\bserver_name\s+([^;]+);', block)
+        root = re.search(r'\broot\s+([^;]+);', block)
+        ports = re.findall(r'\blisten\s+(?:\S*:)?(\d+)', block)
+        sites.append({
+            'domains': [name for line in names for name in line.split() if name not in ['_', 'localhost']],
+            'root': root.group(1).strip().strip('"\'') if root else None,
+            'ports': sorted(set(int(port) for port in ports)),
+            'proxy': 'proxy_pass' in block
+        })
+    return sites
+
+
+def parse_apache(data: str) -> list:
+    """Returns the VirtualHost blocks of an Apache config as dicts of domains, root and ports."""
+    sites = []
+    for port, block in re.findall(r'<VirtualHost\s+[^>]*?:?(\d*)>(.*?)</VirtualHost>', _strip_comments(data), re.S | re.I):
+        names = re.findall(r'^\s*Server(?:Name|Alias)\s+(.+)$', block, re.M | re.I)
+        root = re.search(r'^\s*DocumentRoot\s+(.+)$', block, re.M | re.I)
+        sites.append({
+            'domains': [name for line in names for name in line.split()],
+            'root': root.group(1).strip().strip('"\'') if root else None,
+            'ports': [int(port)] if port else [],
+            'proxy': 'ProxyPass' in block
+        })
+    return sites
+
+
+def parse_caddy(data: str) -> list:
+    """Returns the site blocks of a Caddyfile as dicts of domains, root and ports."""
+    sites = []
+    for addresses, block in re.findall(r'^([^\s{#][^{\n]*)\{(.*?)^\}', _strip_comments(data), re.S | re.M):
+        addresses = [a.strip().rstrip(',') for a in addresses.split()]
+        if not addresses or addresses[0].startswith('('):
+            continue
+        root = re.search(r'\broot\s+(?:\*\s+)?(\S+)', block)
+        sites.append({
+            'domains': [re.sub(r'^https?://', '', a).split(':')[0] for a in addresses if a and not a.startswith(':')],
+            'root': root.group(1) if root else None,
+            'ports': [443, 80],
+            'proxy': 'reverse_proxy' in block
+        })
+    return sites
+
+
+PARSERS = {'nginx': parse_nginx, 'apache': parse_apache, 'caddy': parse_caddy}
+
+
+def discover_vhosts() -> list:
+    """Discover vhosts.
+
+    Parses the vhosts of NGINX, Apache and Caddy that were not created by FastCP, so the websites they
+    serve can be adopted. Domains that already belong to a FastCP website are flagged.
+
+    Returns:
+        list: The discovered websites with the server, the vhost path, the domains and the web root.
+    """
+    managed_roots = [settings.NGINX_VHOSTS_ROOT, settings.APACHE_VHOST_ROOT]
+    existing = set(Domain.objects.values_list('domain', flat=True))
+    found = []
+    for server, patterns in VHOST_GLOBS.items():
+        for pattern in patterns:
+            for path in sorted(glob.glob(pattern)):
+                real_path = os.path.realpath(path)
+                if not os.path.isfile(real_path) or any(real_path.startswith(root) for root in managed_roots):
+                    continue
+                try:
+                    with open(real_path) as f:
+                        sites = PARSERS.get(server)(f.read())
+                except (OSError, UnicodeDecodeError):
+                    continue
+                for i, site in enumerate(sites):
+                    if not site.get('domains'):
+                        continue
+                    root = site.get('root')
+                    found.append({
+                        'id': f'{server}:{path}:{i}',
+                        'server': server,
+                        'path': path,
+                        **site,
+                        'root_exists': bool(root) and os.path.isdir(root),
+                        'managed_domains': [d for d in site.get('domains') if d in existing]
+                    })
+    return found
+
+
+def port_conflicts() -> list:
+    """Returns the processes other than NGINX listening on the HTTP and HTTPS ports."""
+    conflicts = {}
+    for conn in psutil.net_connections(kind='tcp'):
+        if conn.status != psutil.CONN_LISTEN or conn.laddr.port not in [80, 443] or not conn.pid:
+            continue
+        try:
+            name = psutil.Process(conn.pid).name()
+        except psutil.Error:
+            continue
+        if name == 'nginx':
+            continue
+        conflict = conflicts.setdefault(name, {'process': name, 'service': FOREIGN_SERVICES.get(name), 'ports': []})
+        if conn.laddr.port not in conflict['ports']:
+            conflict['ports'].append(conn.laddr.port)
+    return list(conflicts.values())
+
+
+def _run(*cmds: list) -> bool:
+    """Runs the commands in turn until one of them fails, returns True if all of them succeeded."""
+    for cmd in cmds:
+        try:
+            if run(cmd, stdout=DEVNULL, stderr=STDOUT, timeout=300).returncode != 0:
+                return False
+        except (OSError, TimeoutExpired):
+            return False
+    return True
+
+
+def take_over_ports(service: str) -> bool:
+    """Stops and disables a web server holding the HTTP ports, then starts NGINX on them."""
+    if service not in FOREIGN_SERVICES.values():
+        return False
+    if service == 'apache2':
+        # Apache stays as the backend of NGINX, it only has to stop listening on the public ports
+        return _run(
+            ['/usr/bin/sed', '-i', '-E', r's/^(\s*Listen\s+(80|443))\b/# \1/', '/etc/apache2/ports.conf'],
+            ['/usr/bin/systemctl', 'restart', 'apache2'],
+            ['/usr/bin/systemctl', 'restart', 'nginx']
+        )
+    return _run(['/usr/bin/systemctl', 'disable', '--now', service], ['/usr/bin/systemctl', 'restart', 'nginx'])
+
+
+def import_vhost(vhost_id: str, user: object, label: str, php: str, copy_files: bool = True, disable_original: bool = True) -> Website:
+    """Import a vhost.
+
+    Creates a FastCP website with the domains of a discovered vhost, copies the files from its web root
+    and disables the original vhost so it doesn't conflict with the one generated by FastCP. The Caddyfile
+    is left as is, Caddy has to give up the HTTP ports with take_over_ports instead.
+
+    Args:
+        vhost_id (str): The ID of the vhost as returned by discover_vhosts.
+        user (object): The SSH user to own the website.
+        label (str): The label of the new website.
+        php (str): The PHP version of the new website.
+        copy_files (bool): Copy the files of the original web root.
+        disable_original (bool): Rename the original vhost so the web server ignores it.
+
+    Returns:
+        object: The website model object.
+
+    Raises:
+        ValueError: If the vhost cannot be imported.
+    """
+    vhost = next((v for v in discover_vhosts() if v.get('id') == vhost_id), None)
+    if not vhost:
+        raise ValueError('The vhost was not found, it may have been imported already.')
+    if vhost.get('managed_domains'):
+        raise ValueError(f'{", ".join(vhost.get("managed_domains"))} already belong to FastCP websites.')
+    if Website.objects.filter(label=label).exists():
+        raise ValueError(f'A website with label {label} already exists.')
+    # The server names of a vhost may be wildcards or regexes that FastCP cannot serve as domains
+    domains = list(dict.fromkeys(domain.lower() for domain in vhost.get('domains')))
+    invalid = [domain for domain in domains if not validators.domain(domain)]
+    if invalid:
+        raise ValueError(f'{", ".join(invalid)} cannot be imported, only plain domains are supported.')
+
+    with transaction.atomic():
+        website = Website.objects.create(user=user, label=label, php=php)
+        for domain in domains:
+            website.domains.create(domain=domain)
+
+    if copy_files and vhost.get('root_exists'):
+        web_root = filesystem.get_website_paths(website).get('web_root')
+        shutil.rmtree(web_root, ignore_errors=True)
+        shutil.copytree(vhost.get('root'), web_root, symlinks=True)
+        system.fix_ownership(website)
+
+    # Only disable the original vhost if no other site of the file remains to be imported
+    remaining = [v for v in discover_vhosts() if v.get('path') == vhost.get('path') and not v.get('managed_domains')]
+    if disable_original and not remaining and vhost.get('server') != 'caddy':
+        os.rename(vhost.get('path'), f'{vhost.get("path")}{DISABLED_SUFFIX}')
+        _run(['/usr/bin/systemctl', 'reload', 'apache2' if vhost.get('server') == 'apache' else 'nginx'])
+
+    signals.domains_updated.send(sender=website)
+    return website
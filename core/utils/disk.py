@@ -0,0 +1,131 @@
+import re, psutil
+from subprocess import check_output, CalledProcessError, DEVNULL
+from core.utils.system import run_cmd
+
+
+# Partition device names, i.e. /dev/vda1, /dev/sda1, /dev/nvme0n1p1 and /dev/mmcblk0p1
+PARTITION_RE = re.compile(r'^(/dev/(?:nvme\d+n\d+|mmcblk\d+))p(\d+)$|^(/dev/[a-z]+)(\d+)$')
+
+
+def _output(cmd: list) -> str:
+    """Returns the stripped output of a command or None if the command fails."""
+    try:
+        return check_output(cmd, stderr=DEVNULL, timeout=60).decode().strip()
+    except (CalledProcessError, FileNotFoundError):
+        return None
+
+
+def split_partition(device: str) -> tuple:
+    """Split a partition device.
+
+    Args:
+        device (str): The partition device, i.e. /dev/vda1.
+
+    Returns:
+        tuple: The disk device and the partition number, i.e. ('/dev/vda', '1'), or (None, None) if the
+               device is not a partition.
+    """
+    m = PARTITION_RE.match(device or '')
+    if not m:
+        return None, None
+    if m.group(1):
+        return m.group(1), m.group(2)
+    return m.group(3), m.group(4)
+
+
+def root_device() -> dict:
+    """Get the root device.
+
+    Finds the device mounted on / and, if the root filesystem lives on an LVM logical volume, the physical
+    volume behind it.
+
+    Returns:
+        dict: The source device, the filesystem type and the partition that needs to grow.
+    """
+    source = _output(['/usr/bin/findmnt', '-n', '-o', 'SOURCE', '/'])
+    fstype = _output(['/usr/bin/findmnt', '-n', '-o', 'FSTYPE', '/'])
+    is_lvm = bool(source and source.startswith('/dev/mapper/'))
+    partition = source
+
+    if is_lvm:
+        pvs = _output(['/usr/sbin/pvs', '--noheadings', '-o', 'pv_name', '--select', f'lv_path={source}'])
+        if not pvs:
+            pvs = _output(['/usr/sbin/pvs', '--noheadings', '-o', 'pv_name'])
+        partition = pvs.split()[0] if pvs else None
+
+    return {
+        'source': source,
+        'fstype': fstype,
+        'is_lvm': is_lvm,
+        'partition': partition
+    }
+
+
+def unallocated_bytes(partition: str) -> int:
+    """Returns the size in bytes of the disk space that follows the provided partition and is not used yet."""
+    disk, _ = split_partition(partition)
+    if not disk:
+        return 0
+    disk_size = _output(['/usr/bin/lsblk', '-b', '-d', '-n', '-o', 'SIZE', disk])
+    part_size = _output(['/usr/bin/lsblk', '-b', '-d', '-n', '-o', 'SIZE', partition])
+    try:
+        # The partition table and the alignment consume a few MBs
+        free = int(disk_size) - int(part_size) - (16 * 1024 * 1024)
+        return max(free, 0)
+    except (TypeError, ValueError):
+        return 0
+
+
+def disk_status() -> dict:
+    """Get disk status.
+
+    Returns the root filesystem usage along with the information required to decide either the disk can be
+    expanded or not.
+
+    Returns:
+        dict: Disk usage and expansion details.
+    """
+    usage = psutil.disk_usage('/')
+    device = root_device()
+    free = unallocated_bytes(device.get('partition'))
+    return {
+        'total': usage.total,
+        'used': usage.used,
+        'free': usage.free,
+        'percent': usage.percent,
+        'device': device,
+        'unallocated': free,
+        'expandable': free > 0 and device.get('fstype') in ['ext4', 'ext3', 'xfs']
+    }
+
+
+def expand_root_disk() -> bool:
+    """Expand the root disk.
+
+    After a cloud provider resizes the volume, the partition and the filesystem still have the old size.
+    This function grows the root partition to fill the disk and then resizes the filesystem (through LVM if
+    the root filesystem lives on a logical volume).
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    device = root_device()
+    disk, number = split_partition(device.get('partition'))
+    if not disk:
+        return False
+
+    # growpart exits with 1 when there is nothing to grow, so we only
+    # consider it a failure if the partition still has free space after it.
+    run_cmd(f'/usr/bin/growpart {disk} {number}')
+    if unallocated_bytes(device.get('partition')) > 0:
+        return False
+
+    if device.get('is_lvm'):
+        return all([
+            run_cmd(f'/usr/sbin/pvresize {device.get("partition")}'),
+            run_cmd(f'/usr/sbin/lvextend -r -l +100%FREE {device.get("source")}')
+        ])
+
+    if device.get('fstype') == 'xfs':
+        return run_cmd('/usr/sbin/xfs_growfs /')
+    return run_cmd(f'/usr/sbin/resize2fs {device.get("source")}')
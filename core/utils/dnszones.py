@@ -0,0 +1,218 @@
+import os, json, ipaddress, re
+from subprocess import run, PIPE, STDOUT
+from django.conf import settings
+from django.template.loader import render_to_string
+from django.utils import timezone
+from core.models import DnsZone, MailDomain, DNS_RECORD_TYPES
+from core.utils import system, mail
+from api.websites.services.dns_providers import get_provider
+
+
+# BIND includes this file, it lists the zones served locally
+NAMED_CONF_PATH = '/etc/bind/named.conf.fastcp'
+
+CONTROL_RE = re.compile(r'[\x00-\x1f\x7f]')
+
+HOSTNAME_RE = re.compile(r'^(\*\.)?([a-z0-9_]([a-z0-9_-]{0,61}[a-z0-9_])?\.)*[a-z0-9_]([a-z0-9_-]{0,61}[a-z0-9_])?$', re.I)
+
+
+def zone_file_path(zone: object) -> str:
+    return os.path.join(settings.FASTCP_BIND_ZONES_DIR, f'{zone.domain}.db')
+
+
+def validate_record(rtype: str, name: str, value: str, ttl: int) -> dict:
+    """Returns the errors of a record keyed by the field, an empty dict if the record is valid."""
+    errors = {}
+    if rtype not in DNS_RECORD_TYPES:
+        errors['type'] = [f'The record type should be one of {", ".join(DNS_RECORD_TYPES)}.']
+    if name != '@' and not HOSTNAME_RE.fullmatch(name):
+        errors['name'] = ['Enter @ for the domain itself or a valid subdomain name.']
+    if ttl < 60 or ttl > 604800:
+        errors['ttl'] = ['The TTL should be between 60 and 604800 seconds.']
+
+    value = value.strip()
+    if not value:
+        errors['value'] = ['A value is required.']
+    elif CONTROL_RE.search(value):
+        # A line break would end the record in the zone file and start another one
+        errors['value'] = ['The value cannot contain line breaks or control characters.']
+    elif rtype in ['A', 'AAAA']:
+        try:
+            ip = ipaddress.ip_address(value)
+            if (rtype == 'A') != (ip.version == 4):
+                raise ValueError
+        except ValueError:
+            errors['value'] = [f'Enter a valid IPv{4 if rtype == "A" else 6} address.']
+    elif rtype in ['CNAME', 'NS'] and not HOSTNAME_RE.fullmatch(value.rstrip('.')):
+        errors['value'] = ['Enter a valid hostname.']
+    elif rtype == 'MX' and not re.fullmatch(r'\d{1,5} [^\s;"\\]+', value):
+        errors['value'] = ['Enter the priority and the mail server, i.e. 10 mail.example.com.']
+    elif rtype == 'SRV' and not re.fullmatch(r'\d{1,5} \d{1,5} \d{1,5} [^\s;"\\]+', value):
+        errors['value'] = ['Enter the priority, weight, port and target, i.e. 10 5 5060 sip.example.com.']
+    elif rtype == 'CAA' and not re.fullmatch(r'\d{1,3} [a-z0-9]+ "[^"\\]*"', value, re.I):
+        errors['value'] = ['Enter the flags, tag and quoted value, i.e. 0 issue "letsencrypt.org".']
+
+    if rtype == 'CNAME' and name == '@':
+        errors['name'] = ['The domain itself cannot have a CNAME record.']
+    return errors
+
+
+def quote_txt(data: bytes) -> str:
+    """Escapes a TXT string for a zone file, the quotes and backslashes are escaped and any other byte
+    that isn't printable ASCII is written as \\DDD."""
+    escaped = ''
+    for byte in data:
+        if byte in b'"\\':
+            escaped += '\\' + chr(byte)
+        elif 32 <= byte < 127:
+            escaped += chr(byte)
+        else:
+            escaped += f'\\{byte:03d}'
+    return escaped
+
+
+def zone_value(record: object) -> str:
+    """Returns the value of a record as written in a zone file."""
+    if record.type == 'TXT':
+        # Long TXT values are split into the 255 byte strings of the DNS protocol
+        data = record.value.encode()
+        chunks = [data[i:i + 255] for i in range(0, len(data), 255)] or [b'']
+        return ' '.join(f'"{quote_txt(chunk)}"' for chunk in chunks)
+    if record.type in ['CNAME', 'NS', 'MX', 'SRV'] and not record.value.endswith('.') and '.' in record.value:
+        return f'{record.value}.'
+    return record.value
+
+
+def next_serial(zone: object) -> int:
+    """Returns the next serial of a zone in the YYYYMMDDnn format."""
+    today = int(timezone.now().strftime('%Y%m%d')) * 100
+    return max(zone.serial + 1, today)
+
+
+def default_records(zone: object) -> None:
+    """Creates the NS, A and www records of a new zone, and the mail records if emails are enabled for it."""
+    if zone.backend == 'local':
+        # The external providers manage the NS records of their zones
+        for ns in settings.FASTCP_DNS_NAMESERVERS:
+            zone.records.create(name='@', type='NS', value=ns)
+    if settings.SERVER_IP_ADDR and settings.SERVER_IP_ADDR != 'N/A':
+        zone.records.create(name='@', type='A', value=settings.SERVER_IP_ADDR)
+        zone.records.create(name='www', type='CNAME', value=zone.domain)
+    mail_domain = MailDomain.objects.filter(domain=zone.domain).first()
+    if mail_domain:
+        for record in mail.dns_records(mail_domain):
+            name = record.get('name')[:-len(zone.domain) - 1] if record.get('name') != zone.domain else '@'
+            zone.records.create(name=name, type=record.get('type'), value=record.get('value'))
+
+
+def write_named_conf() -> None:
+    """Lists the local zones in the BIND config FastCP includes."""
+    with open(NAMED_CONF_PATH, 'w') as f:
+        f.write('// Generated by FastCP. Changes to this file will be overwritten.\n')
+        for zone in DnsZone.objects.filter(backend='local').order_by('domain'):
+            f.write(f'zone "{zone.domain}" {{\n    type master;\n    file "{zone_file_path(zone)}";\n}};\n')
+
+
+def write_zone(zone: object) -> str:
+    """Write zone file.
+
+    Renders the zone file of a local zone with a new serial, checks it with named-checkzone and reloads
+    BIND. The previous zone file is kept if the check fails.
+
+    Args:
+        zone (object): DnsZone model object.
+
+    Returns:
+        str: The errors of the zone check, None on success.
+    """
+    zone.serial = next_serial(zone)
+    records = list(zone.records.order_by('name', 'type'))
+    for record in records:
+        record.zone_value = zone_value(record)
+    primary_ns = (settings.FASTCP_DNS_NAMESERVERS or [f'ns1.{zone.domain}'])[0].rstrip('.')
+    data = render_to_string('system/dns-zone.txt', {
+        'zone': zone,
+        'records': records,
+        'primary_ns': primary_ns,
+        'hostmaster': f'hostmaster.{zone.domain}'
+    })
+
+    os.makedirs(settings.FASTCP_BIND_ZONES_DIR, exist_ok=True)
+    path = zone_file_path(zone)
+    with open(f'{path}.new', 'w') as f:
+        f.write(data)
+    try:
+        result = run(['/usr/bin/named-checkzone', zone.domain, f'{path}.new'], stdout=PIPE, stderr=STDOUT, timeout=30)
+        if result.returncode != 0:
+            os.remove(f'{path}.new')
+            return result.stdout.decode().strip()
+    except FileNotFoundError:
+        pass
+
+    os.rename(f'{path}.new', path)
+    zone.save()
+    write_named_conf()
+    system.run_cmd('/usr/sbin/rndc reload')
+    return None
+
+
+def _record_set(zone: object, name: str, rtype: str) -> list:
+    return list(zone.records.filter(name=name, type=rtype))
+
+
+def push_record_set(zone: object, name: str, rtype: str, previous: dict = None) -> str:
+    """Push record set.
+
+    Replaces a record set, i.e. the records of the same name and type, at the external DNS provider of the
+    zone. The providers replace record sets as a whole, so the previous set is removed first.
+
+    Args:
+        zone (object): DnsZone model object.
+        name (str): The record name relative to the zone.
+        rtype (str): The record type.
+        previous (dict): The provider reference and the values of a removed record, if any.
+
+    Returns:
+        str: The error message, None on success.
+    """
+    provider = get_provider(zone.dns_credential)
+    records = _record_set(zone, name, rtype)
+    fqdn = zone.domain if name == '@' else f'{name}.{zone.domain}'
+
+    pushed = [json.loads(r.provider_ref) for r in records if r.provider_ref]
+    if previous:
+        pushed.append(previous)
+    try:
+        # Every record of a set holds the same reference, deleting it once is enough
+        for state in {json.dumps(s, sort_keys=True): s for s in pushed}.values():
+            provider.delete_record(fqdn, rtype, state.get('values'), state.get('ref'))
+        if records:
+            values = [r.value for r in records]
+            ref = provider.create_record(fqdn, rtype, values, min(r.ttl for r in records))
+            state = json.dumps({'ref': ref, 'values': values})
+            zone.records.filter(id__in=[r.id for r in records]).update(provider_ref=state)
+    except Exception as e:
+        return str(e)
+    return None
+
+
+def sync_zone(zone: object, name: str = None, rtype: str = None, previous: dict = None) -> str:
+    """Publishes the changes of a zone, the changed record set is enough for the external providers."""
+    if zone.backend == 'local':
+        return write_zone(zone)
+    if not zone.dns_credential:
+        return 'The zone does not have a DNS provider credential.'
+    if name is None:
+        errors = [push_record_set(zone, n, t) for n, t in set(zone.records.values_list('name', 'type'))]
+        return '\n'.join(filter(None, errors)) or None
+    return push_record_set(zone, name, rtype, previous)
+
+
+def delete_zone(zone: object) -> None:
+    """Removes a local zone from BIND, the records at the external providers are left as they are."""
+    if zone.backend == 'local':
+        path = zone_file_path(zone)
+        if os.path.exists(path):
+            os.remove(path)
+        write_named_conf()
+        system.run_cmd('/usr/sbin/rndc reload')
@@ -0,0 +1,150 @@
+import os, re, time, gzip
+from subprocess import Popen, PIPE, DEVNULL
+from django.conf import settings
+from django.http import StreamingHttpResponse, HttpResponse
+from core.models import Website
+from core.utils import volumes, jobs
+from core.utils.filesystem import get_website_paths
+
+
+CHUNK_SIZE = 1024 * 1024
+
+# Seconds between the progress updates of an export
+PROGRESS_SECONDS = 2
+
+RANGE_RE = re.compile(r'^bytes=(\d*)-(\d*)$')
+
+
+def artifact_path(job: object) -> str:
+    """Returns the path of the archive an export job creates."""
+    return os.path.join(settings.FASTCP_EXPORTS_DIR, f'{job.id}-{job.target}.tar.gz')
+
+
+def export_website(job: object, params: dict) -> dict:
+    """Export website.
+
+    Archives the files of a website, or of one of its snapshots, into a tar.gz as a background job. The
+    progress is the bytes archived out of the total, so the panel can show a percentage, and the archive
+    is downloaded once the job is done.
+
+    Args:
+        job (object): The Job model object.
+        params (dict): The ID of the website and the optional snapshot label.
+
+    Returns:
+        dict: The name and the size of the archive.
+    """
+    website = Website.objects.get(id=params.get('website_id'))
+    source = get_website_paths(website).get('base_path')
+    if params.get('snapshot'):
+        source = volumes.get_driver().snapshot_path(source, params.get('snapshot'))
+        if not source:
+            raise RuntimeError(f'Snapshot {params.get("snapshot")} of {website.label} was not found.')
+
+    total = 0
+    for root, dirs, names in os.walk(source):
+        for name in names:
+            path = os.path.join(root, name)
+            if not os.path.islink(path):
+                total += os.path.getsize(path)
+    jobs.report_progress(job, 0, total)
+
+    os.makedirs(settings.FASTCP_EXPORTS_DIR, mode=0o700, exist_ok=True)
+    path = artifact_path(job)
+    # tar runs as the website owner, so a symlink swapped in while it runs cannot add the files of others.
+    # The progress is the size of the uncompressed tar stream, which is close to the size of the files.
+    cmd = ['/usr/sbin/runuser', '-u', website.user.username, '--', '/bin/tar', '-cf', '-', '--ignore-failed-read',
+           f'--transform=s,^\\.,{website.slug},', '-C', source, '.']
+    proc = Popen(cmd, stdout=PIPE, stderr=DEVNULL, cwd='/')
+    done = 0
+    last_report = time.monotonic()
+    try:
+        with gzip.open(f'{path}.part', 'wb') as archive:
+            while True:
+                chunk = proc.stdout.read(CHUNK_SIZE)
+                if not chunk:
+                    break
+                archive.write(chunk)
+                done += len(chunk)
+                if time.monotonic() - last_report >= PROGRESS_SECONDS:
+                    jobs.report_progress(job, min(done, total))
+                    last_report = time.monotonic()
+    finally:
+        proc.stdout.close()
+        returncode = proc.wait()
+    if returncode != 0:
+        os.remove(f'{path}.part')
+        raise RuntimeError(f'The files of {website.label} cannot be archived.')
+    os.rename(f'{path}.part', path)
+
+    jobs.report_progress(job, total)
+    return {'artifact': os.path.basename(path), 'size': os.path.getsize(path)}
+
+
+def _read_range(path: str, start: int, length: int):
+    with open(path, 'rb') as f:
+        f.seek(start)
+        while length > 0:
+            chunk = f.read(min(CHUNK_SIZE, length))
+            if not chunk:
+                break
+            length -= len(chunk)
+            yield chunk
+
+
+def ranged_file_response(request: object, path: str, filename: str, content_type: str = 'application/gzip') -> object:
+    """Ranged file response.
+
+    Returns a response that serves a file in full or the byte range requested with the Range header, so
+    interrupted downloads can be resumed. A range is ignored if If-Range doesn't match the file anymore.
+
+    Args:
+        request (object): The request.
+        path (str): The path of the file.
+        filename (str): The file name the browser saves the file with.
+        content_type (str): The content type of the file.
+
+    Returns:
+        object: The response.
+    """
+    size = os.path.getsize(path)
+    etag = f'"{int(os.path.getmtime(path))}-{size}"'
+    start, end = 0, size - 1
+
+    match = RANGE_RE.match(request.META.get('HTTP_RANGE', '').strip())
+    if_range = request.META.get('HTTP_IF_RANGE')
+    ranged = match is not None and (not if_range or if_range == etag)
+    if ranged:
+        first, last = match.groups()
+        if first:
+            start = int(first)
+            end = min(int(last), size - 1) if last else size - 1
+        elif last:
+            start = max(size - int(last), 0)
+        if start > end or start >= size:
+            response = HttpResponse(status=416)
+            response['Content-Range'] = f'bytes */{size}'
+            return response
+
+    response = StreamingHttpResponse(_read_range(path, start, end - start + 1), content_type=content_type, status=206 if ranged else 200)
+    response['Content-Length'] = str(end - start + 1)
+    response['Accept-Ranges'] = 'bytes'
+    response['ETag'] = etag
+    response['Content-Disposition'] = f'attachment; filename="{filename}"'
+    if ranged:
+        response['Content-Range'] = f'bytes {start}-{end}/{size}'
+    return response
+
+
+def purge_exports() -> int:
+    """Deletes the archives older than the retention period and returns the number of deleted archives."""
+    if not os.path.isdir(settings.FASTCP_EXPORTS_DIR):
+        return 0
+    cutoff = time.time() - settings.FASTCP_EXPORT_RETENTION_HOURS * 3600
+    deleted = 0
+    for name in os.listdir(settings.FASTCP_EXPORTS_DIR):
+        path = os.path.join(settings.FASTCP_EXPORTS_DIR, name)
+        if os.path.getmtime(path) < cutoff:
+            os.remove(path)
+            deleted += 1
+    return deleted
@@ -0,0 +1,166 @@
+import os, re, json, ipaddress
+from subprocess import run, PIPE, DEVNULL, STDOUT, TimeoutExpired
+from django.conf import settings
+from django.template.loader import render_to_string
+from django.utils import timezone
+from core.utils.system import run_cmd
+
+
+CLIENT_PATH = '/usr/bin/fail2ban-client'
+JAIL_CONF_PATH = '/etc/fail2ban/jail.d/fastcp.local'
+FILTERS = {
+    'fastcp-panel': ('system/fail2ban-panel-filter.txt', '/etc/fail2ban/filter.d/fastcp-panel.conf'),
+    'fastcp-wplogin': ('system/fail2ban-wplogin-filter.txt', '/etc/fail2ban/filter.d/fastcp-wplogin.conf'),
+}
+POLICY_PATH = '/var/fastcp/.config/fail2ban.json'
+
+JAILS = {
+    'sshd': 'SSH sign ins',
+    'fastcp-panel': 'FastCP panel sign ins',
+    'fastcp-wplogin': 'WordPress sign ins and XML-RPC',
+}
+
+DEFAULT_POLICY = {
+    'jails': list(JAILS),
+    'maxretry': 5,
+    'findtime': 600,
+    'bantime': 3600,
+    # WordPress sign ins are counted from the access logs, where the successful ones look the same
+    'wp_maxretry': 20,
+    'ignore_ips': [],
+}
+
+BANNED_RE = re.compile(r'Banned IP list:\s*(.*)$', re.MULTILINE)
+
+
+def auth_log_path() -> str:
+    return os.path.join(settings.FASTCP_LOG_DIR, 'auth.log')
+
+
+def log_failed_login(request: object, username: str) -> None:
+    """Appends a failed sign in to the panel to the auth log watched by the fastcp-panel jail."""
+    ip = request.META.get('REMOTE_ADDR') or '-'
+    username = re.sub(r'\s', '', username or '')[:150]
+    try:
+        os.makedirs(settings.FASTCP_LOG_DIR, exist_ok=True)
+        with open(auth_log_path(), 'a') as f:
+            f.write(f'{timezone.now():%Y-%m-%d %H:%M:%S} fastcp login failed for user={username} from ip={ip}\n')
+    except OSError:
+        pass
+
+
+def is_installed() -> bool:
+    return os.path.exists(CLIENT_PATH)
+
+
+def install() -> bool:
+    """Installs fail2ban if it's missing."""
+    if is_installed():
+        return True
+    env = dict(os.environ, DEBIAN_FRONTEND='noninteractive')
+    try:
+        res = run(['/usr/bin/apt-get', 'install', '-y', 'fail2ban'], stdout=DEVNULL, stderr=STDOUT, env=env, timeout=600)
+    except TimeoutExpired:
+        return False
+    return res.returncode == 0
+
+
+def install_job(job: object, params: dict) -> dict:
+    """Installs fail2ban and writes the config of the jails, run as a background job."""
+    if not install():
+        raise ValueError('fail2ban cannot be installed with apt-get.')
+    return {'configured': write_config(params.get('policy'))}
+
+
+def policy() -> dict:
+    try:
+        with open(POLICY_PATH) as f:
+            return {**DEFAULT_POLICY, **json.loads(f.read())}
+    except (OSError, ValueError):
+        return dict(DEFAULT_POLICY)
+
+
+def write_config(new_policy: dict = None) -> bool:
+    """Write fail2ban config.
+
+    Saves the policy, writes the jails and the filters of the panel and of WordPress, and reloads
+    fail2ban. The panel jail watches the auth log of FastCP and the WordPress jail watches the NGINX
+    access logs of all websites.
+
+    Args:
+        new_policy (dict): The new policy, the saved one is written again if None.
+
+    Returns:
+        bool: True if fail2ban accepted the config.
+    """
+    current = {**policy(), **(new_policy or {})}
+    os.makedirs(os.path.dirname(POLICY_PATH), exist_ok=True)
+    with open(POLICY_PATH, 'w') as f:
+        f.write(json.dumps(current))
+
+    for template, path in FILTERS.values():
+        with open(path, 'w') as f:
+            f.write(render_to_string(template, {}))
+
+    # fail2ban refuses to start a jail whose log file doesn't exist yet
+    os.makedirs(settings.FASTCP_LOG_DIR, exist_ok=True)
+    open(auth_log_path(), 'a').close()
+
+    with open(JAIL_CONF_PATH, 'w') as f:
+        f.write(render_to_string('system/fail2ban-jail.txt', {
+            **current,
+            'panel_log': auth_log_path(),
+            'site_logs': os.path.join(settings.FILE_MANAGER_ROOT, '*', 'logs', '*_nginx.access_ssl.log'),
+        }))
+    run_cmd('/usr/bin/systemctl enable --now fail2ban')
+    return run_cmd(f'{CLIENT_PATH} reload')
+
+
+def _client(*args) -> str:
+    try:
+        res = run([CLIENT_PATH] + list(args), stdout=PIPE, stderr=DEVNULL, timeout=30)
+    except (FileNotFoundError, TimeoutExpired):
+        return None
+    return res.stdout.decode(errors='replace') if res.returncode == 0 else None
+
+
+def banned_ips(jail: str) -> list:
+    """Returns the IPs a jail has banned now, or None if the jail isn't running."""
+    output = _client('status', jail)
+    if output is None:
+        return None
+    match = BANNED_RE.search(output)
+    return match.group(1).split() if match else []
+
+
+def status() -> dict:
+    """Returns if fail2ban is installed and the policy, along with the banned IPs of each jail."""
+    installed = is_installed()
+    current = policy()
+    return {
+        'installed': installed,
+        'policy': current,
+        'jails': [{
+            'name': name,
+            'description': description,
+            'enabled': name in current.get('jails'),
+            'banned': banned_ips(name) if installed and name in current.get('jails') else None
+        } for name, description in JAILS.items()]
+    }
+
+
+def valid_ip(ip: str, network: bool = False) -> bool:
+    """Returns True if the value is an IPv4 or IPv6 address, or a network in CIDR notation if allowed."""
+    try:
+        if network:
+            ipaddress.ip_network(ip, strict=False)
+        else:
+            ipaddress.ip_address(ip)
+    except ValueError:
+        return False
+    return True
+
+
+def unban(jail: str, ip: str) -> bool:
+    """Lifts the ban of an IP in a jail."""
+    return _client('set', jail, 'unbanip', ip) is not None
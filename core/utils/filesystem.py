@@ -1,9 +1,13 @@
-import os, shutil, zipfile
+import os, shutil, zipfile, tempfile
+from subprocess import run, PIPE, STDOUT
 from pathlib import Path
 from datetime import datetime
 from django.conf import settings
+from django.utils import timezone
 from django.template.loader import render_to_string
 from core import signals
+from core.utils import volumes, vhosts, access, basicauth
+from core.utils.notifications import notify_admins
 
 
 def extract_zip(root_path, archive_path):
@@ -163,6 +167,39 @@ def get_website_paths(website: object) -> dict:
         'cert_chain_path': os.path.join(ssl_base, 'cert.chain')
     }
 
+def tail_file(path: str, lines: int = 50) -> list:
+    """Tail a file.
+    
+    Reads the last lines of a file without loading the whole file, as log files can be huge.
+    
+    Args:
+        path (str): The file path.
+        lines (int): The number of lines to return.
+    
+    Returns:
+        list: The last lines of the file, an empty list if the file does not exist.
+    """
+    if not os.path.isfile(path):
+        return []
+    
+    with open(path, 'rb') as f:
+        return tail_lines(f, lines)
+
+
+def tail_lines(f: object, lines: int = 50) -> list:
+    """Returns the last lines of a file opened in binary mode, see tail_file."""
+    f.seek(0, os.SEEK_END)
+    end = f.tell()
+    block = 8192
+    data = b''
+    while end > 0 and data.count(b'\n') <= lines:
+        start = max(0, end - block)
+        f.seek(start)
+        data = f.read(end - start) + data
+        end = start
+    return data.decode(errors='replace').splitlines()[-lines:]
+
+
 def create_if_missing(path: str) -> bool:
     """Create a path if missing.
     
@@ -182,6 +219,28 @@ def create_if_missing(path: str) -> bool:
             pass
     return False
 
+def set_fstab_entry(mount_point: str, entry: str = None, fstab_path: str = '/etc/fstab') -> None:
+    """Set fstab entry.
+    
+    Replaces the fstab entry of the provided mount point. If entry is None, the existing entry is removed.
+    
+    Args:
+        mount_point (str): The mount point path.
+        entry (str): The full fstab line without the line break.
+        fstab_path (str): Path of the fstab file.
+    """
+    lines = []
+    if os.path.exists(fstab_path):
+        with open(fstab_path) as f:
+            lines = f.readlines()
+    
+    lines = [line for line in lines if len(line.split()) < 2 or line.split()[1] != mount_point]
+    if entry:
+        lines.append(f'{entry}\n')
+    
+    with open(fstab_path, 'w') as f:
+        f.writelines(lines)
+
 def delete_apache_vhost(website: object) -> bool:
     """Delete Apache vhosts file.
     
@@ -226,6 +285,10 @@ def delete_nginx_vhost(website: object) -> bool:
         
         if os.path.exists(vhost_path):
             os.remove(vhost_path)
+        basicauth.delete_users_files(website)
+        # Imported here like in create_nginx_vhost
+        from core.utils import waf
+        waf.delete_files(website)
         signals.restart_services.send(sender=None, services='nginx')
         return True
     except:
@@ -270,6 +333,8 @@ def create_apache_vhost(website: object, **kwargs) -> bool:
             main_domain = domain.domain
         else:
             server_aliases.append(domain.domain)
+        if domain.wildcard:
+            server_aliases.append(f'*.{domain.domain}')
         i += 1
     
     context = {
@@ -286,10 +351,7 @@ def create_apache_vhost(website: object, **kwargs) -> bool:
     tpl_data = render_to_string('system/apache-vhost.txt', context=context)
     
     try:
-        with open(website_vhost_path, 'w') as f:
-            f.write(tpl_data)
-        signals.restart_services.send(sender=None, services='apache2')
-        return True
+        return vhosts.apply_config(website, website_vhost_path, tpl_data, 'apache2')
     except:
         return False
 
@@ -306,16 +368,34 @@ def create_nginx_vhost(website: object, **kwargs) -> bool:
     Returns:
         bool: True on success and False otherwise.
     """
+    # Imported here as the WAF and the proxy app helpers use the paths of this module
+    from core.utils import waf, proxyapps
+
     website_paths = get_website_paths(website)
     user_paths = get_user_paths(website.user)
     create_if_missing(website_paths.get('ngix_vhost_dir'))
     
+    # The mirrored requests go to the backend of the target website
+    mirror = website.mirror_config()
+    if mirror and mirror.get('backend') == 'proxy':
+        mirror['proxy_pass'] = proxyapps.proxy_pass(website.mirror_to)
+    
     # Template rendering context
     context = {
         'app_name': website.slug,
         'log_path': user_paths.get('logs_path'),
         'webroot': website_paths.get('web_root'),
-        'socket_path': website_paths.get('socket_path')
+        'socket_path': website_paths.get('socket_path'),
+        'redirects': website.host_redirects(),
+        'force_https': website.force_https,
+        'mirror': mirror,
+        'backend': website.get_backend(),
+        'proxy_pass': proxyapps.proxy_pass(website),
+        'debug': {'key': website.debug_key, 'upstream': settings.FASTCP_PANEL_UPSTREAM} if website.debug_mode and website.debug_key else None,
+        'quota_exceeded': website.quota_exceeded,
+        'waf': waf.nginx_rules_file(website),
+        'access': access.nginx_access(website),
+        'basic_auth': basicauth.nginx_basic_auth(website)
     }
     
     # Vhost conf path
@@ -332,6 +412,8 @@ def create_nginx_vhost(website: object, **kwargs) -> bool:
         if i > 0:
             domains += ' '
         domains += domain.domain
+        if domain.wildcard:
+            domains += f' *.{domain.domain}'
         i += 1
     
     context['domains'] = domains
@@ -339,10 +421,7 @@ def create_nginx_vhost(website: object, **kwargs) -> bool:
     tpl_data = render_to_string(nginx_vhost_tpl_path, context=context)
     
     try:
-        with open(website_paths.get('ngix_vhost_conf'), 'w') as f:
-            f.write(tpl_data)
-        signals.restart_services.send(sender=None, services='nginx')
-        return True
+        return vhosts.apply_config(website, website_paths.get('ngix_vhost_conf'), tpl_data, 'nginx')
     except:
         return False
  
@@ -396,9 +475,10 @@ def create_website_dirs(website: object):
         # Create user dirs if missing
         create_user_dirs(website.user) 
         
-        # Website path
+        # Website path. Website data lives in its own volume so it can be
+        # snapshotted and cloned if the storage driver supports it.
         website_paths = get_website_paths(website)
-        create_if_missing(website_paths.get('base_path'))
+        volumes.get_driver().create(website_paths.get('base_path'))
         
         # Website public path
         create_if_missing(website_paths.get('web_root'))
@@ -410,6 +490,42 @@ def create_website_dirs(website: object):
     except:
         return False
 
+def create_default_page(website: object) -> bool:
+    """Create default page.
+    
+    Renders the default page of a new website to index.php and copies the placeholder assets, if the admin
+    has provided any in the default-site directory of the templates dir. The default page template can be
+    customized by the admin as system/default-index.txt in the templates dir.
+    
+    Args:
+        website (object): Website model object.
+        
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    web_root = get_website_paths(website).get('web_root')
+    if any(os.path.exists(os.path.join(web_root, name)) for name in ['index.php', 'index.html']):
+        return True
+    
+    context = {
+        'label': website.label,
+        'username': website.user.username,
+        'php': website.php,
+        'web_root': web_root,
+        'server_ip': settings.SERVER_IP_ADDR,
+        'site_name': settings.FASTCP_SITE_NAME
+    }
+    try:
+        assets_path = os.path.join(settings.FASTCP_TEMPLATES_DIR, 'default-site')
+        if os.path.isdir(assets_path):
+            shutil.copytree(assets_path, web_root, dirs_exist_ok=True)
+        with open(os.path.join(web_root, 'index.php'), 'w') as f:
+            f.write(render_to_string('system/default-index.txt', context))
+        return True
+    except:
+        return False
+
+
 def delete_dir(path: str) -> bool:
     """Delete a directory."""
     try:
@@ -422,13 +538,77 @@ def delete_website_dirs(website: object) -> bool:
     """Deletes website directories."""
     website_paths = get_website_paths(website)
     try:
-        delete_dir(website_paths.get('base_path'))
+        volumes.get_driver().delete(website_paths.get('base_path'))
         delete_dir(website_paths.get('tmp_path'))
         return True
     except:
         return False
     
 
+# Pool directives holding paths that must exist for the pool to work
+FPM_PATH_KEYS = [
+    'php_value[doc_root]', 'php_admin_value[doc_root]', 'php_value[sys_temp_dir]', 'php_value[upload_tmp_dir]',
+    'php_value[session.save_path]', 'php_value[opcache.lockfile_path]', 'env[TMPDIR]', 'php_value[open_basedir]', 'php_admin_value[open_basedir]'
+]
+
+
+def lint_fpm_conf(website: object, data: str) -> list:
+    """Lint FPM pool conf.
+    
+    Validates a rendered pool configuration before it replaces the live one. All paths referenced by the
+    pool must exist and the pool must pass php-fpm's own config test, which is run against a staged copy of
+    the pool directory so a single broken pool can never make the FPM service fail to reload for all sites.
+    
+    Args:
+        website (object): Website model object.
+        data (str): The rendered pool configuration.
+    
+    Returns:
+        list: A list of error strings, empty if the configuration is valid.
+    """
+    errors = []
+    paths = get_website_paths(website)
+    
+    for line in data.splitlines():
+        key, sep, value = line.partition('=')
+        key = key.strip()
+        if not sep:
+            continue
+        
+        if key in FPM_PATH_KEYS:
+            for path in value.strip().split(':'):
+                if path and not os.path.exists(path):
+                    errors.append(f'{key} points to {path} which does not exist.')
+        elif key == 'listen' and not os.path.isdir(os.path.dirname(value.strip())):
+            errors.append(f'The socket directory of {value.strip()} does not exist.')
+    
+    fpm_bin = f'/usr/sbin/php-fpm{website.php}'
+    if not errors and os.path.exists(fpm_bin):
+        with tempfile.TemporaryDirectory(prefix='fastcp-fpm-') as staging_dir:
+            pool_dir = os.path.join(staging_dir, 'pool.d')
+            os.makedirs(pool_dir)
+            
+            # Other pools are included too, so duplicate pool names are caught
+            fpm_root = paths.get('fpm_root')
+            if os.path.exists(fpm_root):
+                for name in os.listdir(fpm_root):
+                    if name.endswith('.conf') and name != os.path.basename(paths.get('fpm_path')):
+                        shutil.copy(os.path.join(fpm_root, name), pool_dir)
+            
+            with open(os.path.join(pool_dir, os.path.basename(paths.get('fpm_path'))), 'w') as f:
+                f.write(data)
+            
+            main_conf = os.path.join(staging_dir, 'php-fpm.conf')
+            with open(main_conf, 'w') as f:
+                f.write(f'[global]\npid = {staging_dir}/php-fpm.pid\nerror_log = /dev/null\ninclude = {pool_dir}/*.conf\n')
+            
+            result = run([fpm_bin, '-t', '-y', main_conf], stdout=PIPE, stderr=STDOUT, timeout=60)
+            if result.returncode != 0:
+                errors.append(result.stdout.decode().strip())
+    
+    return errors
+
+
 def generate_fpm_conf(website: object) -> bool:
     """Generate FPM pool conf.
 
@@ -455,12 +635,20 @@ def generate_fpm_conf(website: object) -> bool:
         'ssh_user': website.user.username,
         'ssh_group': website.user.username,
         'listen_group': 'www-data',
-        'socket_path': paths.get('socket_path')
+        'socket_path': paths.get('socket_path'),
+        'dev_extension': website.dev_extension if website.dev_mode_until and website.dev_mode_until > timezone.now() else None,
+        'extensions': [e for e in (website.php_extensions or '').split(',') if e]
     }
 
     # Render template data
     data = render_to_string('system/php-fpm-pool.txt', context)
 
+    # Never touch the live pools with a broken conf
+    errors = lint_fpm_conf(website, data)
+    if errors:
+        notify_admins(f'The PHP-FPM pool of {website} was rejected', details='\n'.join(errors), event='config')
+        return False
+
     # Write conf file
     try:
         with open(paths.get('fpm_path'), 'w') as f:
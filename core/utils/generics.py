@@ -1,6 +1,7 @@
 import psutil
 from core.models import Website, Database
 from datetime import datetime
+from core.utils.reboot import reboot_required
 
 
 MEMORY = psutil.virtual_memory()
@@ -38,6 +39,7 @@ def hardware_info():
     uptime = datetime.now() - datetime.fromtimestamp(psutil.boot_time())
     return {
         'uptime': str(uptime).split('.')[0],
+        'reboot_required': reboot_required().get('required'),
         'ram': {
             'memory': {
                 'total': MEMORY.total,
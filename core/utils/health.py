@@ -0,0 +1,68 @@
+import requests
+from subprocess import check_output, CalledProcessError, DEVNULL
+from core.models import Website
+
+
+# System services FastCP relies on, PHP-FPM services are added per PHP version in use
+CORE_SERVICES = ['nginx', 'apache2', 'mysql']
+
+# The systemd service of the panel itself
+PANEL_SERVICE = 'fastcp'
+
+
+def service_is_active(service: str) -> bool:
+    """Check either a systemd service is active or not.
+
+    Args:
+        service (str): The service name.
+
+    Returns:
+        bool: True if the service is active and False otherwise.
+    """
+    try:
+        output = check_output(['/usr/bin/systemctl', 'is-active', service], stderr=DEVNULL, timeout=30)
+    except CalledProcessError as e:
+        output = e.output
+    except FileNotFoundError:
+        return False
+    return output.decode().strip() == 'active'
+
+
+def fastcp_services() -> list:
+    """Returns the names of the services that FastCP manages."""
+    php_versions = Website.objects.values_list('php', flat=True).distinct()
+    return CORE_SERVICES + [f'php{version}-fpm' for version in sorted(php_versions)]
+
+
+def check_services() -> dict:
+    """Returns a dict of FastCP services with True as the value if the service is active."""
+    return {service: service_is_active(service) for service in fastcp_services()}
+
+
+def check_website(website: object) -> dict:
+    """Check website health.
+
+    Requests the homepage of a website from the local web server. A website is considered healthy if it
+    doesn't respond with a server error.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        dict: The domain checked, the HTTP status code (None if the request failed) and the health.
+    """
+    domain = website.domains.first()
+    if not domain:
+        return {'domain': None, 'status_code': None, 'healthy': False}
+
+    try:
+        res = requests.get('http://127.0.0.1/', headers={'Host': domain.domain}, timeout=15, allow_redirects=False)
+        status_code = res.status_code
+    except requests.RequestException:
+        status_code = None
+
+    return {
+        'domain': domain.domain,
+        'status_code': status_code,
+        'healthy': status_code is not None and status_code < 500
+    }
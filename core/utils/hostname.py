@@ -0,0 +1,86 @@
+import socket
+from django.conf import settings
+from core.utils.system import run_cmd
+
+
+HOSTS_PATH = '/etc/hosts'
+
+
+def _resolve(name: str) -> list:
+    """Returns the IPv4 addresses a name resolves to."""
+    try:
+        return socket.gethostbyname_ex(name)[2]
+    except (socket.gaierror, socket.herror, UnicodeError):
+        return []
+
+
+def _reverse(ip_addr: str) -> str:
+    """Returns the PTR record of an IP address or None if there is none."""
+    try:
+        return socket.gethostbyaddr(ip_addr)[0]
+    except (socket.gaierror, socket.herror, OSError):
+        return None
+
+
+def check_hostname() -> dict:
+    """Check hostname.
+
+    Validates the hostname and FQDN of the server, checks that the FQDN resolves to the server IP and that
+    the PTR record of the server IP points back to the FQDN. Mismatches here get outbound emails rejected
+    and may break Let's Encrypt validation of the hostname.
+
+    Returns:
+        dict: The hostname details and a list of warnings.
+    """
+    hostname = socket.gethostname()
+    fqdn = socket.getfqdn()
+    ip_addr = settings.SERVER_IP_ADDR
+    resolves_to = _resolve(fqdn)
+    ptr = _reverse(ip_addr) if ip_addr != 'N/A' else None
+
+    warnings = []
+    if '.' not in fqdn:
+        warnings.append(f'The hostname {fqdn} is not a fully qualified domain name. Mail servers will reject emails from this server.')
+    elif ip_addr != 'N/A' and ip_addr not in resolves_to:
+        warnings.append(f'{fqdn} does not resolve to the server IP {ip_addr}. Add an A record for it at your DNS provider.')
+
+    if ip_addr != 'N/A':
+        if not ptr:
+            warnings.append(f'The server IP {ip_addr} does not have a PTR record. Ask your hosting provider to set it to {fqdn}.')
+        elif ptr != fqdn:
+            warnings.append(f'The PTR record of {ip_addr} points to {ptr} instead of {fqdn}. Mail servers may reject emails from this server.')
+
+    return {
+        'hostname': hostname,
+        'fqdn': fqdn,
+        'ip_addr': ip_addr,
+        'resolves_to': resolves_to,
+        'ptr': ptr,
+        'warnings': warnings
+    }
+
+
+def set_hostname(fqdn: str) -> bool:
+    """Set hostname.
+
+    Sets the system hostname using hostnamectl and maps it to the loopback address in /etc/hosts so the
+    FQDN is resolvable locally.
+
+    Args:
+        fqdn (str): The fully qualified domain name, i.e. server1.example.com.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    if not run_cmd(f'/usr/bin/hostnamectl set-hostname {fqdn}'):
+        return False
+
+    with open(HOSTS_PATH) as f:
+        lines = f.readlines()
+
+    entry = f'127.0.1.1 {fqdn} {fqdn.split(".")[0]}\n'
+    lines = [line for line in lines if not line.startswith('127.0.1.1')]
+    lines.insert(1 if lines else 0, entry)
+    with open(HOSTS_PATH, 'w') as f:
+        f.writelines(lines)
+    return True
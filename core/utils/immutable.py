@@ -0,0 +1,93 @@
+import os
+from contextlib import contextmanager
+from subprocess import run, PIPE, DEVNULL
+from core.utils import filesystem
+
+
+# The files offered for locking, relative to the public directory of the website
+SUGGESTED_FILES = ['wp-config.php', 'index.php', '.htaccess', '.user.ini']
+
+
+def locked_files(website: object) -> list:
+    """Returns the files of a website that should be immutable, relative to its public directory."""
+    return [f for f in (website.immutable_files or '').split(',') if f]
+
+
+def clean_file(website: object, name: str) -> str:
+    """Returns a file path relative to the public directory, None if it, or a symlink on the way to it,
+    points outside of it."""
+    name = os.path.normpath(name.strip().strip('/')) if name.strip().strip('/') else ''
+    if not name or name.startswith('..') or os.path.isabs(name) or not file_path(website, name):
+        return None
+    return name
+
+
+def file_path(website: object, name: str) -> str:
+    """Returns the resolved path of a file of a website, None if the file, or a symlink on the way to it,
+    points outside of the public directory."""
+    root = os.path.realpath(filesystem.get_website_paths(website).get('web_root'))
+    path = os.path.realpath(os.path.join(root, name))
+    return path if path.startswith(root + '/') else None
+
+
+def is_immutable(path: str) -> bool:
+    """Returns True if the immutable attribute of a file is set."""
+    if not path or not os.path.isfile(path) or os.path.islink(path):
+        return False
+    res = run(['/usr/bin/lsattr', '-d', path], stdout=PIPE, stderr=DEVNULL)
+    return res.returncode == 0 and 'i' in res.stdout.decode(errors='replace').split(' ')[0]
+
+
+def set_immutable(path: str, immutable: bool) -> bool:
+    """Sets or clears the immutable attribute of a file, symlinks are never followed."""
+    if not path or not os.path.isfile(path) or os.path.islink(path):
+        return False
+    return run(['/usr/bin/chattr', '+i' if immutable else '-i', path], stdout=DEVNULL, stderr=DEVNULL).returncode == 0
+
+
+def file_states(website: object) -> list:
+    """Returns the suggested and the locked files of a website, with whether they exist, should be locked and are locked."""
+    locked = locked_files(website)
+    names = SUGGESTED_FILES + [f for f in locked if f not in SUGGESTED_FILES]
+    return [{
+        'file': name,
+        'exists': bool(file_path(website, name)) and os.path.isfile(file_path(website, name)),
+        'locked': name in locked,
+        'immutable': is_immutable(file_path(website, name))
+    } for name in names]
+
+
+def apply(website: object) -> list:
+    """Makes the locked files of a website immutable and the other suggested files mutable again.
+    Returns the locked files that cannot be made immutable, i.e. because they don't exist."""
+    locked = locked_files(website)
+    failed = []
+    for name in set(locked + SUGGESTED_FILES):
+        path = file_path(website, name)
+        if name in locked:
+            if not is_immutable(path) and not set_immutable(path, True):
+                failed.append(name)
+        elif is_immutable(path):
+            set_immutable(path, False)
+    return sorted(failed)
+
+
+@contextmanager
+def lifted(website: object):
+    """Lifted.
+
+    Clears the immutable attribute of the locked files of a website for the duration of a managed change,
+    like a restore or a staging push, and sets it back afterwards even if the change fails.
+
+    Args:
+        website (object): Website model object.
+    """
+    paths = [file_path(website, name) for name in locked_files(website)]
+    paths = [p for p in paths if is_immutable(p)]
+    for path in paths:
+        set_immutable(path, False)
+    try:
+        yield
+    finally:
+        for path in paths:
+            set_immutable(path, True)
@@ -0,0 +1,138 @@
+import os, json, hashlib
+from datetime import timedelta
+from django.conf import settings
+from django.db.models import Q
+from django.utils import timezone
+from core.models import Website, User
+from core.utils.filesystem import get_website_paths
+from core.utils.notifications import notify_users
+
+
+# The files whose changes are a sign of a compromise
+WATCHED_EXTENSIONS = ['.php', '.php5', '.php7', '.phtml', '.phar', '.inc', '.htaccess', '.user.ini']
+
+# Larger files are not hashed, the PHP files of a website are much smaller
+MAX_FILE_BYTES = 20 * 1024 * 1024
+
+
+def is_watched(name: str) -> bool:
+    return name in WATCHED_EXTENSIONS or os.path.splitext(name)[1].lower() in WATCHED_EXTENSIONS
+
+
+def baseline_path(website: object) -> str:
+    return os.path.join(settings.FASTCP_INTEGRITY_DIR, f'{website.id}.json')
+
+
+def report_path(website: object) -> str:
+    return os.path.join(settings.FASTCP_INTEGRITY_DIR, f'{website.id}.report.json')
+
+
+def hash_files(website: object) -> dict:
+    """Returns the SHA-256 of the watched files of a website, by their path relative to the public directory.
+    Symlinks are not followed."""
+    web_root = get_website_paths(website).get('web_root')
+    hashes = {}
+    for root, dirs, files in os.walk(web_root):
+        for name in files:
+            path = os.path.join(root, name)
+            if not is_watched(name) or os.path.islink(path):
+                continue
+            try:
+                if os.path.getsize(path) > MAX_FILE_BYTES:
+                    continue
+                digest = hashlib.sha256()
+                with open(path, 'rb') as f:
+                    for chunk in iter(lambda: f.read(1024 * 1024), b''):
+                        digest.update(chunk)
+            except OSError:
+                continue
+            hashes[os.path.relpath(path, web_root)] = digest.hexdigest()
+    return hashes
+
+
+def _load(path: str) -> dict:
+    try:
+        with open(path) as f:
+            return json.load(f)
+    except (OSError, ValueError):
+        return None
+
+
+def _save(path: str, data: dict) -> None:
+    os.makedirs(settings.FASTCP_INTEGRITY_DIR, mode=0o700, exist_ok=True)
+    with open(path, 'w') as f:
+        json.dump(data, f)
+
+
+def save_baseline(website: object) -> dict:
+    """Hashes the watched files of a website as the known good state and clears the last report."""
+    baseline = {'created': timezone.now().isoformat(), 'files': hash_files(website)}
+    _save(baseline_path(website), baseline)
+    if os.path.exists(report_path(website)):
+        os.remove(report_path(website))
+    return baseline
+
+
+def rebaseline(website: object) -> None:
+    """Takes a new baseline after a managed change of a website, like a restore or a staging push, so the
+    change isn't reported as a compromise."""
+    if website.monitor_integrity:
+        save_baseline(website)
+
+
+def delete_baseline(website: object) -> None:
+    for path in [baseline_path(website), report_path(website)]:
+        if os.path.exists(path):
+            os.remove(path)
+
+
+def compare(website: object) -> dict:
+    """Compare.
+
+    Compares the watched files of a website with its baseline and saves the result as the last report.
+    A website without a baseline gets one.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        dict: The added, modified and removed files, along with when the baseline and the check were made.
+    """
+    baseline = _load(baseline_path(website))
+    if not baseline:
+        baseline = save_baseline(website)
+    known, current = baseline.get('files'), hash_files(website)
+    report = {
+        'baseline': baseline.get('created'),
+        'checked': timezone.now().isoformat(),
+        'added': sorted(p for p in current if p not in known),
+        'modified': sorted(p for p in current if p in known and current.get(p) != known.get(p)),
+        'removed': sorted(p for p in known if p not in current),
+    }
+    _save(report_path(website), report)
+    return report
+
+
+def last_report(website: object) -> dict:
+    return _load(report_path(website))
+
+
+def check_websites() -> int:
+    """Compares the websites that monitor their integrity with their baselines and alerts the owners and the
+    admins about the unexpected changes, once a day per website until the changes are accepted. Returns the
+    number of websites with changes."""
+    changed = 0
+    for website in Website.objects.filter(monitor_integrity=True).select_related('user'):
+        report = compare(website)
+        count = sum(len(report.get(kind)) for kind in ['added', 'modified', 'removed'])
+        if not count:
+            continue
+        changed += 1
+        examples = ', '.join((report.get('added') + report.get('modified') + report.get('removed'))[:5])
+        users = User.objects.filter(Q(is_superuser=True) | Q(id=website.user_id))
+        notify_users(
+            users, f'{count} PHP files of {website} changed unexpectedly',
+            details=f'Added: {len(report.get("added"))}, modified: {len(report.get("modified"))}, removed: {len(report.get("removed"))}. {examples}',
+            once_every=timedelta(days=1), event='integrity'
+        )
+    return changed
@@ -0,0 +1,162 @@
+import os, json, time, fcntl, threading
+import psutil
+from django.conf import settings
+from django.db import connection
+from django.utils import timezone
+from rest_framework import status
+from rest_framework.exceptions import APIException
+from core.models import Job
+from core.utils import notifications, audit
+
+
+# The most per-path results a job keeps, the counts are always complete
+MAX_RESULTS = 1000
+
+# The job kinds the users are notified about when they finish
+NOTIFY_KINDS = {
+    'server_backup': 'Server backup',
+    'export_website': 'Website export',
+    'restore': 'Restore',
+    'import_database': 'Database import',
+}
+
+# The job kinds that run fewer at once than FASTCP_JOB_CONCURRENCY
+KIND_CONCURRENCY = {
+    'server_backup': 1,
+    'dev_mode': 1,
+    'rotate_credentials_key': 1,
+}
+
+# Held while a queued job takes a free slot, so the panel processes don't take the same slot
+SLOTS_LOCK_PATH = '/var/fastcp/.config/jobs.lock'
+
+
+class QueueFull(APIException):
+    """Raised when too many jobs of a kind are waiting already, the API returns 429 for it."""
+    status_code = status.HTTP_429_TOO_MANY_REQUESTS
+
+    def __init__(self, kind: str):
+        super().__init__({'message': f'Too many {kind.replace("_", " ")} jobs are waiting already. Please try again once they finish.'})
+
+
+def _reap_dead_jobs() -> None:
+    """Fails the jobs whose panel process is gone, i.e. the panel was restarted while they were waiting
+    or running, so they don't hold their slot forever."""
+    for job in Job.objects.filter(state__in=['queued', 'running']):
+        # The jobs without a PID were started before the panel was upgraded, so their process is gone
+        if job.pid is None or not psutil.pid_exists(job.pid):
+            job.state = 'failed'
+            job.error = 'The job was interrupted because the panel restarted.'
+            job.finished = timezone.now()
+            job.save()
+
+
+def concurrency(kind: str) -> int:
+    return KIND_CONCURRENCY.get(kind, settings.FASTCP_JOB_CONCURRENCY)
+
+
+def queue_position(job: object) -> int:
+    """Returns the position of a waiting job in the queue of its kind, starting from 1, None if the job
+    isn't waiting."""
+    if job.state != 'queued':
+        return None
+    return Job.objects.filter(kind=job.kind, state='queued', id__lt=job.id).count() + 1
+
+
+def start_job(user: object, kind: str, target: str, params: dict, func) -> object:
+    """Start job.
+
+    Creates a job and runs it in a background thread, so the API can return the job right away and the
+    panel polls it for the progress and the results. Only so many jobs of a kind run at once, the others
+    wait in the queue in order. A job that is the same as one waiting or running already is not started
+    again for the same user, the existing one is returned instead, so clients retrying aggressively don't
+    pile up jobs.
+
+    Args:
+        user (object): User model object who started the job.
+        kind (str): The job kind, i.e. fix_ownership.
+        target (str): What the job runs on, i.e. the website label.
+        params (dict): JSON serializable params of the job.
+        func (callable): Runs the job, it's called with the job and the params and returns the results.
+
+    Raises:
+        QueueFull: If FASTCP_JOB_QUEUE_LIMIT jobs of the kind are waiting already.
+
+    Returns:
+        object: The job model object.
+    """
+    _reap_dead_jobs()
+    existing = Job.objects.filter(user=user, kind=kind, target=target, params=json.dumps(params), state__in=['queued', 'running']).first()
+    if existing:
+        return existing
+    if Job.objects.filter(kind=kind, state='queued').count() >= settings.FASTCP_JOB_QUEUE_LIMIT:
+        raise QueueFull(kind)
+
+    job = Job.objects.create(user=user, kind=kind, target=target, params=json.dumps(params), pid=os.getpid())
+    threading.Thread(target=_run_job, args=(job, params, func, audit.current_request_id()), daemon=True).start()
+    return job
+
+
+def _take_slot(job: object) -> bool:
+    """Starts a waiting job if a slot of its kind is free and no job of the kind has waited longer."""
+    os.makedirs(os.path.dirname(SLOTS_LOCK_PATH), exist_ok=True)
+    with open(SLOTS_LOCK_PATH, 'a') as f:
+        fcntl.flock(f, fcntl.LOCK_EX)
+        _reap_dead_jobs()
+        running = Job.objects.filter(kind=job.kind, state='running').count()
+        first = Job.objects.filter(kind=job.kind, state='queued').order_by('id').first()
+        if running >= concurrency(job.kind) or not first or first.id != job.id:
+            return False
+        job.state = 'running'
+        job.save()
+        return True
+
+
+def _run_job(job: object, params: dict, func, request_id: str = None) -> None:
+    while not _take_slot(job):
+        time.sleep(2)
+    try:
+        job.results = json.dumps(func(job, params))
+        job.state = 'done'
+    except Exception as e:
+        job.error = str(e)
+        job.state = 'failed'
+    job.finished = timezone.now()
+    job.save()
+    audit.record_job(job, request_id)
+    if job.kind in NOTIFY_KINDS and job.user:
+        name = NOTIFY_KINDS.get(job.kind)
+        if job.state == 'done':
+            title = f'{name} of {job.target} has finished'
+        else:
+            title = f'{name} of {job.target} has failed'
+        notifications.notify_users([job.user], title, details=job.error, event='jobs')
+    # The thread has its own database connection
+    connection.close()
+
+
+def report_progress(job: object, progress: int, total: int = None, results: dict = None) -> None:
+    """Saves the progress of a running job, along with the results so far if provided."""
+    job.progress = progress
+    if total is not None:
+        job.total = total
+    if results is not None:
+        job.results = json.dumps(results)
+    job.save(update_fields=['progress', 'total', 'results'])
+
+
+def serialize_job(job: object) -> dict:
+    return {
+        'id': job.id,
+        'kind': job.kind,
+        'target': job.target,
+        'params': json.loads(job.params),
+        'state': job.state,
+        'queue_position': queue_position(job),
+        'progress': job.progress,
+        'total': job.total,
+        'results': json.loads(job.results) if job.results else None,
+        'error': job.error,
+        'created': job.created,
+        'finished': job.finished
+    }
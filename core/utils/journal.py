@@ -0,0 +1,163 @@
+import os, pwd, json
+import psutil
+from django.utils import timezone
+from core.models import Operation, Website, User, MailDomain
+from core.utils import filesystem, system, mail, integrity, dependencies, seo
+
+
+def _website(params: dict) -> object:
+    """Returns a detached copy of a deleted website, enough for the filesystem helpers to find its paths."""
+    return Website(id=params.get('id'), label=params.get('label'), slug=params.get('slug'), php=params.get('php'),
+                   user=User(id=params.get('user_id'), username=params.get('username')))
+
+
+def _user(params: dict) -> object:
+    return User(id=params.get('id'), username=params.get('username'))
+
+
+def _removed(deleted: bool, *paths: str) -> bool:
+    """The delete helpers also return False when there was nothing left to delete, i.e. on replay, so a
+    delete only fails if the paths are still there."""
+    return deleted or not any(os.path.lexists(path) for path in paths)
+
+
+def _website_paths(params: dict, *keys: str) -> list:
+    paths = filesystem.get_website_paths(_website(params))
+    return [paths.get(key) for key in keys]
+
+
+def _delete_system_user(params: dict) -> bool:
+    username = params.get('username')
+    if system.run_cmd(f'/usr/sbin/userdel {username}'):
+        return True
+    try:
+        pwd.getpwnam(username)
+        return False
+    except KeyError:
+        return True
+
+
+# The steps of each operation, in order. A step fails if it raises or returns False, and it may run twice
+# on replay, so each one has to be idempotent.
+OPERATIONS = {
+    'delete_website': [
+        ('Delete website directories', lambda p: _removed(
+            filesystem.delete_website_dirs(_website(p)), *_website_paths(p, 'base_path', 'tmp_path'))),
+        ('Delete PHP-FPM pool', lambda p: _removed(
+            filesystem.delete_fpm_conf(_website(p)), *_website_paths(p, 'fpm_path'))),
+        ('Delete NGINX vhost', lambda p: _removed(
+            filesystem.delete_nginx_vhost(_website(p)), *_website_paths(p, 'ngix_vhost_dir', 'ngix_vhost_conf'))),
+        ('Delete Apache vhost', lambda p: _removed(
+            filesystem.delete_apache_vhost(_website(p)), *_website_paths(p, 'apache_vhost_dir', 'apache_vhost_conf'))),
+        ('Delete SSL certificates', lambda p: filesystem.delete_ssl_certs(_website(p))),
+        ('Delete emails', lambda p: [mail.delete_domain_data(MailDomain(domain=d)) for d in p.get('mail_domains', [])]),
+        ('Delete integrity, dependency and SEO reports', lambda p: [
+            integrity.delete_baseline(_website(p)), dependencies.delete_report(_website(p)), seo.delete_report(_website(p))]),
+    ],
+    'delete_user': [
+        ('Unmount temp directory', lambda p: system.unmount_user_tmp(_user(p))),
+        ('Delete user directories', lambda p: _removed(
+            filesystem.delete_user_dirs(_user(p)), filesystem.get_user_paths(_user(p)).get('base_path'))),
+        ('Delete system user', _delete_system_user),
+    ],
+}
+
+
+def website_params(website: object) -> dict:
+    return {
+        'id': website.id,
+        'label': website.label,
+        'slug': website.slug,
+        'php': website.php,
+        'user_id': website.user.id,
+        'username': website.user.username,
+        'mail_domains': list(website.mail_domains.values_list('domain', flat=True))
+    }
+
+
+def user_params(user: object) -> dict:
+    return {'id': user.id, 'username': user.username}
+
+
+def _run_steps(operation: object) -> bool:
+    """Runs the steps of an operation from the first step not done, saving the progress after each step."""
+    steps = OPERATIONS.get(operation.kind)
+    params = json.loads(operation.params)
+    for name, step in steps[operation.completed:]:
+        try:
+            # Some helpers report a failure by returning False instead of raising
+            if step(params) is False:
+                raise RuntimeError('The step did not succeed.')
+        except Exception as e:
+            operation.error = f'{name}: {e}'
+            operation.save()
+            return False
+        operation.completed += 1
+        operation.save()
+    return True
+
+
+def run_operation(kind: str, target: str, params: dict) -> bool:
+    """Run operation.
+
+    Runs the steps of a multi-step operation and journals the progress, so the operation can be resumed
+    by recover_operations if the process dies half way.
+
+    Args:
+        kind (str): The operation, one of OPERATIONS.
+        target (str): What the operation is run on, i.e. the website label.
+        params (dict): JSON serializable values the steps need.
+
+    Returns:
+        bool: True if all steps succeeded and False otherwise.
+    """
+    operation = Operation.objects.create(kind=kind, target=target, params=json.dumps(params), pid=os.getpid())
+    succeeded = _run_steps(operation)
+    operation.state = 'done' if succeeded else 'failed'
+    operation.finished = timezone.now()
+    operation.save()
+    return succeeded
+
+
+def is_interrupted(operation: object) -> bool:
+    """Returns True if the process that ran the operation is gone. A PID reused by a newer process doesn't count."""
+    try:
+        return psutil.Process(operation.pid).create_time() > operation.started.timestamp()
+    except (psutil.NoSuchProcess, TypeError, ValueError):
+        return True
+
+
+def recover_operations() -> list:
+    """Recover operations.
+
+    Resumes the operations whose process died before they finished. The steps done are not repeated, the
+    operation continues from the step it stopped at.
+
+    Returns:
+        list: The recovered operations.
+    """
+    recovered = []
+    for operation in Operation.objects.filter(state='running').order_by('started'):
+        if operation.kind not in OPERATIONS or not is_interrupted(operation):
+            continue
+        retry_operation(operation)
+        recovered.append(operation)
+    return recovered
+
+
+def retry_operation(operation: object) -> bool:
+    """Resumes a failed operation from the step that failed."""
+    operation.pid = os.getpid()
+    operation.error = None
+    operation.state = 'running'
+    operation.save()
+    succeeded = _run_steps(operation)
+    operation.state = 'recovered' if succeeded else 'failed'
+    operation.finished = timezone.now()
+    operation.save()
+    return succeeded
+
+
+def operation_steps(operation: object) -> list:
+    """Returns the steps of an operation along with whether each one is done."""
+    return [{'name': name, 'done': i < operation.completed} for i, (name, step) in enumerate(OPERATIONS.get(operation.kind, []))]
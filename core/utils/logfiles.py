@@ -0,0 +1,165 @@
+import os, re, json, stat
+from datetime import datetime, timezone
+from django.conf import settings
+from django.template.loader import render_to_string
+from core.models import User
+from core.utils import logstream
+from core.utils.filesystem import get_user_paths
+from core.utils.system import run_cmd
+
+
+LOGROTATE_CONF_PATH = '/etc/logrotate.d/fastcp'
+POLICY_PATH = '/var/fastcp/.config/logrotate.json'
+
+DEFAULT_POLICY = {
+    'frequency': 'daily',
+    'rotate': 14,
+    'max_size_mb': 100,
+    'compress': True,
+}
+FREQUENCIES = ['daily', 'weekly', 'monthly']
+
+# The rotated logs, i.e. example_nginx.access_ssl.log.1 or .2.gz
+ROTATED_RE = re.compile(r'\.\d+(\.gz)?$')
+
+
+def list_logs(user: object) -> list:
+    """Returns the log files in the logs directory of a user, the rotated ones included, newest first."""
+    logs_path = get_user_paths(user).get('logs_path')
+    slugs = list(user.websites.values_list('slug', flat=True))
+    logs = []
+    try:
+        entries = list(os.scandir(logs_path))
+    except OSError:
+        return []
+    for entry in entries:
+        try:
+            info = entry.stat(follow_symlinks=False)
+        except OSError:
+            continue
+        if not stat.S_ISREG(info.st_mode):
+            continue
+        logs.append({
+            'name': entry.name,
+            'website': next((s for s in slugs if entry.name.startswith(f'{s}_')), None),
+            'kind': 'access' if 'access' in entry.name else 'error',
+            'rotated': bool(ROTATED_RE.search(entry.name)),
+            'compressed': entry.name.endswith('.gz'),
+            'size': info.st_size,
+            'modified': datetime.fromtimestamp(info.st_mtime, tz=timezone.utc)
+        })
+    logs.sort(key=lambda l: l.get('modified'), reverse=True)
+    return logs
+
+
+def lines_backwards(f: object, block: int = 65536):
+    """Yields the lines of a file from the last one to the first one."""
+    f.seek(0, os.SEEK_END)
+    position = f.tell()
+    remainder = b''
+    while position > 0:
+        start = max(0, position - block)
+        f.seek(start)
+        data = f.read(position - start) + remainder
+        position = start
+        lines = data.split(b'\n')
+        remainder = lines.pop(0)
+        for line in reversed(lines):
+            if line:
+                yield line.decode(errors='replace')
+    if remainder:
+        yield remainder.decode(errors='replace')
+
+
+def read_log(user: object, name: str, page: int = 1, per_page: int = 100, search: str = None, level: str = None) -> dict:
+    """Read log.
+
+    Reads a page of the lines of a log file of a user, newest first. The file is read back from the end
+    as far as the page needs only, so huge logs are cheap to page through. Compressed logs cannot be read.
+
+    Args:
+        user (object): User model object.
+        name (str): The name of the log file in the logs directory.
+        page (int): The page number.
+        per_page (int): The number of lines per page.
+        search (str): Only return the lines that contain this text, case insensitive.
+        level (str): Only return the lines of this level or more severe, one of logstream.LEVELS.
+
+    Returns:
+        dict: The lines of the page along with the next and the previous page numbers, None if the log
+            cannot be read.
+    """
+    if '/' in name or name.endswith('.gz'):
+        return None
+    logs_path = get_user_paths(user).get('logs_path')
+    f = logstream.open_log(os.path.join(logs_path, name), logs_path)
+    if not f:
+        return None
+
+    kind = 'access' if 'access' in name else 'error'
+    allowed = logstream.LEVELS[:logstream.LEVELS.index(level) + 1] if level else None
+    needle = search.lower() if search else None
+    skip = (page - 1) * per_page
+    lines = []
+    with f:
+        for line in lines_backwards(f):
+            if needle and needle not in line.lower():
+                continue
+            line_lvl = logstream.line_level(line, kind)
+            if allowed and line_lvl not in allowed:
+                continue
+            if skip:
+                skip -= 1
+                continue
+            lines.append({'level': line_lvl, 'line': line})
+            # One more line than the page tells if there is a next page
+            if len(lines) > per_page:
+                break
+    return {
+        'name': name,
+        'links': {
+            'next': page + 1 if len(lines) > per_page else None,
+            'previous': page - 1 if page > 1 else None
+        },
+        'results': lines[:per_page]
+    }
+
+
+def rotation_policy() -> dict:
+    try:
+        with open(POLICY_PATH) as f:
+            return {**DEFAULT_POLICY, **json.loads(f.read())}
+    except (OSError, ValueError):
+        return dict(DEFAULT_POLICY)
+
+
+def write_logrotate(policy: dict = None) -> bool:
+    """Write logrotate config.
+
+    Saves the rotation policy and writes the logrotate config of the website logs and the FastCP logs from
+    it, with a block per user. The logs are rotated by age and also once they grow over the size limit, so a busy website
+    cannot fill up the disk between two rotations.
+
+    Args:
+        policy (dict): The new policy, the saved one is written again if None.
+
+    Returns:
+        bool: True if logrotate accepts the config.
+    """
+    policy = {**rotation_policy(), **(policy or {})}
+    os.makedirs(os.path.dirname(POLICY_PATH), exist_ok=True)
+    with open(POLICY_PATH, 'w') as f:
+        f.write(json.dumps(policy))
+
+    # The users can write to their logs directories, so their logs are rotated as them
+    blocks = []
+    for user in User.objects.filter(is_superuser=False).order_by('username'):
+        logs_path = get_user_paths(user).get('logs_path')
+        if os.path.isdir(logs_path):
+            blocks.append({'logs': os.path.join(logs_path, '*.log'), 'username': user.username})
+    blocks.append({'logs': f'{os.path.join(settings.FASTCP_LOG_DIR, "*.log")} {os.path.join(settings.FASTCP_LOG_DIR, "modsec", "*.log")}'})
+
+    config = render_to_string('system/logrotate.txt', {**policy, 'blocks': blocks})
+    with open(LOGROTATE_CONF_PATH, 'w') as f:
+        f.write(config)
+    return run_cmd(f'/usr/sbin/logrotate --debug {LOGROTATE_CONF_PATH}')
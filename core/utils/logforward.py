@@ -0,0 +1,156 @@
+import os, ssl, queue, socket, logging
+from logging.handlers import QueueHandler, QueueListener
+from datetime import datetime, timezone
+
+
+# Where the access logs of the websites are forwarded from and the rsyslog config that forwards them
+SITE_LOGS_CONF_PATH = '/etc/rsyslog.d/60-fastcp-sites.conf'
+DEFAULT_CA_FILE = '/etc/ssl/certs/ca-certificates.crt'
+
+# The syslog facility of the panel logs, local0
+FACILITY = 16
+SEVERITIES = {
+    logging.DEBUG: 7,
+    logging.INFO: 6,
+    logging.WARNING: 4,
+    logging.ERROR: 3,
+    logging.CRITICAL: 2,
+}
+
+
+class RemoteSyslogHandler(logging.Handler):
+    """Remote syslog handler.
+
+    Sends the log records as RFC 5424 messages over TCP with octet-counted framing, wrapped in TLS by
+    default as RFC 5425 requires, which both rsyslog and Vector accept. The standard SysLogHandler
+    cannot do TLS. The connection is opened on the first record and reopened once if it was dropped.
+    """
+    def __init__(self, host: str, port: int = 6514, tls: bool = True, ca_file: str = None, app_name: str = 'fastcp'):
+        super().__init__()
+        self.host = host
+        self.port = port
+        self.tls = tls
+        self.ca_file = ca_file
+        self.app_name = app_name
+        self.hostname = socket.gethostname()
+        self.sock = None
+
+    def _connect(self) -> None:
+        sock = socket.create_connection((self.host, self.port), timeout=5)
+        if self.tls:
+            context = ssl.create_default_context(cafile=self.ca_file)
+            sock = context.wrap_socket(sock, server_hostname=self.host)
+        self.sock = sock
+
+    def _close(self) -> None:
+        if self.sock:
+            try:
+                self.sock.close()
+            except OSError:
+                pass
+        self.sock = None
+
+    def frame(self, record: logging.LogRecord) -> bytes:
+        """Returns a record as an octet-counted RFC 5424 message."""
+        priority = FACILITY * 8 + SEVERITIES.get(record.levelno, 6)
+        timestamp = datetime.fromtimestamp(record.created, tz=timezone.utc).isoformat()
+        message = f'<{priority}>1 {timestamp} {self.hostname} {self.app_name} {os.getpid()} {record.name} - {self.format(record)}'.encode()
+        return f'{len(message)} '.encode() + message
+
+    def emit(self, record: logging.LogRecord) -> None:
+        try:
+            data = self.frame(record)
+        except Exception:
+            self.handleError(record)
+            return
+        for attempt in range(2):
+            try:
+                if not self.sock:
+                    self._connect()
+                self.sock.sendall(data)
+                return
+            except OSError:
+                self._close()
+                if attempt:
+                    self.handleError(record)
+
+    def close(self) -> None:
+        self.acquire()
+        try:
+            self._close()
+        finally:
+            self.release()
+        super().close()
+
+
+class QueuedSyslogHandler(QueueHandler):
+    """Queued syslog handler.
+
+    Puts the log records on a bounded queue that a RemoteSyslogHandler sends from a thread of its own, so
+    the requests logging them never wait on a slow or unreachable endpoint. The records are dropped while
+    the queue is full.
+    """
+    def __init__(self, max_records: int = 10000, **kwargs):
+        super().__init__(queue.Queue(max_records))
+        self.remote = RemoteSyslogHandler(**kwargs)
+        self.listener = QueueListener(self.queue, self.remote)
+        self.listener.start()
+
+    def enqueue(self, record: logging.LogRecord) -> None:
+        try:
+            self.queue.put_nowait(record)
+        except queue.Full:
+            pass
+
+    def close(self) -> None:
+        if self.listener:
+            try:
+                self.listener.stop()
+            except queue.Full:
+                # The listener thread is a daemon, it stops with the process
+                pass
+            self.listener = None
+        self.remote.close()
+        super().close()
+
+
+def sync_site_forwarding() -> bool:
+    """Sync site forwarding.
+
+    Writes the rsyslog config that tails the access logs of all websites and forwards them to the remote
+    syslog endpoint, or removes it if forwarding the site logs is off, then restarts rsyslog if the config
+    changed. rsyslog does the forwarding so the logs keep flowing while the panel is down.
+
+    Returns:
+        bool: True if the config changed.
+    """
+    # The handler is loaded while the settings are configured, before the models can be imported
+    from django.conf import settings
+    from django.template.loader import render_to_string
+    from core.utils import system
+
+    current = None
+    if os.path.exists(SITE_LOGS_CONF_PATH):
+        with open(SITE_LOGS_CONF_PATH) as f:
+            current = f.read()
+
+    config = None
+    if settings.FASTCP_SYSLOG_HOST and settings.FASTCP_SYSLOG_SITE_LOGS:
+        config = render_to_string('system/rsyslog-forward.txt', {
+            'host': settings.FASTCP_SYSLOG_HOST,
+            'port': settings.FASTCP_SYSLOG_PORT,
+            'tls': not settings.FASTCP_SYSLOG_PLAIN,
+            'ca_file': settings.FASTCP_SYSLOG_CA_FILE or DEFAULT_CA_FILE,
+            'logs_glob': os.path.join(settings.FILE_MANAGER_ROOT, '*', 'logs', '*access*.log')
+        })
+    if config == current:
+        return False
+
+    if config is None:
+        os.remove(SITE_LOGS_CONF_PATH)
+    else:
+        os.makedirs(os.path.dirname(SITE_LOGS_CONF_PATH), exist_ok=True)
+        with open(SITE_LOGS_CONF_PATH, 'w') as f:
+            f.write(config)
+    system.run_cmd('/usr/bin/systemctl restart rsyslog')
+    return True
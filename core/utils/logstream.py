@@ -0,0 +1,168 @@
+import os, re, json, time, stat
+from django.conf import settings
+from django.core.serializers.json import DjangoJSONEncoder
+from core.utils import metrics
+from core.utils.filesystem import get_user_paths
+
+
+# The levels to filter the log lines by, most severe first
+LEVELS = ['fatal', 'error', 'warning', 'notice']
+
+PHP_LEVEL_RE = re.compile(r'PHP (Fatal error|Parse error|Recoverable fatal error|Warning|Notice|Deprecated)')
+PHP_LEVELS = {
+    'Fatal error': 'fatal',
+    'Parse error': 'fatal',
+    'Recoverable fatal error': 'error',
+    'Warning': 'warning',
+    'Notice': 'notice',
+    'Deprecated': 'notice',
+}
+# [php7:error] in the Apache error logs and [error] in the NGINX error logs
+SERVER_LEVEL_RE = re.compile(r'\[(?:[\w-]+:)?(emerg|alert|crit|error|warn|notice|info|debug)\]')
+SERVER_LEVELS = {
+    'emerg': 'fatal',
+    'alert': 'fatal',
+    'crit': 'fatal',
+    'error': 'error',
+    'warn': 'warning',
+}
+ACCESS_STATUS_RE = re.compile(r'" (\d{3}) ')
+
+# Seconds between comments sent to idle streams, so proxies don't close them
+KEEPALIVE_SECONDS = 15
+
+
+def site_logs(website: object) -> dict:
+    """Returns the paths of the error and access logs of a website. The PHP errors end up in the Apache
+    error log, or in the NGINX error log if the website runs on NGINX only or proxies to an app."""
+    logs_path = get_user_paths(website.user).get('logs_path')
+    if website.get_backend() in ['nginx', 'proxy']:
+        error_log = os.path.join(logs_path, f'{website.slug}_nginx.error_ssl.log')
+    else:
+        error_log = os.path.join(logs_path, f'{website.slug}_apache.error.log')
+    return {
+        'error': error_log,
+        'access': metrics.access_log_path(website)
+    }
+
+
+def line_level(line: str, kind: str) -> str:
+    """Returns the level of a log line, one of LEVELS. The access log lines get their level from the
+    status code, 5xx are errors and 4xx are warnings."""
+    if kind == 'access':
+        match = ACCESS_STATUS_RE.search(line)
+        code = int(match.group(1)) if match else 200
+        return 'error' if code >= 500 else 'warning' if code >= 400 else 'notice'
+    match = PHP_LEVEL_RE.search(line)
+    if match:
+        return PHP_LEVELS.get(match.group(1))
+    match = SERVER_LEVEL_RE.search(line)
+    return SERVER_LEVELS.get(match.group(1), 'notice') if match else 'notice'
+
+
+def open_log(path: str, allowed_dir: str) -> object:
+    """Opens a log file for reading if it's a regular file inside the allowed directory.
+
+    The logs directories are writable by the users, so a log file could have been swapped for a symlink
+    to a file they cannot read. Symlinks are never followed and the directory the file is in must be the
+    allowed one.
+
+    Returns:
+        object: The file object, None if the file doesn't exist or cannot be read.
+    """
+    if os.path.realpath(os.path.dirname(path)) != os.path.realpath(allowed_dir):
+        return None
+    try:
+        fd = os.open(path, os.O_RDONLY | os.O_NOFOLLOW | os.O_NONBLOCK)
+    except OSError:
+        return None
+    if not stat.S_ISREG(os.fstat(fd).st_mode):
+        os.close(fd)
+        return None
+    return os.fdopen(fd, 'rb')
+
+
+def follow_log(path: str, allowed_dir: str, kind: str, level: str = None, offset: int = None, lines: int = 50):
+    """Follow a log file.
+
+    Yields the lines of a log file as they are written, for up to FASTCP_LOG_FOLLOW_SECONDS, along with
+    the offset after each line. Clients continue following from the offset of the last line they got. If
+    the log was rotated or truncated since, following starts over from the beginning of the new file.
+
+    Args:
+        path (str): The log file path.
+        allowed_dir (str): The directory the log file must be in.
+        kind (str): Either error or access.
+        level (str): Only yield the lines of this level or more severe, one of LEVELS.
+        offset (int): Start at this offset instead of the last lines.
+        lines (int): The number of last lines to start with if there is no offset.
+
+    Yields:
+        dict: The lines, or None while there are no new lines for a while.
+    """
+    f = open_log(path, allowed_dir)
+    if not f:
+        return
+    allowed = LEVELS[:LEVELS.index(level) + 1] if level else LEVELS
+    size = os.fstat(f.fileno()).st_size
+    if offset is None or offset > size:
+        # Start with the last lines, found by reading back from the end
+        position = size
+        data = b''
+        while position > 0 and data.count(b'\n') <= lines:
+            position = max(0, position - 8192)
+            f.seek(position)
+            data = f.read(size - position)
+        # The first line read back may be partial, only the last ones are kept
+        kept = data.split(b'\n')[-(lines + 1):]
+        offset = size - len(b'\n'.join(kept))
+    f.seek(offset)
+
+    deadline = time.monotonic() + settings.FASTCP_LOG_FOLLOW_SECONDS
+    idle_since = time.monotonic()
+    buffer = b''
+    try:
+        while time.monotonic() < deadline:
+            chunk = f.read(65536)
+            if chunk:
+                buffer += chunk
+                *complete, buffer = buffer.split(b'\n')
+                for line in complete:
+                    offset += len(line) + 1
+                    text = line.decode(errors='replace')
+                    line_lvl = line_level(text, kind)
+                    if line_lvl in allowed:
+                        idle_since = time.monotonic()
+                        yield {'offset': offset, 'level': line_lvl, 'line': text}
+                continue
+
+            # A new file at the path or a shorter one means the log was rotated or truncated
+            try:
+                current = os.stat(path, follow_symlinks=False)
+            except OSError:
+                current = None
+            rotated = current and current.st_ino != os.fstat(f.fileno()).st_ino
+            if rotated or (current and current.st_size < offset):
+                new = open_log(path, allowed_dir)
+                if new:
+                    f.close()
+                    f, offset, buffer = new, 0, b''
+                    continue
+            if time.monotonic() - idle_since > KEEPALIVE_SECONDS:
+                idle_since = time.monotonic()
+                yield None
+            time.sleep(1)
+    finally:
+        f.close()
+
+
+def sse_event(data: dict, event: str = None, event_id: str = None) -> str:
+    """Returns a server-sent event, or a keepalive comment if there is no data."""
+    if data is None:
+        return ': keepalive\n\n'
+    message = ''
+    if event_id is not None:
+        message += f'id: {event_id}\n'
+    if event:
+        message += f'event: {event}\n'
+    return message + f'data: {json.dumps(data, cls=DjangoJSONEncoder)}\n\n'
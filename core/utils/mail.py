@@ -0,0 +1,159 @@
+import os, shutil, base64
+from subprocess import run, PIPE
+from cryptography.hazmat.backends import default_backend
+from cryptography.hazmat.primitives import serialization
+from cryptography.hazmat.primitives.asymmetric import rsa
+from django.conf import settings
+from core.models import MailDomain, Mailbox
+from core.utils import system
+
+
+# Lookup tables generated for Postfix, Dovecot and OpenDKIM
+POSTFIX_DOMAINS_PATH = '/etc/postfix/fastcp_domains'
+POSTFIX_MAILBOXES_PATH = '/etc/postfix/fastcp_mailboxes'
+DOVECOT_USERS_PATH = '/etc/dovecot/fastcp-users'
+DKIM_KEYS_DIR = '/etc/opendkim/keys'
+DKIM_KEY_TABLE_PATH = '/etc/opendkim/fastcp_key_table'
+DKIM_SIGNING_TABLE_PATH = '/etc/opendkim/fastcp_signing_table'
+
+DKIM_KEY_BITS = 2048
+
+
+def _write(path: str, lines: list, mode: int = 0o644) -> None:
+    """Writes a lookup table, the header tells admins not to edit it."""
+    os.makedirs(os.path.dirname(path), exist_ok=True)
+    with open(path, 'w') as f:
+        f.write('# Generated by FastCP. Changes to this file will be overwritten.\n')
+        f.writelines(f'{line}\n' for line in lines)
+    os.chmod(path, mode)
+
+
+def mailbox_path(mailbox: object) -> str:
+    """Returns the Maildir of a mailbox."""
+    return os.path.join(settings.FASTCP_VMAIL_ROOT, mailbox.mail_domain.domain, mailbox.local_part)
+
+
+def hash_password(password: str) -> str:
+    """Returns the password hashed in a scheme Dovecot understands, None if it cannot be hashed."""
+    try:
+        # The password is asked twice on stdin, so it doesn't show up in the arguments of the process. A new
+        # session has no controlling terminal for doveadm to read from instead.
+        result = run(
+            ['/usr/bin/doveadm', 'pw', '-s', 'SHA512-CRYPT'], input=f'{password}\n{password}\n'.encode(),
+            stdout=PIPE, stderr=PIPE, timeout=30, start_new_session=True
+        )
+    except FileNotFoundError:
+        return None
+    output = result.stdout.decode().strip().splitlines()
+    if result.returncode != 0 or not output:
+        return None
+    return output[-1].strip()
+
+
+def sync_mail_config() -> bool:
+    """Sync mail config.
+
+    Regenerates the Postfix virtual domain and mailbox maps, the Dovecot users file and the OpenDKIM key
+    and signing tables from the database, then reloads the mail services.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    uid = settings.FASTCP_VMAIL_UID
+    domains = MailDomain.objects.order_by('domain')
+    mailboxes = Mailbox.objects.select_related('mail_domain').order_by('mail_domain__domain', 'local_part')
+
+    _write(POSTFIX_DOMAINS_PATH, [f'{d.domain} OK' for d in domains])
+    _write(POSTFIX_MAILBOXES_PATH, [f'{m.address} {m.mail_domain.domain}/{m.local_part}/' for m in mailboxes])
+
+    users = []
+    for m in mailboxes:
+        quota = f'userdb_quota_rule=*:storage={m.quota_mb}M' if m.quota_mb > 0 else ''
+        users.append(f'{m.address}:{m.password_hash}:{uid}:{uid}::{mailbox_path(m)}::{quota}')
+    _write(DOVECOT_USERS_PATH, users, 0o640)
+    try:
+        shutil.chown(DOVECOT_USERS_PATH, 'root', 'dovecot')
+    except (OSError, LookupError):
+        pass
+
+    key_table, signing_table = [], []
+    for d in domains.filter(dkim_public_key__isnull=False):
+        key_name = f'{d.dkim_selector}._domainkey.{d.domain}'
+        key_table.append(f'{key_name} {d.domain}:{d.dkim_selector}:{os.path.join(DKIM_KEYS_DIR, d.domain, f"{d.dkim_selector}.private")}')
+        signing_table.append(f'*@{d.domain} {key_name}')
+    _write(DKIM_KEY_TABLE_PATH, key_table)
+    _write(DKIM_SIGNING_TABLE_PATH, signing_table)
+
+    return system.run_cmd(f'/usr/sbin/postmap {POSTFIX_DOMAINS_PATH}') \
+        and system.run_cmd(f'/usr/sbin/postmap {POSTFIX_MAILBOXES_PATH}') \
+        and system.run_cmd('/usr/bin/systemctl reload postfix dovecot opendkim')
+
+
+def generate_dkim(mail_domain: object) -> bool:
+    """Generate DKIM key.
+
+    Generates the DKIM key pair of a mail domain. The private key is saved for OpenDKIM and the public
+    key is kept on the model for the DNS record.
+
+    Args:
+        mail_domain (object): MailDomain model object.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    key = rsa.generate_private_key(public_exponent=65537, key_size=DKIM_KEY_BITS, backend=default_backend())
+    private_pem = key.private_bytes(serialization.Encoding.PEM, serialization.PrivateFormat.TraditionalOpenSSL, serialization.NoEncryption())
+    public_der = key.public_key().public_bytes(serialization.Encoding.DER, serialization.PublicFormat.SubjectPublicKeyInfo)
+
+    key_dir = os.path.join(DKIM_KEYS_DIR, mail_domain.domain)
+    try:
+        os.makedirs(key_dir, exist_ok=True)
+        key_path = os.path.join(key_dir, f'{mail_domain.dkim_selector}.private')
+        with open(key_path, 'wb') as f:
+            f.write(private_pem)
+        os.chmod(key_path, 0o600)
+        shutil.chown(key_path, 'opendkim', 'opendkim')
+    except (OSError, LookupError):
+        return False
+
+    mail_domain.dkim_public_key = base64.b64encode(public_der).decode()
+    mail_domain.save()
+    return True
+
+
+def dns_records(mail_domain: object) -> list:
+    """Returns the DNS records the mail domain needs to receive emails and to pass DKIM and SPF checks."""
+    records = [
+        {'type': 'MX', 'name': mail_domain.domain, 'value': f'10 {settings.FASTCP_MAIL_HOSTNAME or mail_domain.domain}'},
+        {'type': 'TXT', 'name': mail_domain.domain, 'value': f'v=spf1 mx a ip4:{settings.SERVER_IP_ADDR} ~all'},
+    ]
+    if mail_domain.dkim_public_key:
+        records.append({
+            'type': 'TXT',
+            'name': f'{mail_domain.dkim_selector}._domainkey.{mail_domain.domain}',
+            'value': f'v=DKIM1; k=rsa; p={mail_domain.dkim_public_key}'
+        })
+    return records
+
+
+def mailbox_usage(mailbox: object) -> int:
+    """Returns the disk usage of a mailbox in bytes."""
+    total = 0
+    for root, dirs, files in os.walk(mailbox_path(mailbox)):
+        for name in files:
+            try:
+                total += os.path.getsize(os.path.join(root, name))
+            except OSError:
+                pass
+    return total
+
+
+def delete_mailbox_data(mailbox: object) -> None:
+    """Deletes the emails of a mailbox."""
+    shutil.rmtree(mailbox_path(mailbox), ignore_errors=True)
+
+
+def delete_domain_data(mail_domain: object) -> None:
+    """Deletes the emails and the DKIM key of a mail domain."""
+    shutil.rmtree(os.path.join(settings.FASTCP_VMAIL_ROOT, mail_domain.domain), ignore_errors=True)
+    shutil.rmtree(os.path.join(DKIM_KEYS_DIR, mail_domain.domain), ignore_errors=True)
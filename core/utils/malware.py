@@ -0,0 +1,166 @@
+import os, re, uuid, shutil
+from subprocess import run, PIPE
+from django.conf import settings
+from django.db.models import Q
+from core.models import Website, QuarantinedFile, User
+from core.utils import filesystem, jobs
+from core.utils.notifications import notify_users
+from api.filemanager.services.base_service import BaseService
+
+
+# PHP files have no business in the uploads directories, so only these are scanned
+SCANNED_EXTENSIONS = ['.php', '.php5', '.php7', '.phtml', '.phar', '.pht']
+UPLOADS_DIR_NAMES = ['uploads']
+
+# Patterns of the common webshells, used if ClamAV is not installed or doesn't know the file
+HEURISTICS = [
+    ('Eval.Encoded', re.compile(rb'eval\s*\(\s*(base64_decode|gzinflate|gzuncompress|str_rot13|strrev)\s*\(', re.I)),
+    ('Exec.Request', re.compile(rb'(system|exec|shell_exec|passthru|popen|proc_open|assert)\s*\(\s*\$_(GET|POST|REQUEST|COOKIE)', re.I)),
+    ('Eval.Request', re.compile(rb'eval\s*\(\s*\$_(GET|POST|REQUEST|COOKIE)', re.I)),
+]
+
+# Files larger than this are not read for the heuristics
+MAX_HEURISTIC_BYTES = 2 * 1024 * 1024
+
+
+def is_scanned_path(path: str) -> bool:
+    """Returns True if the path is a PHP file inside an uploads directory."""
+    parts = path.split(os.sep)
+    return os.path.splitext(path)[1].lower() in SCANNED_EXTENSIONS and any(p in UPLOADS_DIR_NAMES for p in parts[:-1])
+
+
+def scan_roots() -> dict:
+    """Returns the public directories of the websites that scan their uploads, with the websites."""
+    roots = {}
+    for website in Website.objects.filter(scan_uploads=True).select_related('user'):
+        web_root = filesystem.get_website_paths(website).get('web_root')
+        if os.path.isdir(web_root):
+            roots[web_root] = website
+    return roots
+
+
+def website_for_path(path: str) -> object:
+    """Returns the website a path belongs to if the website scans its uploads, None otherwise."""
+    for root, website in scan_roots().items():
+        if path.startswith(root + os.sep):
+            return website
+    return None
+
+
+def clamav_signature(path: str) -> str:
+    """Scans a file with clamdscan, or clamscan if the daemon isn't running, and returns the signature found."""
+    for cmd in [['/usr/bin/clamdscan', '--no-summary', '--fdpass'], ['/usr/bin/clamscan', '--no-summary']]:
+        if not os.path.exists(cmd[0]):
+            continue
+        res = run(cmd + [path], stdout=PIPE, stderr=PIPE, timeout=120)
+        # 0 means clean, 1 means infected and 2 means the scan failed
+        if res.returncode == 0:
+            return None
+        if res.returncode == 1:
+            line = res.stdout.decode(errors='replace').strip().splitlines()[-1]
+            return line.rpartition(': ')[2].replace(' FOUND', '')
+    return None
+
+
+def heuristic_signature(path: str) -> str:
+    """Returns the name of the first webshell pattern the file matches."""
+    try:
+        if os.path.getsize(path) > MAX_HEURISTIC_BYTES:
+            return None
+        with open(path, 'rb') as f:
+            content = f.read()
+    except OSError:
+        return None
+    for name, pattern in HEURISTICS:
+        if pattern.search(content):
+            return f'FastCP.Heuristic.{name}'
+    return None
+
+
+def scan_file(path: str) -> str:
+    """Returns the signature of a file if it looks malicious, None if it's clean."""
+    if not os.path.isfile(path) or os.path.islink(path):
+        return None
+    return clamav_signature(path) or heuristic_signature(path)
+
+
+def quarantine(website: object, path: str, signature: str) -> object:
+    """Quarantine.
+
+    Moves a malicious file out of the website into the quarantine directory, where only root can read
+    it, and notifies the website owner and the admins. The file can be restored if it was a false
+    positive.
+
+    Args:
+        website (object): Website model object.
+        path (str): The path of the file.
+        signature (str): What the file was detected as.
+
+    Returns:
+        object: The QuarantinedFile model object.
+    """
+    directory = os.path.join(settings.FASTCP_QUARANTINE_DIR, str(website.id))
+    os.makedirs(directory, mode=0o700, exist_ok=True)
+    dest = os.path.join(directory, f'{uuid.uuid4().hex}-{os.path.basename(path)}')
+    size = os.path.getsize(path)
+    shutil.move(path, dest)
+    os.chown(dest, 0, 0)
+    os.chmod(dest, 0o600)
+
+    item = QuarantinedFile.objects.create(website=website, path=path, quarantine_path=dest, signature=signature[:255], size=size)
+    # The emails and the webhooks would hold up the upload
+    jobs.start_job(website.user, 'notify_quarantined', website.label, {'item_id': item.id}, notify_job)
+    return item
+
+
+def notify_job(job: object, params: dict) -> dict:
+    """Notifies the owner of a website and the admins about a quarantined file, run as a background job."""
+    item = QuarantinedFile.objects.select_related('website').get(id=params.get('item_id'))
+    website = item.website
+    users = User.objects.filter(Q(is_superuser=True) | Q(id=website.user_id))
+    notify_users(users, f'An upload of {website} has been quarantined', details=f'{item.path} was detected as {item.signature}.', event='malware')
+    return {'path': item.path}
+
+
+def scan_and_quarantine(path: str, website: object = None) -> object:
+    """Scans a new file of a website that scans its uploads and quarantines it if it's malicious."""
+    if not is_scanned_path(path) or not os.path.isfile(path) or os.path.islink(path):
+        return None
+    website = website or website_for_path(path)
+    if not website:
+        return None
+    if QuarantinedFile.objects.filter(website=website, path=path, restored=True, size=os.path.getsize(path)).exists():
+        return None
+    signature = scan_file(path)
+    if not signature:
+        return None
+    return quarantine(website, path, signature)
+
+
+def restore_quarantined(item: object) -> bool:
+    """Moves a quarantined file back to where it was found. The file is written by a process running as the
+    website owner, so a symlink swapped in on the way cannot have root write it elsewhere. The file is
+    remembered as a false positive so it's not quarantined again."""
+    if item.restored or os.path.lexists(item.path) or not os.path.exists(item.quarantine_path):
+        return False
+
+    def write(source):
+        os.makedirs(os.path.dirname(item.path), exist_ok=True)
+        fd = os.open(item.path, os.O_WRONLY | os.O_CREAT | os.O_EXCL | os.O_NOFOLLOW, 0o644)
+        os.fchmod(fd, 0o644)
+        with os.fdopen(fd, 'wb') as dest:
+            shutil.copyfileobj(source, dest)
+
+    with open(item.quarantine_path, 'rb') as source:
+        if not BaseService().as_owner(item.path, write, source):
+            return False
+    os.remove(item.quarantine_path)
+    item.restored = True
+    item.save()
+    return True
+
+
+def delete_quarantined(item: object) -> None:
+    if not item.restored and os.path.exists(item.quarantine_path):
+        os.remove(item.quarantine_path)
+    item.delete()
@@ -0,0 +1,367 @@
+import os, re, time, json, fcntl, struct
+from collections import defaultdict
+from datetime import timedelta
+import psutil, requests
+from django.conf import settings
+from django.db.models import Count
+from django.utils import timezone
+from core.models import User, Website, CheckResult, Job
+from core.utils.filesystem import get_user_paths
+from api.databases.services.mysql import FastcpSqlService
+
+
+# The metrics are prefixed so they don't clash with the other exporters of the host
+PREFIX = 'fastcp'
+
+# Where the read offsets of the access logs are kept, so the request counts survive restarts and are
+# the same in every panel worker
+REQUESTS_STATE_PATH = '/var/fastcp/.config/metrics-requests.json'
+
+# The status and the body bytes sent that follow the request line in the combined log format
+SENT_BYTES_RE = re.compile(rb'" \d{3} (\d+) ')
+
+# The MySQL status counters that are exported, with the metric names
+MYSQL_STATUS = {
+    'Threads_connected': 'mysql_threads_connected',
+    'Threads_running': 'mysql_threads_running',
+    'Max_used_connections': 'mysql_max_used_connections',
+    'max_connections': 'mysql_max_connections',
+    'Connections': 'mysql_connections_total',
+    'Aborted_connects': 'mysql_aborted_connects_total',
+    'Slow_queries': 'mysql_slow_queries_total',
+}
+
+# The Prometheus types of the metrics that are not gauges
+COUNTERS = ['website_requests_total', 'website_sent_bytes_total'] + [name for name in MYSQL_STATUS.values() if name.endswith('_total')]
+
+
+def user_processes() -> dict:
+    """Returns the process count, the resident memory and the CPU seconds of the processes of each user."""
+    usage = defaultdict(lambda: {'processes': 0, 'memory_bytes': 0, 'cpu_seconds': 0.0})
+    for proc in psutil.process_iter(['username', 'memory_info', 'cpu_times']):
+        try:
+            info = proc.info
+            if not info.get('username') or not info.get('memory_info'):
+                continue
+            row = usage[info.get('username')]
+            row['processes'] += 1
+            row['memory_bytes'] += info.get('memory_info').rss
+            row['cpu_seconds'] += info.get('cpu_times').user + info.get('cpu_times').system
+        except (psutil.Error, AttributeError):
+            continue
+    return usage
+
+
+def access_log_path(website: object) -> str:
+    return os.path.join(get_user_paths(website.user).get('logs_path'), f'{website.slug}_nginx.access_ssl.log')
+
+
+def access_log_totals() -> dict:
+    """Access log totals.
+
+    Counts the requests and sums the bytes sent of each website from its NGINX access log. Only the lines
+    added since the last run are read, and the totals keep growing across log rotations, so they are
+    proper counters.
+
+    Returns:
+        dict: The requests and the bytes sent of each website label.
+    """
+    os.makedirs(os.path.dirname(REQUESTS_STATE_PATH), exist_ok=True)
+    with open(REQUESTS_STATE_PATH, 'a+') as state_file:
+        fcntl.flock(state_file, fcntl.LOCK_EX)
+        state_file.seek(0)
+        try:
+            state = json.loads(state_file.read() or '{}')
+        except ValueError:
+            state = {}
+
+        totals = {}
+        for website in Website.objects.select_related('user'):
+            path = access_log_path(website)
+            entry = state.get(website.slug, {'inode': None, 'offset': 0, 'count': 0})
+            entry.setdefault('bytes', 0)
+            try:
+                st = os.stat(path)
+                if st.st_ino != entry.get('inode') or st.st_size < entry.get('offset'):
+                    entry.update({'inode': st.st_ino, 'offset': 0})
+                with open(path, 'rb') as f:
+                    f.seek(entry.get('offset'))
+                    while True:
+                        chunk = f.read(1024 * 1024)
+                        # A partly written last line is read on the next run
+                        complete = chunk[:chunk.rfind(b'\n') + 1]
+                        if not complete:
+                            break
+                        entry['count'] += complete.count(b'\n')
+                        entry['bytes'] += sum(int(n) for n in SENT_BYTES_RE.findall(complete))
+                        entry['offset'] += len(complete)
+                        if len(complete) < len(chunk):
+                            break
+            except OSError:
+                pass
+            state[website.slug] = entry
+            totals[website.label] = {'requests': entry.get('count'), 'bytes': entry.get('bytes')}
+
+        state_file.seek(0)
+        state_file.truncate()
+        state_file.write(json.dumps(state))
+    return totals
+
+
+def fpm_pools() -> dict:
+    """Returns the process count, the resident memory and the CPU seconds of each PHP-FPM pool."""
+    pools = defaultdict(lambda: {'processes': 0, 'memory_bytes': 0, 'cpu_seconds': 0.0})
+    for proc in psutil.process_iter(['cmdline', 'memory_info', 'cpu_times']):
+        try:
+            cmdline = ' '.join(proc.info.get('cmdline') or [])
+            if not cmdline.startswith('php-fpm: pool '):
+                continue
+            pool = pools[cmdline.replace('php-fpm: pool ', '', 1).strip()]
+            pool['processes'] += 1
+            pool['memory_bytes'] += proc.info.get('memory_info').rss
+            pool['cpu_seconds'] += proc.info.get('cpu_times').user + proc.info.get('cpu_times').system
+        except (psutil.Error, AttributeError):
+            continue
+    return pools
+
+
+def server_samples() -> list:
+    """Server samples.
+
+    Gathers the server wide metrics: the load, the memory and the disk usage, the requests of the
+    websites, the PHP-FPM pools, the MySQL connections and the outcomes of the background jobs, like the
+    server backups.
+
+    Returns:
+        list: The samples, tuples of the metric name, the labels dict and the value.
+    """
+    load1, load5, load15 = os.getloadavg()
+    memory = psutil.virtual_memory()
+    disk = psutil.disk_usage('/')
+    samples = [
+        ('load1', {}, load1),
+        ('load5', {}, load5),
+        ('load15', {}, load15),
+        ('memory_total_bytes', {}, memory.total),
+        ('memory_available_bytes', {}, memory.available),
+        ('disk_total_bytes', {'mount': '/'}, disk.total),
+        ('disk_used_bytes', {'mount': '/'}, disk.used),
+    ]
+
+    for label, totals in access_log_totals().items():
+        samples += [
+            ('website_requests_total', {'website': label}, totals.get('requests')),
+            ('website_sent_bytes_total', {'website': label}, totals.get('bytes')),
+        ]
+
+    for pool, usage in fpm_pools().items():
+        samples += [
+            ('php_fpm_processes', {'pool': pool}, usage.get('processes')),
+            ('php_fpm_memory_bytes', {'pool': pool}, usage.get('memory_bytes')),
+        ]
+
+    try:
+        status = FastcpSqlService().global_status()
+        samples.append(('mysql_up', {}, 1))
+        for key, name in MYSQL_STATUS.items():
+            if key in status:
+                samples.append((name, {}, float(status.get(key))))
+    except Exception:
+        samples.append(('mysql_up', {}, 0))
+
+    for row in Job.objects.values('kind', 'state').annotate(total=Count('id')):
+        samples.append(('jobs', {'kind': row.get('kind'), 'state': row.get('state')}, row.get('total')))
+    last_backup = Job.objects.filter(kind='server_backup', state='done').order_by('-finished').first()
+    if last_backup and last_backup.finished:
+        samples.append(('last_server_backup_timestamp_seconds', {}, int(last_backup.finished.timestamp())))
+    return samples
+
+
+def prometheus_text(samples: list) -> str:
+    """Returns the samples in the Prometheus text exposition format, grouped by metric."""
+    families = defaultdict(list)
+    for name, labels, value in samples:
+        families[name].append((labels, value))
+
+    lines = []
+    for name, rows in families.items():
+        lines.append(f'# TYPE {PREFIX}_{name} {"counter" if name in COUNTERS else "gauge"}')
+        for labels, value in rows:
+            tags = ','.join(f'{k}="{_escape(str(v), chr(34)).replace(chr(10), " ")}"' for k, v in sorted(labels.items()))
+            lines.append(f'{PREFIX}_{name}{{{tags}}} {float(value)}' if tags else f'{PREFIX}_{name} {float(value)}')
+    return '\n'.join(lines) + '\n'
+
+
+def collect() -> list:
+    """Collect metrics.
+
+    Gathers the per-user resource usage, the per-website health from the latest site check results and
+    the server samples, so the same data can be written to any time-series database or scraped.
+
+    Returns:
+        list: The samples, tuples of the metric name, the labels dict and the value.
+    """
+    samples = []
+    processes = user_processes()
+    for user in User.objects.filter(is_superuser=False):
+        labels = {'user': user.username}
+        usage = processes.get(user.username, {})
+        samples += [
+            ('user_storage_used_bytes', labels, user.storage_used),
+            ('user_storage_max_bytes', labels, user.max_storage),
+            ('user_websites', labels, user.total_sites),
+            ('user_databases', labels, user.total_dbs),
+            ('user_processes', labels, usage.get('processes', 0)),
+            ('user_memory_bytes', labels, usage.get('memory_bytes', 0)),
+            ('user_cpu_seconds', labels, usage.get('cpu_seconds', 0)),
+        ]
+
+    since = timezone.now() - timedelta(minutes=15)
+    for website in Website.objects.select_related('user'):
+        result = CheckResult.objects.filter(
+            site_check__website=website, source='local', created__gte=since
+        ).select_related('site_check').order_by('-created').first()
+        if not result:
+            continue
+        labels = {'website': website.label, 'user': website.user.username}
+        samples.append(('website_up', labels, 1 if result.healthy else 0))
+        if result.status_code is not None:
+            samples += [
+                ('website_status_code', labels, result.status_code),
+                ('website_ttfb_ms', labels, result.ttfb or 0),
+                ('website_latency_ms', labels, result.latency or 0),
+            ]
+    return samples + server_samples()
+
+
+def _escape(value: str, chars: str) -> str:
+    for c in '\\' + chars:
+        value = value.replace(c, '\\' + c)
+    return value
+
+
+def influx_lines(samples: list, timestamp: int) -> str:
+    """Returns the samples in the InfluxDB line protocol, timestamp is in seconds."""
+    lines = []
+    for name, labels, value in samples:
+        tags = ''.join(f',{k}={_escape(str(v), " ,=")}' for k, v in sorted(labels.items()))
+        lines.append(f'{PREFIX}_{name}{tags} value={float(value)} {timestamp}')
+    return '\n'.join(lines)
+
+
+def _varint(n: int) -> bytes:
+    out = bytearray()
+    while True:
+        byte = n & 0x7f
+        n >>= 7
+        if n:
+            out.append(byte | 0x80)
+        else:
+            out.append(byte)
+            return bytes(out)
+
+
+def _field(number: int, data: bytes) -> bytes:
+    """Encodes a length delimited protobuf field."""
+    return _varint(number << 3 | 2) + _varint(len(data)) + data
+
+
+def write_request(samples: list, timestamp: int) -> bytes:
+    """Returns the samples as a Prometheus remote-write WriteRequest protobuf message, timestamp is in seconds."""
+    message = b''
+    for name, labels, value in samples:
+        labels = dict(labels, __name__=f'{PREFIX}_{name}')
+        series = b''
+        for k, v in sorted(labels.items()):
+            series += _field(1, _field(1, k.encode()) + _field(2, str(v).encode()))
+        sample = b'\x09' + struct.pack('<d', float(value)) + b'\x10' + _varint(timestamp * 1000)
+        series += _field(2, sample)
+        message += _field(1, series)
+    return message
+
+
+def snappy_block(data: bytes) -> bytes:
+    """Snappy block.
+
+    Frames the data as a valid Snappy block made of literals only. Remote-write endpoints require Snappy
+    but don't mind the data not being compressed, so no native library is needed.
+
+    Args:
+        data (bytes): The data to frame.
+
+    Returns:
+        bytes: The Snappy block.
+    """
+    out = _varint(len(data))
+    for i in range(0, len(data), 65536):
+        chunk = data[i:i + 65536]
+        n = len(chunk) - 1
+        if n < 60:
+            out += bytes([n << 2])
+        elif n < 256:
+            out += bytes([60 << 2, n])
+        else:
+            out += bytes([61 << 2]) + struct.pack('<H', n)
+        out += chunk
+    return out
+
+
+def exporters() -> list:
+    """Returns the names of the configured exporters."""
+    names = []
+    if settings.FASTCP_METRICS_INFLUX_URL:
+        names.append('influxdb')
+    if settings.FASTCP_METRICS_REMOTE_WRITE_URL:
+        names.append('prometheus')
+    return names
+
+
+def push_influx(samples: list, timestamp: int) -> None:
+    headers = {'Content-Type': 'text/plain; charset=utf-8'}
+    if settings.FASTCP_METRICS_INFLUX_TOKEN:
+        headers['Authorization'] = f'Token {settings.FASTCP_METRICS_INFLUX_TOKEN}'
+    res = requests.post(
+        settings.FASTCP_METRICS_INFLUX_URL, data=influx_lines(samples, timestamp).encode(),
+        params={'precision': 's'}, headers=headers, timeout=15
+    )
+    res.raise_for_status()
+
+
+def push_remote_write(samples: list, timestamp: int) -> None:
+    headers = {
+        'Content-Type': 'application/x-protobuf',
+        'Content-Encoding': 'snappy',
+        'X-Prometheus-Remote-Write-Version': '0.1.0'
+    }
+    if settings.FASTCP_METRICS_REMOTE_WRITE_TOKEN:
+        headers['Authorization'] = f'Bearer {settings.FASTCP_METRICS_REMOTE_WRITE_TOKEN}'
+    res = requests.post(
+        settings.FASTCP_METRICS_REMOTE_WRITE_URL, data=snappy_block(write_request(samples, timestamp)),
+        headers=headers, timeout=15
+    )
+    res.raise_for_status()
+
+
+def export_metrics() -> dict:
+    """Export metrics.
+
+    Collects the metrics and pushes them to each configured exporter. An unreachable exporter doesn't
+    stop the others.
+
+    Returns:
+        dict: The error of each exporter, None if the push succeeded.
+    """
+    names = exporters()
+    if not names:
+        return {}
+    samples = collect()
+    timestamp = int(time.time())
+    pushers = {'influxdb': push_influx, 'prometheus': push_remote_write}
+    errors = {}
+    for name in names:
+        try:
+            pushers.get(name)(samples, timestamp)
+            errors[name] = None
+        except Exception as e:
+            errors[name] = str(e)
+    return errors
@@ -0,0 +1,204 @@
+import time, hashlib, secrets, requests
+from concurrent.futures import ThreadPoolExecutor
+from datetime import timedelta
+from django.conf import settings
+from django.db.models import Avg, Count, Q
+from django.db.models.functions import TruncDate
+from django.utils import timezone
+from core.models import Website, SiteCheck, CheckResult, User, Probe
+from core.utils.notifications import notify_users
+
+
+def run_check(site_check: object, domain: str) -> object:
+    """Run a site check.
+
+    Requests the URL of the check from the local web server and records the status code, the time to
+    first byte and the total response time in milliseconds. Websites with SSL are requested over HTTPS, as
+    their HTTP requests are only redirected. The certificate isn't verified, the request goes to 127.0.0.1.
+    The result isn't saved, so the checks can run from threads.
+
+    Args:
+        site_check (object): SiteCheck model object.
+        domain (str): The domain to request the URL for, None if the website doesn't have any.
+
+    Returns:
+        object: The unsaved CheckResult model object.
+    """
+    result = CheckResult(site_check=site_check)
+    if not domain:
+        result.error = 'The website does not have any domains.'
+        return result
+
+    scheme = 'https' if site_check.website.has_ssl else 'http'
+    start = time.monotonic()
+    try:
+        res = requests.get(
+            f'{scheme}://127.0.0.1{site_check.path}', headers={'Host': domain},
+            timeout=settings.FASTCP_CHECK_TIMEOUT, allow_redirects=False, verify=False, stream=True
+        )
+        result.ttfb = int(res.elapsed.total_seconds() * 1000)
+        res.content
+        result.latency = int((time.monotonic() - start) * 1000)
+        result.status_code = res.status_code
+    except requests.RequestException as e:
+        result.error = str(e)[:255]
+    return result
+
+
+def alert(site_check: object, result: object) -> None:
+    """Notifies the owner of the website and the admins about a failing check, at most once an hour."""
+    website = site_check.website
+    if result.status_code is None:
+        reason = result.error
+    elif result.status_code >= 500:
+        reason = f'{site_check.path} responded with HTTP {result.status_code}.'
+    else:
+        reason = f'{site_check.path} took {result.latency}ms to respond, the threshold is {site_check.max_ms}ms.'
+    if result.source != 'local':
+        reason = f'{reason} Reported by the {result.source} probe.'
+    users = User.objects.filter(Q(is_superuser=True) | Q(id=website.user_id))
+    notify_users(users, f'{website} is slow or down', details=reason, once_every=timedelta(hours=1), event='site_down')
+
+
+def run_checks() -> None:
+    """Runs the checks of all websites concurrently, the homepage check is created for websites that don't
+    have it. The results are saved once all checks are done."""
+    for website in Website.objects.all():
+        SiteCheck.objects.get_or_create(website=website, path='/')
+
+    site_checks = list(SiteCheck.objects.select_related('website'))
+    domains = [site_check.website.domains.first() for site_check in site_checks]
+    with ThreadPoolExecutor(max_workers=settings.FASTCP_CHECK_WORKERS) as pool:
+        results = list(pool.map(run_check, site_checks, [d.domain if d else None for d in domains]))
+
+    for site_check, result in zip(site_checks, results):
+        result.save()
+        if not result.healthy:
+            alert(site_check, result)
+
+
+def purge_results() -> int:
+    """Deletes the results older than the retention period and returns the number of deleted results."""
+    cutoff = timezone.now() - timedelta(days=settings.FASTCP_CHECK_RETENTION_DAYS)
+    return CheckResult.objects.filter(created__lt=cutoff).delete()[0]
+
+
+def check_trend(site_check: object, days: int = 30) -> list:
+    """Get check trend.
+
+    Aggregates the results of a check per day.
+
+    Args:
+        site_check (object): SiteCheck model object.
+        days (int): Number of days to go back.
+
+    Returns:
+        list: A dict per day with the average TTFB and latency, the number of runs and failures.
+    """
+    since = timezone.now() - timedelta(days=days)
+    return list(
+        site_check.results.filter(created__gte=since)
+        .annotate(day=TruncDate('created'))
+        .values('day', 'source')
+        .annotate(
+            ttfb=Avg('ttfb'),
+            latency=Avg('latency'),
+            runs=Count('id'),
+            failures=Count('id', filter=Q(status_code__isnull=True) | Q(status_code__gte=500))
+        )
+        .order_by('day', 'source')
+    )
+
+
+def hash_token(token: str) -> str:
+    """Returns the hash of a probe token, only the hashes are stored."""
+    return hashlib.sha256(token.encode()).hexdigest()
+
+
+def create_probe(name: str) -> tuple:
+    """Creates a probe and returns the probe model object along with its token."""
+    token = secrets.token_urlsafe(32)
+    probe = Probe.objects.create(name=name, token_hash=hash_token(token))
+    return probe, token
+
+
+def probe_targets() -> list:
+    """Returns the public URLs of all site checks for the external probes."""
+    targets = []
+    for site_check in SiteCheck.objects.select_related('website'):
+        domain = site_check.website.domains.first()
+        if domain:
+            scheme = 'https' if site_check.website.has_ssl else 'http'
+            targets.append({
+                'id': site_check.id,
+                'url': f'{scheme}://{domain.domain}{site_check.path}',
+                'max_ms': site_check.max_ms
+            })
+    return targets
+
+
+# The most results a probe can report at once
+MAX_PROBE_RESULTS = 1000
+
+
+def _is_int(value, low: int, high: int) -> bool:
+    return isinstance(value, int) and not isinstance(value, bool) and low <= value <= high
+
+
+def validate_probe_results(results: object) -> list:
+    """Returns the errors of the results reported by a probe, an empty list if they can be recorded."""
+    if not isinstance(results, list) or not results:
+        return ['A list of results is required.']
+    if len(results) > MAX_PROBE_RESULTS:
+        return [f'At most {MAX_PROBE_RESULTS} results can be reported at once.']
+    errors = []
+    for i, data in enumerate(results):
+        if not isinstance(data, dict):
+            errors.append(f'Result {i} should be an object.')
+            continue
+        if not _is_int(data.get('id'), 1, 2 ** 31 - 1):
+            errors.append(f'Result {i} should have the ID of a check.')
+        if data.get('status_code') is not None and not _is_int(data.get('status_code'), 100, 599):
+            errors.append(f'The status code of result {i} should be between 100 and 599.')
+        for key in ['ttfb', 'latency']:
+            if data.get(key) is not None and not _is_int(data.get(key), 0, 2 ** 31 - 1):
+                errors.append(f'The {key} of result {i} should be a positive number of milliseconds.')
+        if data.get('error') is not None and not isinstance(data.get('error'), str):
+            errors.append(f'The error of result {i} should be a string.')
+    return errors
+
+
+def record_probe_results(probe: object, results: list) -> int:
+    """Record probe results.
+
+    Saves the results reported by an external probe. Failing results alert the same way local results do.
+
+    Args:
+        probe (object): Probe model object.
+        results (list): A dict per check with id, status_code, ttfb, latency and error, see validate_probe_results.
+
+    Returns:
+        int: The number of recorded results.
+    """
+    checks = SiteCheck.objects.select_related('website').in_bulk([r.get('id') for r in results if r.get('id')])
+    recorded = 0
+    for data in results:
+        site_check = checks.get(data.get('id'))
+        if not site_check:
+            continue
+        result = CheckResult.objects.create(
+            site_check=site_check,
+            source=probe.name,
+            status_code=data.get('status_code'),
+            ttfb=data.get('ttfb'),
+            latency=data.get('latency'),
+            error=(data.get('error') or '')[:255] or None
+        )
+        recorded += 1
+        if not result.healthy:
+            alert(site_check, result)
+
+    probe.last_seen = timezone.now()
+    probe.save()
+    return recorded
+
@@ -0,0 +1,193 @@
+import socket, logging, threading, ipaddress, requests
+from datetime import timedelta
+from urllib.parse import urlsplit
+from django.conf import settings
+from django.core.mail import send_mail
+from django.utils import timezone
+from core.models import Notification, User
+
+
+logger = logging.getLogger('fastcp.notifications')
+
+# The events users can set their notification preferences for
+EVENTS = {
+    'general': 'General',
+    'disk': 'Disk usage alerts',
+    'reboot_ok': 'Successful reboots',
+    'reboot_failed': 'Services or websites down after a reboot',
+    'watchdog': 'Suspicious processes',
+    'site_down': 'Slow or down websites',
+    'config': 'Rejected or rolled back config changes',
+    'new_login': 'Sign ins from new devices',
+    'dev_mode': 'Developer mode',
+    'operations': 'Recovered operations',
+    'oom': 'Processes killed for running out of memory',
+    'jobs': 'Finished and failed backups, exports, imports and restores',
+    'malware': 'Quarantined uploads',
+    'integrity': 'Unexpected changes of the PHP files',
+    'traffic': 'Used up traffic quotas',
+    'dependencies': 'Vulnerable Composer and NPM dependencies',
+}
+
+
+def default_preference(event: str) -> dict:
+    """Returns the channels of an event for the users that haven't set their preference."""
+    return {
+        'panel': True,
+        'email': event == 'new_login' and settings.FASTCP_LOGIN_ALERT_EMAIL
+    }
+
+
+def get_preferences(user: object) -> dict:
+    """Returns the channels of all events for a user."""
+    saved = {p.event: {'panel': p.panel, 'email': p.email} for p in user.notification_preferences.all()}
+    return {event: saved.get(event, default_preference(event)) for event in EVENTS}
+
+
+def in_quiet_hours(user: object) -> bool:
+    """Returns True if it's the quiet hours of the user now. Quiet hours may span midnight."""
+    start, end = user.quiet_hours_start, user.quiet_hours_end
+    if start is None or end is None:
+        return False
+    now = timezone.localtime().time()
+    if start <= end:
+        return start <= now < end
+    return now >= start or now < end
+
+
+def channel_payload(kind: str, title: str, details: str = None, url: str = None, event: str = 'general') -> dict:
+    """Returns the JSON body a channel expects for a notification."""
+    # Slack bolds with single asterisks, Discord with double ones
+    bold = '**' if kind == 'discord' else '*'
+    text = '\n'.join(filter(None, [f'{bold}{title}{bold}', details, url]))
+    if kind == 'slack':
+        return {'text': text}
+    if kind == 'discord':
+        return {'content': text[:2000]}
+    return {
+        'site': settings.FASTCP_SITE_NAME,
+        'event': event,
+        'title': title,
+        'details': details,
+        'url': url,
+        'time': timezone.now().isoformat()
+    }
+
+
+def is_public_url(url: str) -> bool:
+    """Returns True if a URL is HTTPS and its host only resolves to public addresses, so the channels
+    cannot be pointed at the services of the server or of its private network."""
+    parts = urlsplit(url)
+    if parts.scheme != 'https' or not parts.hostname:
+        return False
+    try:
+        addresses = [ipaddress.ip_address(info[4][0].split('%')[0]) for info in socket.getaddrinfo(parts.hostname, parts.port or 443)]
+    except (socket.gaierror, ValueError):
+        return False
+    return bool(addresses) and not any(
+        a.is_private or a.is_loopback or a.is_link_local or a.is_unspecified or a.is_multicast or a.is_reserved
+        for a in addresses
+    )
+
+
+def post_to_channel(channel: object, title: str, details: str = None, url: str = None, event: str = 'general') -> bool:
+    """Posts a notification to a webhook channel, returns True if the webhook accepted it."""
+    if not is_public_url(channel.url):
+        logger.warning('Notification channel %s points to a local or private address, skipped.', channel.id)
+        return False
+    try:
+        # A redirect could lead to an address that hasn't been checked
+        res = requests.post(channel.url, json=channel_payload(channel.kind, title, details, url, event), timeout=10, allow_redirects=False)
+    except requests.RequestException as e:
+        logger.warning('Notification channel %s cannot be reached: %s', channel.id, e)
+        return False
+    if res.status_code >= 300:
+        logger.warning('Notification channel %s answered with HTTP %s.', channel.id, res.status_code)
+        return False
+    return True
+
+
+def post_in_background(channel: object, title: str, details: str = None, url: str = None, event: str = 'general') -> None:
+    """Posts a notification to a webhook channel from a thread, so a slow webhook doesn't hold the caller."""
+    threading.Thread(target=post_to_channel, args=(channel, title), kwargs={
+        'details': details, 'url': url, 'event': event
+    }, daemon=True).start()
+
+
+def send_email(email: str, title: str, details: str = None) -> bool:
+    """Emails a notification to a user, returns True if the mail server accepted it."""
+    try:
+        send_mail(f'{settings.FASTCP_SITE_NAME}: {title}', details or title, None, [email])
+    except Exception as e:
+        logger.warning('Notification email to %s cannot be sent: %s', email, e)
+        return False
+    return True
+
+
+def email_in_background(email: str, title: str, details: str = None) -> None:
+    """Emails a notification from a thread, so a slow mail server doesn't hold the caller."""
+    threading.Thread(target=send_email, args=(email, title), kwargs={'details': details}, daemon=True).start()
+
+
+def channel_wants(channel: object, event: str) -> bool:
+    """Returns True if a channel subscribed to the event, channels without events get all of them."""
+    return not channel.events or event in channel.events.split(',')
+
+
+def notify_users(users, title: str, details: str = None, url: str = None, once_every: timedelta = None, event: str = 'general') -> object:
+    """Notify users.
+
+    Creates a notification and attaches it to the provided users, and emails it to the users who want it by
+    email, unless it's their quiet hours. Users who turned off the event are skipped. The notification is
+    also posted to the webhook, Slack and Discord channels of the users that subscribed to the event. If
+    once_every is provided, the notification is skipped when a notification with the same title has been
+    created within that period, so periodic checks do not flood the users with the same alert.
+
+    Args:
+        users (iterable): User model objects to notify.
+        title (str): Title of the notification.
+        details (str): Optional details of the notification.
+        url (str): Optional URL for more information.
+        once_every (timedelta): Optional period to suppress duplicate notifications for.
+        event (str): The event the notification is about, one of EVENTS.
+
+    Returns:
+        object: The notification model object or None if it was suppressed.
+    """
+    if once_every and Notification.objects.filter(title=title, date__gte=timezone.now() - once_every).exists():
+        return None
+
+    panel_users = []
+    for user in users:
+        preference = get_preferences(user).get(event, default_preference(event))
+        if preference.get('panel'):
+            panel_users.append(user)
+        if preference.get('email') and user.email and not in_quiet_hours(user):
+            email_in_background(user.email, title, details=details)
+        for channel in user.notification_channels.all():
+            if channel_wants(channel, event):
+                post_in_background(channel, title, details=details, url=url, event=event)
+
+    if not panel_users:
+        return None
+    notification = Notification.objects.create(title=title, details=details, url=url)
+    notification.users.set(panel_users)
+    return notification
+
+
+def notify_admins(title: str, details: str = None, url: str = None, once_every: timedelta = None, event: str = 'general') -> object:
+    """Notify admins.
+
+    Creates a notification for all superusers, see notify_users.
+
+    Args:
+        title (str): Title of the notification.
+        details (str): Optional details of the notification.
+        url (str): Optional URL for more information.
+        once_every (timedelta): Optional period to suppress duplicate notifications for.
+        event (str): The event the notification is about, one of EVENTS.
+
+    Returns:
+        object: The notification model object or None if it was suppressed.
+    """
+    return notify_users(User.objects.filter(is_superuser=True), title, details=details, url=url, once_every=once_every, event=event)
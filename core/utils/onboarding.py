@@ -0,0 +1,113 @@
+import os, re, json
+from core.models import Website, Job, NotificationChannel, NotificationPreference
+from core.utils.health import service_is_active
+
+
+# The steps marked as done by hand, i.e. backups taken by an external tool
+SKIPPED_STEPS_PATH = '/var/fastcp/.config/onboarding-skipped.json'
+UFW_CONF_PATH = '/etc/ufw/ufw.conf'
+SHADOW_PATH = '/etc/shadow'
+
+STEPS = [
+    ('change_password', 'Change the admin password', 'The password set during the installation should be replaced with your own.'),
+    ('enable_firewall', 'Enable the firewall', 'Allow only SSH, HTTP and HTTPS with UFW or firewalld.'),
+    ('configure_backups', 'Configure backups', 'Take a server backup so the panel and the system config can be restored.'),
+    ('setup_notifications', 'Set up notifications', 'Add a webhook, Slack or Discord channel, or turn on the notification emails.'),
+    ('add_website', 'Add your first website', 'Create a website or import one that is already on the server.'),
+]
+
+
+def skipped_steps() -> list:
+    try:
+        with open(SKIPPED_STEPS_PATH) as f:
+            return json.loads(f.read())
+    except (OSError, ValueError):
+        return []
+
+
+def skip_step(name: str, skipped: bool = True) -> None:
+    """Marks a step as done by hand, or unmarks it."""
+    steps = set(skipped_steps())
+    if skipped:
+        steps.add(name)
+    else:
+        steps.discard(name)
+    os.makedirs(os.path.dirname(SKIPPED_STEPS_PATH), exist_ok=True)
+    with open(SKIPPED_STEPS_PATH, 'w') as f:
+        f.write(json.dumps(sorted(steps)))
+
+
+def last_password_change(username: str) -> int:
+    """Returns the day of the last password change of a unix user from the shadow file, None if unknown.
+    The file is read directly, the spwd module is gone as of Python 3.13."""
+    try:
+        with open(SHADOW_PATH) as f:
+            for line in f:
+                fields = line.rstrip('\n').split(':')
+                if fields[0] == username and len(fields) > 2:
+                    return int(fields[2]) if fields[2].isdigit() else None
+    except OSError:
+        pass
+    return None
+
+
+def password_changed(user: object) -> bool:
+    """Returns True if the unix password of a user was changed after the user was added to the panel."""
+    last_change = last_password_change(user.username)
+    if last_change is None:
+        return False
+    # The shadow file keeps the days since the epoch, so a change on the day the user was added counts
+    return last_change > user.date_joined.timestamp() // 86400
+
+
+def firewall_enabled() -> bool:
+    """Returns True if UFW or firewalld is active."""
+    if os.path.exists(UFW_CONF_PATH):
+        with open(UFW_CONF_PATH) as f:
+            if re.search(r'^ENABLED\s*=\s*yes', f.read(), re.M):
+                return True
+    return service_is_active('firewalld')
+
+
+def step_states(user: object) -> dict:
+    """Returns either each step is done, by looking at the server state rather than at what was clicked."""
+    return {
+        'change_password': password_changed(user),
+        'enable_firewall': firewall_enabled(),
+        'configure_backups': Job.objects.filter(kind='server_backup', state='done').exists(),
+        'setup_notifications': (
+            NotificationChannel.objects.filter(user=user).exists()
+            or NotificationPreference.objects.filter(user=user, email=True).exists()
+        ),
+        'add_website': Website.objects.exists(),
+    }
+
+
+def checklist(user: object) -> dict:
+    """Checklist.
+
+    Returns the recommended steps after installing FastCP, with either each of them is done. A step is done
+    when the server state says so, i.e. the firewall is active, or when the admin marked it as done by hand.
+
+    Args:
+        user (object): The admin User model object the checklist is for.
+
+    Returns:
+        dict: The steps, the number of steps done and either all of them are done.
+    """
+    states = step_states(user)
+    skipped = skipped_steps()
+    steps = [{
+        'name': name,
+        'title': title,
+        'description': description,
+        'done': states.get(name) or name in skipped,
+        'skipped': name in skipped and not states.get(name)
+    } for name, title, description in STEPS]
+    done = len([s for s in steps if s.get('done')])
+    return {
+        'steps': steps,
+        'done': done,
+        'total': len(steps),
+        'complete': done == len(steps)
+    }
@@ -0,0 +1,91 @@
+import re, json, pwd
+from datetime import datetime, timedelta, timezone as dt_timezone
+from subprocess import run, PIPE, TimeoutExpired
+from django.conf import settings
+from django.db.models import Count
+from django.utils import timezone
+from core.models import User, OomEvent
+from core.utils.notifications import notify_users
+
+
+# i.e. Memory cgroup out of memory: Killed process 1234 (php-fpm8.1) total-vm:..., anon-rss:51200kB, ... UID:1001 ...
+KILLED_RE = re.compile(r'Killed process (\d+) \((.+?)\).*?anon-rss:(\d+)kB.*?UID:(\d+)')
+
+
+def scan_oom_kills(minutes: int = 10) -> list:
+    """Scan OOM kills.
+
+    Reads the kills of the kernel OOM killer from the kernel journal and records the ones of hosted users.
+    Kills for hitting a memory limit are logged by the kernel as memory cgroup OOMs, the others mean that
+    the server ran out of memory. The events already recorded are skipped, so the scanned period can
+    overlap the previous scan.
+
+    Args:
+        minutes (int): How far back to read the journal.
+
+    Returns:
+        list: The new OomEvent model objects.
+    """
+    try:
+        res = run(['/usr/bin/journalctl', '-k', '-o', 'json', '--no-pager', '--since', f'-{minutes}min'], stdout=PIPE, stderr=PIPE, timeout=60)
+    except (FileNotFoundError, TimeoutExpired):
+        return []
+
+    users = {}
+    events = []
+    for line in res.stdout.decode(errors='replace').splitlines():
+        try:
+            entry = json.loads(line)
+        except ValueError:
+            continue
+        message = entry.get('MESSAGE')
+        match = KILLED_RE.search(message) if isinstance(message, str) else None
+        if not match:
+            continue
+
+        pid, process, rss_kb, uid = match.groups()
+        if uid not in users:
+            try:
+                users[uid] = User.objects.filter(username=pwd.getpwuid(int(uid)).pw_name).first()
+            except KeyError:
+                users[uid] = None
+        if not users[uid]:
+            continue
+
+        occurred = datetime.fromtimestamp(int(entry.get('__REALTIME_TIMESTAMP')) / 1000000, tz=dt_timezone.utc).replace(microsecond=0)
+        event, created = OomEvent.objects.get_or_create(user=users[uid], pid=int(pid), occurred=occurred, defaults={
+            'process': process[:100],
+            'cgroup': 'Memory cgroup out of memory' in message,
+            'rss_kb': int(rss_kb)
+        })
+        if created:
+            events.append(event)
+    return events
+
+
+def recent_oom_kills(user: object, hours: int = 24) -> int:
+    """Returns the number of processes of a user killed by the OOM killer in the past hours."""
+    return user.oom_events.filter(occurred__gte=timezone.now() - timedelta(hours=hours)).count()
+
+
+def notify_undersized() -> None:
+    """Lets the users whose processes keep getting killed by the OOM killer know that they need more memory."""
+    if not settings.FASTCP_OOM_NOTIFY_USERS:
+        return
+    since = timezone.now() - timedelta(hours=24)
+    counts = OomEvent.objects.filter(occurred__gte=since).values('user').annotate(kills=Count('id')).filter(kills__gte=settings.FASTCP_OOM_ALERT_KILLS)
+    for row in counts:
+        user = User.objects.get(id=row.get('user'))
+        notify_users(
+            [user],
+            f'{row.get("kills")} processes of {user.username} were killed for running out of memory',
+            details='Your websites ran out of memory in the past 24 hours and their processes were killed, which shows up as 502 errors. Your plan may be undersized for your websites.',
+            once_every=timedelta(hours=24),
+            event='oom'
+        )
+
+
+def purge_oom_events() -> int:
+    """Deletes the events older than the retention period and returns the number of deleted events."""
+    cutoff = timezone.now() - timedelta(days=settings.FASTCP_OOM_RETENTION_DAYS)
+    return OomEvent.objects.filter(occurred__lt=cutoff).delete()[0]
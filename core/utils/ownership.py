@@ -0,0 +1,172 @@
+import os, pwd, stat, threading
+from concurrent.futures import ThreadPoolExecutor, wait
+from django.conf import settings
+from core.models import Website
+from core.utils import filesystem, jobs
+
+
+# How often the progress is reported, in seconds
+PROGRESS_SECONDS = 2
+
+
+def website_roots(website: object) -> list:
+    """Returns the directories of a website that should be owned by its user."""
+    paths = filesystem.get_website_paths(website)
+    return [p for p in [paths.get('base_path'), paths.get('tmp_path')] if p and os.path.isdir(p)]
+
+
+def _tree(dir_fd: int, name: str):
+    """Yields the directory fd, the name and the relative path of an entry and of everything under it if
+    it's a directory. The paths are reached from directories opened without following symlinks, so a
+    symlink swapped in during the walk cannot lead outside of the tree."""
+    yield dir_fd, name, name
+    try:
+        if not stat.S_ISDIR(os.stat(name, dir_fd=dir_fd, follow_symlinks=False).st_mode):
+            return
+    except OSError:
+        return
+    for root, dirs, files, root_fd in os.fwalk(name, dir_fd=dir_fd, follow_symlinks=False):
+        for child in dirs + files:
+            yield root_fd, child, os.path.join(root, child)
+
+
+def _open_dir(path: str) -> int:
+    """Opens a directory without following symlinks, None if the path resolves elsewhere."""
+    if os.path.realpath(path) != os.path.normpath(path):
+        return None
+    try:
+        return os.open(path, os.O_RDONLY | os.O_DIRECTORY | os.O_NOFOLLOW)
+    except OSError:
+        return None
+
+
+def fix_website_ownership(website: object, dry_run: bool = False, changes: list = None, on_progress=None) -> dict:
+    """Fix website ownership.
+
+    Walks the directories of a website without following symlinks and gives the paths owned by another
+    user or group back to the website owner. The paths are changed relative to directories opened along
+    the way, so the owner cannot redirect the walk with a symlink swapped in while it runs. The top level directories are walked by parallel workers
+    and the paths that are already owned by the owner are skipped without a chown. In dry-run mode the
+    paths are only reported.
+
+    Args:
+        website (object): Website model object.
+        dry_run (bool): Report the paths that would be changed without changing them.
+        changes (list): Optional list the changed paths are appended to.
+        on_progress (callable): Optional callback that gets the counts every PROGRESS_SECONDS.
+
+    Returns:
+        dict: The counts of the scanned, changed and failed paths.
+    """
+    entry = pwd.getpwnam(website.user.username)
+    uid, gid = entry.pw_uid, entry.pw_gid
+    counts = {'scanned': 0, 'changed': 0, 'failed': 0}
+    lock = threading.Lock()
+
+    def visit(dir_fd, name, path):
+        try:
+            st = os.stat(name, dir_fd=dir_fd, follow_symlinks=False)
+        except FileNotFoundError:
+            return
+        result = None
+        if st.st_uid != uid or st.st_gid != gid:
+            result = {'path': path, 'uid': st.st_uid, 'gid': st.st_gid}
+            try:
+                if not dry_run:
+                    os.chown(name, uid, gid, dir_fd=dir_fd, follow_symlinks=False)
+            except OSError as e:
+                result['error'] = e.strerror
+
+        with lock:
+            counts['scanned'] += 1
+            if result:
+                counts['failed' if result.get('error') else 'changed'] += 1
+                if changes is not None and len(changes) < jobs.MAX_RESULTS:
+                    changes.append(result)
+
+    def visit_tree(root_path, root_fd, name):
+        for dir_fd, entry, rel_path in _tree(root_fd, name):
+            visit(dir_fd, entry, os.path.join(root_path, rel_path))
+
+    subtrees, root_fds = [], []
+    for root_path in website_roots(website):
+        root_fd = _open_dir(root_path)
+        if root_fd is None:
+            continue
+        root_fds.append(root_fd)
+        visit(root_fd, '.', root_path)
+        subtrees += [(root_path, root_fd, name) for name in os.listdir(root_fd)]
+
+    try:
+        with ThreadPoolExecutor(max_workers=settings.FASTCP_OWNERSHIP_WORKERS) as executor:
+            futures = [executor.submit(visit_tree, *subtree) for subtree in subtrees]
+            # The progress is reported from this thread, the workers don't touch the database
+            pending = futures
+            while pending:
+                done, pending = wait(pending, timeout=PROGRESS_SECONDS)
+                if on_progress and pending:
+                    with lock:
+                        progress = dict(counts)
+                    on_progress(progress)
+            # Raise the errors of the workers, if any
+            for future in futures:
+                future.result()
+    finally:
+        for root_fd in root_fds:
+            os.close(root_fd)
+    return counts
+
+
+def fix_ownership_job(job: object, params: dict) -> dict:
+    """Fixes the ownership of the websites of a job, reporting the progress and the counts as it goes."""
+    websites = Website.objects.filter(id__in=params.get('website_ids', [])).select_related('user').order_by('label')
+    total = websites.count()
+    jobs.report_progress(job, 0, total)
+
+    results = {'dry_run': params.get('dry_run', False), 'websites': {}, 'changes': []}
+    for i, website in enumerate(websites):
+        def on_progress(counts):
+            results['websites'][website.label] = counts
+            jobs.report_progress(job, i, results=results)
+
+        try:
+            results['websites'][website.label] = fix_website_ownership(website, params.get('dry_run', False), results['changes'], on_progress)
+        except KeyError:
+            results['websites'][website.label] = {'error': f'System user {website.user.username} does not exist.'}
+        jobs.report_progress(job, i + 1, results=results)
+    return results
+
+
+def watched_roots() -> dict:
+    """Returns the public directories of the websites whose ownership is watched, with the UID and GID of their owners."""
+    roots = {}
+    for website in Website.objects.filter(watch_ownership=True).select_related('user'):
+        web_root = filesystem.get_website_paths(website).get('web_root')
+        try:
+            entry = pwd.getpwnam(website.user.username)
+        except KeyError:
+            continue
+        if os.path.isdir(web_root):
+            roots[web_root] = (entry.pw_uid, entry.pw_gid)
+    return roots
+
+
+def repair_path(path: str, uid: int, gid: int) -> int:
+    """Gives a new path back to the website owner, along with the contents if it's a directory moved in.
+    Returns the number of the paths changed."""
+    changed = 0
+    parent_fd = _open_dir(os.path.dirname(path))
+    if parent_fd is None:
+        return changed
+    try:
+        for dir_fd, name, rel_path in _tree(parent_fd, os.path.basename(path)):
+            try:
+                st = os.stat(name, dir_fd=dir_fd, follow_symlinks=False)
+                if st.st_uid != uid or st.st_gid != gid:
+                    os.chown(name, uid, gid, dir_fd=dir_fd, follow_symlinks=False)
+                    changed += 1
+            except OSError:
+                pass
+    finally:
+        os.close(parent_fd)
+    return changed
@@ -1,5 +1,217 @@
 # Functions related to PHP
+import os, re, json, shutil
+from subprocess import run, PIPE, TimeoutExpired
+from django.conf import settings
+from core.models import Website
+from core.utils.filesystem import get_website_paths, generate_fpm_conf
+
 
 def update_php_conf(website):
     # To-do: Update PHP-FPM configuration
-    pass
\ No newline at end of file
+    pass
+
+
+def pool_ini_values(website: object) -> dict:
+    """Returns the php_value and php_admin_value settings of a website's FPM pool."""
+    values = {}
+    fpm_path = get_website_paths(website).get('fpm_path')
+    if not os.path.exists(fpm_path):
+        return values
+    with open(fpm_path) as f:
+        for line in f:
+            key, sep, value = line.partition('=')
+            key = key.strip()
+            if sep and (key.startswith('php_value[') or key.startswith('php_admin_value[')) and key.endswith(']'):
+                values[key[key.index('[') + 1:-1]] = value.strip()
+    return values
+
+
+EXTENSION_RE = re.compile(r'^[a-z0-9_]+$')
+
+
+def site_extensions(website: object) -> list:
+    """Returns the extensions the website loads in its own pool."""
+    return [e for e in (website.php_extensions or '').split(',') if e]
+
+
+def extension_dir(version: str) -> str:
+    """Returns the directory a PHP version loads its shared extensions from, None if it's not installed."""
+    php_bin = f'/usr/bin/php{version}'
+    if not os.path.exists(php_bin):
+        return None
+    res = run([php_bin, '-n', '-r', 'echo ini_get("extension_dir");'], stdout=PIPE, stderr=PIPE, timeout=30)
+    return res.stdout.decode().strip() or None
+
+
+def global_extensions(version: str) -> list:
+    """Returns the extensions enabled for all pools of a PHP version in its FPM conf.d."""
+    conf_d = os.path.join(settings.PHP_INSTALL_PATH, version, 'fpm', 'conf.d')
+    if not os.path.isdir(conf_d):
+        return []
+    extensions = []
+    for name in os.listdir(conf_d):
+        match = re.match(r'^\d+-(.+)\.ini$', name)
+        if match:
+            extensions.append(match.group(1))
+    return sorted(extensions)
+
+
+def is_zend_extension(version: str, extension: str) -> bool:
+    """Returns True if the extension is a Zend extension, i.e. opcache or xdebug, those cannot be loaded per pool."""
+    ini_path = os.path.join(settings.PHP_INSTALL_PATH, version, 'mods-available', f'{extension}.ini')
+    if not os.path.exists(ini_path):
+        return False
+    with open(ini_path) as f:
+        return any(line.strip().startswith('zend_extension') for line in f)
+
+
+def available_extensions(version: str) -> list:
+    """Available extensions.
+
+    Lists the shared extensions installed for a PHP version, whether they are enabled for all pools and
+    whether a website can load them in its own pool.
+
+    Args:
+        version (str): The PHP version, i.e. 8.1.
+
+    Returns:
+        list: The extensions, empty if the PHP version is not installed.
+    """
+    ext_dir = extension_dir(version)
+    if not ext_dir or not os.path.isdir(ext_dir):
+        return []
+    enabled = global_extensions(version)
+    extensions = []
+    for name in sorted(os.listdir(ext_dir)):
+        if not name.endswith('.so'):
+            continue
+        extension = name[:-3]
+        zend = is_zend_extension(version, extension)
+        extensions.append({
+            'name': extension,
+            'global': extension in enabled,
+            'per_site': not zend and extension not in enabled
+        })
+    return extensions
+
+
+def set_site_extensions(website: object, extensions: list) -> bool:
+    """Set site extensions.
+
+    Saves the extensions the website loads in its own pool and regenerates the pool, which reloads the
+    FPM service of the website's PHP version. The extensions should be validated with available_extensions.
+
+    Args:
+        website (object): Website model object.
+        extensions (list): The extension names.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    previous = website.php_extensions
+    website.php_extensions = ','.join(sorted(set(extensions))) or None
+    website.save()
+    if generate_fpm_conf(website):
+        return True
+    website.php_extensions = previous
+    website.save()
+    return False
+
+
+def _run_as(website: object, cmd: list, timeout: int = 60) -> object:
+    """Runs a command as the SSH user of the website in the website directory."""
+    return run(
+        ['/usr/sbin/runuser', '-u', website.user.username, '--'] + cmd,
+        stdout=PIPE, stderr=PIPE, timeout=timeout, cwd=get_website_paths(website).get('base_path')
+    )
+
+
+def phpinfo(website: object) -> str:
+    """Get phpinfo.
+
+    Renders phpinfo with the php.ini of the FPM service and the settings of the website's pool applied on
+    top. It runs on the CLI as the website user, so nothing is exposed over HTTP.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        str: The phpinfo output or None if the PHP version is not installed.
+    """
+    php_bin = f'/usr/bin/php{website.php}'
+    if not os.path.exists(php_bin):
+        return None
+
+    ini_path = os.path.join(settings.PHP_INSTALL_PATH, website.php, 'fpm', 'php.ini')
+    cmd = [php_bin, '-c', ini_path]
+    for key, value in pool_ini_values(website).items():
+        cmd += ['-d', f'{key}={value}']
+    cmd += ['-r', 'phpinfo();']
+    return _run_as(website, cmd).stdout.decode(errors='replace')
+
+
+def compat_scan(website: object, version: str) -> dict:
+    """PHP compatibility scan.
+
+    Scans the PHP files of a website for code that may break with another PHP version. If phpcs with the
+    PHPCompatibility standard is available, it is used. Otherwise, the files are linted with the target PHP
+    version, which catches the syntax that no longer compiles.
+
+    Args:
+        website (object): Website model object.
+        version (str): The target PHP version, i.e. 8.1.
+
+    Returns:
+        dict: The scanner used and the issues found per file.
+    """
+    web_root = get_website_paths(website).get('web_root')
+    phpcs = settings.FASTCP_PHPCS_PATH
+    if phpcs and os.path.exists(phpcs):
+        try:
+            res = _run_as(website, [
+                phpcs, '--standard=PHPCompatibility', '--runtime-set', 'testVersion', version,
+                '--report=json', '--extensions=php', '-q', web_root
+            ], timeout=600)
+            report = json.loads(res.stdout.decode())
+        except (TimeoutExpired, ValueError):
+            return {'scanner': 'phpcs', 'error': 'The scan could not be completed.', 'files': {}}
+
+        files = {}
+        for path, data in report.get('files', {}).items():
+            if data.get('messages'):
+                files[os.path.relpath(path, web_root)] = [
+                    {'line': m.get('line'), 'type': m.get('type'), 'message': m.get('message')}
+                    for m in data.get('messages')
+                ]
+        return {'scanner': 'phpcs', 'files': files, 'totals': report.get('totals')}
+
+    php_bin = shutil.which(f'php{version}')
+    if not php_bin:
+        return {'scanner': 'lint', 'error': f'PHP {version} is not installed.', 'files': {}}
+
+    files = {}
+    scanned = 0
+    for root, dirs, names in os.walk(web_root):
+        for name in names:
+            if not name.endswith('.php'):
+                continue
+            if scanned >= settings.FASTCP_COMPAT_MAX_FILES:
+                return {'scanner': 'lint', 'files': files, 'scanned': scanned, 'truncated': True}
+            path = os.path.join(root, name)
+            scanned += 1
+            # Linted as the owner, so a symlink cannot make root read a file the owner can't
+            try:
+                res = _run_as(website, [php_bin, '-n', '-l', path], timeout=60)
+            except TimeoutExpired:
+                files[os.path.relpath(path, web_root)] = [{'line': None, 'type': 'ERROR', 'message': 'The file could not be linted in time.'}]
+                continue
+            if res.returncode != 0:
+                output = (res.stderr or res.stdout).decode(errors='replace').strip()
+                files[os.path.relpath(path, web_root)] = [{'line': None, 'type': 'ERROR', 'message': output}]
+    return {'scanner': 'lint', 'files': files, 'scanned': scanned, 'truncated': False}
+
+
+def compat_job(job: object, params: dict) -> dict:
+    """Runs compat_scan as a background job."""
+    website = Website.objects.select_related('user').get(id=params.get('website_id'))
+    return {'version': params.get('version'), **compat_scan(website, params.get('version'))}
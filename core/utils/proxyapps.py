@@ -0,0 +1,143 @@
+import os, re, pwd
+from django.conf import settings
+from django.template.loader import render_to_string
+from core.utils import servicelogs
+from core.utils.filesystem import get_user_paths, get_website_paths
+from core.utils.system import run_cmd
+from core.utils.timers import UNITS_DIR, systemd_quote, show_unit
+
+
+PORT_RE = re.compile(r'^(?:(127\.0\.0\.1|localhost|\[::1\]):)?(\d{1,5})$')
+SERVICE_PROPERTIES = ['ActiveState', 'SubState', 'Result', 'ExecMainPID', 'ExecMainStartTimestamp', 'NRestarts']
+
+
+def port_range(user: object) -> tuple:
+    """Returns the first and the last local port the apps of a user can listen on, each user has a range of
+    their own so an app cannot be proxied to a port opened by someone else. None if the ports ran out."""
+    first = settings.FASTCP_APP_PORTS_START + (user.id - 1) * settings.FASTCP_APP_PORTS_PER_USER
+    last = first + settings.FASTCP_APP_PORTS_PER_USER - 1
+    return (first, last) if last <= 65535 else None
+
+
+def normalize_upstream(website: object, upstream: str) -> tuple:
+    """Normalize upstream.
+
+    Checks the upstream of an app, either a local port like 20000 or 127.0.0.1:20000 in the port range of
+    the owner of the website, or a unix socket like unix:/srv/users/john/run/app.sock in the home directory
+    of the owner.
+
+    Args:
+        website (object): Website model object.
+        upstream (str): The upstream entered by the user.
+
+    Returns:
+        tuple: The normalized upstream and the error, one of them is None.
+    """
+    upstream = (upstream or '').strip()
+    match = PORT_RE.match(upstream)
+    if match:
+        port = int(match.group(2))
+        ports = port_range(website.user)
+        if not ports:
+            return None, 'There are no free ports for the apps of this user, use a unix socket instead.'
+        if port < ports[0] or port > ports[1]:
+            return None, f'Port {port} cannot be used, pick a port from {ports[0]} to {ports[1]}.'
+        host = '[::1]' if match.group(1) == '[::1]' else '127.0.0.1'
+        return f'{host}:{port}', None
+    if upstream.startswith('unix:'):
+        path = os.path.realpath(upstream[5:])
+        home = os.path.realpath(get_user_paths(website.user).get('base_path'))
+        if not os.path.isabs(upstream[5:]) or not path.startswith(f'{home}/'):
+            return None, f'The unix socket should be in {home}.'
+        return f'unix:{path}', None
+    return None, 'The upstream should be a local port, i.e. 20000, or a unix socket, i.e. unix:/path/to/app.sock.'
+
+
+def upstream_port(website: object) -> int:
+    """Returns the local port of the app of a website, None if it listens on a unix socket."""
+    match = PORT_RE.match(website.proxy_upstream or '')
+    return int(match.group(2)) if match else None
+
+
+def proxy_pass(website: object) -> str:
+    """Returns the proxy_pass URL of NGINX for the app of a website, None if it has no app."""
+    if not website.proxy_upstream:
+        return None
+    if website.proxy_upstream.startswith('unix:'):
+        return f'http://{website.proxy_upstream}:'
+    return f'http://{website.proxy_upstream}'
+
+
+def unit_name(website: object) -> str:
+    return f'fastcp-app-{website.id}'
+
+
+def unit_path(website: object) -> str:
+    return os.path.join(UNITS_DIR, f'{unit_name(website)}.service')
+
+
+def sync_unit(website: object) -> bool:
+    """Sync app unit.
+
+    Writes and (re)starts the systemd service that keeps the app of a website running, or removes it if
+    the website doesn't use the proxy backend or has no command. The app runs with bash as the owner of the
+    website in the systemd slice of the owner, with PORT set to the port it should listen on, and it's
+    restarted if it crashes.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        bool: True if the service was started or removed.
+    """
+    if website.get_backend() != 'proxy' or not website.app_command:
+        remove_unit(website)
+        return True
+
+    user = website.user
+    uid = user.uid or pwd.getpwnam(user.username).pw_uid
+    with open(unit_path(website), 'w') as f:
+        f.write(render_to_string('system/systemd-app-service.txt', {
+            'slug': website.slug,
+            'username': user.username,
+            'uid': uid,
+            'home': get_website_paths(website).get('base_path'),
+            'port': upstream_port(website),
+            'command': systemd_quote(website.app_command),
+        }))
+    run_cmd('/usr/bin/systemctl daemon-reload')
+    run_cmd(f'/usr/bin/systemctl enable {unit_name(website)}.service')
+    return run_cmd(f'/usr/bin/systemctl restart {unit_name(website)}.service')
+
+
+def remove_unit(website: object) -> None:
+    """Stops the app of a website and removes its service."""
+    if not os.path.exists(unit_path(website)):
+        return
+    run_cmd(f'/usr/bin/systemctl disable --now {unit_name(website)}.service')
+    os.remove(unit_path(website))
+    run_cmd('/usr/bin/systemctl daemon-reload')
+
+
+def restart(website: object) -> bool:
+    return run_cmd(f'/usr/bin/systemctl restart {unit_name(website)}.service')
+
+
+def app_status(website: object) -> dict:
+    """Returns the state of the app service of a website, None if FastCP doesn't run the app."""
+    if not os.path.exists(unit_path(website)):
+        return None
+    service = show_unit(f'{unit_name(website)}.service', SERVICE_PROPERTIES)
+    return {
+        'running': service.get('ActiveState') == 'active',
+        'state': service.get('SubState'),
+        'result': service.get('Result'),
+        'pid': int(service.get('ExecMainPID') or 0) or None,
+        'started': service.get('ExecMainStartTimestamp'),
+        'restarts': int(service.get('NRestarts') or 0),
+    }
+
+
+def app_output(website: object, lines: int = 100) -> list:
+    """Returns the recent output of the app of a website from the journal."""
+    return servicelogs.journal_entries(unit_name(website), lines)
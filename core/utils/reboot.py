@@ -0,0 +1,172 @@
+import os, json, psutil
+from datetime import datetime
+from core.models import Website, User
+from core.utils import health, jobs
+from core.utils.notifications import notify_admins
+from core.utils.system import run_cmd
+
+
+# Created by Ubuntu's update-notifier when an update needs a reboot
+REBOOT_REQUIRED_PATH = '/var/run/reboot-required'
+REBOOT_REQUIRED_PKGS_PATH = '/var/run/reboot-required.pkgs'
+REBOOT_STATE_PATH = '/var/fastcp/.config/reboot.json'
+
+# A pending reboot the server hasn't gone through this many minutes after it was due was cancelled
+PENDING_EXPIRY_MINS = 30
+
+
+def _read_state() -> dict:
+    """Returns the saved reboot state."""
+    if os.path.exists(REBOOT_STATE_PATH):
+        with open(REBOOT_STATE_PATH) as f:
+            return json.load(f)
+    return {}
+
+
+def _write_state(state: dict) -> None:
+    """Saves the reboot state."""
+    os.makedirs(os.path.dirname(REBOOT_STATE_PATH), exist_ok=True)
+    with open(REBOOT_STATE_PATH, 'w') as f:
+        json.dump(state, f)
+
+
+def reboot_required() -> dict:
+    """Check if a reboot is required.
+
+    Returns:
+        dict: Either a reboot is required or not and the packages that requested it.
+    """
+    packages = []
+    if os.path.exists(REBOOT_REQUIRED_PKGS_PATH):
+        with open(REBOOT_REQUIRED_PKGS_PATH) as f:
+            packages = sorted(set(filter(None, [line.strip() for line in f])))
+    return {
+        'required': os.path.exists(REBOOT_REQUIRED_PATH),
+        'packages': packages
+    }
+
+
+def _expire_pending(state: dict) -> dict:
+    """Drops the pending reboot if it was cancelled, i.e. with shutdown -c, so it doesn't block the next one."""
+    pending = state.get('pending')
+    if not pending or psutil.boot_time() >= pending.get('requested'):
+        return state
+    due = pending.get('requested') + (pending.get('delay_mins', 1) + PENDING_EXPIRY_MINS) * 60
+    if datetime.now().timestamp() > due:
+        state['pending'] = None
+        _write_state(state)
+    return state
+
+
+def reboot_status() -> dict:
+    """Returns the reboot requirement, the pending supervised reboot if any and the last verification report."""
+    state = _expire_pending(_read_state())
+    return {
+        **reboot_required(),
+        'pending': state.get('pending'),
+        'report': state.get('report')
+    }
+
+
+def supervised_reboot(user: object, delay_mins: int = 1) -> bool:
+    """Reboot the server under supervision.
+
+    Records the services and websites that are healthy right now and schedules a reboot. Once the server
+    is back, verify_reboot compares the state with this record and reports what didn't come back.
+
+    Args:
+        user (object): The admin who requested the reboot, the verification job runs on their behalf.
+        delay_mins (int): Minutes to wait before rebooting so the job can finish.
+
+    Returns:
+        bool: True if the reboot has been scheduled and False otherwise.
+    """
+    websites = {}
+    for website in Website.objects.all():
+        if health.check_website(website).get('healthy'):
+            websites[website.id] = website.label
+
+    services = [service for service, active in health.check_services().items() if active]
+    state = _read_state()
+    state['pending'] = {
+        'requested': datetime.now().timestamp(),
+        'delay_mins': delay_mins,
+        'user_id': user.id,
+        'services': services,
+        'websites': websites
+    }
+    _write_state(state)
+
+    if run_cmd(f'/usr/sbin/shutdown -r +{delay_mins}'):
+        return True
+
+    state['pending'] = None
+    _write_state(state)
+    return False
+
+
+def reboot_job(job: object, params: dict) -> dict:
+    """Checks the services and websites and schedules a supervised reboot, run as a background job."""
+    if not supervised_reboot(job.user):
+        raise ValueError('The reboot cannot be scheduled.')
+    return {'scheduled': True}
+
+
+def start_verification() -> object:
+    """Starts the job that verifies a completed supervised reboot, returns None if there is nothing to verify."""
+    pending = _read_state().get('pending')
+    if not pending or psutil.boot_time() < pending.get('requested'):
+        return None
+    user = User.objects.filter(id=pending.get('user_id'), is_superuser=True).first() or User.objects.filter(is_superuser=True).first()
+    if not user:
+        return None
+    return jobs.start_job(user, 'verify_reboot', 'server', {}, verify_job)
+
+
+def verify_job(job: object, params: dict) -> dict:
+    """Verifies a supervised reboot and notifies the admins of the outcome, run as a background job."""
+    report = verify_reboot()
+    if report is None:
+        return {}
+
+    if report.get('healthy'):
+        notify_admins('Server rebooted successfully', details='All services and websites are back online.', event='reboot_ok')
+    else:
+        details = []
+        if report.get('failed_services'):
+            details.append(f'Services down: {", ".join(report.get("failed_services"))}.')
+        if report.get('failed_websites'):
+            details.append(f'Websites down: {", ".join(report.get("failed_websites"))}.')
+        notify_admins('Some services did not come back after the reboot', details=' '.join(details), event='reboot_failed')
+    return report
+
+
+def verify_reboot() -> dict:
+    """Verify a supervised reboot.
+
+    If a supervised reboot has completed, checks that the services and websites that were healthy before
+    the reboot are healthy again.
+
+    Returns:
+        dict: The verification report or None if there is no completed supervised reboot to verify.
+    """
+    state = _read_state()
+    pending = state.get('pending')
+    if not pending or psutil.boot_time() < pending.get('requested'):
+        return None
+
+    failed_services = [service for service in pending.get('services') if not health.service_is_active(service)]
+    failed_websites = []
+    for website_id, label in pending.get('websites').items():
+        website = Website.objects.filter(id=website_id).first()
+        if website and not health.check_website(website).get('healthy'):
+            failed_websites.append(label)
+
+    report = {
+        'verified': datetime.now().timestamp(),
+        'failed_services': failed_services,
+        'failed_websites': failed_websites,
+        'healthy': not failed_services and not failed_websites
+    }
+    _write_state({'pending': None, 'report': report})
+    return report
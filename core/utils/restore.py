@@ -0,0 +1,205 @@
+import os, stat
+from contextlib import nullcontext
+from datetime import datetime
+from subprocess import run, Popen, PIPE, DEVNULL
+from django.core.paginator import Paginator, EmptyPage
+from django.utils import timezone
+from core.models import Website
+from core.utils import volumes, jobs, system, immutable, integrity
+from core.utils.filesystem import get_website_paths
+
+
+def clean_paths(paths: list) -> list:
+    """Returns the paths to restore relative to the website directory, None if a path points outside of it."""
+    cleaned = []
+    for path in paths or ['']:
+        path = os.path.normpath(path.strip().strip('/')) if path.strip().strip('/') else ''
+        if path.startswith('..') or os.path.isabs(path):
+            return None
+        cleaned.append(path)
+    return cleaned
+
+
+def snapshot_dir(website: object, label: str) -> str:
+    """Returns the directory of a snapshot of a website, None if the label is not one of the snapshots the
+    driver lists for the website, so a label like ../../etc cannot point anywhere else."""
+    driver = volumes.get_driver()
+    base_path = get_website_paths(website).get('base_path')
+    if not driver.has_snapshot(base_path, label):
+        return None
+    return driver.snapshot_path(base_path, label)
+
+
+def snapshot_file(website: object, label: str, path: str) -> str:
+    """Returns the absolute path of a file or directory inside a snapshot of a website.
+
+    None is returned if the snapshot or the path doesn't exist, or if the path, or a symlink on the way to
+    it, points outside of the snapshot.
+    """
+    cleaned = clean_paths([path or ''])
+    directory = snapshot_dir(website, label) if cleaned is not None else None
+    if not directory:
+        return None
+    root = os.path.realpath(directory)
+    resolved = os.path.realpath(os.path.join(root, cleaned[0]))
+    if resolved != root and not resolved.startswith(root + '/'):
+        return None
+    return resolved if os.path.exists(resolved) else None
+
+
+def browse_snapshot(website: object, label: str, path: str, page: int = 1, per_page: int = 100) -> dict:
+    """Browse snapshot.
+
+    Lists a directory inside a snapshot of a website a page at a time, directories first, so the single
+    files and folders to restore or download can be picked without restoring the whole website.
+
+    Args:
+        website (object): Website model object.
+        label (str): The snapshot label.
+        path (str): The directory relative to the website directory, empty for the website directory.
+        page (int): The page number.
+        per_page (int): The entries per page.
+
+    Returns:
+        dict: The paginated entries, or None if the directory was not found in the snapshot.
+    """
+    directory = snapshot_file(website, label, path)
+    if not directory or not os.path.isdir(directory):
+        return None
+    path = clean_paths([path or ''])[0]
+
+    entries = []
+    with os.scandir(directory) as it:
+        for entry in it:
+            try:
+                info = entry.stat(follow_symlinks=False)
+            except OSError:
+                continue
+            if stat.S_ISLNK(info.st_mode):
+                kind = 'link'
+            elif stat.S_ISDIR(info.st_mode):
+                kind = 'directory'
+            else:
+                kind = 'file'
+            entries.append({
+                'name': entry.name,
+                'path': os.path.join(path, entry.name),
+                'type': kind,
+                'size': info.st_size if kind == 'file' else None,
+                'modified': datetime.fromtimestamp(info.st_mtime, tz=timezone.utc)
+            })
+    entries.sort(key=lambda e: (e.get('type') != 'directory', e.get('name').lower()))
+
+    paginator = Paginator(entries, per_page)
+    try:
+        current = paginator.page(page)
+    except EmptyPage:
+        current = paginator.page(1)
+    return {
+        'snapshot': label,
+        'path': path,
+        'links': {
+            'next': current.next_page_number() if current.has_next() else None,
+            'previous': current.previous_page_number() if current.has_previous() else None
+        },
+        'count': len(entries),
+        'results': current.object_list
+    }
+
+
+def stream_directory(directory: str):
+    """Yields a directory inside a snapshot as a tar.gz, so a folder is downloaded without an export job."""
+    proc = Popen(['/bin/tar', '-czf', '-', '-C', os.path.dirname(directory), os.path.basename(directory)], stdout=PIPE, stderr=DEVNULL)
+    try:
+        while True:
+            chunk = proc.stdout.read(1024 * 1024)
+            if not chunk:
+                break
+            yield chunk
+    finally:
+        proc.stdout.close()
+        proc.wait()
+
+
+def _rsync(website: object, source: str, dest: str, dry_run: bool) -> list:
+    """Copies a snapshot directory over the destination and returns the itemized changes. rsync runs as the
+    owner of the website, so a symlink planted in the website directory cannot send the files elsewhere."""
+    as_owner = ['/usr/sbin/runuser', '-u', website.user.username, '--']
+    cmd = as_owner + ['/usr/bin/rsync', '-a', '--delete', '--itemize-changes', '--out-format=%i %n']
+    if dry_run:
+        cmd.append('--dry-run')
+    if os.path.isdir(source):
+        source, dest = f'{source}/', f'{dest}/'
+    else:
+        run(as_owner + ['/bin/mkdir', '-p', os.path.dirname(dest)], stdout=DEVNULL, stderr=DEVNULL)
+    res = run(cmd + [source, dest], stdout=PIPE, stderr=PIPE, cwd='/')
+    if res.returncode != 0:
+        raise RuntimeError(f'rsync: {res.stderr.decode(errors="replace").strip()}')
+    return res.stdout.decode(errors='replace').splitlines()
+
+
+def restore_website(website: object, label: str, paths: list, alternate: str = None, dry_run: bool = True) -> dict:
+    """Restore website.
+
+    Restores the files of a website, or only the provided paths of it, from a snapshot. The files are
+    restored in place or into an alternate directory inside the website directory, so they can be
+    compared before they replace the live files. In place restores take a snapshot of the current state
+    first. With dry_run, the files that would be overwritten, created and deleted are only listed.
+
+    Args:
+        website (object): Website model object.
+        label (str): The snapshot label.
+        paths (list): The paths relative to the website directory, an empty path restores everything.
+        alternate (str): Optional directory relative to the website directory to restore into.
+        dry_run (bool): Only list the changes.
+
+    Returns:
+        dict: The changes per kind.
+    """
+    base_path = get_website_paths(website).get('base_path')
+    directory = snapshot_dir(website, label)
+    if not directory:
+        raise RuntimeError(f'Snapshot {label} of {website.label} was not found.')
+
+    target = os.path.join(base_path, alternate) if alternate else base_path
+    report = {'website': website.label, 'snapshot': label, 'target': target, 'overwritten': [], 'created': [], 'deleted': []}
+    if not dry_run and not alternate:
+        report['before_restore'] = system.snapshot_website(website, label=f'before-restore-{timezone.now().strftime("%Y%m%d%H%M%S")}')
+
+    for path in paths:
+        source = os.path.join(directory, path) if path else directory
+        if not os.path.lexists(source):
+            raise RuntimeError(f'{path} does not exist in snapshot {label}.')
+        for line in _rsync(website, source, os.path.join(target, path) if path else target, dry_run):
+            change, _, name = line.partition(' ')
+            name = os.path.join(path, name) if path and os.path.isdir(source) else (path or name)
+            if change.startswith('*deleting'):
+                kind = 'deleted'
+            elif change[1:2] == 'f' and '+++' in change:
+                kind = 'created'
+            elif change[1:2] == 'f' and change[0] in '<>c':
+                kind = 'overwritten'
+            else:
+                continue
+            if len(report[kind]) < jobs.MAX_RESULTS:
+                report[kind].append(name)
+
+    if not dry_run:
+        system.fix_ownership(website)
+    return report
+
+
+def restore_job(job: object, params: dict) -> dict:
+    """Runs restore_website for the websites of a restore as a job and returns the changes per website."""
+    websites = Website.objects.filter(id__in=params.get('website_ids')).order_by('id')
+    jobs.report_progress(job, 0, len(websites))
+    results = []
+    for i, website in enumerate(websites):
+        # The locked files are only unlocked while they are restored in place
+        in_place = not params.get('dry_run') and not params.get('alternate')
+        with immutable.lifted(website) if in_place else nullcontext():
+            results.append(restore_website(website, params.get('snapshot'), params.get('paths'), params.get('alternate'), params.get('dry_run')))
+        if in_place:
+            integrity.rebaseline(website)
+        jobs.report_progress(job, i + 1)
+    return {'websites': results}
@@ -0,0 +1,42 @@
+from datetime import timedelta
+from django.conf import settings
+from django.utils import timezone
+from django_cron.models import CronJobLog
+from core.models import Job, Operation, Notification, CheckResult, OomEvent, UsageSample
+
+
+# The tables that grow with the time, the field their age is read from, the setting holding their retention
+# in days and the rows kept no matter how old they are, i.e. running jobs
+POLICIES = {
+    'jobs': (Job, 'created', 'FASTCP_JOB_RETENTION_DAYS', {'state__in': ['queued', 'running']}),
+    'operations': (Operation, 'started', 'FASTCP_OPERATION_RETENTION_DAYS', {'state__in': ['running', 'failed']}),
+    'notifications': (Notification, 'date', 'FASTCP_NOTIFICATION_RETENTION_DAYS', {}),
+    'check_results': (CheckResult, 'created', 'FASTCP_CHECK_RETENTION_DAYS', {}),
+    'oom_events': (OomEvent, 'occurred', 'FASTCP_OOM_RETENTION_DAYS', {}),
+    'cron_logs': (CronJobLog, 'end_time', 'DJANGO_CRON_DELETE_LOGS_OLDER_THAN', {}),
+    'usage_5min': (UsageSample, 'time', 'FASTCP_USAGE_RAW_RETENTION_DAYS', {'resolution': 'hour'}),
+    'usage_hourly': (UsageSample, 'time', 'FASTCP_USAGE_RETENTION_DAYS', {'resolution': '5min'}),
+}
+
+
+def _expired(name: str):
+    """Returns the rows of a table older than its retention period."""
+    model, field, setting, keep = POLICIES.get(name)
+    cutoff = timezone.now() - timedelta(days=getattr(settings, setting))
+    return model.objects.filter(**{f'{field}__lt': cutoff}).exclude(**keep)
+
+
+def retention_status() -> dict:
+    """Returns the retention in days, the number of rows and the number of expired rows of each table."""
+    return {
+        name: {
+            'retention_days': getattr(settings, setting),
+            'rows': model.objects.count(),
+            'expired': _expired(name).count()
+        } for name, (model, field, setting, keep) in POLICIES.items()
+    }
+
+
+def purge_expired() -> dict:
+    """Deletes the expired rows of all tables and returns the number of deleted rows per table."""
+    return {name: _expired(name).delete()[0] for name in POLICIES}
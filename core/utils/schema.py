@@ -0,0 +1,71 @@
+import os, glob, sqlite3
+from django.conf import settings
+from django.db import connection
+from django.db.migrations.executor import MigrationExecutor
+from django.db.migrations.recorder import MigrationRecorder
+from django.utils import timezone
+
+
+def database_path() -> str:
+    """Returns the path of the panel database, None if it isn't an SQLite database."""
+    db = settings.DATABASES.get('default')
+    if db.get('ENGINE') != 'django.db.backends.sqlite3':
+        return None
+    return str(db.get('NAME'))
+
+
+def list_backups() -> list:
+    """Returns the database backups taken before the migrations, newest first."""
+    paths = sorted(glob.glob(os.path.join(settings.FASTCP_DB_BACKUPS_DIR, 'db-*.sqlite3')), reverse=True)
+    return [{'name': os.path.basename(p), 'size': os.path.getsize(p), 'path': p} for p in paths]
+
+
+def backup_database() -> str:
+    """Backup database.
+
+    Takes a consistent copy of the panel database with the SQLite backup API, so a failed or unwanted
+    migration can be undone by copying the backup back while the panel is stopped. Only the latest
+    backups are kept.
+
+    Returns:
+        str: The backup path, None if there is no SQLite database to back up yet.
+    """
+    path = database_path()
+    if not path or not os.path.exists(path):
+        return None
+    os.makedirs(settings.FASTCP_DB_BACKUPS_DIR, mode=0o700, exist_ok=True)
+    backup_path = os.path.join(settings.FASTCP_DB_BACKUPS_DIR, f'db-{timezone.now():%Y%m%d%H%M%S}.sqlite3')
+    source = sqlite3.connect(path)
+    dest = sqlite3.connect(backup_path)
+    with dest:
+        source.backup(dest)
+    source.close()
+    dest.close()
+    os.chmod(backup_path, 0o600)
+
+    for backup in list_backups()[settings.FASTCP_DB_BACKUPS_KEEP:]:
+        os.remove(backup.get('path'))
+    return backup_path
+
+
+def migration_status() -> dict:
+    """Returns the applied and the pending migrations of each app, along with the database backups."""
+    executor = MigrationExecutor(connection)
+    applied = {(m.app, m.name): m.applied for m in MigrationRecorder(connection).migration_qs.all()}
+    pending = [f'{m.app_label}.{m.name}' for m, backwards in executor.migration_plan(executor.loader.graph.leaf_nodes())]
+
+    apps = {}
+    for app_label, name in sorted(executor.loader.graph.nodes):
+        app = apps.setdefault(app_label, {'applied': 0, 'pending': 0, 'latest': None, 'latest_applied': None})
+        if (app_label, name) in applied:
+            app['applied'] += 1
+            if not app['latest_applied'] or applied[(app_label, name)] > app['latest_applied']:
+                app['latest'], app['latest_applied'] = name, applied[(app_label, name)]
+        else:
+            app['pending'] += 1
+    return {
+        'up_to_date': not pending,
+        'pending': pending,
+        'apps': apps,
+        'backups': [{'name': b.get('name'), 'size': b.get('size')} for b in list_backups()]
+    }
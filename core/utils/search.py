@@ -0,0 +1,82 @@
+import os
+from django.db.models import Q
+from core.models import Website, Domain, Database, User, MailDomain, Mailbox, DnsZone
+from core.utils import tags
+
+
+# Crontabs of the system users
+CRONTABS_DIR = '/var/spool/cron/crontabs'
+
+
+def _result(kind: str, obj_id: int, title: str, subtitle: str = None, url: str = None, api_url: str = None) -> dict:
+    return {'type': kind, 'id': obj_id, 'title': title, 'subtitle': subtitle, 'url': url, 'api_url': api_url}
+
+
+def cron_matches(usernames: list, query: str, limit: int) -> list:
+    """Returns the crontab lines of the users that contain the query."""
+    matches = []
+    for username in usernames:
+        path = os.path.join(CRONTABS_DIR, username)
+        try:
+            with open(path) as f:
+                lines = f.readlines()
+        except OSError:
+            continue
+        for line in lines:
+            line = line.strip()
+            if line and not line.startswith('#') and query.lower() in line.lower():
+                matches.append((username, line))
+                if len(matches) >= limit:
+                    return matches
+    return matches
+
+
+def search(user: object, query: str, limit: int = 10) -> list:
+    """Search.
+
+    Searches the domains, websites, SSH users, databases, mail domains, mailboxes, DNS zones, crontabs
+    and the tags of the user, or of everyone along with the notes for admins. Each result has its type
+    and the panel and API links to it.
+
+    Args:
+        user (object): User model object who searches.
+        query (str): The text to search for.
+        limit (int): The most results per type.
+
+    Returns:
+        list: The results.
+    """
+    admin = user.is_superuser
+    owned = Q() if admin else Q(user=user)
+    results = []
+
+    for website in Website.objects.filter(owned).filter(Q(label__icontains=query) | tags.search_q(query, notes=admin)).order_by('label')[:limit]:
+        results.append(_result('website', website.id, website.label, website.tags, f'/websites/{website.id}', f'/api/websites/{website.id}/'))
+
+    domains = Domain.objects.filter(Q() if admin else Q(website__user=user)).filter(domain__icontains=query).select_related('website')
+    for domain in domains.order_by('domain')[:limit]:
+        results.append(_result('domain', domain.id, domain.domain, domain.website.label, f'/websites/{domain.website.id}', f'/api/websites/{domain.website.id}/'))
+
+    if admin:
+        users = User.objects.filter(is_superuser=False).filter(Q(username__icontains=query) | tags.search_q(query))
+        for u in users.order_by('username')[:limit]:
+            results.append(_result('user', u.id, u.username, u.tags, f'/users/{u.id}', f'/api/ssh-users/{u.id}/'))
+
+    for database in Database.objects.filter(owned).filter(Q(name__icontains=query) | Q(username__icontains=query)).order_by('name')[:limit]:
+        results.append(_result('database', database.id, database.name, database.get_engine_display(), f'/databases/{database.id}', f'/api/databases/{database.id}/'))
+
+    for mail_domain in MailDomain.objects.filter(Q() if admin else Q(website__user=user)).filter(domain__icontains=query).order_by('domain')[:limit]:
+        results.append(_result('mail_domain', mail_domain.id, mail_domain.domain, None, f'/websites/{mail_domain.website_id}', f'/api/mail/domains/{mail_domain.id}/'))
+
+    mailboxes = Mailbox.objects.filter(Q() if admin else Q(mail_domain__website__user=user)).filter(local_part__icontains=query).select_related('mail_domain')
+    for mailbox in mailboxes.order_by('local_part')[:limit]:
+        results.append(_result('mailbox', mailbox.id, mailbox.address, None, f'/websites/{mailbox.mail_domain.website_id}', f'/api/mail/domains/{mailbox.mail_domain_id}/mailboxes/'))
+
+    for zone in DnsZone.objects.filter(owned).filter(domain__icontains=query).order_by('domain')[:limit]:
+        results.append(_result('dns_zone', zone.id, zone.domain, zone.get_backend_display(), None, f'/api/dns/zones/{zone.id}/'))
+
+    users = User.objects.filter(is_superuser=False) if admin else User.objects.filter(id=user.id)
+    usernames = {u.username: u.id for u in users}
+    for username, line in cron_matches(list(usernames), query, limit):
+        results.append(_result('cron', usernames.get(username), line, username, f'/users/{usernames.get(username)}' if admin else None, None))
+    return results
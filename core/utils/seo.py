@@ -0,0 +1,206 @@
+import os, re, json
+import requests
+from defusedxml import ElementTree as ET, DefusedXmlException
+from urllib.parse import urlsplit
+from django.conf import settings
+from django.utils import timezone
+from core.models import Website
+
+
+# The sitemaps looked for when robots.txt doesn't list one, WordPress serves its own since 5.5
+SITEMAP_PATHS = ['/sitemap.xml', '/sitemap_index.xml', '/wp-sitemap.xml']
+SITEMAP_ROOTS = ['urlset', 'sitemapindex']
+
+META_ROBOTS_RE = re.compile(r'<meta[^>]+name=["\']robots["\'][^>]*>', re.IGNORECASE)
+CANONICAL_RE = re.compile(r'<link[^>]+rel=["\']canonical["\'][^>]*href=["\']([^"\']+)["\']', re.IGNORECASE)
+
+# The homepage is read up to this size to find the meta tags in the head
+MAX_PAGE_BYTES = 512 * 1024
+
+# The sitemaps are read up to the 50 MB the sitemap protocol allows
+MAX_SITEMAP_BYTES = 50 * 1024 * 1024
+
+
+def report_path(website: object) -> str:
+    return os.path.join(settings.FASTCP_SEO_DIR, f'{website.id}.json')
+
+
+def canonical_domain(website: object) -> str:
+    """Returns the domain a website is served on, the ones redirected to another domain are skipped."""
+    redirected = [source for source, target in website.host_redirects()]
+    for domain in website.domains.order_by('id').values_list('domain', flat=True):
+        if domain not in redirected:
+            return domain
+    return None
+
+
+def fetch(domain: str, path: str, https: bool = False) -> object:
+    """Requests a path of a website from the local web server, the certificate is checked separately."""
+    url = f'{"https" if https else "http"}://127.0.0.1{path}'
+    try:
+        return requests.get(url, headers={'Host': domain, 'User-Agent': 'FastCP SEO check'}, timeout=15, allow_redirects=False, verify=False, stream=True)
+    except requests.RequestException:
+        return None
+
+
+def read_body(res: object, limit: int) -> bytes:
+    """Reads a streamed response up to a size limit and closes it."""
+    data = b''
+    for chunk in res.iter_content(65536):
+        data += chunk
+        if len(data) >= limit:
+            break
+    res.close()
+    return data[:limit]
+
+
+def _body(res: object) -> str:
+    return read_body(res, MAX_PAGE_BYTES).decode(res.encoding or 'utf-8', errors='replace')
+
+
+def _result(name: str, ok: bool, message: str) -> dict:
+    return {'check': name, 'ok': ok, 'message': message}
+
+
+def check_https(website: object, domain: str) -> dict:
+    if not website.has_ssl:
+        return _result('https', False, f'{domain} has no SSL certificate, search engines prefer HTTPS pages.')
+    res = fetch(domain, '/')
+    if res is None:
+        return _result('https', False, f'http://{domain}/ cannot be requested.')
+    location = res.headers.get('Location', '')
+    res.close()
+    if res.status_code in [301, 308] and location.startswith(f'https://{domain}'):
+        return _result('https', True, f'http://{domain}/ redirects to HTTPS permanently.')
+    if res.status_code in [302, 307] and location.startswith('https://'):
+        return _result('https', False, f'http://{domain}/ redirects to HTTPS temporarily, a permanent 301 redirect is better for search engines.')
+    return _result('https', False, f'http://{domain}/ is served without redirecting to HTTPS, enable Force HTTPS.')
+
+
+def check_robots(website: object, domain: str, https: bool) -> tuple:
+    """Checks robots.txt and returns the result along with the sitemaps it lists."""
+    res = fetch(domain, '/robots.txt', https)
+    if res is None or res.status_code != 200:
+        if res is not None:
+            res.close()
+        return _result('robots', False, '/robots.txt is not served, search engines crawl the whole website without it.'), []
+    body = _body(res)
+    sitemaps = [line.split(':', 1)[1].strip() for line in body.splitlines() if line.lower().startswith('sitemap:')]
+
+    # A Disallow: / for all user agents hides the whole website from the search engines
+    agent, blocks_all = None, False
+    for line in body.splitlines():
+        line = line.split('#', 1)[0].strip()
+        key, _, value = line.partition(':')
+        key, value = key.strip().lower(), value.strip()
+        if key == 'user-agent':
+            agent = value
+        elif key == 'disallow' and agent == '*' and value == '/':
+            blocks_all = True
+    if blocks_all:
+        return _result('robots', False, '/robots.txt disallows all crawlers from the whole website.'), sitemaps
+    return _result('robots', True, '/robots.txt is served and allows crawling.'), sitemaps
+
+
+def check_sitemap(website: object, domain: str, https: bool, listed: list) -> dict:
+    paths = [urlsplit(url).path or '/' for url in listed if urlsplit(url).hostname in [domain, None]] + SITEMAP_PATHS
+    for path in dict.fromkeys(paths):
+        res = fetch(domain, path, https)
+        if res is None:
+            continue
+        if res.status_code != 200:
+            res.close()
+            continue
+        try:
+            # Sitemaps are often larger than the pages, so they have a cap of their own
+            root = ET.fromstring(read_body(res, MAX_SITEMAP_BYTES))
+        except (ET.ParseError, DefusedXmlException):
+            return _result('sitemap', False, f'{path} is not valid XML.')
+        if root.tag.split('}')[-1] not in SITEMAP_ROOTS:
+            return _result('sitemap', False, f'{path} is not a sitemap.')
+        return _result('sitemap', True, f'{path} is a valid sitemap.')
+    return _result('sitemap', False, 'No sitemap was found, list one in robots.txt or serve /sitemap.xml.')
+
+
+def check_homepage(website: object, domain: str, https: bool) -> list:
+    """Checks that the homepage can be indexed and that its canonical URL uses HTTPS."""
+    res = fetch(domain, '/', https)
+    if res is None or res.status_code != 200:
+        status = res.status_code if res is not None else 'no response'
+        if res is not None:
+            res.close()
+        return [_result('indexing', False, f'The homepage cannot be checked, it responded with {status}.')]
+    header = res.headers.get('X-Robots-Tag', '')
+    body = _body(res)
+    meta = ' '.join(META_ROBOTS_RE.findall(body))
+
+    results = []
+    if 'noindex' in header.lower() or 'noindex' in meta.lower():
+        where = 'X-Robots-Tag header' if 'noindex' in header.lower() else 'robots meta tag'
+        hint = ' Uncheck Discourage search engines in the WordPress reading settings.' if website.is_wp else ''
+        results.append(_result('indexing', False, f'The homepage is hidden from search engines with a noindex {where}.{hint}'))
+    else:
+        results.append(_result('indexing', True, 'The homepage can be indexed.'))
+
+    canonical = CANONICAL_RE.search(body)
+    if canonical and canonical.group(1).startswith('http://') and website.has_ssl:
+        results.append(_result('canonical', False, f'The canonical URL of the homepage is {canonical.group(1)}, it should use HTTPS.'))
+    elif canonical:
+        results.append(_result('canonical', True, f'The canonical URL of the homepage is {canonical.group(1)}.'))
+    return results
+
+
+def check_website(website: object) -> dict:
+    """Check SEO basics.
+
+    Checks the basics that keep a website visible to the search engines: HTTPS with a permanent redirect,
+    a robots.txt that allows crawling, a valid sitemap, a homepage without noindex and a canonical URL on
+    HTTPS. The website is requested from the local web server and the last report is saved.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        dict: The checked domain and the result of each check, with a message to show to the owner.
+    """
+    domain = canonical_domain(website)
+    results = []
+    if domain:
+        https = website.has_ssl
+        results.append(check_https(website, domain))
+        robots, sitemaps = check_robots(website, domain, https)
+        results.append(robots)
+        results.append(check_sitemap(website, domain, https, sitemaps))
+        results += check_homepage(website, domain, https)
+    report = {
+        'checked': timezone.now().isoformat(),
+        'domain': domain,
+        'warnings': len([r for r in results if not r.get('ok')]),
+        'results': results
+    }
+    os.makedirs(settings.FASTCP_SEO_DIR, mode=0o700, exist_ok=True)
+    with open(report_path(website), 'w') as f:
+        json.dump(report, f)
+    return report
+
+
+def last_report(website: object) -> dict:
+    try:
+        with open(report_path(website)) as f:
+            return json.load(f)
+    except (OSError, ValueError):
+        return None
+
+
+def delete_report(website: object) -> None:
+    if os.path.exists(report_path(website)):
+        os.remove(report_path(website))
+
+
+def check_websites() -> int:
+    """Checks the websites with the SEO checks on and returns the number of websites with warnings."""
+    warned = 0
+    for website in Website.objects.filter(seo_checks=True).prefetch_related('domains'):
+        if check_website(website).get('warnings'):
+            warned += 1
+    return warned
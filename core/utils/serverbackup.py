@@ -0,0 +1,134 @@
+import os, io, glob, json, fnmatch, time, socket, sqlite3, tarfile, tempfile
+from django.conf import settings
+from core.utils import jobs, exports
+
+
+# The FastCP systemd units, matched by name as they differ per server
+UNITS_PATTERN = '/etc/systemd/system/fastcp*'
+
+
+# The panel state and the system config FastCP generates, enough to rebuild a server with the website data
+def backup_paths(existing: bool = True) -> list:
+    """Returns the paths a server backup includes, only the existing ones unless existing is False."""
+    paths = [
+        '/etc/fastcp',
+        '/var/fastcp/.config',
+        settings.FASTCP_TEMPLATES_DIR,
+        settings.NGINX_BASE_DIR,
+        os.path.dirname(settings.APACHE_VHOST_ROOT),
+        settings.PHP_INSTALL_PATH,
+        settings.FASTCP_BIND_ZONES_DIR,
+        '/etc/letsencrypt',
+        '/etc/postfix',
+        '/etc/dovecot',
+        '/etc/opendkim',
+        '/etc/proftpd',
+        *glob.glob(UNITS_PATTERN),
+    ]
+    return [p for p in dict.fromkeys(paths) if p and (not existing or os.path.exists(p))]
+
+
+def restore_paths(manifest: dict) -> list:
+    """Returns the paths of a manifest a server backup may restore, the paths it doesn't include are left
+    out so a crafted archive cannot pick its own destinations."""
+    allowed = backup_paths(existing=False)
+    paths = []
+    for path in manifest.get('paths') or []:
+        if not isinstance(path, str) or os.path.normpath(path) != path:
+            continue
+        unit = os.path.dirname(path) == os.path.dirname(UNITS_PATTERN) and fnmatch.fnmatch(path, UNITS_PATTERN)
+        if path in allowed or unit:
+            paths.append(path)
+    return paths
+
+
+def _panel_db() -> str:
+    """Returns a consistent copy of the panel database, taken with the SQLite backup API."""
+    fd, path = tempfile.mkstemp(prefix='fastcp-db-')
+    os.close(fd)
+    source = sqlite3.connect(str(settings.DATABASES.get('default').get('NAME')))
+    dest = sqlite3.connect(path)
+    with dest:
+        source.backup(dest)
+    source.close()
+    dest.close()
+    return path
+
+
+def server_backup(job: object, params: dict) -> dict:
+    """Server backup.
+
+    Archives the panel database, the panel config, the web server, PHP, DNS and mail config, the SSL
+    material and the FastCP systemd units into a tar.gz as a background job. Along with the website data
+    and the databases, it's all it takes to rebuild the server with the disaster-restore command.
+
+    Args:
+        job (object): The Job model object.
+        params (dict): Not used.
+
+    Returns:
+        dict: The name and the size of the archive and the included paths.
+    """
+    paths = backup_paths()
+    jobs.report_progress(job, 0, len(paths) + 1)
+    os.makedirs(settings.FASTCP_EXPORTS_DIR, mode=0o700, exist_ok=True)
+    archive = exports.artifact_path(job)
+
+    manifest = {
+        'version': settings.FASTCP_VERSION,
+        'hostname': socket.gethostname(),
+        'created': int(time.time()),
+        'paths': paths
+    }
+    db_path = _panel_db()
+    try:
+        with tarfile.open(f'{archive}.part', 'w:gz') as tar:
+            data = json.dumps(manifest, indent=2).encode()
+            info = tarfile.TarInfo('manifest.json')
+            info.size = len(data)
+            info.mtime = manifest.get('created')
+            tar.addfile(info, io.BytesIO(data))
+            tar.add(db_path, arcname='panel/db.sqlite3')
+            jobs.report_progress(job, 1)
+            for i, path in enumerate(paths):
+                tar.add(path, arcname=os.path.join('files', path.lstrip('/')))
+                jobs.report_progress(job, i + 2)
+    finally:
+        os.remove(db_path)
+    os.rename(f'{archive}.part', archive)
+    return {'artifact': os.path.basename(archive), 'size': os.path.getsize(archive), 'paths': paths}
+
+
+def _unsafe_link(linkname: str) -> bool:
+    return os.path.isabs(linkname) or '..' in linkname.split('/')
+
+
+def safe_members(tar: tarfile.TarFile) -> tuple:
+    """Safe members.
+
+    Checks the members of a server backup and splits them into the ones that can be extracted and the
+    symlinks pointing outside of their directory, i.e. the ../../archive links of Let's Encrypt. Those are
+    never extracted, so no later member can be written through them, and are created in place once the
+    files have been copied.
+
+    Args:
+        tar (object): The opened server backup.
+
+    Raises:
+        ValueError: If a member or a hard link points outside of the backup.
+
+    Returns:
+        tuple: The members to extract and the symlinks to create afterwards.
+    """
+    members, links = [], []
+    for member in tar.getmembers():
+        name = os.path.normpath(member.name)
+        if name.startswith('..') or os.path.isabs(name):
+            raise ValueError(f'{member.name} is not a safe path.')
+        if member.islnk() and _unsafe_link(member.linkname):
+            raise ValueError(f'{member.name} links to {member.linkname}, which is not a safe path.')
+        if member.issym() and _unsafe_link(member.linkname):
+            links.append(member)
+        elif member.isfile() or member.isdir() or member.issym() or member.islnk():
+            members.append(member)
+    return members, links
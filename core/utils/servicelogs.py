@@ -0,0 +1,110 @@
+import json, time, select
+from subprocess import run, Popen, PIPE, DEVNULL, TimeoutExpired
+from django.conf import settings
+from core.models import PHP_CHOICES
+from core.utils import health
+
+
+# Services whose logs are exposed besides the ones FastCP manages
+EXTRA_UNITS = ['fastcp', 'postfix', 'dovecot', 'opendkim', 'proftpd', 'named']
+
+PRIORITIES = ['emerg', 'alert', 'crit', 'err', 'warning', 'notice', 'info', 'debug']
+
+
+def log_units() -> list:
+    """Returns the systemd units whose logs can be read, the PHP-FPM services of all PHP versions included."""
+    units = EXTRA_UNITS + health.CORE_SERVICES + [f'php{version}-fpm' for version, label in PHP_CHOICES]
+    return sorted(set(units))
+
+
+def _entry(line: str) -> dict:
+    """Returns the fields of a journal entry that matter to admins, None if the line isn't an entry."""
+    try:
+        entry = json.loads(line)
+    except ValueError:
+        return None
+    message = entry.get('MESSAGE')
+    if isinstance(message, list):
+        # Binary messages are arrays of bytes
+        message = bytes(message).decode(errors='replace')
+    priority = int(entry.get('PRIORITY', 6))
+    return {
+        'time': int(entry.get('__REALTIME_TIMESTAMP', 0)) / 1000000,
+        'priority': PRIORITIES[priority] if priority < len(PRIORITIES) else str(priority),
+        'pid': entry.get('_PID'),
+        'message': message,
+        'cursor': entry.get('__CURSOR')
+    }
+
+
+def _journalctl_cmd(unit: str, priority: str = None, cursor: str = None) -> list:
+    cmd = ['/usr/bin/journalctl', '-u', f'{unit}.service', '-o', 'json', '--no-pager']
+    if priority:
+        cmd += ['-p', priority]
+    if cursor:
+        cmd += ['--after-cursor', cursor]
+    return cmd
+
+
+def journal_entries(unit: str, lines: int = 100, priority: str = None, cursor: str = None) -> list:
+    """Journal entries.
+
+    Reads the recent journal entries of a systemd unit.
+
+    Args:
+        unit (str): The unit name, one of log_units.
+        lines (int): The number of most recent entries to return.
+        priority (str): Only return the entries of this priority or more severe, one of PRIORITIES.
+        cursor (str): Only return the entries after this cursor.
+
+    Returns:
+        list: The entries, oldest first.
+    """
+    try:
+        res = run(_journalctl_cmd(unit, priority, cursor) + ['-n', str(lines)], stdout=PIPE, stderr=DEVNULL, timeout=30)
+    except (FileNotFoundError, TimeoutExpired):
+        return []
+    return [e for e in map(_entry, res.stdout.decode(errors='replace').splitlines()) if e]
+
+
+def follow_entries(unit: str, priority: str = None, cursor: str = None):
+    """Follow journal entries.
+
+    Yields the new journal entries of a systemd unit as they are written, for up to
+    FASTCP_LOG_FOLLOW_SECONDS. Clients continue following with the cursor of the last entry.
+
+    Args:
+        unit (str): The unit name, one of log_units.
+        priority (str): Only yield the entries of this priority or more severe, one of PRIORITIES.
+        cursor (str): Start after this cursor instead of the end of the journal.
+
+    Yields:
+        dict: The entries.
+    """
+    cmd = _journalctl_cmd(unit, priority, cursor) + ['-f']
+    if not cursor:
+        cmd += ['-n', '0']
+    try:
+        proc = Popen(cmd, stdout=PIPE, stderr=DEVNULL, bufsize=0)
+    except FileNotFoundError:
+        return
+
+    deadline = time.monotonic() + settings.FASTCP_LOG_FOLLOW_SECONDS
+    buffer = b''
+    try:
+        while time.monotonic() < deadline:
+            ready, _, _ = select.select([proc.stdout], [], [], 1)
+            if not ready:
+                continue
+            chunk = proc.stdout.read(65536)
+            if not chunk:
+                break
+            buffer += chunk
+            *lines, buffer = buffer.split(b'\n')
+            for line in lines:
+                entry = _entry(line.decode(errors='replace'))
+                if entry:
+                    yield entry
+    finally:
+        proc.kill()
+        proc.wait()
@@ -0,0 +1,59 @@
+import os, pwd
+from subprocess import run, PIPE
+from django.conf import settings
+from django.template.loader import render_to_string
+from core.models import SftpAccount
+from core.utils import system, filesystem
+
+
+# The ProFTPD config and the users file of the virtual SFTP accounts
+PROFTPD_CONF_PATH = '/etc/proftpd/conf.d/fastcp-sftp.conf'
+PROFTPD_PASSWD_PATH = '/etc/proftpd/fastcp.passwd'
+
+
+def hash_password(password: str) -> str:
+    """Returns the SHA-512 crypt hash of a password, None if it cannot be hashed."""
+    try:
+        result = run(['/usr/bin/openssl', 'passwd', '-6', '-stdin'], input=password.encode(), stdout=PIPE, stderr=PIPE, timeout=30)
+    except FileNotFoundError:
+        return None
+    if result.returncode != 0:
+        return None
+    return result.stdout.decode().strip()
+
+
+def sync_sftp_accounts() -> bool:
+    """Sync SFTP accounts.
+
+    Regenerates the ProFTPD users file and config of the virtual SFTP accounts from the database, then
+    reloads ProFTPD. The accounts log in with the UID and GID of the website owner and their home is the
+    public directory of the website, so they cannot reach the other websites of the owner.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    lines, read_only = [], []
+    for account in SftpAccount.objects.select_related('website__user').order_by('username'):
+        owner = account.website.user
+        try:
+            uid = owner.uid or pwd.getpwnam(owner.username).pw_uid
+        except KeyError:
+            continue
+        web_root = filesystem.get_website_paths(account.website).get('web_root')
+        lines.append(f'{account.username}:{account.password_hash}:{uid}:{uid}::{web_root}:/bin/false')
+        if account.read_only:
+            read_only.append(account.username)
+
+    os.makedirs(os.path.dirname(PROFTPD_CONF_PATH), exist_ok=True)
+    with open(PROFTPD_PASSWD_PATH, 'w') as f:
+        f.writelines(f'{line}\n' for line in lines)
+    # ProFTPD refuses a users file readable by others
+    os.chmod(PROFTPD_PASSWD_PATH, 0o600)
+
+    with open(PROFTPD_CONF_PATH, 'w') as f:
+        f.write(render_to_string('system/proftpd-sftp.txt', {
+            'port': settings.FASTCP_SFTP_PORT,
+            'passwd_path': PROFTPD_PASSWD_PATH,
+            'read_only': read_only
+        }))
+    return system.run_cmd('/usr/bin/systemctl reload proftpd')
@@ -0,0 +1,182 @@
+import os, re, ipaddress
+from urllib.parse import urlsplit
+from core import signals
+from core.utils import vhosts
+from core.utils.filesystem import get_website_paths
+
+
+NAME_RE = re.compile(r'^[a-z0-9][a-z0-9-]{0,49}$')
+MAX_SIZE = 64 * 1024
+
+# The directives the website owners can use, the others read or write files, load code, run scripts or
+# talk to local services, through which the owners could reach the files of the other users
+USER_DIRECTIVES = {
+    'location', 'if', 'limit_except', 'return', 'rewrite', 'break', 'set', 'try_files', 'index', 'autoindex',
+    'autoindex_exact_size', 'autoindex_format', 'autoindex_localtime', 'charset', 'default_type', 'error_page',
+    'add_header', 'expires', 'etag', 'if_modified_since', 'allow', 'deny', 'internal', 'client_max_body_size',
+    'client_body_timeout', 'send_timeout', 'keepalive_timeout', 'limit_rate', 'limit_rate_after',
+    'log_not_found', 'server_tokens', 'gzip', 'gzip_comp_level', 'gzip_min_length', 'gzip_proxied', 'gzip_types',
+    'gzip_vary', 'sub_filter', 'sub_filter_once', 'sub_filter_types', 'valid_referers', 'proxy_pass',
+    'proxy_set_header', 'proxy_hide_header', 'proxy_pass_header', 'proxy_http_version', 'proxy_redirect',
+    'proxy_buffering', 'proxy_connect_timeout', 'proxy_read_timeout', 'proxy_send_timeout', 'proxy_ssl_server_name',
+    'proxy_intercept_errors',
+}
+
+# The escapes NGINX reads in the words of a config, the other backslashes are kept
+ESCAPES = {'"': '"', "'": "'", '\\': '\\', 't': '\t', 'r': '\r', 'n': '\n'}
+
+
+def snippet_path(snippet: object) -> str:
+    """Returns the include file of a snippet, .ssl_conf files are included in all server blocks of the website."""
+    return os.path.join(get_website_paths(snippet.website).get('ngix_vhost_dir'), f'custom-{snippet.name}.ssl_conf')
+
+
+def tokenize(content: str) -> list:
+    """Splits directives into tokens the way NGINX reads them, the words and quoted strings unescaped and the
+    ; { } separators as they are. Each token is a tuple of the text and whether it's a separator. Raises
+    ValueError if a quoted string isn't closed."""
+    tokens, i = [], 0
+    while i < len(content):
+        char = content[i]
+        if char.isspace():
+            i += 1
+        elif char == '#':
+            end = content.find('\n', i)
+            i = len(content) if end == -1 else end
+        elif char in ';{}':
+            tokens.append((char, True))
+            i += 1
+        else:
+            quote = char if char in '"\'' else None
+            i += 1 if quote else 0
+            text = ''
+            while True:
+                if i >= len(content):
+                    if quote:
+                        raise ValueError('A quoted string of the snippet is not closed.')
+                    break
+                char = content[i]
+                if quote and char == quote:
+                    i += 1
+                    break
+                if not quote and (char.isspace() or char in ';{}'):
+                    break
+                if char == '\\' and i + 1 < len(content):
+                    escaped = ESCAPES.get(content[i + 1])
+                    text += escaped if escaped else content[i:i + 2]
+                    i += 2
+                    continue
+                text += char
+                i += 1
+            tokens.append((text, False))
+    return tokens
+
+
+def statements(tokens: list) -> list:
+    """Groups the tokens into statements, each a list of the words of a directive. Raises ValueError if the
+    braces are not balanced or the last directive isn't ended."""
+    result, words, depth = [], [], 0
+    for text, separator in tokens:
+        if not separator:
+            words.append(text)
+            continue
+        if text == '}':
+            if words or depth == 0:
+                raise ValueError('The braces of the snippet are not balanced.')
+            depth -= 1
+            continue
+        if not words:
+            raise ValueError(f'The snippet has a {text} without a directive.')
+        if text == '{':
+            depth += 1
+        result.append(words)
+        words = []
+    if words:
+        raise ValueError(f'The {words[0]} directive should end with a semicolon.')
+    if depth != 0:
+        raise ValueError('The braces of the snippet are not balanced.')
+    return result
+
+
+def proxy_error(target: str) -> str:
+    """Returns why the website owners cannot proxy to a target, None if it's a remote address."""
+    if '$' in target:
+        return 'The target of proxy_pass cannot use variables.'
+    host = urlsplit(target).hostname or ''
+    if not target.lower().startswith(('http://', 'https://')) or host in ['', 'unix', 'localhost']:
+        return 'proxy_pass can only forward to remote http:// or https:// addresses.'
+    # NGINX resolves the names again on every reload, so a name checked now could point at a local address
+    # later. Only IP addresses are accepted, they cannot change.
+    try:
+        address = ipaddress.ip_address(host)
+    except ValueError:
+        return 'proxy_pass can only forward to IP addresses, i.e. https://203.0.113.10.'
+    address = getattr(address, 'ipv4_mapped', None) or address
+    if address.is_private or address.is_loopback or address.is_link_local or address.is_unspecified:
+        return 'proxy_pass cannot forward to local or private addresses.'
+    return None
+
+
+def validate_content(content: str, admin: bool = False) -> str:
+    """Returns why the directives of a snippet cannot be used, None if they look fine to test with NGINX. The
+    admins can use any directive, the website owners only the ones in USER_DIRECTIVES."""
+    if not content.strip():
+        return 'The snippet is empty.'
+    if len(content.encode()) > MAX_SIZE:
+        return 'The snippet should be at most 64 KB.'
+    try:
+        directives = statements(tokenize(content))
+    except ValueError as e:
+        return str(e)
+    if admin:
+        return None
+    for words in directives:
+        name = words[0].lower()
+        if name not in USER_DIRECTIVES:
+            return f'Only admins can use the {words[0]} directive.'
+        if name == 'proxy_pass' and len(words) > 1:
+            error = proxy_error(words[1])
+            if error:
+                return error
+    return None
+
+
+def public_errors(snippet: object, errors: str) -> str:
+    """Returns the nginx -t errors of a snippet for the website owners, only the line NGINX rejected, as the
+    output may show the configs of the other websites."""
+    match = re.search(re.escape(os.path.basename(snippet_path(snippet))) + r':(\d+)', errors)
+    if match and int(match.group(1)) > 1:
+        return f'NGINX rejected line {int(match.group(1)) - 1} of the snippet.'
+    return 'NGINX rejected the snippet.'
+
+
+def apply_snippet(snippet: object, content: str = None, admin: bool = False) -> str:
+    """Apply snippet.
+
+    Writes the include file of a snippet, or removes it if the snippet is disabled or deleted, and reloads
+    NGINX. The change is tested with nginx -t first and undone if NGINX rejects it, and it's rolled back in
+    the background like the other vhost changes if the website starts failing after the reload.
+
+    Args:
+        snippet (object): Snippet model object.
+        content (str): The new directives, None to remove the include file.
+        admin (bool): Return the full errors of nginx -t, the website owners only get the rejected line.
+
+    Returns:
+        str: The errors of nginx -t, None if the change has been applied.
+    """
+    path = snippet_path(snippet)
+    os.makedirs(os.path.dirname(path), exist_ok=True)
+    data = f'# Custom snippet {snippet.name}, saved from FastCP\n{content.strip()}\n' if content is not None else None
+    errors = vhosts.test_change(path, data, 'nginx')
+    if errors:
+        return errors if admin else public_errors(snippet, errors)
+
+    if data is None:
+        if os.path.exists(path):
+            os.remove(path)
+            signals.reload_services.send(sender=None, services='nginx')
+        return None
+    if not vhosts.apply_config(snippet.website, path, data, 'nginx'):
+        return 'NGINX rejected the change.'
+    return None
@@ -1,8 +1,9 @@
-import secrets, string, os, crypt, pwd
+import secrets, string, os, crypt, pwd, grp
 from datetime import datetime
 from django.template.loader import render_to_string
 from api.databases.services.mysql import FastcpSqlService
-from core.utils import filesystem
+from api.databases.services.postgresql import PostgresSqlService
+from core.utils import filesystem, volumes, journal, ownership, immutable
 from subprocess import (
     STDOUT, check_call, CalledProcessError, Popen, PIPE, DEVNULL
 )
@@ -54,60 +55,98 @@ def run_cmd(cmd: str, shell=False) -> bool:
 def fix_ownership(website: object):
     """Fix ownership.
 
-    Fixes the ownership of a website base directory and sub-directoris and files recursively.
+    Fixes the ownership of a website base directory and sub-directoris and files recursively. The paths
+    already owned by the SSH user are skipped, see ownership.fix_website_ownership.
     """
-    # SSH user
-    ssh_user = website.user.username
-
-    # Website paths
-    web_paths = filesystem.get_website_paths(website)
-    base_path = web_paths.get('base_path')
-    tmp_path = web_paths.get('tmp_path')
-
-    # Fix permissions
-    run_cmd(f'/usr/bin/chown -R {ssh_user}:{ssh_user} {base_path}')
-    run_cmd(f'/usr/bin/chown -R {ssh_user}:{ssh_user} {tmp_path}')
+    try:
+        ownership.fix_website_ownership(website)
+    except KeyError:
+        # The SSH user doesn't exist on the system
+        pass
 
 
-def setup_website(website: object):
+def setup_website(website: object) -> bool:
     """Setup website.
 
     This function is responsible to setup the website when it's created and it
     restarts the services. Ideally, this function should be called soon after
     the website model is created.
+
+    Returns:
+        bool: False if the FPM pool conf was rejected, True otherwise.
     """
 
     # Create initial directories
     filesystem.create_website_dirs(website)
 
+    # Create the default page
+    filesystem.create_default_page(website)
+
     # Create FPM pool conf
-    filesystem.generate_fpm_conf(website)
+    pool_written = filesystem.generate_fpm_conf(website)
     
     # Fix permissions
     fix_ownership(website)
 
+    # Recreate the temp dir at boot if it lives on a tmpfs
+    write_user_tmpfiles(website.user)
+    return pool_written
+
 
 def delete_website(website: object):
     """Delete website.
 
     This function cleans the website data and it should be called right before
-    the website model is about to be deleted.
+    the website model is about to be deleted. The cleanup is journaled so it is
+    resumed if the panel dies half way.
     """
+    # Immutable files cannot be deleted
+    for name in immutable.locked_files(website):
+        immutable.set_immutable(immutable.file_path(website, name), False)
+    journal.run_operation('delete_website', website.label, journal.website_params(website))
 
-    # Delete website directories
-    filesystem.delete_website_dirs(website)
 
-    # Delete PHP FPM pool conf
-    filesystem.delete_fpm_conf(website)
+def snapshot_website(website: object, label: str = None) -> str:
+    """Snapshot website.
 
-    # Delete NGINX vhost files
-    filesystem.delete_nginx_vhost(website)
+    Takes an instant snapshot of the website data if the storage driver supports snapshots. It should be
+    called right before a risky operation so the website can be rolled back if something goes wrong.
 
-    # Delete Apache vhost files
-    filesystem.delete_apache_vhost(website)
-    
-    # Delete SSL certs
-    filesystem.delete_ssl_certs(website)
+    Args:
+        website (object): Website model object.
+        label (str): Optional snapshot label. A timestamp is used if not provided.
+
+    Returns:
+        str: The snapshot label on success and None if snapshots are not supported or snapshot fails.
+    """
+    driver = volumes.get_driver()
+    if not driver.supports_snapshots:
+        return None
+    return driver.snapshot(filesystem.get_website_paths(website).get('base_path'), label=label)
+
+
+def restore_website_snapshot(website: object, label: str) -> bool:
+    """Restore website snapshot.
+
+    Rolls the website data back to the state of the provided snapshot and fixes the ownership.
+
+    Args:
+        website (object): Website model object.
+        label (str): The snapshot label.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    driver = volumes.get_driver()
+    base_path = filesystem.get_website_paths(website).get('base_path')
+    if label not in driver.list_snapshots(base_path):
+        return False
+
+    with immutable.lifted(website):
+        if driver.rollback(base_path, label):
+            fix_ownership(website)
+            return True
+    return False
 
     
 def setup_wordpress(website: object, **kwargs) -> None:
@@ -146,20 +185,15 @@ def setup_wordpress(website: object, **kwargs) -> None:
     dbname = kwargs.get('dbname')
     dbpassword = kwargs.get('dbpassword')
     dbuser = kwargs.get('dbuser')
-    with open(os.path.join(pub_path, 'wp-config-sample.php')) as f:
-        content = f.read()
-        with open(os.path.join(pub_path, 'wp-config.php'), 'w') as f2:
-            # Set DB name
-            content = content.replace('database_name_here', dbname)
-            # Set DB username
-            content = content.replace('username_here', dbuser)
-            # Set DB password
-            content = content.replace('password_here', dbpassword)
-            # Set unique keys
-            for _ in range(1, 8+1):
-                content = content.replace('put your unique phrase here', rand_passwd(60), 1)
-            
-            f2.write(content)
+    salt_keys = ['AUTH_KEY', 'SECURE_AUTH_KEY', 'LOGGED_IN_KEY', 'NONCE_KEY', 'AUTH_SALT', 'SECURE_AUTH_SALT', 'LOGGED_IN_SALT', 'NONCE_SALT']
+    context = {
+        'dbname': dbname,
+        'dbuser': dbuser,
+        'dbpassword': dbpassword,
+        'salts': [(key, rand_passwd(60)) for key in salt_keys]
+    }
+    with open(os.path.join(pub_path, 'wp-config.php'), 'w') as f:
+        f.write(render_to_string('system/wp-config.txt', context))
     fix_ownership(website)
 
 
@@ -184,25 +218,40 @@ def change_password(username: str) -> str:
         str: The new password.
     """
     passwd = rand_passwd()
-    passwd_hash = crypt.crypt(passwd, '22')
-    run_cmd(f'/usr/sbin/usermod --password {passwd_hash} {username}')
+    set_password(username, passwd)
     
     return passwd
 
-def change_db_password(username: str) -> str:
-    """Change a MySQL user's password.
+def set_password(username: str, password: str) -> bool:
+    """Sets the password of a Unix user, hashed with SHA-512 crypt."""
+    passwd_hash = crypt.crypt(password, crypt.mksalt(crypt.METHOD_SHA512))
+    try:
+        check_call(['/usr/sbin/usermod', '--password', passwd_hash, username], stdout=DEVNULL, stderr=DEVNULL)
+        return True
+    except CalledProcessError:
+        return False
+
+def sql_service(engine: str = 'mysql') -> object:
+    """Returns the service that manages the databases of an engine."""
+    if engine == 'postgresql':
+        return PostgresSqlService()
+    return FastcpSqlService()
+
+def change_db_password(username: str, engine: str = 'mysql') -> str:
+    """Change a database user's password.
     
     This function generates a random password using rand_passwd function, sets the password for the user
     and returns the password as a string.
     
     Args:
-        user (str): The MySQL username.
+        user (str): The MySQL username or the PostgreSQL role.
+        engine (str): The database engine, mysql or postgresql.
     
     Returns:
         str: The new password or None if update fails.
     """
     passwd = rand_passwd()
-    result = FastcpSqlService().update_password(username, passwd)
+    result = sql_service(engine).update_password(username, passwd)
     if result:
         return passwd
     return None
@@ -264,11 +313,87 @@ def setup_user(user: object, password: str = None) -> bool:
     user.uid = int(uid)
     user.save()
 
+    # Limit the size of the temp dir
+    if user.tmp_size > 0:
+        mount_user_tmp(user)
+
+
+def mount_user_tmp(user: object) -> bool:
+    """Mount user tmp.
+
+    Mounts a size-limited tmpfs on the tmp directory of the user, so one website's runaway cache or
+    sessions can't fill the disk for everyone. The mount is persisted in fstab and binaries cannot be
+    executed from it. If the user's tmp_size is 0, the tmpfs is unmounted instead.
+
+    Args:
+        user (object): User model object.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    tmp_path = filesystem.get_user_paths(user).get('tmp_path')
+    if os.path.ismount(tmp_path):
+        run_cmd(f'/usr/bin/umount {tmp_path}')
+
+    if user.tmp_size <= 0:
+        filesystem.set_fstab_entry(tmp_path)
+        mounted = True
+    else:
+        uid = pwd.getpwnam(user.username).pw_uid
+        gid = grp.getgrnam(user.username).gr_gid
+        options = f'size={user.tmp_size}M,mode=0700,uid={uid},gid={gid},noexec,nosuid,nodev'
+        filesystem.create_if_missing(tmp_path)
+        filesystem.set_fstab_entry(tmp_path, f'tmpfs {tmp_path} tmpfs {options} 0 0')
+        mounted = run_cmd(f'/usr/bin/mount {tmp_path}')
+
+    # A fresh mount point is empty, so the temp dirs of the websites
+    # need to be created again.
+    for website in user.websites.all():
+        filesystem.create_if_missing(filesystem.get_website_paths(website).get('tmp_path'))
+    run_cmd(f'/usr/bin/chown -R {user.username}:{user.username} {tmp_path}')
+    write_user_tmpfiles(user)
+    return mounted
+
+
+def write_user_tmpfiles(user: object) -> None:
+    """Write user tmpfiles conf.
+
+    A tmpfs is empty after every boot, so the temp dirs of the user's websites are declared in a systemd
+    tmpfiles.d conf to be recreated at boot. The conf is removed if the user doesn't have a tmpfs.
+
+    Args:
+        user (object): User model object.
+    """
+    conf_path = f'/etc/tmpfiles.d/fastcp-{user.username}.conf'
+    if user.tmp_size <= 0:
+        if os.path.exists(conf_path):
+            os.remove(conf_path)
+        return
+
+    lines = ['# Managed by FastCP. Changes to this file will be overwritten.\n']
+    for website in user.websites.all():
+        tmp_path = filesystem.get_website_paths(website).get('tmp_path')
+        lines.append(f'd {tmp_path} 0700 {user.username} {user.username} -\n')
+    with open(conf_path, 'w') as f:
+        f.writelines(lines)
+
+
+def unmount_user_tmp(user: object) -> None:
+    """Unmounts the tmpfs of the user's tmp directory if any and removes it from fstab."""
+    tmp_path = filesystem.get_user_paths(user).get('tmp_path')
+    if os.path.ismount(tmp_path):
+        run_cmd(f'/usr/bin/umount {tmp_path}')
+    filesystem.set_fstab_entry(tmp_path)
+
+    conf_path = f'/etc/tmpfiles.d/fastcp-{user.username}.conf'
+    if os.path.exists(conf_path):
+        os.remove(conf_path)
+
 
 def create_database(database: object, password: str) -> bool:
     """Create database.
 
-    Creates the MySQL database in the system.
+    Creates the MySQL or PostgreSQL database in the system.
 
     Args:
         database (object): The database model object.
@@ -278,7 +403,7 @@ def create_database(database: object, password: str) -> bool:
         bool: True on success False otherwise.
     """
 
-    return FastcpSqlService().setup_db(
+    return sql_service(database.engine).setup_db(
         user=database.username,
         dbname=database.name,
         password=password
@@ -293,8 +418,8 @@ def drop_db(database: object) -> None:
         database (object): Database model object.
     """
     try:
-        FastcpSqlService().drop_db(database.name)
-        FastcpSqlService().drop_user(database.username)
+        sql_service(database.engine).drop_db(database.name)
+        sql_service(database.engine).drop_user(database.username)
     except:
         pass
 
@@ -315,11 +440,8 @@ def delete_user_data(user: object) -> None:
     for db in user.databases.all():
         db.delete()
 
-    # Delete user paths
-    filesystem.delete_user_dirs(user)
-
-    # Delete system user
-    run_cmd(f'/usr/sbin/userdel {user.username}')
+    # Unmount the temp dir, delete the user paths and the system user
+    journal.run_operation('delete_user', user.username, journal.user_params(user))
 
 
 def ssl_expiring(website: object) -> bool:
@@ -0,0 +1,44 @@
+import re
+from django.db.models import Q
+
+
+TAG_RE = re.compile(r'^[a-z0-9][a-z0-9_.:-]{0,29}$')
+MAX_TAGS = 20
+
+
+def clean_tags(value: str) -> str:
+    """Clean tags.
+
+    Normalizes comma separated tags, i.e. client:acme, renew-2027, to lowercase without duplicates.
+
+    Args:
+        value (str): The comma separated tags.
+
+    Returns:
+        str: The normalized tags or None if there are none.
+
+    Raises:
+        ValueError: If a tag is invalid or there are too many tags.
+    """
+    tags = []
+    for tag in (value or '').split(','):
+        tag = tag.strip().lower()
+        if not tag or tag in tags:
+            continue
+        if not TAG_RE.match(tag):
+            raise ValueError(f'{tag} is not a valid tag. Use up to 30 letters, digits and _ . : - characters.')
+        tags.append(tag)
+    if len(tags) > MAX_TAGS:
+        raise ValueError(f'Up to {MAX_TAGS} tags are allowed.')
+    return ','.join(tags) or None
+
+
+def filter_tagged(queryset, tag: str):
+    """Returns the objects of a queryset that have the tag."""
+    return queryset.filter(tags__regex=rf'(^|,){re.escape(tag.strip().lower())}(,|$)')
+
+
+def search_q(query: str, notes: bool = True) -> Q:
+    """Returns the lookup that matches the tags, and the notes unless they are hidden, containing the query."""
+    lookup = Q(tags__icontains=query)
+    return lookup | Q(notes__icontains=query) if notes else lookup
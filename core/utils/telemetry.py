@@ -0,0 +1,163 @@
+import os, re, json, uuid, time, socket, platform, traceback
+from collections import Counter
+import psutil, requests
+from django.conf import settings
+from core.models import Website, User, Database, Domain, MailDomain
+
+
+TELEMETRY_DIR = '/var/fastcp/.config/telemetry'
+INSTALL_ID_PATH = os.path.join(TELEMETRY_DIR, 'install-id')
+CRASHES_DIR = os.path.join(TELEMETRY_DIR, 'crashes')
+
+SCRUB_PATTERNS = [
+    (re.compile(r'[\w.+-]+@[\w-]+(\.[\w-]+)+'), '<email>'),
+    (re.compile(r'\b\d{1,3}(\.\d{1,3}){3}\b'), '<ip>'),
+    (re.compile(r'\b([0-9a-f]{1,4}:){2,7}[0-9a-f]{0,4}\b', re.I), '<ip>'),
+    (re.compile(r'/srv/users/[^/\s\'"]+'), '/srv/users/<user>'),
+    (re.compile(r'(password|passwd|secret|token|key)(["\']?\s*[=:]\s*)\S+', re.I), r'\1\2<secret>'),
+]
+
+
+def install_id() -> str:
+    """Returns the random ID of this installation, so the reports of a server can be grouped without identifying it."""
+    if os.path.exists(INSTALL_ID_PATH):
+        with open(INSTALL_ID_PATH) as f:
+            return f.read().strip()
+    os.makedirs(TELEMETRY_DIR, exist_ok=True)
+    value = uuid.uuid4().hex
+    with open(INSTALL_ID_PATH, 'w') as f:
+        f.write(value)
+    return value
+
+
+def scrub_names() -> set:
+    """Returns the names that identify this server or its users: the hostname, the usernames, the domains,
+    the labels and slugs of the websites and the names and users of the databases."""
+    names = {socket.gethostname(), socket.getfqdn()}
+    names.update(User.objects.values_list('username', flat=True))
+    names.update(Domain.objects.values_list('domain', flat=True))
+    names.update(MailDomain.objects.values_list('domain', flat=True))
+    for label, slug in Website.objects.values_list('label', 'slug'):
+        names.update([label, slug])
+    for name, username in Database.objects.values_list('name', 'username'):
+        names.update([name, username])
+    return {name for name in names if name and len(name) > 2}
+
+
+def scrub(text: str) -> str:
+    """Removes the emails, IPs, secrets and the names of this server and its users from a text."""
+    text = str(text)
+    for pattern, replacement in SCRUB_PATTERNS:
+        text = pattern.sub(replacement, text)
+    for name in sorted(scrub_names(), key=len, reverse=True):
+        text = re.sub(rf'(?<!\w){re.escape(name)}(?!\w)', '<redacted>', text)
+    return text[:1000]
+
+
+def _frame_path(path: str) -> str:
+    """Returns the path of a source file relative to FastCP or to the installed packages."""
+    base = str(settings.BASE_DIR)
+    if path.startswith(base):
+        return os.path.relpath(path, base)
+    if 'site-packages' in path:
+        return path.split('site-packages/', 1)[-1]
+    return os.path.basename(path)
+
+
+def record_crash(exc: BaseException) -> None:
+    """Record crash.
+
+    Saves the report of an unhandled exception to be uploaded with the next telemetry upload. Only the
+    exception type, the scrubbed message and the code locations of the stack are kept, the local variables
+    and the request data are never recorded.
+
+    Args:
+        exc (BaseException): The exception.
+    """
+    if not settings.FASTCP_TELEMETRY or exc is None:
+        return
+    try:
+        os.makedirs(CRASHES_DIR, exist_ok=True)
+        if len(os.listdir(CRASHES_DIR)) >= settings.FASTCP_TELEMETRY_MAX_CRASHES:
+            return
+        report = {
+            'type': type(exc).__name__,
+            'message': scrub(exc),
+            'frames': [
+                {'file': _frame_path(f.filename), 'line': f.lineno, 'function': f.name}
+                for f in traceback.extract_tb(exc.__traceback__)
+            ],
+            'version': settings.FASTCP_VERSION,
+            'time': int(time.time())
+        }
+        with open(os.path.join(CRASHES_DIR, f'{report.get("time")}-{uuid.uuid4().hex[:8]}.json'), 'w') as f:
+            json.dump(report, f)
+    except Exception:
+        # Crash reporting must never crash the panel
+        pass
+
+
+def usage_stats() -> dict:
+    """Returns anonymous counts of what the panel is used for, nothing that identifies the server or its users."""
+    return {
+        'websites': Website.objects.count(),
+        'wordpress': Website.objects.filter(is_wp=True).count(),
+        'users': User.objects.filter(is_superuser=False).count(),
+        'databases': Database.objects.count(),
+        'mail_domains': MailDomain.objects.count(),
+        'php_versions': dict(Counter(Website.objects.values_list('php', flat=True))),
+        'backends': dict(Counter(b or 'default' for b in Website.objects.values_list('backend', flat=True))),
+        'os': platform.platform(terse=True),
+        'python': platform.python_version(),
+        'cpus': psutil.cpu_count(),
+        'memory_gb': round(psutil.virtual_memory().total / 1024 ** 3),
+    }
+
+
+def _crash_files() -> list:
+    if not os.path.isdir(CRASHES_DIR):
+        return []
+    return sorted(os.path.join(CRASHES_DIR, name) for name in os.listdir(CRASHES_DIR) if name.endswith('.json'))
+
+
+def payload() -> dict:
+    """Returns the exact data the next upload sends."""
+    crashes = []
+    for path in _crash_files():
+        try:
+            with open(path) as f:
+                crashes.append(json.load(f))
+        except (OSError, ValueError):
+            pass
+    return {
+        'install_id': install_id(),
+        'version': settings.FASTCP_VERSION,
+        'stats': usage_stats(),
+        'crashes': crashes
+    }
+
+
+def upload() -> bool:
+    """Upload telemetry.
+
+    Sends the usage stats and the pending crash reports to FASTCP_TELEMETRY_URL if the admin opted in.
+    The crash reports are deleted once uploaded.
+
+    Returns:
+        bool: True if the data was uploaded and False otherwise.
+    """
+    if not settings.FASTCP_TELEMETRY or not settings.FASTCP_TELEMETRY_URL:
+        return False
+    files = _crash_files()
+    try:
+        res = requests.post(settings.FASTCP_TELEMETRY_URL, json=payload(), timeout=30)
+    except requests.RequestException:
+        return False
+    if res.status_code >= 400:
+        return False
+    for path in files:
+        try:
+            os.remove(path)
+        except OSError:
+            pass
+    return True
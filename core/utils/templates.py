@@ -0,0 +1,190 @@
+import os
+from django.conf import settings
+from django.template import Template, Context, TemplateSyntaxError
+
+
+# The bundled templates, the admin overrides live in the same relative paths in FASTCP_TEMPLATES_DIR
+BUNDLED_TEMPLATES_DIR = os.path.join(settings.BASE_DIR, 'templates')
+
+# Sample values used to preview the templates
+SAMPLE_PATHS = {
+    'app_name': 'example',
+    'ssh_user': 'john',
+    'ssh_group': 'john',
+    'socket_path': '/srv/users/john/run/example.sock',
+    'web_root': '/srv/users/john/apps/example/public',
+}
+
+# The templates FastCP generates files from, along with what they generate and a sample context
+SYSTEM_TEMPLATES = {
+    'system/nginx-vhost-http.txt': {
+        'description': 'NGINX vhost of the websites without SSL',
+        'context': {**SAMPLE_PATHS, 'domains': 'example.com www.example.com', 'log_path': '/srv/users/john/logs', 'webroot': SAMPLE_PATHS.get('web_root'), 'backend': 'apache'}
+    },
+    'system/nginx-vhost-https.txt': {
+        'description': 'NGINX vhost of the websites with SSL',
+        'context': {**SAMPLE_PATHS, 'domains': 'example.com www.example.com', 'log_path': '/srv/users/john/logs', 'webroot': SAMPLE_PATHS.get('web_root'), 'backend': 'apache',
+                    'chain_path': '/etc/letsencrypt/live/example/fullchain.pem', 'privkey_path': '/etc/letsencrypt/live/example/privkey.pem'}
+    },
+    'system/nginx-redirects.txt': {
+        'description': 'NGINX redirects to the canonical host, included in the vhosts',
+        'context': {'redirects': [('www.example.com', 'example.com')]}
+    },
+    'system/nginx-quota.txt': {
+        'description': 'NGINX page of the websites that used up their monthly traffic quota',
+        'context': {}
+    },
+    'system/nginx-backend.txt': {
+        'description': 'NGINX locations of the web server backends, included in the vhosts',
+        'context': {**SAMPLE_PATHS, 'backend': 'nginx', 'scheme': '$scheme'}
+    },
+    'system/apache-vhost.txt': {
+        'description': 'Apache vhost of the websites',
+        'context': {**SAMPLE_PATHS, 'domain': 'example.com', 'server_aliases': ['www.example.com'], 'log_root': '/srv/users/john/logs'}
+    },
+    'system/php-fpm-pool.txt': {
+        'description': 'PHP-FPM pool of the websites',
+        'context': {**SAMPLE_PATHS, 'listen_group': 'www-data', 'dev_extension': None}
+    },
+    'system/wp-config.txt': {
+        'description': 'wp-config.php of new WordPress websites',
+        'context': {'dbname': 'wp_db', 'dbuser': 'wp_user', 'dbpassword': 'secret', 'salts': [('AUTH_KEY', 'salt')]}
+    },
+    'system/default-index.txt': {
+        'description': 'Default page of new websites',
+        'context': {'label': 'example', 'username': 'john', 'php': '8.1', 'web_root': SAMPLE_PATHS.get('web_root'),
+                    'server_ip': '127.0.0.1', 'site_name': 'FastCP'}
+    },
+    'system/sysctl.txt': {
+        'description': 'sysctl values of the tuning profiles',
+        'context': {'sysctl': [('vm.swappiness', 10)]}
+    },
+    'system/dns-zone.txt': {
+        'description': 'BIND zone file of the local DNS zones',
+        'context': {'zone': {'domain': 'example.com', 'serial': 2026101700}, 'primary_ns': 'ns1.example.com', 'hostmaster': 'hostmaster.example.com',
+                    'records': [{'name': '@', 'ttl': 3600, 'type': 'A', 'zone_value': '127.0.0.1'}]}
+    },
+    'system/proftpd-sftp.txt': {
+        'description': 'ProFTPD config of the virtual SFTP accounts',
+        'context': {'port': 2222, 'passwd_path': '/etc/proftpd/fastcp.passwd', 'read_only': ['example-readonly']}
+    },
+    'system/rsyslog-forward.txt': {
+        'description': 'rsyslog config that forwards the access logs of the websites',
+        'context': {'host': 'logs.example.com', 'port': 6514, 'tls': True, 'ca_file': '/etc/ssl/certs/ca-certificates.crt',
+                    'logs_glob': '/srv/users/*/logs/*access*.log'}
+    },
+    'system/logrotate.txt': {
+        'description': 'logrotate config of the website and FastCP logs',
+        'context': {'frequency': 'daily', 'rotate': 14, 'max_size_mb': 100, 'compress': True,
+                    'blocks': [{'logs': '/srv/users/john/logs/*.log', 'username': 'john'},
+                               {'logs': '/var/log/fastcp/*.log /var/log/fastcp/modsec/*.log'}]}
+    },
+    'system/systemd-task-service.txt': {
+        'description': 'systemd service of the scheduled tasks of the SSH users',
+        'context': {'name': 'backup', 'username': 'john', 'uid': 1001, 'home': '/srv/users/john', 'command': '"php artisan schedule:run"'}
+    },
+    'system/systemd-task-timer.txt': {
+        'description': 'systemd timer of the scheduled tasks of the SSH users',
+        'context': {'name': 'backup', 'username': 'john', 'schedule_type': 'calendar', 'schedule': '*:0/15'}
+    },
+    'system/systemd-app-service.txt': {
+        'description': 'systemd service of the apps of the websites with the proxy backend',
+        'context': {'slug': 'example', 'username': 'john', 'uid': 1001, 'home': '/srv/users/john/apps/example', 'port': 20000, 'command': '"npm start"'}
+    },
+    'system/fail2ban-jail.txt': {
+        'description': 'fail2ban jails of SSH, the panel and WordPress sign ins',
+        'context': {'bantime': 3600, 'findtime': 600, 'maxretry': 5, 'wp_maxretry': 20, 'ignore_ips': ['203.0.113.10'],
+                    'jails': ['sshd', 'fastcp-panel', 'fastcp-wplogin'], 'panel_log': '/var/log/fastcp/auth.log',
+                    'site_logs': '/srv/users/*/logs/*_nginx.access_ssl.log'}
+    },
+    'system/fail2ban-panel-filter.txt': {'description': 'fail2ban filter of the failed panel sign ins', 'context': {}},
+    'system/fail2ban-wplogin-filter.txt': {'description': 'fail2ban filter of the WordPress sign ins', 'context': {}},
+    'system/modsecurity-site.txt': {
+        'description': 'ModSecurity rules of the websites with the WAF on',
+        'context': {'app_name': 'example', 'mode': 'block', 'paranoia': 1, 'is_wp': True, 'audit_log': '/var/log/fastcp/modsec/example_audit.log',
+                    'crs_setup': '/etc/modsecurity/crs/crs-setup.conf', 'crs_rules': '/usr/share/modsecurity-crs/rules', 'disabled_rules': ['942100']}
+    },
+    'system/nginx-geoip2.txt': {
+        'description': 'NGINX config that looks up the country of the visitors for the websites blocking countries',
+        'context': {'db_path': '/usr/share/GeoIP/GeoLite2-Country.mmdb'}
+    },
+    'system/bash_profile.txt': {'description': '.bash_profile of the SSH users', 'context': {}},
+    'system/bash_rc.txt': {'description': '.bashrc of the SSH users', 'context': {}},
+    'system/bash_logout.txt': {'description': '.bash_logout of the SSH users', 'context': {}},
+}
+
+
+def override_path(name: str) -> str:
+    """Returns the path of the admin override of a template."""
+    return os.path.join(settings.FASTCP_TEMPLATES_DIR, name)
+
+
+def template_source(name: str) -> dict:
+    """Returns the source of a template, the override if there is one, and either it's overridden."""
+    custom = os.path.exists(override_path(name))
+    with open(override_path(name) if custom else os.path.join(BUNDLED_TEMPLATES_DIR, name)) as f:
+        return {'name': name, 'source': f.read(), 'custom': custom}
+
+
+def save_override(name: str, source: str) -> str:
+    """Save template override.
+
+    Validates the template syntax and saves it as the override of a bundled template.
+
+    Args:
+        name (str): The template name, i.e. system/default-index.txt.
+        source (str): The template source.
+
+    Returns:
+        str: The syntax error or None on success.
+    """
+    try:
+        Template(source)
+    except TemplateSyntaxError as e:
+        return str(e)
+
+    path = override_path(name)
+    os.makedirs(os.path.dirname(path), exist_ok=True)
+    with open(path, 'w') as f:
+        f.write(source)
+    return None
+
+
+def delete_override(name: str) -> bool:
+    """Deletes the override of a template so the bundled one is used again. Returns True if deleted."""
+    path = override_path(name)
+    if os.path.exists(path):
+        os.remove(path)
+        return True
+    return False
+
+
+def list_templates() -> list:
+    """Returns the system templates along with either they are overridden."""
+    return [{
+        'name': name,
+        'description': tpl.get('description'),
+        'custom': os.path.exists(override_path(name))
+    } for name, tpl in SYSTEM_TEMPLATES.items()]
+
+
+def render_preview(name: str, source: str = None) -> dict:
+    """Render preview.
+
+    Renders a template, or the provided source in place of it, with sample values so admins can see what
+    the generated file will look like before saving an override.
+
+    Args:
+        name (str): The template name, i.e. system/php-fpm-pool.txt.
+        source (str): Optional source to render instead of the current template.
+
+    Returns:
+        dict: The rendered output or the error.
+    """
+    if source is None:
+        source = template_source(name).get('source')
+    try:
+        return {'output': Template(source).render(Context(SYSTEM_TEMPLATES.get(name, {}).get('context', {})))}
+    except Exception as e:
+        return {'error': str(e)}
+
@@ -0,0 +1,135 @@
+import os, re, pwd
+from subprocess import run, PIPE, DEVNULL, TimeoutExpired
+from django.template.loader import render_to_string
+from core.utils import servicelogs
+from core.utils.filesystem import get_user_paths
+from core.utils.system import run_cmd
+
+
+UNITS_DIR = '/etc/systemd/system'
+
+# The properties of the units shown with the tasks
+SERVICE_PROPERTIES = ['ActiveState', 'Result', 'ExecMainStatus', 'ExecMainStartTimestamp', 'ExecMainExitTimestamp']
+TIMER_PROPERTIES = ['LastTriggerUSec', 'NextElapseUSecRealtime']
+
+NAME_RE = re.compile(r'^[\w .-]{1,50}$')
+
+# systemd ends a line of a unit file at \n, \r and \0, so none of the control characters can reach a unit
+CONTROL_RE = re.compile(r'[\x00-\x1f\x7f]')
+
+
+def unit_name(task: object) -> str:
+    return f'fastcp-task-{task.id}'
+
+
+def unit_paths(task: object) -> tuple:
+    return (
+        os.path.join(UNITS_DIR, f'{unit_name(task)}.service'),
+        os.path.join(UNITS_DIR, f'{unit_name(task)}.timer')
+    )
+
+
+def valid_calendar(expression: str) -> bool:
+    """Returns True if systemd accepts an OnCalendar expression, i.e. *:0/15 or Mon..Fri 09:00."""
+    if not expression or CONTROL_RE.search(expression):
+        return False
+    try:
+        res = run(['/usr/bin/systemd-analyze', 'calendar', expression], stdout=DEVNULL, stderr=DEVNULL, timeout=10)
+    except (FileNotFoundError, TimeoutExpired):
+        return False
+    return res.returncode == 0
+
+
+def valid_command(command: str) -> bool:
+    """Returns True if a command can be written to ExecStart, i.e. it is not empty and has no control characters."""
+    return bool(command) and not CONTROL_RE.search(command)
+
+
+def systemd_quote(command: str) -> str:
+    """Quotes a command as one argument of ExecStart. The specifiers and the variables are escaped, so
+    systemd passes the command to the shell as it is. Raises ValueError if the command has a line break,
+    which would end the ExecStart line and let the rest of the command add settings to the unit."""
+    if any(c in command for c in '\r\n\0'):
+        raise ValueError('The command should be a single line.')
+    command = command.replace('\\', '\\\\').replace('"', '\\"').replace('%', '%%').replace('$', '$$')
+    return f'"{command}"'
+
+
+def write_units(task: object) -> bool:
+    """Write units.
+
+    Writes the service and the timer of a task and starts the timer, or stops it if the task is disabled.
+    The service runs the command with bash as the SSH user, in the systemd slice of the user, so the task
+    counts against the resource limits of the user and its output ends up in the journal.
+
+    Args:
+        task (object): ScheduledTask model object.
+
+    Returns:
+        bool: True if the timer was started or stopped.
+    """
+    user = task.user
+    uid = user.uid or pwd.getpwnam(user.username).pw_uid
+    context = {
+        'name': task.name,
+        'username': user.username,
+        'uid': uid,
+        'home': get_user_paths(user).get('base_path'),
+        'command': systemd_quote(task.command),
+        'schedule_type': task.schedule_type,
+        'schedule': task.schedule,
+    }
+    service_path, timer_path = unit_paths(task)
+    with open(service_path, 'w') as f:
+        f.write(render_to_string('system/systemd-task-service.txt', context))
+    with open(timer_path, 'w') as f:
+        f.write(render_to_string('system/systemd-task-timer.txt', context))
+
+    run_cmd('/usr/bin/systemctl daemon-reload')
+    if task.enabled:
+        return run_cmd(f'/usr/bin/systemctl enable --now {unit_name(task)}.timer')
+    return run_cmd(f'/usr/bin/systemctl disable --now {unit_name(task)}.timer')
+
+
+def remove_units(task: object) -> None:
+    """Stops the timer of a task and removes its units."""
+    run_cmd(f'/usr/bin/systemctl disable --now {unit_name(task)}.timer')
+    for path in unit_paths(task):
+        if os.path.exists(path):
+            os.remove(path)
+    run_cmd('/usr/bin/systemctl daemon-reload')
+
+
+def run_now(task: object) -> bool:
+    """Starts the service of a task right away, without waiting for it to finish."""
+    return run_cmd(f'/usr/bin/systemctl start --no-block {unit_name(task)}.service')
+
+
+def show_unit(unit: str, properties: list) -> dict:
+    """Returns the properties of a systemd unit, the empty ones as None."""
+    try:
+        res = run(['/usr/bin/systemctl', 'show', unit, '-p', ','.join(properties)], stdout=PIPE, stderr=DEVNULL, timeout=30)
+    except (FileNotFoundError, TimeoutExpired):
+        return {}
+    values = dict(line.split('=', 1) for line in res.stdout.decode(errors='replace').splitlines() if '=' in line)
+    return {key: values.get(key) or None for key in properties}
+
+
+def task_status(task: object) -> dict:
+    """Returns the state and the result of the last run of a task, and when it runs next."""
+    service = show_unit(f'{unit_name(task)}.service', SERVICE_PROPERTIES)
+    timer = show_unit(f'{unit_name(task)}.timer', TIMER_PROPERTIES)
+    return {
+        'running': service.get('ActiveState') == 'activating',
+        'result': service.get('Result'),
+        'exit_status': int(service.get('ExecMainStatus') or 0) if service.get('ExecMainStartTimestamp') else None,
+        'last_started': service.get('ExecMainStartTimestamp'),
+        'last_finished': service.get('ExecMainExitTimestamp'),
+        'last_trigger': timer.get('LastTriggerUSec'),
+        'next_run': timer.get('NextElapseUSecRealtime'),
+    }
+
+
+def task_output(task: object, lines: int = 100) -> list:
+    """Returns the recent output of the runs of a task from the journal."""
+    return servicelogs.journal_entries(unit_name(task), lines)
@@ -0,0 +1,66 @@
+from django.db.models import F, Q
+from django.utils import timezone
+from core.models import TrafficMonth, Website, User
+from core.utils import webservers
+from core.utils.notifications import notify_users
+
+
+GB = 1024 ** 3
+
+
+def current_month():
+    return timezone.now().date().replace(day=1)
+
+
+def account(website: object, bytes_sent: int, requests: int) -> None:
+    """Adds traffic to the total of a website for the current month."""
+    if not bytes_sent and not requests:
+        return
+    month, _ = TrafficMonth.objects.get_or_create(website=website, month=current_month())
+    TrafficMonth.objects.filter(id=month.id).update(bytes_sent=F('bytes_sent') + bytes_sent, requests=F('requests') + requests)
+
+
+def month_usage(website: object) -> int:
+    """Returns the bytes a website sent in the current month."""
+    month = TrafficMonth.objects.filter(website=website, month=current_month()).first()
+    return month.bytes_sent if month else 0
+
+
+def set_exceeded(website: object, exceeded: bool) -> bool:
+    """Switches a website to the quota exceeded page, or back, and rewrites its NGINX vhost. The flag is set
+    back if the vhost cannot be applied, so it's tried again on the next run. Returns True on success."""
+    website.quota_exceeded = exceeded
+    website.save(update_fields=['quota_exceeded'])
+    if webservers.get_backend(website).write_vhosts(website, only_nginx=True):
+        return True
+    website.quota_exceeded = not exceeded
+    website.save(update_fields=['quota_exceeded'])
+    return False
+
+
+def enforce_quotas() -> dict:
+    """Enforce quotas.
+
+    Switches the websites that used up their monthly traffic quota to the quota exceeded page and
+    notifies their owners and the admins. The websites back under their quota, because the month
+    changed or the quota was raised, serve their content again.
+
+    Returns:
+        dict: The labels of the websites switched to the quota page and of those switched back.
+    """
+    result = {'exceeded': [], 'lifted': []}
+    for website in Website.objects.filter(Q(traffic_quota_gb__gt=0) | Q(quota_exceeded=True)).select_related('user'):
+        over = website.traffic_quota_gb > 0 and month_usage(website) >= website.traffic_quota_gb * GB
+        if over and not website.quota_exceeded:
+            if not set_exceeded(website, True):
+                continue
+            result['exceeded'].append(website.label)
+            users = User.objects.filter(Q(is_superuser=True) | Q(id=website.user_id))
+            notify_users(
+                users, f'{website} used up its traffic quota',
+                details=f'The website has served {website.traffic_quota_gb} GB this month and shows the quota exceeded page until the next month or until the quota is raised.',
+                event='traffic'
+            )
+        elif not over and website.quota_exceeded and set_exceeded(website, False):
+            result['lifted'].append(website.label)
+    return result
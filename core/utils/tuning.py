@@ -0,0 +1,191 @@
+import os, json
+from subprocess import check_output, CalledProcessError, DEVNULL
+from django.template.loader import render_to_string
+from core.utils.system import run_cmd
+
+
+# Paths managed by the tuning profiles
+SYSCTL_CONF_PATH = '/etc/sysctl.d/60-fastcp.conf'
+SWAP_FILE_PATH = '/fastcp.swap'
+FSTAB_PATH = '/etc/fstab'
+TUNING_STATE_PATH = '/var/fastcp/.config/tuning.json'
+
+# Swap size is in MBs, the rest are sysctl keys
+PROFILES = {
+    'low-ram': {
+        'label': 'Low RAM',
+        'swap_mb': 2048,
+        'sysctl': {
+            'vm.swappiness': 60,
+            'vm.vfs_cache_pressure': 100,
+            'fs.inotify.max_user_watches': 65536,
+            'fs.inotify.max_user_instances': 256,
+            'net.core.somaxconn': 1024,
+        }
+    },
+    'balanced': {
+        'label': 'Balanced',
+        'swap_mb': 1024,
+        'sysctl': {
+            'vm.swappiness': 30,
+            'vm.vfs_cache_pressure': 75,
+            'fs.inotify.max_user_watches': 262144,
+            'fs.inotify.max_user_instances': 512,
+            'net.core.somaxconn': 4096,
+        }
+    },
+    'performance': {
+        'label': 'Performance',
+        'swap_mb': 1024,
+        'sysctl': {
+            'vm.swappiness': 10,
+            'vm.vfs_cache_pressure': 50,
+            'fs.inotify.max_user_watches': 524288,
+            'fs.inotify.max_user_instances': 1024,
+            'net.core.somaxconn': 65535,
+        }
+    },
+}
+
+
+def _read_state() -> dict:
+    """Returns the saved tuning state."""
+    if os.path.exists(TUNING_STATE_PATH):
+        with open(TUNING_STATE_PATH) as f:
+            return json.load(f)
+    return {}
+
+
+def _write_state(state: dict) -> None:
+    """Saves the tuning state."""
+    os.makedirs(os.path.dirname(TUNING_STATE_PATH), exist_ok=True)
+    with open(TUNING_STATE_PATH, 'w') as f:
+        json.dump(state, f)
+
+
+def get_sysctl(key: str) -> str:
+    """Returns the current value of a sysctl key or None if it cannot be read."""
+    try:
+        return check_output(['/usr/sbin/sysctl', '-n', key], stderr=DEVNULL, timeout=10).decode().strip()
+    except (CalledProcessError, FileNotFoundError):
+        return None
+
+
+def swap_size_mb() -> int:
+    """Returns the size of the FastCP swap file in MBs, 0 if it does not exist."""
+    if os.path.exists(SWAP_FILE_PATH):
+        return int(os.path.getsize(SWAP_FILE_PATH) / (1024 * 1024))
+    return 0
+
+
+def _remove_swap() -> None:
+    """Disables and deletes the FastCP swap file."""
+    if os.path.exists(SWAP_FILE_PATH):
+        run_cmd(f'/usr/sbin/swapoff {SWAP_FILE_PATH}')
+        os.remove(SWAP_FILE_PATH)
+
+    if os.path.exists(FSTAB_PATH):
+        with open(FSTAB_PATH) as f:
+            lines = f.readlines()
+        with open(FSTAB_PATH, 'w') as f:
+            f.writelines([line for line in lines if not line.startswith(f'{SWAP_FILE_PATH} ')])
+
+
+def _ensure_swap(size_mb: int) -> bool:
+    """Ensures that the FastCP swap file exists with the provided size."""
+    if swap_size_mb() == size_mb:
+        return True
+
+    _remove_swap()
+    if size_mb <= 0:
+        return True
+
+    created = all([
+        run_cmd(f'/usr/bin/fallocate -l {size_mb}M {SWAP_FILE_PATH}'),
+        run_cmd(f'/usr/bin/chmod 600 {SWAP_FILE_PATH}'),
+        run_cmd(f'/usr/sbin/mkswap {SWAP_FILE_PATH}'),
+        run_cmd(f'/usr/sbin/swapon {SWAP_FILE_PATH}')
+    ])
+    if created:
+        with open(FSTAB_PATH, 'a') as f:
+            f.write(f'{SWAP_FILE_PATH} none swap sw 0 0\n')
+    return created
+
+
+def tuning_status() -> dict:
+    """Get tuning status.
+
+    Returns:
+        dict: The available profiles, the applied profile and the current values of the managed tunables.
+    """
+    state = _read_state()
+    keys = PROFILES.get('balanced').get('sysctl').keys()
+    return {
+        'profiles': [{'name': name, 'label': p.get('label'), 'swap_mb': p.get('swap_mb'), 'sysctl': p.get('sysctl')}
+                     for name, p in PROFILES.items()],
+        'profile': state.get('profile'),
+        'current': {
+            'swap_mb': swap_size_mb(),
+            'sysctl': {key: get_sysctl(key) for key in keys}
+        }
+    }
+
+
+def apply_profile(name: str) -> bool:
+    """Apply a tuning profile.
+
+    Writes the sysctl values of the profile, loads them and resizes the swap file. The values that were in
+    effect before the first profile was applied are remembered so they can be restored with revert_profile.
+    Applying the same profile again re-applies it, which fixes any values changed manually in the meantime.
+
+    Args:
+        name (str): The profile name.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    profile = PROFILES.get(name)
+    if not profile:
+        return False
+
+    state = _read_state()
+    if 'original' not in state:
+        state['original'] = {
+            'swap_mb': swap_size_mb(),
+            'sysctl': {key: get_sysctl(key) for key in profile.get('sysctl').keys()}
+        }
+
+    with open(SYSCTL_CONF_PATH, 'w') as f:
+        f.write(render_to_string('system/sysctl.txt', {'sysctl': profile.get('sysctl').items()}))
+
+    applied = run_cmd(f'/usr/sbin/sysctl -p {SYSCTL_CONF_PATH}') and _ensure_swap(profile.get('swap_mb'))
+    state['profile'] = name
+    _write_state(state)
+    return applied
+
+
+def revert_profile() -> bool:
+    """Revert the tuning profile.
+
+    Removes the managed sysctl configuration and restores the values and the swap size that were in
+    effect before a profile was applied for the first time.
+
+    Returns:
+        bool: True on success and False otherwise.
+    """
+    state = _read_state()
+    original = state.get('original')
+    if not original:
+        return False
+
+    if os.path.exists(SYSCTL_CONF_PATH):
+        os.remove(SYSCTL_CONF_PATH)
+
+    results = []
+    for key, value in original.get('sysctl').items():
+        if value is not None:
+            results.append(run_cmd(f'/usr/sbin/sysctl -w {key}={value}'))
+    results.append(_ensure_swap(original.get('swap_mb')))
+
+    _write_state({})
+    return all(results)
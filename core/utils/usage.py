@@ -0,0 +1,207 @@
+import os, json, fcntl, pwd
+from datetime import timedelta
+from subprocess import run, PIPE, DEVNULL
+from django.conf import settings
+from django.db.models import Sum, Avg, Max
+from django.utils import timezone
+from core.models import User, Website, UsageSample
+from core.utils import metrics, traffic
+from core.utils.filesystem import get_user_paths, get_website_paths
+
+
+# The cumulative CPU times and the last disk usage of each user and website, used to turn the samples
+# into per-interval values
+COUNTERS_STATE_PATH = '/var/fastcp/.config/usage-counters.json'
+USER_SLICES_DIR = '/sys/fs/cgroup/user.slice'
+
+# The graph ranges, with how far back they go, the resolution they read and the seconds per point
+RANGES = {
+    '1h': (timedelta(hours=1), '5min', 300),
+    '24h': (timedelta(hours=24), '5min', 300),
+    '30d': (timedelta(days=30), 'hour', 3600),
+}
+
+
+def _read(path: str) -> str:
+    try:
+        with open(path) as f:
+            return f.read()
+    except OSError:
+        return None
+
+
+def slice_usage(user: object) -> tuple:
+    """Returns the cumulative CPU seconds and the memory of the systemd slice of a user, None if there is no
+    slice, i.e. on cgroup v1 or if the user has no session."""
+    try:
+        uid = user.uid or pwd.getpwnam(user.username).pw_uid
+    except KeyError:
+        return None
+    slice_dir = os.path.join(USER_SLICES_DIR, f'user-{uid}.slice')
+    cpu_stat, memory = _read(os.path.join(slice_dir, 'cpu.stat')), _read(os.path.join(slice_dir, 'memory.current'))
+    if not cpu_stat or not memory:
+        return None
+    usage_usec = next((int(line.split()[1]) for line in cpu_stat.splitlines() if line.startswith('usage_usec ')), 0)
+    return usage_usec / 1000000, int(memory)
+
+
+def disk_usage(path: str) -> int:
+    """Returns the bytes used by a directory."""
+    res = run(['/usr/bin/du', '-sb', path], stdout=PIPE, stderr=DEVNULL)
+    try:
+        return int(res.stdout.decode().split()[0])
+    except (IndexError, ValueError):
+        return 0
+
+
+def _delta(state: dict, key: str, name: str, total: float) -> float:
+    """Returns how much a cumulative counter grew since the last sample and remembers the new total. A
+    counter that went down, i.e. processes exited, counts as a restart from zero."""
+    previous = state.setdefault(key, {}).get(name)
+    state[key][name] = total
+    if previous is None:
+        return 0
+    return total - previous if total >= previous else total
+
+
+def _with_state(func):
+    """Runs func with the counters state locked, and saves the state it changed."""
+    os.makedirs(os.path.dirname(COUNTERS_STATE_PATH), exist_ok=True)
+    with open(COUNTERS_STATE_PATH, 'a+') as f:
+        fcntl.flock(f, fcntl.LOCK_EX)
+        f.seek(0)
+        try:
+            state = json.loads(f.read() or '{}')
+        except ValueError:
+            state = {}
+        result = func(state)
+        f.seek(0)
+        f.truncate()
+        f.write(json.dumps(state))
+    return result
+
+
+def record_usage() -> int:
+    """Record usage.
+
+    Samples the CPU, the memory and the traffic of each user and website for the last 5 minutes. The
+    users are read from their systemd slices, or from their processes without one, and the websites from
+    their PHP-FPM pools and NGINX access logs. The disk usage is the one measured by the hourly rollup.
+
+    Returns:
+        int: The number of samples recorded.
+    """
+    def sample(state):
+        now = timezone.now().replace(second=0, microsecond=0)
+        processes = metrics.user_processes()
+        pools = metrics.fpm_pools()
+        logs = metrics.access_log_totals()
+        disk = state.setdefault('disk', {})
+        samples = []
+
+        site_traffic = {}
+        for website in Website.objects.select_related('user'):
+            key = str(website.id)
+            pool = pools.get(website.slug, {})
+            totals = logs.get(website.label, {})
+            sent = _delta(state, 'site_bytes', key, totals.get('bytes', 0))
+            requests = _delta(state, 'site_requests', key, totals.get('requests', 0))
+            traffic.account(website, sent, requests)
+            site_traffic.setdefault(website.user_id, [0, 0])
+            site_traffic[website.user_id][0] += sent
+            site_traffic[website.user_id][1] += requests
+            samples.append(UsageSample(
+                user=website.user, website=website, time=now,
+                cpu_seconds=_delta(state, 'site_cpu', key, pool.get('cpu_seconds', 0)),
+                memory_bytes=pool.get('memory_bytes', 0), disk_bytes=disk.get(f'site-{key}', 0),
+                bytes_sent=sent, requests=requests
+            ))
+
+        for user in User.objects.filter(is_superuser=False):
+            key = str(user.id)
+            from_slice = slice_usage(user)
+            if from_slice:
+                cpu_total, memory = from_slice
+            else:
+                usage = processes.get(user.username, {})
+                cpu_total, memory = usage.get('cpu_seconds', 0), usage.get('memory_bytes', 0)
+            sent, requests = site_traffic.get(user.id, [0, 0])
+            samples.append(UsageSample(
+                user=user, time=now, cpu_seconds=_delta(state, 'user_cpu', key, cpu_total),
+                memory_bytes=memory, disk_bytes=disk.get(f'user-{key}', 0), bytes_sent=sent, requests=requests
+            ))
+
+        UsageSample.objects.bulk_create(samples)
+        return len(samples)
+    return _with_state(sample)
+
+
+def measure_disk() -> None:
+    """Measures the disk usage of each user and website for the samples, and updates the storage used by
+    the users."""
+    def measure(state):
+        disk = state.setdefault('disk', {})
+        for website in Website.objects.all():
+            disk[f'site-{website.id}'] = disk_usage(get_website_paths(website).get('base_path'))
+        for user in User.objects.filter(is_superuser=False):
+            used = disk_usage(get_user_paths(user).get('base_path'))
+            disk[f'user-{user.id}'] = used
+            User.objects.filter(id=user.id).update(storage_used=used)
+    _with_state(measure)
+
+
+def rollup_usage() -> int:
+    """Rollup usage.
+
+    Aggregates the 5 minute samples of each hour that ended since the last rollup into one hourly sample
+    per user and website, so the long ranges stay cheap to query once the 5 minute samples expire.
+
+    Returns:
+        int: The number of hourly samples created.
+    """
+    current_hour = timezone.now().replace(minute=0, second=0, microsecond=0)
+    last = UsageSample.objects.filter(resolution='hour').order_by('-time').first()
+    hour = last.time + timedelta(hours=1) if last else current_hour - timedelta(days=settings.FASTCP_USAGE_RAW_RETENTION_DAYS)
+
+    created = 0
+    while hour < current_hour:
+        rows = (
+            UsageSample.objects.filter(resolution='5min', time__gte=hour, time__lt=hour + timedelta(hours=1))
+            .values('user_id', 'website_id')
+            .annotate(cpu=Sum('cpu_seconds'), memory=Avg('memory_bytes'), disk=Max('disk_bytes'), sent=Sum('bytes_sent'), reqs=Sum('requests'))
+        )
+        UsageSample.objects.bulk_create([UsageSample(
+            user_id=row.get('user_id'), website_id=row.get('website_id'), resolution='hour', time=hour,
+            cpu_seconds=row.get('cpu') or 0, memory_bytes=int(row.get('memory') or 0), disk_bytes=row.get('disk') or 0,
+            bytes_sent=row.get('sent') or 0, requests=row.get('reqs') or 0
+        ) for row in rows])
+        created += len(rows)
+        hour += timedelta(hours=1)
+    return created
+
+
+def usage_series(user: object, website: object = None, range_name: str = '24h') -> list:
+    """Usage series.
+
+    Returns the usage of a user, or of one of their websites, over a graph range, oldest first.
+
+    Args:
+        user (object): User model object.
+        website (object): Optional Website model object of the user.
+        range_name (str): One of RANGES.
+
+    Returns:
+        list: A dict per point with the CPU percent, the memory, the disk, the bytes sent and the requests.
+    """
+    period, resolution, seconds = RANGES.get(range_name)
+    samples = UsageSample.objects.filter(
+        user=user, website=website, resolution=resolution, time__gte=timezone.now() - period
+    ).order_by('time')
+    return [{
+        'time': s.time,
+        'cpu_percent': round(s.cpu_seconds / seconds * 100, 2),
+        'memory_bytes': s.memory_bytes,
+        'disk_bytes': s.disk_bytes,
+        'bytes_sent': s.bytes_sent,
+        'requests': s.requests
+    } for s in samples]
@@ -0,0 +1,101 @@
+import os, tempfile
+from cryptography.fernet import Fernet, MultiFernet
+from django.apps import apps
+from django.conf import settings
+
+
+# The values encrypted with the key start with this, older plain text values don't
+PREFIX = 'fernet:'
+
+# The model fields holding encrypted values, re-encrypted when the key is rotated
+ENCRYPTED_FIELDS = [
+    ('core.DnsCredential', 'credentials'),
+]
+
+
+def _read_keys() -> list:
+    """Returns the keys in FASTCP_CREDENTIALS_KEY_FILE, one per line and the current key first."""
+    with open(settings.FASTCP_CREDENTIALS_KEY_FILE, 'rb') as f:
+        return [line.strip() for line in f.read().splitlines() if line.strip()]
+
+
+def _write_keys(keys: list) -> None:
+    """Replaces the key file at once, so the panel processes never read half of it."""
+    path = settings.FASTCP_CREDENTIALS_KEY_FILE
+    fd, tmp = tempfile.mkstemp(dir=os.path.dirname(path), prefix='.credentials-')
+    with os.fdopen(fd, 'wb') as f:
+        f.write(b'\n'.join(keys) + b'\n')
+    os.replace(tmp, path)
+
+
+def _fernet() -> MultiFernet:
+    """Returns the cipher of the root only FASTCP_CREDENTIALS_KEY_FILE, which is created on first use. The key
+    is kept apart from the secret key as rotating that one would make the stored credentials unreadable.
+    While a rotation runs the file holds the old key too, so the values not re-encrypted yet can be read."""
+    path = settings.FASTCP_CREDENTIALS_KEY_FILE
+    try:
+        return MultiFernet([Fernet(key) for key in _read_keys()])
+    except FileNotFoundError:
+        pass
+    os.makedirs(os.path.dirname(path), mode=0o700, exist_ok=True)
+    try:
+        fd = os.open(path, os.O_WRONLY | os.O_CREAT | os.O_EXCL, 0o600)
+    except FileExistsError:
+        # Another process created it in the meantime
+        return _fernet()
+    key = Fernet.generate_key()
+    with os.fdopen(fd, 'wb') as f:
+        f.write(key)
+    return MultiFernet([Fernet(key)])
+
+
+def encrypt(value: str) -> str:
+    """Encrypts a value to store it in the database."""
+    return PREFIX + _fernet().encrypt(value.encode()).decode()
+
+
+def decrypt(value: str) -> str:
+    """Decrypts a stored value, the plain text values stored before the encryption are returned as they are."""
+    if not value.startswith(PREFIX):
+        return value
+    return _fernet().decrypt(value[len(PREFIX):].encode()).decode()
+
+
+def rotate_job(job: object, params: dict) -> dict:
+    """Rotate job.
+
+    Rotates the credentials key as a background job. A new key becomes the current one, the stored values
+    are re-encrypted with it, and the old keys are removed from the key file once nothing uses them. If the
+    job fails in between, the old keys stay and every value can still be read, so it can simply run again.
+
+    Args:
+        job (object): The Job model object.
+        params (dict): The job params, none are used.
+
+    Returns:
+        dict: The number of re-encrypted values.
+    """
+    # Imported here as the models use this module
+    from core.utils import jobs
+
+    _fernet()
+    _write_keys([Fernet.generate_key()] + _read_keys())
+    cipher = _fernet()
+
+    objects = []
+    for model_name, field in ENCRYPTED_FIELDS:
+        objects += [(obj, field) for obj in apps.get_model(model_name).objects.all()]
+    jobs.report_progress(job, 0, len(objects))
+
+    for done, (obj, field) in enumerate(objects, 1):
+        value = getattr(obj, field)
+        if value.startswith(PREFIX):
+            value = PREFIX + cipher.rotate(value[len(PREFIX):].encode()).decode()
+        else:
+            value = PREFIX + cipher.encrypt(value.encode()).decode()
+        setattr(obj, field, value)
+        obj.save(update_fields=[field])
+        jobs.report_progress(job, done)
+
+    _write_keys(_read_keys()[:1])
+    return {'values': len(objects)}
@@ -0,0 +1,220 @@
+import os, time, socket, hashlib, functools
+from subprocess import run, PIPE, STDOUT
+from django.conf import settings
+from core import signals
+from core.utils import filesystem, health, jobs
+from core.utils.notifications import notify_admins
+
+
+# Apache listens here behind NGINX, see templates/system/apache-vhost.txt
+APACHE_UPSTREAM = ('127.0.0.1', 8080)
+
+# Config test commands of the web servers
+CONFIG_TESTS = {
+    'nginx': ['/usr/sbin/nginx', '-t'],
+    'apache2': ['/usr/sbin/apache2ctl', 'configtest']
+}
+
+
+def _read(path: str) -> str:
+    """Returns the contents of a file or None if it does not exist."""
+    if os.path.isfile(path):
+        with open(path) as f:
+            return f.read()
+    return None
+
+
+def _includes(dir_path: str) -> dict:
+    """Returns the contents of the files in an include dir keyed by the file name."""
+    if not os.path.isdir(dir_path):
+        return {}
+    return {name: _read(os.path.join(dir_path, name)) for name in sorted(os.listdir(dir_path))}
+
+
+def website_config(website: object) -> dict:
+    """Get website config.
+
+    Reads the generated web server and PHP-FPM configuration of a website, including the per website
+    include files, so routing can be debugged from the panel. Only the paths FastCP generates for the
+    website are read.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        dict: The configuration files keyed by the server they belong to.
+    """
+    paths = filesystem.get_website_paths(website)
+    return {
+        'nginx': {
+            'path': paths.get('ngix_vhost_conf'),
+            'config': _read(paths.get('ngix_vhost_conf')),
+            'includes': _includes(paths.get('ngix_vhost_dir'))
+        },
+        'apache': {
+            'path': paths.get('apache_vhost_conf'),
+            'config': _read(paths.get('apache_vhost_conf')),
+            'includes': _includes(paths.get('apache_vhost_dir'))
+        },
+        'php_fpm': {
+            'path': paths.get('fpm_path'),
+            'config': _read(paths.get('fpm_path'))
+        }
+    }
+
+
+def _can_connect(address: object, family: int = socket.AF_INET) -> bool:
+    """Returns True if a connection can be opened to the address."""
+    sock = socket.socket(family, socket.SOCK_STREAM)
+    sock.settimeout(5)
+    try:
+        sock.connect(address)
+        return True
+    except OSError:
+        return False
+    finally:
+        sock.close()
+
+
+def upstream_health(website: object) -> dict:
+    """Get upstream health.
+
+    Checks the upstreams a request to the website passes through after NGINX: Apache, unless NGINX is the
+    only backend of the website, the PHP-FPM socket of the website, and the app of the proxy backend.
+
+    Args:
+        website (object): Website model object.
+
+    Returns:
+        dict: The upstreams with either they accept connections or not.
+    """
+    socket_path = filesystem.get_website_paths(website).get('socket_path')
+    upstreams = {
+        'php_fpm': {
+            'address': f'unix:{socket_path}',
+            'healthy': os.path.exists(socket_path) and _can_connect(socket_path, socket.AF_UNIX)
+        }
+    }
+    if website.get_backend() == 'apache':
+        upstreams['apache'] = {
+            'address': f'{APACHE_UPSTREAM[0]}:{APACHE_UPSTREAM[1]}',
+            'healthy': _can_connect(APACHE_UPSTREAM)
+        }
+    if website.get_backend() == 'proxy' and website.proxy_upstream:
+        upstream = website.proxy_upstream
+        if upstream.startswith('unix:'):
+            healthy = os.path.exists(upstream[5:]) and _can_connect(upstream[5:], socket.AF_UNIX)
+        else:
+            host, port = upstream.rsplit(':', 1)
+            healthy = _can_connect((host.strip('[]'), int(port)), socket.AF_INET6 if host.startswith('[') else socket.AF_INET)
+        upstreams['app'] = {'address': upstream, 'healthy': healthy}
+    return upstreams
+
+
+def _restore(path: str, previous: str) -> None:
+    """Restores the previous contents of a config file, deletes the file if it didn't exist."""
+    if previous is None:
+        if os.path.exists(path):
+            os.remove(path)
+    else:
+        with open(path, 'w') as f:
+            f.write(previous)
+
+
+def test_config(service: str) -> str:
+    """Runs the config test of a web server and returns the errors, None if the config is valid."""
+    try:
+        result = run(CONFIG_TESTS.get(service), stdout=PIPE, stderr=STDOUT, timeout=60)
+    except FileNotFoundError:
+        return None
+    if result.returncode != 0:
+        return result.stdout.decode().strip()
+    return None
+
+
+def test_change(path: str, data: str, service: str) -> str:
+    """Tests a change of a config file with the config test of the web server without keeping it. The file
+    is removed for the test if the data is None. Returns the errors, None if the change is valid."""
+    previous = _read(path)
+    if data is None:
+        if os.path.exists(path):
+            os.remove(path)
+    else:
+        with open(path, 'w') as f:
+            f.write(data)
+    try:
+        return test_config(service)
+    finally:
+        _restore(path, previous)
+
+
+def _error_spike(website: object) -> bool:
+    """Checks the website a few times after a reload and returns True if most of the checks failed."""
+    failed = 0
+    for i in range(settings.FASTCP_ROLLBACK_CHECKS):
+        time.sleep(2)
+        if not health.check_website(website).get('healthy'):
+            failed += 1
+    return failed > settings.FASTCP_ROLLBACK_CHECKS / 2
+
+
+def verify_config(website: object, path: str, data: str, previous: str, service: str, job: object, params: dict) -> dict:
+    """Checks a website after a config change as a background job and restores the previous config if the
+    website started failing. A config changed again in the meantime is left to its own job."""
+    if not _error_spike(website):
+        return {'rolled_back': False}
+    if _read(path) != data:
+        return {'rolled_back': False, 'changed': True}
+    _restore(path, previous)
+    signals.reload_services.send(sender=None, services=service)
+    notify_admins(
+        f'The {service} config change of {website} was rolled back',
+        details=f'{website} started failing after {path} was updated, so the previous config has been restored.',
+        event='config'
+    )
+    return {'rolled_back': True}
+
+
+def apply_config(website: object, path: str, data: str, service: str) -> bool:
+    """Apply a web server config.
+
+    Writes a config file of a website and reloads the web server, keeping the previous config around.
+    The previous config is restored if the config test fails or, by a background job, if the website that
+    was healthy before starts failing with server errors after the reload. Admins are notified of the
+    rejected and rolled back changes.
+
+    Args:
+        website (object): Website model object.
+        path (str): The path of the config file.
+        data (str): The new config.
+        service (str): The web server service, either nginx or apache2.
+
+    Returns:
+        bool: True if the config has been applied and False if it has been rejected.
+    """
+    previous = _read(path)
+    if previous == data:
+        return True
+
+    monitor = settings.FASTCP_ROLLBACK_CHECKS > 0
+    was_healthy = monitor and health.check_website(website).get('healthy')
+
+    with open(path, 'w') as f:
+        f.write(data)
+
+    errors = test_config(service)
+    if errors:
+        _restore(path, previous)
+        notify_admins(f'The {service} config change of {website} was rejected', details=errors, event='config')
+        return False
+
+    signals.reload_services.send(sender=None, services=service)
+    if was_healthy:
+        # The checks take a few seconds, the request doesn't wait for them. The configs stay in memory.
+        params = {'path': path, 'config': hashlib.sha1(data.encode()).hexdigest()[:12]}
+        try:
+            jobs.start_job(website.user, 'verify_config', website.label, params, functools.partial(verify_config, website, path, data, previous, service))
+        except jobs.QueueFull:
+            # Too many changes are being checked already, this one is kept unchecked like a manual edit
+            pass
+    return True
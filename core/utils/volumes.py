@@ -0,0 +1,287 @@
+import os, shutil
+from datetime import datetime
+from subprocess import check_output, CalledProcessError, DEVNULL
+from django.conf import settings
+from core.utils import system as fcpsys
+
+
+class DirectoryDriver(object):
+    """Plain directory storage driver.
+
+    This is the default storage driver and it is used on filesystems that do not support subvolumes. Directories
+    are created and deleted as usual and snapshots are not supported.
+
+    Attributes:
+        name (str): The name of the driver as used in settings.
+        supports_snapshots (bool): Either the driver can take instant snapshots or not.
+    """
+    name = 'directory'
+    supports_snapshots = False
+
+    def create(self, path: str) -> bool:
+        """Create a volume.
+
+        Args:
+            path (str): The path of the volume.
+
+        Returns:
+            bool: True if created and False if it existed already or cannot be created.
+        """
+        if not os.path.exists(path):
+            try:
+                os.makedirs(path)
+                return True
+            except:
+                pass
+        return False
+
+    def delete(self, path: str) -> bool:
+        """Delete a volume along with its snapshots."""
+        try:
+            shutil.rmtree(path)
+            return True
+        except:
+            return False
+
+    def snapshot(self, path: str, label: str = None) -> str:
+        """Take a snapshot of a volume.
+
+        Args:
+            path (str): The path of the volume.
+            label (str): Snapshot label. A timestamp is used if not provided.
+
+        Returns:
+            str: The snapshot label on success and None otherwise.
+        """
+        return None
+
+    def list_snapshots(self, path: str) -> list:
+        """Returns the list of snapshot labels of a volume, oldest first."""
+        return []
+
+    def rollback(self, path: str, label: str) -> bool:
+        """Restore a volume to the state of the provided snapshot."""
+        return False
+
+    def delete_snapshot(self, path: str, label: str) -> bool:
+        """Delete a snapshot of a volume."""
+        return False
+
+    def snapshot_path(self, path: str, label: str) -> str:
+        """Returns the read-only directory the files of a snapshot can be copied from, None if there is none."""
+        return None
+
+    def clone(self, source: str, dest: str) -> bool:
+        """Clone a volume.
+
+        Args:
+            source (str): Path of the volume to clone.
+            dest (str): Path of the new volume. It must not exist.
+
+        Returns:
+            bool: True on success and False otherwise.
+        """
+        try:
+            shutil.copytree(source, dest, symlinks=True)
+            return True
+        except:
+            return False
+
+    def _label(self, label: str = None) -> str:
+        """Generates a snapshot label if one is not provided."""
+        if not label:
+            label = datetime.now().strftime('%Y%m%d%H%M%S')
+        return label
+
+    def has_snapshot(self, path: str, label: str) -> bool:
+        """Returns True if the label is one of the snapshots of the volume, so a label cannot point outside them."""
+        return bool(label) and label in self.list_snapshots(path)
+
+
+class BtrfsDriver(DirectoryDriver):
+    """Btrfs storage driver.
+
+    Every volume is a btrfs subvolume. Read-only snapshots are kept in a hidden directory in the root of
+    the file manager so they live on the same filesystem as the volumes.
+    """
+    name = 'btrfs'
+    supports_snapshots = True
+
+    def _snapshots_dir(self, path: str) -> str:
+        """Returns the directory where the snapshots of a volume are kept."""
+        rel_path = os.path.relpath(path, settings.FILE_MANAGER_ROOT)
+        return os.path.join(settings.FILE_MANAGER_ROOT, '.snapshots', rel_path)
+
+    def create(self, path: str) -> bool:
+        if os.path.exists(path):
+            return False
+
+        parent = os.path.dirname(path)
+        if not os.path.exists(parent):
+            os.makedirs(parent)
+        return fcpsys.run_cmd(f'/usr/bin/btrfs subvolume create {path}')
+
+    def delete(self, path: str) -> bool:
+        for label in self.list_snapshots(path):
+            self.delete_snapshot(path, label)
+
+        if fcpsys.run_cmd(f'/usr/bin/btrfs subvolume delete {path}'):
+            return True
+
+        # The path is a plain directory created before the driver was enabled
+        return super().delete(path)
+
+    def snapshot(self, path: str, label: str = None) -> str:
+        label = self._label(label)
+        snapshots_dir = self._snapshots_dir(path)
+        if not os.path.exists(snapshots_dir):
+            os.makedirs(snapshots_dir)
+
+        if fcpsys.run_cmd(f'/usr/bin/btrfs subvolume snapshot -r {path} {os.path.join(snapshots_dir, label)}'):
+            return label
+        return None
+
+    def list_snapshots(self, path: str) -> list:
+        snapshots_dir = self._snapshots_dir(path)
+        if not os.path.exists(snapshots_dir):
+            return []
+        return sorted(os.listdir(snapshots_dir))
+
+    def rollback(self, path: str, label: str) -> bool:
+        if not self.has_snapshot(path, label):
+            return False
+        snapshot_path = os.path.join(self._snapshots_dir(path), label)
+
+        # Keep the current state aside until the writable snapshot is in place
+        old_path = f'{path}.rollback'
+        os.rename(path, old_path)
+        if fcpsys.run_cmd(f'/usr/bin/btrfs subvolume snapshot {snapshot_path} {path}'):
+            fcpsys.run_cmd(f'/usr/bin/btrfs subvolume delete {old_path}')
+            return True
+
+        os.rename(old_path, path)
+        return False
+
+    def delete_snapshot(self, path: str, label: str) -> bool:
+        if not self.has_snapshot(path, label):
+            return False
+        snapshot_path = os.path.join(self._snapshots_dir(path), label)
+        return fcpsys.run_cmd(f'/usr/bin/btrfs subvolume delete {snapshot_path}')
+
+    def snapshot_path(self, path: str, label: str) -> str:
+        if not self.has_snapshot(path, label):
+            return None
+        snapshot_path = os.path.join(self._snapshots_dir(path), label)
+        return snapshot_path if os.path.isdir(snapshot_path) else None
+
+    def clone(self, source: str, dest: str) -> bool:
+        return fcpsys.run_cmd(f'/usr/bin/btrfs subvolume snapshot {source} {dest}')
+
+
+class ZfsDriver(DirectoryDriver):
+    """ZFS storage driver.
+
+    Every volume is a ZFS dataset created directly under the parent dataset set using FASTCP_ZFS_DATASET
+    setting and it is mounted on the volume path. Datasets are kept flat so they never shadow the plain user
+    directories above them.
+    """
+    name = 'zfs'
+    supports_snapshots = True
+
+    def _dataset(self, path: str) -> str:
+        """Returns the dataset name of a volume path."""
+        rel_path = os.path.relpath(path, settings.FILE_MANAGER_ROOT)
+        return f'{settings.FASTCP_ZFS_DATASET}/{rel_path.replace("/", ".")}'
+
+    def create(self, path: str) -> bool:
+        if os.path.exists(path):
+            return False
+        return fcpsys.run_cmd(f'/usr/sbin/zfs create -o mountpoint={path} {self._dataset(path)}')
+
+    def delete(self, path: str) -> bool:
+        if fcpsys.run_cmd(f'/usr/sbin/zfs destroy -r {self._dataset(path)}'):
+            return True
+
+        # The path is a plain directory created before the driver was enabled
+        return super().delete(path)
+
+    def snapshot(self, path: str, label: str = None) -> str:
+        label = self._label(label)
+        if fcpsys.run_cmd(f'/usr/sbin/zfs snapshot {self._dataset(path)}@{label}'):
+            return label
+        return None
+
+    def list_snapshots(self, path: str) -> list:
+        try:
+            output = check_output([
+                '/usr/sbin/zfs', 'list', '-H', '-t', 'snapshot', '-o', 'name', '-s', 'creation', '-d', '1',
+                self._dataset(path)
+            ], stderr=DEVNULL, timeout=60).decode()
+        except (CalledProcessError, FileNotFoundError):
+            return []
+        return [line.split('@', 1)[1] for line in output.splitlines() if '@' in line]
+
+    def rollback(self, path: str, label: str) -> bool:
+        if not self.has_snapshot(path, label):
+            return False
+        return fcpsys.run_cmd(f'/usr/sbin/zfs rollback -r {self._dataset(path)}@{label}')
+
+    def delete_snapshot(self, path: str, label: str) -> bool:
+        if not self.has_snapshot(path, label):
+            return False
+        return fcpsys.run_cmd(f'/usr/sbin/zfs destroy {self._dataset(path)}@{label}')
+
+    def snapshot_path(self, path: str, label: str) -> str:
+        # ZFS mounts snapshots on access under the hidden .zfs directory of the dataset
+        snapshot_path = os.path.join(path, '.zfs', 'snapshot', label)
+        return snapshot_path if self.has_snapshot(path, label) else None
+
+    def clone(self, source: str, dest: str) -> bool:
+        label = self.snapshot(source, label=f'clone-{self._label()}')
+        if label:
+            return fcpsys.run_cmd(
+                f'/usr/sbin/zfs clone -o mountpoint={dest} {self._dataset(source)}@{label} {self._dataset(dest)}')
+        return False
+
+
+DRIVERS = {
+    DirectoryDriver.name: DirectoryDriver,
+    BtrfsDriver.name: BtrfsDriver,
+    ZfsDriver.name: ZfsDriver,
+}
+
+
+def detect_driver_name() -> str:
+    """Detect the storage driver.
+
+    Checks the filesystem type of the file manager root and returns the name of the driver that suits it.
+
+    Returns:
+        str: The name of the driver.
+    """
+    try:
+        fs_type = check_output(
+            ['/usr/bin/stat', '-f', '-c', '%T', settings.FILE_MANAGER_ROOT], stderr=DEVNULL, timeout=10).decode().strip()
+    except (CalledProcessError, FileNotFoundError):
+        fs_type = None
+
+    if fs_type == 'btrfs':
+        return BtrfsDriver.name
+    if fs_type == 'zfs' and settings.FASTCP_ZFS_DATASET:
+        return ZfsDriver.name
+    return DirectoryDriver.name
+
+
+def get_driver() -> DirectoryDriver:
+    """Get the storage driver.
+
+    Returns the driver selected by FASTCP_STORAGE_DRIVER setting. If the setting is auto, the driver is
+    detected from the filesystem of the file manager root.
+
+    Returns:
+        object: The storage driver object.
+    """
+    name = settings.FASTCP_STORAGE_DRIVER
+    if name == 'auto':
+        name = detect_driver_name()
+    return DRIVERS.get(name, DirectoryDriver)()
@@ -0,0 +1,159 @@
+import os, re, json, glob, shutil
+from subprocess import run, DEVNULL, STDOUT, TimeoutExpired
+from django.conf import settings
+from django.template.loader import render_to_string
+from core.utils import logfiles, logstream
+
+
+# The config that loads the ModSecurity module of NGINX, added by libnginx-mod-http-modsecurity
+MODULE_CONF_GLOB = '/etc/nginx/modules-enabled/*modsecurity*.conf'
+PACKAGES = ['libnginx-mod-http-modsecurity', 'modsecurity-crs']
+
+PARANOIA_LEVELS = [1, 2, 3, 4]
+RULE_ID_RE = re.compile(r'^\d{6}$')
+CRS_VERSION_RE = re.compile(r"ver:'?OWASP_CRS/([\d.]+)")
+
+
+def rules_path(website: object) -> str:
+    return os.path.join(settings.FASTCP_WAF_RULES_DIR, f'{website.slug}.conf')
+
+
+def audit_log_dir() -> str:
+    return os.path.join(settings.FASTCP_LOG_DIR, 'modsec')
+
+
+def audit_log_path(website: object) -> str:
+    """Returns the audit log of a website. The logs are kept out of the logs directories of the users, where
+    a log swapped for a symlink would have NGINX append to any file."""
+    return os.path.join(audit_log_dir(), f'{website.slug}_audit.log')
+
+
+def crs_setup_path() -> str:
+    """Returns the crs-setup.conf of the Core Rule Set, the Debian packages keep it in /etc/modsecurity/crs."""
+    for path in [os.path.join(settings.FASTCP_WAF_CRS_DIR, 'crs-setup.conf'), '/etc/modsecurity/crs/crs-setup.conf']:
+        if os.path.exists(path):
+            return path
+    return None
+
+
+def is_available() -> bool:
+    """Returns True if the ModSecurity module of NGINX and the Core Rule Set are installed."""
+    return bool(glob.glob(MODULE_CONF_GLOB)) and crs_setup_path() is not None
+
+
+def install_job(job: object, params: dict) -> dict:
+    """Installs the ModSecurity module of NGINX and the Core Rule Set, run as a background job."""
+    env = dict(os.environ, DEBIAN_FRONTEND='noninteractive')
+    try:
+        res = run(['/usr/bin/apt-get', 'install', '-y'] + PACKAGES, stdout=DEVNULL, stderr=STDOUT, env=env, timeout=900)
+    except TimeoutExpired:
+        raise ValueError('The installation of ModSecurity timed out.')
+    if res.returncode != 0 or not is_available():
+        raise ValueError('ModSecurity cannot be installed with apt-get.')
+    return {'crs_version': crs_version()}
+
+
+def crs_version() -> str:
+    """Returns the version of the installed Core Rule Set."""
+    for path in sorted(glob.glob(os.path.join(settings.FASTCP_WAF_CRS_DIR, 'rules', 'REQUEST-901-*.conf'))):
+        try:
+            with open(path) as f:
+                match = CRS_VERSION_RE.search(f.read())
+        except OSError:
+            continue
+        if match:
+            return match.group(1)
+    return None
+
+
+def crs_rule_files() -> list:
+    """Returns the rule files of the Core Rule Set, i.e. REQUEST-942-APPLICATION-ATTACK-SQLI.conf."""
+    return sorted(os.path.basename(p) for p in glob.glob(os.path.join(settings.FASTCP_WAF_CRS_DIR, 'rules', '*.conf')))
+
+
+def disabled_rules(website: object) -> list:
+    return [r for r in (website.waf_disabled_rules or '').split(',') if r]
+
+
+def nginx_rules_file(website: object) -> str:
+    """Returns the rules file the NGINX vhost of a website loads, None if the WAF is off for the website."""
+    if website.waf_enabled and is_available() and os.path.exists(rules_path(website)):
+        return rules_path(website)
+    return None
+
+
+def write_rules(website: object) -> bool:
+    """Writes the ModSecurity rules of a website, or removes them if the WAF is off for the website. The
+    vhosts should be written again afterwards. Returns False if ModSecurity is not installed."""
+    path = rules_path(website)
+    if not website.waf_enabled:
+        if os.path.exists(path):
+            os.remove(path)
+        return True
+    if not is_available():
+        return False
+    os.makedirs(settings.FASTCP_WAF_RULES_DIR, exist_ok=True)
+    # Only root and the NGINX workers can write to the audit logs directory
+    os.makedirs(audit_log_dir(), mode=0o770, exist_ok=True)
+    shutil.chown(audit_log_dir(), 'root', 'www-data')
+    with open(path, 'w') as f:
+        f.write(render_to_string('system/modsecurity-site.txt', {
+            'app_name': website.slug,
+            'mode': website.waf_mode,
+            'paranoia': website.waf_paranoia,
+            'is_wp': website.is_wp,
+            'audit_log': audit_log_path(website),
+            'crs_setup': crs_setup_path(),
+            'crs_rules': os.path.join(settings.FASTCP_WAF_CRS_DIR, 'rules'),
+            'disabled_rules': disabled_rules(website),
+        }))
+    return True
+
+
+def delete_files(website: object) -> None:
+    """Removes the rules and the audit log of a website that is being deleted."""
+    for path in [rules_path(website), audit_log_path(website)]:
+        if os.path.exists(path):
+            os.remove(path)
+
+
+def blocked_requests(website: object, limit: int = 100) -> list:
+    """Blocked requests.
+
+    Returns the latest requests of a website that matched the WAF rules, newest first, from the JSON
+    audit log of ModSecurity. In the detection mode, the requests were only logged and not blocked.
+
+    Args:
+        website (object): Website model object.
+        limit (int): The most requests to return.
+
+    Returns:
+        list: The requests with the rules they matched.
+    """
+    path = audit_log_path(website)
+    f = logstream.open_log(path, os.path.dirname(path))
+    if not f:
+        return []
+    requests = []
+    with f:
+        for line in logfiles.lines_backwards(f):
+            try:
+                transaction = json.loads(line).get('transaction') or {}
+            except (ValueError, AttributeError):
+                continue
+            request = transaction.get('request') or {}
+            requests.append({
+                'time': transaction.get('time_stamp'),
+                'client_ip': transaction.get('client_ip'),
+                'method': request.get('method'),
+                'uri': request.get('uri'),
+                'status': (transaction.get('response') or {}).get('http_code'),
+                'rules': [{
+                    'id': (m.get('details') or {}).get('ruleId'),
+                    'message': m.get('message'),
+                    'severity': (m.get('details') or {}).get('severity'),
+                } for m in transaction.get('messages') or []],
+            })
+            if len(requests) >= limit:
+                break
+    return requests
@@ -0,0 +1,129 @@
+import time, threading
+from defusedxml import ElementTree as ET, DefusedXmlException
+from concurrent.futures import ThreadPoolExecutor
+from urllib.parse import urlsplit
+from django.conf import settings
+from core.models import Website
+from core.utils import jobs, seo
+
+
+# The sitemap indexes are followed this many levels deep
+MAX_SITEMAP_DEPTH = 2
+
+
+def _locations(domain: str, path: str, https: bool) -> tuple:
+    """Returns the page URLs and the child sitemaps of a sitemap."""
+    res = seo.fetch(domain, path, https)
+    if res is None or res.status_code != 200:
+        if res is not None:
+            res.close()
+        return [], []
+    try:
+        root = ET.fromstring(seo.read_body(res, seo.MAX_SITEMAP_BYTES))
+    except (ET.ParseError, DefusedXmlException):
+        return [], []
+    locations = [el.text.strip() for el in root.iter() if el.tag.split('}')[-1] == 'loc' and el.text]
+    if root.tag.split('}')[-1] == 'sitemapindex':
+        return [], locations
+    return locations, []
+
+
+def sitemap_urls(website: object, limit: int) -> tuple:
+    """Sitemap URLs.
+
+    Collects the page URLs of a website from its sitemap, the ones listed in robots.txt or else the first
+    of the usual sitemap paths found. Sitemap indexes are followed, and only the URLs of the website's own
+    domain are kept.
+
+    Args:
+        website (object): Website model object.
+        limit (int): The most URLs to collect.
+
+    Returns:
+        tuple: The domain the pages are requested with and the paths of the pages.
+    """
+    domain = seo.canonical_domain(website)
+    if not domain:
+        return None, []
+    https = website.has_ssl
+    robots, listed = seo.check_robots(website, domain, https)
+    listed = [urlsplit(url).path for url in listed if urlsplit(url).hostname in [domain, None]]
+
+    paths, seen = [], set()
+    level = list(dict.fromkeys(listed)) or seo.SITEMAP_PATHS
+    for depth in range(MAX_SITEMAP_DEPTH + 1):
+        children = []
+        for sitemap in level:
+            if sitemap in seen:
+                continue
+            seen.add(sitemap)
+            pages, nested = _locations(domain, sitemap, https)
+            paths += [urlsplit(url).path or '/' for url in pages if urlsplit(url).hostname == domain]
+            children += [urlsplit(url).path for url in nested if urlsplit(url).hostname in [domain, None]]
+            if len(paths) >= limit:
+                return domain, list(dict.fromkeys(paths))[:limit]
+            # The usual paths are alternatives of each other, the first sitemap found is enough
+            if not listed and depth == 0 and (pages or nested):
+                break
+        level = children
+    return domain, list(dict.fromkeys(paths))[:limit]
+
+
+def warm_job(job: object, params: dict) -> dict:
+    """Warm cache.
+
+    Requests the pages in the sitemap of a website from the local web server, so the page caches are
+    filled before the visitors hit them, i.e. after a staging push. The requests run a few at once and
+    are spread out to the rate limit, so the website isn't overwhelmed.
+
+    Args:
+        job (object): The Job model object.
+        params (dict): The website ID, the concurrency and the requests per second.
+
+    Returns:
+        dict: The number of the pages warmed, failed and the time it took.
+    """
+    website = Website.objects.select_related('user').get(id=params.get('website_id'))
+    domain, paths = sitemap_urls(website, settings.FASTCP_WARMUP_MAX_URLS)
+    if not paths:
+        raise ValueError('No pages were found in the sitemap of the website.')
+
+    https = website.has_ssl
+    results = {'pages': len(paths), 'warmed': 0, 'failed': 0, 'failed_paths': []}
+    lock = threading.Lock()
+    jobs.report_progress(job, 0, len(paths), results=results)
+    started = time.monotonic()
+
+    def warm(path):
+        res = seo.fetch(domain, path, https)
+        ok = res is not None and res.status_code < 400
+        if res is not None:
+            # The whole page is read so the caches store it
+            for chunk in res.iter_content(65536):
+                pass
+            res.close()
+        with lock:
+            results['warmed' if ok else 'failed'] += 1
+            if not ok and len(results['failed_paths']) < jobs.MAX_RESULTS:
+                results['failed_paths'].append(path)
+
+    interval = 1 / params.get('rate', 5)
+    with ThreadPoolExecutor(max_workers=params.get('concurrency', 2)) as pool:
+        futures = []
+        for i, path in enumerate(paths):
+            futures.append(pool.submit(warm, path))
+            time.sleep(interval)
+            if i % 20 == 19:
+                with lock:
+                    jobs.report_progress(job, results['warmed'] + results['failed'], results=results)
+        for future in futures:
+            future.result()
+    results['seconds'] = round(time.monotonic() - started, 1)
+    jobs.report_progress(job, len(paths), results=results)
+    return results
+
+
+def start_warmup(user: object, website: object, concurrency: int = 2, rate: int = 5) -> object:
+    """Starts warming the caches of a website as a background job."""
+    params = {'website_id': website.id, 'concurrency': concurrency, 'rate': rate}
+    return jobs.start_job(user, 'warm_cache', website.label, params, warm_job)
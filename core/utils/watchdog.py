@@ -0,0 +1,146 @@
+import os, json, time, psutil
+from django.conf import settings
+from core.models import User
+from core.utils.filesystem import get_user_paths
+
+
+WATCHDOG_STATE_PATH = '/var/fastcp/.config/watchdog.json'
+
+# Directories where no legitimate binary of a hosted user should live
+SUSPICIOUS_EXE_DIRS = ['/tmp/', '/var/tmp/', '/dev/shm/']
+
+
+def _read_state() -> dict:
+    """Returns the CPU hits recorded in the previous runs keyed by PID."""
+    if os.path.exists(WATCHDOG_STATE_PATH):
+        with open(WATCHDOG_STATE_PATH) as f:
+            return json.load(f)
+    return {}
+
+
+def _write_state(state: dict) -> None:
+    """Saves the CPU hits keyed by PID."""
+    os.makedirs(os.path.dirname(WATCHDOG_STATE_PATH), exist_ok=True)
+    with open(WATCHDOG_STATE_PATH, 'w') as f:
+        json.dump(state, f)
+
+
+def _evidence(proc: psutil.Process, reasons: list, cpu: float, connections: int) -> dict:
+    """Returns the details of a flagged process."""
+    try:
+        cmdline = ' '.join(proc.cmdline())[:500]
+    except psutil.Error:
+        cmdline = None
+    try:
+        exe = proc.exe()
+    except psutil.Error:
+        exe = None
+    return {
+        'pid': proc.pid,
+        'user': proc.info.get('username'),
+        'name': proc.info.get('name'),
+        'exe': exe,
+        'cmdline': cmdline,
+        'cpu_percent': cpu,
+        'connections': connections,
+        'reasons': reasons
+    }
+
+
+def scan_processes(interval: float = 2) -> list:
+    """Scan processes of hosted users.
+
+    Flags the processes of hosted users (PHP-FPM workers excluded) that keep a CPU core busy across
+    consecutive scans, run binaries from temp directories (a common trait of cryptominers dropped through
+    vulnerable sites), or hold an excessive number of outbound connections. Users running an excessive
+    number of processes, as in a forkbomb, are flagged as a whole.
+
+    Args:
+        interval (float): Seconds to measure the CPU usage for.
+
+    Returns:
+        list: A list of dicts with the evidence of each flagged process.
+    """
+    usernames = {u.username: u for u in User.objects.filter(is_superuser=False)}
+    procs = []
+    counts = {}
+    for proc in psutil.process_iter(['pid', 'name', 'username', 'create_time']):
+        username = proc.info.get('username')
+        if username in usernames:
+            counts[username] = counts.get(username, 0) + 1
+        if username in usernames and not (proc.info.get('name') or '').startswith('php-fpm'):
+            try:
+                proc.cpu_percent()
+                procs.append(proc)
+            except psutil.Error:
+                pass
+
+    time.sleep(interval)
+
+    state = _read_state()
+    new_state = {}
+    flagged = []
+    for username, count in counts.items():
+        if count >= settings.FASTCP_WATCHDOG_MAX_PROCESSES:
+            flagged.append({
+                'pid': None,
+                'user': username,
+                'name': 'processes',
+                'exe': None,
+                'cmdline': None,
+                'cpu_percent': None,
+                'connections': None,
+                'reasons': [f'Running {count} processes, possibly a forkbomb.']
+            })
+
+    for proc in procs:
+        try:
+            cpu = proc.cpu_percent()
+            exe = proc.exe()
+            connections = len([c for c in proc.connections(kind='inet') if c.raddr and c.status == psutil.CONN_ESTABLISHED])
+        except psutil.Error:
+            continue
+
+        reasons = []
+        key = f'{proc.pid}-{int(proc.info.get("create_time"))}'
+        if cpu >= settings.FASTCP_WATCHDOG_CPU_PERCENT:
+            hits = state.get(key, 0) + 1
+            new_state[key] = hits
+            if hits >= settings.FASTCP_WATCHDOG_CPU_RUNS:
+                reasons.append(f'Sustained CPU usage of {cpu}% over {hits} scans.')
+
+        tmp_dirs = SUSPICIOUS_EXE_DIRS + [get_user_paths(usernames.get(proc.info.get('username'))).get('tmp_path') + '/']
+        if exe and (any(exe.startswith(d) for d in tmp_dirs) or exe.endswith(' (deleted)')):
+            reasons.append(f'Running an unknown binary from {exe}.')
+
+        if connections >= settings.FASTCP_WATCHDOG_MAX_CONNECTIONS:
+            reasons.append(f'Holding {connections} outbound connections.')
+
+        if reasons:
+            flagged.append(_evidence(proc, reasons, cpu, connections))
+
+    _write_state(new_state)
+    return flagged
+
+
+def kill_process(pid: int) -> bool:
+    """Kills a process and returns True if it was killed."""
+    try:
+        proc = psutil.Process(pid)
+        proc.kill()
+        proc.wait(timeout=5)
+        return True
+    except (psutil.Error, psutil.TimeoutExpired):
+        return False
+
+
+def kill_user_processes(username: str) -> bool:
+    """Kills all processes of a user and returns True on success."""
+    killed = True
+    for proc in psutil.process_iter(['username']):
+        if proc.info.get('username') == username:
+            try:
+                proc.kill()
+            except psutil.Error:
+                killed = False
+    return killed
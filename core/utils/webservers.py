@@ -0,0 +1,59 @@
+import os
+from core.utils import filesystem
+
+
+class ApacheBackend(object):
+    """Apache backend.
+
+    NGINX terminates the connections and proxies the requests to Apache, which runs PHP through the FPM
+    socket of the website. This is the default backend as it supports .htaccess files.
+    """
+    name = 'apache'
+    label = 'NGINX + Apache'
+
+    def write_vhosts(self, website: object, only_nginx: bool = False) -> bool:
+        """Writes the vhosts of the website and reloads the web servers."""
+        created = filesystem.create_nginx_vhost(website)
+        if not only_nginx:
+            created = filesystem.create_apache_vhost(website) and created
+        return created
+
+
+class NginxBackend(ApacheBackend):
+    """NGINX backend.
+
+    NGINX passes the PHP requests to the FPM socket of the website directly and serves the static files
+    itself. It's lighter and faster, but .htaccess files have no effect.
+    """
+    name = 'nginx'
+    label = 'NGINX only'
+
+    def write_vhosts(self, website: object, only_nginx: bool = False) -> bool:
+        """Writes the NGINX vhost and removes the Apache vhost left from the Apache backend."""
+        created = filesystem.create_nginx_vhost(website)
+        if not only_nginx and os.path.exists(filesystem.get_website_paths(website).get('apache_vhost_conf')):
+            filesystem.delete_apache_vhost(website)
+        return created
+
+
+class ProxyBackend(NginxBackend):
+    """Proxy backend.
+
+    NGINX proxies the requests to an app of the website, i.e. a Node.js, Python or Go server, listening
+    on a local port or a unix socket instead of serving a document root. The app can be kept running by
+    a systemd service of FastCP.
+    """
+    name = 'proxy'
+    label = 'NGINX reverse proxy to an app'
+
+
+BACKENDS = {
+    ApacheBackend.name: ApacheBackend,
+    NginxBackend.name: NginxBackend,
+    ProxyBackend.name: ProxyBackend,
+}
+
+
+def get_backend(website: object) -> ApacheBackend:
+    """Returns the web server backend of a website."""
+    return BACKENDS.get(website.get_backend(), ApacheBackend)()
@@ -1,15 +1,18 @@
 from django.shortcuts import render, redirect
 from django.http import JsonResponse
-from django.contrib.auth.decorators import login_required, user_passes_test
+from django.contrib.auth.decorators import user_passes_test
 from .forms import LoginForm
 from django.contrib.auth import login, logout
-from .models import User
-from django.http import FileResponse, Http404
+from .models import User, Website
+from .utils.filesystem import get_user_paths, tail_lines
+from .utils import devices, fail2ban, logstream, metrics as panel_metrics
+from django.views.decorators.http import require_GET
+from django.http import Http404, HttpResponse
 from django.conf import settings
-import os
+import os, secrets
 
 
-@user_passes_test(lambda user: not user.is_authenticated, login_url='/', redirect_field_name=None)
+@user_passes_test(lambda user: not user.is_authenticated, login_url='spa', redirect_field_name=None)
 def sign_in(request):
     """Custom login.
     
@@ -22,7 +25,25 @@ def sign_in(request):
             username = form.cleaned_data.get('username')
             user = User.objects.filter(username=username).first()
             login(request, user)
-            return redirect('/dashboard')
+            
+            # Sessions that aren't remembered end with the browser
+            remember_me = form.cleaned_data.get('remember_me')
+            request.session['remember_me'] = remember_me
+            if not remember_me:
+                request.session.set_expiry(0)
+            
+            device, token, is_new = devices.register_login(request, user)
+            request.session['device_id'] = device.id
+            if is_new:
+                devices.alert_new_device(user, device)
+            
+            response = redirect('spa')
+            response.set_cookie(
+                devices.DEVICE_COOKIE, token, max_age=365 * 86400, secure=settings.SESSION_COOKIE_SECURE,
+                httponly=True, samesite='Lax'
+            )
+            return response
+        fail2ban.log_failed_login(request, request.POST.get('username'))
     context = {
         'form': form
     }
@@ -30,18 +51,58 @@ def sign_in(request):
 
 def sign_out(request):
     logout(request)
-    return redirect('/dashboard')
+    # The URL names keep the redirects under FASTCP_BASE_PATH
+    return redirect(settings.LOGIN_URL)
 
-@login_required
-def download_file(request):
-    path = request.GET.get('path')
-    user = request.user
-    if user.is_superuser:
-        BASE_PATH = settings.FILE_MANAGER_ROOT
-    else:
-        BASE_PATH = os.path.join(settings.FILE_MANAGER_ROOT, user.username)
+@require_GET
+def debug_error(request, slug):
+    """Debug error page.
     
-    if path and path.startswith(BASE_PATH) and os.path.exists(path):
-        response = FileResponse(open(path, 'rb'))
-        return response
-    raise Http404
\ No newline at end of file
+    NGINX proxies the 5xx responses of the websites in debug mode here. The page contains the last lines
+    of the website's error log, but only if the client IP is allowed. Others get a generic error page.
+    """
+    website = Website.objects.filter(slug=slug, debug_mode=True).first()
+    key = request.META.get('HTTP_X_FASTCP_DEBUG_KEY')
+    if not website or not website.debug_key or key != website.debug_key:
+        raise Http404
+    
+    try:
+        status_code = int(request.META.get('HTTP_X_FASTCP_STATUS'))
+    except (TypeError, ValueError):
+        status_code = 500
+    
+    lines = None
+    if request.META.get('HTTP_X_REAL_IP') in website.debug_allowed_ips():
+        # The error log depends on the backend, and the owner could have swapped it for a symlink
+        f = logstream.open_log(logstream.site_logs(website).get('error'), get_user_paths(website.user).get('logs_path'))
+        if f:
+            with f:
+                lines = tail_lines(f, settings.FASTCP_DEBUG_LOG_LINES)
+    
+    context = {
+        'website': website,
+        'status_code': status_code,
+        'lines': lines
+    }
+    return render(request, 'debug/error.html', context=context, status=status_code)
+
+
+@require_GET
+def metrics(request):
+    """Prometheus metrics.
+    
+    Serves the panel and server metrics in the Prometheus text format. The endpoint only exists if a
+    bearer token or an IP allowlist is set, and the scraper must send the token or connect from an
+    allowed IP.
+    """
+    token = settings.FASTCP_METRICS_TOKEN
+    allowed_ips = settings.FASTCP_METRICS_ALLOWED_IPS
+    if not token and not allowed_ips:
+        raise Http404
+    
+    sent = request.META.get('HTTP_AUTHORIZATION', '')
+    token_ok = bool(token) and secrets.compare_digest(sent, f'Bearer {token}')
+    if not token_ok and request.META.get('REMOTE_ADDR') not in allowed_ips:
+        return HttpResponse('Forbidden\n', status=403, content_type='text/plain')
+    return HttpResponse(panel_metrics.prometheus_text(panel_metrics.collect()), content_type='text/plain; version=0.0.4; charset=utf-8')
+
@@ -0,0 +1,30 @@
+"""
+Gunicorn config of the panel, start it with:
+
+    gunicorn -c fastcp/gunicorn.conf.py fastcp.wsgi
+
+The panel listens on FASTCP_BIND over TCP and, if FASTCP_UNIX_SOCKET is set, on a unix socket as well so
+a local reverse proxy or script can reach it without any open TCP port. Set FASTCP_BIND to an empty
+string to only listen on the unix socket.
+"""
+
+import os, grp
+
+tcp_bind = os.environ.get('FASTCP_BIND', '127.0.0.1:8000')
+unix_socket = os.environ.get('FASTCP_UNIX_SOCKET')
+socket_group = os.environ.get('FASTCP_UNIX_SOCKET_GROUP', 'www-data')
+
+bind = list(filter(None, [tcp_bind, f'unix:{unix_socket}' if unix_socket else None]))
+workers = int(os.environ.get('FASTCP_WORKERS', 3))
+timeout = 120
+
+
+def when_ready(server):
+    """Only the owner and the socket group, i.e. NGINX, can connect to the unix socket."""
+    if not unix_socket:
+        return
+    try:
+        os.chown(unix_socket, -1, grp.getgrnam(socket_group).gr_gid)
+    except (KeyError, OSError) as e:
+        server.log.warning(f'Cannot set the group of {unix_socket} to {socket_group}: {e}')
+    os.chmod(unix_socket, 0o660)
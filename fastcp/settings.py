@@ -17,12 +17,36 @@ import os
 # Build paths inside the project like this: BASE_DIR / 'subdir'.
 BASE_DIR = Path(__file__).resolve().parent.parent
 
+# Invalid environment values fall back to the defaults and they are reported by the config checks
+FASTCP_CONFIG_ERRORS = []
+
+
+def env_number(name, default, cast=int):
+    value = os.environ.get(name, default)
+    try:
+        return cast(value)
+    except (TypeError, ValueError):
+        FASTCP_CONFIG_ERRORS.append((name, f'{value!r} is not a valid {cast.__name__}, using {default} instead.'))
+        return default
+
 
 # Quick-start development settings - unsuitable for production
 # See https://docs.djangoproject.com/en/3.2/howto/deployment/checklist/
 
 # SECURITY WARNING: keep the secret key used in production secret!
-SECRET_KEY = os.environ.get('FASTCP_APP_SECRET', 'django-insecure-swm^3n$0#i^x3uuh3hy&_h(%ud$a6qfo6#tnukvxmyem7j3x8=')
+# The secret rotated with the rotate-secret command is kept in a root only file and overrides the environment
+FASTCP_SECRET_FILE = os.environ.get('FASTCP_SECRET_FILE', '/etc/fastcp/secret.key')
+
+
+def read_secret_file(path):
+    try:
+        with open(path) as f:
+            return f.read().strip() or None
+    except OSError:
+        return None
+
+
+SECRET_KEY = read_secret_file(FASTCP_SECRET_FILE) or os.environ.get('FASTCP_APP_SECRET', 'django-insecure-swm^3n$0#i^x3uuh3hy&_h(%ud$a6qfo6#tnukvxmyem7j3x8=')
 
 # SECURITY WARNING: don't run with debug turned on in production!
 DEBUG = os.environ.get('IS_DEBUG') is not None
@@ -83,18 +107,41 @@ INSTALLED_APPS = [
 ]
 
 CRON_CLASSES = [
-    'core.crons.ProcessSsls'
+    'core.crons.ProcessSsls',
+    'core.crons.MonitorDisk',
+    'core.crons.VerifyReboot',
+    'core.crons.ProcessWatchdog',
+    'core.crons.SyntheticChecks',
+    'core.crons.ExpireDevMode',
+    'core.crons.RecoverOperations',
+    'core.crons.RecordOomKills',
+    'core.crons.UploadTelemetry',
+    'core.crons.PurgeExports',
+    'core.crons.PurgeExpiredData',
+    'core.crons.ExportMetrics',
+    'core.crons.SyncLogForwarding',
+    'core.crons.RecordUsage',
+    'core.crons.RollupUsage',
+    'core.crons.CheckIntegrity',
+    'core.crons.AuditDependencies',
+    'core.crons.CheckSeo'
 ]
 DJANGO_CRON_DELETE_LOGS_OLDER_THAN = 1
 
 MIDDLEWARE = [
+    'core.middleware.ProxyHeadersMiddleware',
     'django.middleware.security.SecurityMiddleware',
+    'core.middleware.CompressionMiddleware',
     'django.contrib.sessions.middleware.SessionMiddleware',
     'django.middleware.common.CommonMiddleware',
     'django.middleware.csrf.CsrfViewMiddleware',
     'django.contrib.auth.middleware.AuthenticationMiddleware',
+    'core.middleware.SessionTimeoutMiddleware',
+    'core.middleware.RevokedDeviceMiddleware',
     'django.contrib.messages.middleware.MessageMiddleware',
     'django.middleware.clickjacking.XFrameOptionsMiddleware',
+    'core.middleware.SecurityHeadersMiddleware',
+    'core.middleware.AccessLogMiddleware',
 ]
 
 if DEBUG:
@@ -107,10 +154,13 @@ else:
 ROOT_URLCONF = 'fastcp.urls'
 
 
+# Templates placed here by the admin override the bundled ones
+FASTCP_TEMPLATES_DIR = os.environ.get('FASTCP_TEMPLATES_DIR', '/var/fastcp/templates')
+
 TEMPLATES = [
     {
         'BACKEND': 'django.template.backends.django.DjangoTemplates',
-        'DIRS': ['templates'],
+        'DIRS': [FASTCP_TEMPLATES_DIR, 'templates'],
         'APP_DIRS': True,
         'OPTIONS': {
             'context_processors': [
@@ -176,10 +226,31 @@ USE_TZ = True
 # Static files (CSS, JavaScript, Images)
 # https://docs.djangoproject.com/en/3.2/howto/static-files/
 
-STATIC_URL = '/static/'
+# The panel can be served under a sub-path by a reverse proxy that strips the prefix, i.e. /fastcp/
+FASTCP_BASE_PATH = '/' + os.environ.get('FASTCP_BASE_PATH', '/').strip('/') + '/'
+FASTCP_BASE_PATH = FASTCP_BASE_PATH.replace('//', '/')
+FORCE_SCRIPT_NAME = FASTCP_BASE_PATH.rstrip('/') or None
+SESSION_COOKIE_PATH = FASTCP_BASE_PATH
+CSRF_COOKIE_PATH = FASTCP_BASE_PATH
+
+# The X-Forwarded-* headers are only trusted from these proxies
+FASTCP_TRUSTED_PROXIES = list(filter(None, [ip.strip() for ip in os.environ.get('FASTCP_TRUSTED_PROXIES', '').split(',')]))
+USE_X_FORWARDED_HOST = len(FASTCP_TRUSTED_PROXIES) > 0
+if FASTCP_TRUSTED_PROXIES:
+    SECURE_PROXY_SSL_HEADER = ('HTTP_X_FORWARDED_PROTO', 'https')
+
+STATIC_URL = f'{FASTCP_BASE_PATH}static/'
 STATIC_ROOT = BASE_DIR / 'staticfiles'
 STATICFILES_STORAGE = 'core.storage.WhiteNoiseStaticFilesStorage'
 
+# The bundled UI files are served as they are. For white-label builds, the files in FASTCP_UI_DIR replace the
+# bundled ones by the same relative paths, i.e. core/assets/css/sb-admin-2.min.css, and are served straight
+# from the directory without running collectstatic.
+FASTCP_UI_DIR = os.environ.get('FASTCP_UI_DIR')
+if FASTCP_UI_DIR and os.path.isdir(FASTCP_UI_DIR):
+    STATICFILES_DIRS = [FASTCP_UI_DIR]
+    WHITENOISE_USE_FINDERS = True
+
 # Default primary key field type
 # https://docs.djangoproject.com/en/3.2/ref/settings/#default-auto-field
 
@@ -195,9 +266,213 @@ PHP_INSTALL_PATH = os.environ.get('PHP_INSTALL_PATH', '/etc/php')
 NGINX_BASE_DIR = os.environ.get('NGINX_BASE_DIR', '/etc/nginx')
 NGINX_VHOSTS_ROOT = os.environ.get('NGINX_VHOSTS_ROOT', '/etc/nginx/vhosts.d')
 APACHE_VHOST_ROOT = os.environ.get('APACHE_VHOST_ROOT', '/etc/apache2/vhosts.d')
+FASTCP_WEBSERVER_BACKEND = os.environ.get('FASTCP_WEBSERVER_BACKEND', 'apache')
 FASTCP_VERSION = os.environ.get('FASTCP_VERSION', '1.0.1')
 LETSENCRYPT_IS_STAGING = os.environ.get('LETSENCRYPT_IS_STAGING') is not None
+FASTCP_DNS_PROPAGATION_SECONDS = env_number('FASTCP_DNS_PROPAGATION_SECONDS', 30)
+
+# The key the DNS provider credentials are encrypted with in the database, created on first use
+FASTCP_CREDENTIALS_KEY_FILE = os.environ.get('FASTCP_CREDENTIALS_KEY_FILE', '/etc/fastcp/credentials.key')
 SERVER_IP_ADDR = os.environ.get('SERVER_IP_ADDR', 'N/A')
 FASTCP_SQL_PASSWORD = os.environ.get('FASTCP_SQL_PASSWORD')
 FASTCP_SQL_USER = os.environ.get('FASTCP_SQL_USER')
-FASTCP_PHPMYADMIN_PATH = os.environ.get('FASTCP_PHPMYADMIN_PATH', '/var/fastcp/phpmyadmin')
\ No newline at end of file
+FASTCP_PANEL_CERT_PATH = os.environ.get('FASTCP_PANEL_CERT_PATH', '/etc/nginx/ssl/fastcp.crt')
+FASTCP_PHPCS_PATH = os.environ.get('FASTCP_PHPCS_PATH', '/usr/local/bin/phpcs')
+FASTCP_COMPAT_MAX_FILES = env_number('FASTCP_COMPAT_MAX_FILES', 5000)
+FASTCP_DEV_MODE_MAX_HOURS = env_number('FASTCP_DEV_MODE_MAX_HOURS', 24)
+FASTCP_UNIX_SOCKET = os.environ.get('FASTCP_UNIX_SOCKET')
+FASTCP_PANEL_UPSTREAM = os.environ.get('FASTCP_PANEL_UPSTREAM', f'http://unix:{FASTCP_UNIX_SOCKET}:' if FASTCP_UNIX_SOCKET else 'http://127.0.0.1:8000')
+FASTCP_DEBUG_LOG_LINES = env_number('FASTCP_DEBUG_LOG_LINES', 50)
+FASTCP_PHPMYADMIN_PATH = os.environ.get('FASTCP_PHPMYADMIN_PATH', '/var/fastcp/phpmyadmin')
+FASTCP_STORAGE_DRIVER = os.environ.get('FASTCP_STORAGE_DRIVER', 'auto')
+FASTCP_ZFS_DATASET = os.environ.get('FASTCP_ZFS_DATASET')
+FASTCP_DISK_ALERT_PERCENT = env_number('FASTCP_DISK_ALERT_PERCENT', 90, float)
+FASTCP_CHECK_RETENTION_DAYS = env_number('FASTCP_CHECK_RETENTION_DAYS', 30)
+FASTCP_CHECK_TIMEOUT = env_number('FASTCP_CHECK_TIMEOUT', 10)
+FASTCP_CHECK_WORKERS = env_number('FASTCP_CHECK_WORKERS', 8)
+FASTCP_ROLLBACK_CHECKS = env_number('FASTCP_ROLLBACK_CHECKS', 3)
+FASTCP_WATCHDOG_CPU_PERCENT = env_number('FASTCP_WATCHDOG_CPU_PERCENT', 90, float)
+FASTCP_WATCHDOG_CPU_RUNS = env_number('FASTCP_WATCHDOG_CPU_RUNS', 3)
+FASTCP_WATCHDOG_MAX_CONNECTIONS = env_number('FASTCP_WATCHDOG_MAX_CONNECTIONS', 200)
+FASTCP_WATCHDOG_MAX_PROCESSES = env_number('FASTCP_WATCHDOG_MAX_PROCESSES', 300)
+FASTCP_WATCHDOG_KILL = os.environ.get('FASTCP_WATCHDOG_KILL') is not None
+FASTCP_SESSION_IDLE_MINS = env_number('FASTCP_SESSION_IDLE_MINS', 60)
+FASTCP_SESSION_MAX_HOURS = env_number('FASTCP_SESSION_MAX_HOURS', 12)
+FASTCP_SESSION_REMEMBER_DAYS = env_number('FASTCP_SESSION_REMEMBER_DAYS', 14)
+SESSION_COOKIE_AGE = FASTCP_SESSION_REMEMBER_DAYS * 86400
+
+# The panel authenticates with session cookies guarded by CSRF tokens. The session cookie is never
+# readable by scripts, the CSRF cookie has to be as the UI sends it back in the X-CSRFToken header.
+FASTCP_INSECURE_COOKIES = os.environ.get('FASTCP_INSECURE_COOKIES') is not None
+SESSION_COOKIE_SECURE = not (DEBUG or FASTCP_INSECURE_COOKIES)
+SESSION_COOKIE_HTTPONLY = True
+SESSION_COOKIE_SAMESITE = 'Lax'
+CSRF_COOKIE_SECURE = SESSION_COOKIE_SECURE
+CSRF_COOKIE_HTTPONLY = False
+CSRF_COOKIE_SAMESITE = 'Strict'
+
+# Security headers of the panel responses
+FASTCP_CSP_MODE = os.environ.get('FASTCP_CSP_MODE', 'report-only')
+FASTCP_CSP_REPORT_URI = os.environ.get('FASTCP_CSP_REPORT_URI')
+X_FRAME_OPTIONS = 'DENY'
+SECURE_CONTENT_TYPE_NOSNIFF = True
+SECURE_REFERRER_POLICY = 'same-origin'
+
+# Outgoing emails, i.e. the new sign in alerts
+FASTCP_LOGIN_ALERT_EMAIL = os.environ.get('FASTCP_LOGIN_ALERT_EMAIL') is not None
+EMAIL_HOST = os.environ.get('EMAIL_HOST', 'localhost')
+EMAIL_PORT = env_number('EMAIL_PORT', 25)
+EMAIL_HOST_USER = os.environ.get('EMAIL_HOST_USER', '')
+EMAIL_HOST_PASSWORD = os.environ.get('EMAIL_HOST_PASSWORD', '')
+EMAIL_USE_TLS = os.environ.get('EMAIL_USE_TLS') is not None
+DEFAULT_FROM_EMAIL = os.environ.get('DEFAULT_FROM_EMAIL', 'fastcp@localhost')
+
+# Where the generated admin credentials are delivered on the first run, and the root only file that keeps
+# them if none is set or the delivery fails
+FASTCP_CREDENTIALS_EMAIL = os.environ.get('FASTCP_CREDENTIALS_EMAIL')
+FASTCP_CREDENTIALS_WEBHOOK = os.environ.get('FASTCP_CREDENTIALS_WEBHOOK')
+FASTCP_CREDENTIALS_FILE = os.environ.get('FASTCP_CREDENTIALS_FILE', '/root/fastcp-credentials.txt')
+
+# Mailboxes are stored as Maildirs owned by the virtual mail user
+FASTCP_VMAIL_ROOT = os.environ.get('FASTCP_VMAIL_ROOT', '/var/vmail')
+FASTCP_VMAIL_UID = env_number('FASTCP_VMAIL_UID', 5000)
+FASTCP_MAIL_HOSTNAME = os.environ.get('FASTCP_MAIL_HOSTNAME')
+
+# DNS zones served by the local BIND
+FASTCP_BIND_ZONES_DIR = os.environ.get('FASTCP_BIND_ZONES_DIR', '/var/lib/bind/fastcp')
+FASTCP_DNS_NAMESERVERS = list(filter(None, [ns.strip() for ns in os.environ.get('FASTCP_DNS_NAMESERVERS', '').split(',')]))
+
+# The most requests the panel can run in one batch request
+FASTCP_BATCH_MAX_REQUESTS = env_number('FASTCP_BATCH_MAX_REQUESTS', 50)
+
+# The port ProFTPD serves the virtual SFTP accounts of the websites on
+FASTCP_SFTP_PORT = env_number('FASTCP_SFTP_PORT', 2222)
+
+# The parallel workers that fix the ownership of the website files
+FASTCP_OWNERSHIP_WORKERS = env_number('FASTCP_OWNERSHIP_WORKERS', 4)
+
+# Staging websites get a subdomain of this domain if set, i.e. slug.staging.example.com, otherwise
+# staging-slug of the domain of the website
+FASTCP_STAGING_DOMAIN = os.environ.get('FASTCP_STAGING_DOMAIN')
+
+# Processes of users killed by the OOM killer, the users are notified once they reach the kills in 24 hours
+FASTCP_OOM_NOTIFY_USERS = os.environ.get('FASTCP_OOM_NOTIFY_USERS') is not None
+FASTCP_OOM_ALERT_KILLS = env_number('FASTCP_OOM_ALERT_KILLS', 3)
+FASTCP_OOM_RETENTION_DAYS = env_number('FASTCP_OOM_RETENTION_DAYS', 30)
+
+# Following the logs of a service ends after this many seconds, the client continues with the last cursor
+FASTCP_LOG_FOLLOW_SECONDS = env_number('FASTCP_LOG_FOLLOW_SECONDS', 55)
+
+# Anonymous usage stats and crash reports are only sent if the admin opts in
+FASTCP_TELEMETRY = os.environ.get('FASTCP_TELEMETRY') is not None
+FASTCP_TELEMETRY_URL = os.environ.get('FASTCP_TELEMETRY_URL')
+FASTCP_TELEMETRY_MAX_CRASHES = env_number('FASTCP_TELEMETRY_MAX_CRASHES', 100)
+
+# The database engines users can create databases with, PostgreSQL is installed on demand
+FASTCP_DATABASE_ENGINES = os.environ.get('FASTCP_DATABASE_ENGINES', 'mysql,postgresql').split(',')
+
+# Uploaded database dumps are kept here until they are imported
+FASTCP_DB_IMPORT_DIR = os.environ.get('FASTCP_DB_IMPORT_DIR', '/var/fastcp/imports')
+FASTCP_DB_IMPORT_MAX_MB = env_number('FASTCP_DB_IMPORT_MAX_MB', 10240)
+
+# Website exports are kept here for download until they expire
+FASTCP_EXPORTS_DIR = os.environ.get('FASTCP_EXPORTS_DIR', '/var/fastcp/exports')
+FASTCP_EXPORT_RETENTION_HOURS = env_number('FASTCP_EXPORT_RETENTION_HOURS', 24)
+
+# Days to keep the finished jobs, the completed operations and the notifications for
+FASTCP_JOB_RETENTION_DAYS = env_number('FASTCP_JOB_RETENTION_DAYS', 30)
+FASTCP_OPERATION_RETENTION_DAYS = env_number('FASTCP_OPERATION_RETENTION_DAYS', 90)
+FASTCP_NOTIFICATION_RETENTION_DAYS = env_number('FASTCP_NOTIFICATION_RETENTION_DAYS', 90)
+
+# Optional exporters that push the per-user and per-website metrics to external time-series databases,
+# the InfluxDB URL is the full write endpoint, i.e. https://influx.example.com/api/v2/write?org=o&bucket=b
+FASTCP_METRICS_INFLUX_URL = os.environ.get('FASTCP_METRICS_INFLUX_URL')
+FASTCP_METRICS_INFLUX_TOKEN = os.environ.get('FASTCP_METRICS_INFLUX_TOKEN')
+FASTCP_METRICS_REMOTE_WRITE_URL = os.environ.get('FASTCP_METRICS_REMOTE_WRITE_URL')
+FASTCP_METRICS_REMOTE_WRITE_TOKEN = os.environ.get('FASTCP_METRICS_REMOTE_WRITE_TOKEN')
+
+# /metrics serves the metrics to Prometheus scrapers sending this bearer token or connecting from these
+# IPs, it's disabled unless one of them is set
+FASTCP_METRICS_TOKEN = os.environ.get('FASTCP_METRICS_TOKEN')
+FASTCP_METRICS_ALLOWED_IPS = list(filter(None, [ip.strip() for ip in os.environ.get('FASTCP_METRICS_ALLOWED_IPS', '').split(',')]))
+
+# Ship the panel audit and access logs, and optionally the access logs of the websites, to a remote
+# syslog or Vector endpoint. TLS is used unless FASTCP_SYSLOG_PLAIN is set.
+FASTCP_SYSLOG_HOST = os.environ.get('FASTCP_SYSLOG_HOST')
+FASTCP_SYSLOG_PORT = env_number('FASTCP_SYSLOG_PORT', 6514)
+FASTCP_SYSLOG_PLAIN = os.environ.get('FASTCP_SYSLOG_PLAIN') is not None
+FASTCP_SYSLOG_CA_FILE = os.environ.get('FASTCP_SYSLOG_CA_FILE')
+FASTCP_SYSLOG_SITE_LOGS = os.environ.get('FASTCP_SYSLOG_SITE_LOGS') is not None
+
+if FASTCP_SYSLOG_HOST:
+    LOGGING['handlers']['syslog'] = {
+        'level': 'INFO',
+        'class': 'core.utils.logforward.QueuedSyslogHandler',
+        'host': FASTCP_SYSLOG_HOST,
+        'port': FASTCP_SYSLOG_PORT,
+        'tls': not FASTCP_SYSLOG_PLAIN,
+        'ca_file': FASTCP_SYSLOG_CA_FILE,
+    }
+    for logger in ['fastcp.access', 'fastcp.audit']:
+        LOGGING['loggers'][logger] = {'handlers': ['syslog'], 'level': 'INFO', 'propagate': False}
+
+# Malicious uploads are moved here, readable by root only
+FASTCP_QUARANTINE_DIR = os.environ.get('FASTCP_QUARANTINE_DIR', '/var/fastcp/quarantine')
+
+# Days to keep the 5 minute and the hourly resource usage samples of the users and websites for
+FASTCP_USAGE_RAW_RETENTION_DAYS = env_number('FASTCP_USAGE_RAW_RETENTION_DAYS', 2)
+FASTCP_USAGE_RETENTION_DAYS = env_number('FASTCP_USAGE_RETENTION_DAYS', 40)
+
+# The known good hashes of the PHP files of the websites that monitor their integrity
+FASTCP_INTEGRITY_DIR = os.environ.get('FASTCP_INTEGRITY_DIR', '/var/fastcp/integrity')
+
+# The last Composer and NPM audit reports of the websites
+FASTCP_DEPENDENCIES_DIR = os.environ.get('FASTCP_DEPENDENCIES_DIR', '/var/fastcp/dependencies')
+
+# The last SEO check reports of the websites
+FASTCP_SEO_DIR = os.environ.get('FASTCP_SEO_DIR', '/var/fastcp/seo')
+
+# The most pages of a sitemap requested to warm the caches of a website
+FASTCP_WARMUP_MAX_URLS = env_number('FASTCP_WARMUP_MAX_URLS', 1000)
+
+# The ModSecurity rules of the websites with the WAF on, and the OWASP Core Rule Set they load
+FASTCP_WAF_RULES_DIR = os.environ.get('FASTCP_WAF_RULES_DIR', '/etc/nginx/modsecurity')
+FASTCP_WAF_CRS_DIR = os.environ.get('FASTCP_WAF_CRS_DIR', '/usr/share/modsecurity-crs')
+
+# The MaxMind GeoIP2 country database the websites block the countries with, kept up to date by geoipupdate,
+# and the NGINX config that loads it
+FASTCP_GEOIP_DB = os.environ.get('FASTCP_GEOIP_DB', '/usr/share/GeoIP/GeoLite2-Country.mmdb')
+FASTCP_GEOIP_CONF = os.environ.get('FASTCP_GEOIP_CONF', '/etc/nginx/conf.d/fastcp-geoip2.conf')
+
+# The HTTP basic auth users files of the protected paths of the websites
+FASTCP_HTPASSWD_DIR = os.environ.get('FASTCP_HTPASSWD_DIR', '/etc/nginx/htpasswd')
+
+# The first local port of the apps of the proxy backend, and the ports each user gets from there on
+FASTCP_APP_PORTS_START = env_number('FASTCP_APP_PORTS_START', 20000)
+FASTCP_APP_PORTS_PER_USER = env_number('FASTCP_APP_PORTS_PER_USER', 20)
+
+# The panel database is backed up here before the migrations, and the latest backups that are kept
+FASTCP_DB_BACKUPS_DIR = os.environ.get('FASTCP_DB_BACKUPS_DIR', '/var/fastcp/db-backups')
+FASTCP_DB_BACKUPS_KEEP = env_number('FASTCP_DB_BACKUPS_KEEP', 5)
+
+# The logs of FastCP itself, rotated along with the logs of the websites
+FASTCP_LOG_DIR = os.environ.get('FASTCP_LOG_DIR', '/var/log/fastcp')
+
+# The background jobs of a kind that run at once, the others wait in the queue, and the most jobs of a kind
+# that can wait. Server backups always run one at a time.
+FASTCP_JOB_CONCURRENCY = env_number('FASTCP_JOB_CONCURRENCY', 2)
+FASTCP_JOB_QUEUE_LIMIT = env_number('FASTCP_JOB_QUEUE_LIMIT', 10)
+
+# The most scheduled tasks run by systemd timers an SSH user can have, and the shortest interval in seconds
+FASTCP_MAX_USER_TASKS = env_number('FASTCP_MAX_USER_TASKS', 20)
+FASTCP_TASK_MIN_INTERVAL = env_number('FASTCP_TASK_MIN_INTERVAL', 10)
+
+# Demo mode lets anyone click through the panel safely, the API requests that would change anything are
+# simulated. All panel users can sign in with FASTCP_DEMO_PASSWORD.
+FASTCP_DEMO_MODE = os.environ.get('FASTCP_DEMO_MODE') is not None
+FASTCP_DEMO_PASSWORD = os.environ.get('FASTCP_DEMO_PASSWORD', 'demo')
+
+if FASTCP_DEMO_MODE:
+    MIDDLEWARE.append('core.middleware.DemoModeMiddleware')
+    # The CRON jobs change the server, so none of them run in demo mode
+    CRON_CLASSES = []
@@ -4,12 +4,14 @@ from django.views.generic import RedirectView
 from django.views.generic import TemplateView
 from django.contrib.auth.decorators import login_required
 from django.contrib import admin
+from core.views import metrics
 
 
 urlpatterns = [
     path('admin/', admin.site.urls),
     path('api/', include('api.urls', namespace='api')),
     path('dashboard/', include('core.urls', namespace='core')),
+    path('metrics', metrics, name='metrics'),
     path('', RedirectView.as_view(pattern_name='spa', permanent=False)),
     re_path(r'^dashboard/.*$', login_required(TemplateView.as_view(template_name='master.html')), name='spa')
 ]
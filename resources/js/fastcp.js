@@ -1,7 +1,7 @@
 require('./bootstrap');
 import Vue from 'vue';
 
-window.axios.defaults.baseURL = '/api/';
+window.axios.defaults.baseURL = BASE_PATH + 'api/';
 
 export const EventBus = new Vue();
 
@@ -50,7 +50,7 @@ import { routes } from './routes';
 const router = new VueRouter({
     mode: 'history',
     routes: routes,
-    base: '/dashboard/'
+    base: BASE_PATH + 'dashboard/'
 });
 
 import { store } from './store';
@@ -60,7 +60,8 @@ Vue.mixin({
         return {
             EventBus: EventBus,
             FM_ROOT: FM_ROOT,
-            PMA_URL: PMA_URL
+            PMA_URL: PMA_URL,
+            BASE_PATH: BASE_PATH
         }
     },
     methods: {
@@ -82,7 +83,7 @@ const app = new Vue({
     methods: {
         signOut() {
             let _this = this;
-            axios.defaults.baseURL = '/dashboard/';
+            axios.defaults.baseURL = BASE_PATH + 'dashboard/';
             axios.get('/sign-out/').then((res) => {
                 _this.$store.commit('unsetUser');
                 window.location = '';
@@ -20,7 +20,7 @@ export const store = new Vuex.Store({
             state.busy = status;
         },
         setUser(state) {
-            axios.defaults.baseURL = '/api/';
+            axios.defaults.baseURL = BASE_PATH + 'api/';
             axios.get('/account/').then((res) => {
                 localStorage.setItem('user', JSON.stringify(res.data));
                 state.user = res.data;